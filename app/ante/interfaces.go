@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/params"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	vestingtypes "github.com/evmos/evmos/v10/x/vesting/types"
 )
 
 // EvmKeeper defines the expected keeper interface used on the AnteHandler
@@ -14,3 +16,8 @@ type EvmKeeper interface {
 	ChainID() *big.Int
 	GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int
 }
+
+// VestingKeeper defines the expected keeper interface used on the AnteHandler
+type VestingKeeper interface {
+	GetParams(ctx sdk.Context) (params vestingtypes.Params)
+}
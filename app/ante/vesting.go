@@ -16,16 +16,30 @@ import (
 // permitted to perform Ethereum Tx.
 type EthVestingTransactionDecorator struct {
 	ak evmtypes.AccountKeeper
+	bk evmtypes.BankKeeper
+	ek EvmKeeper
+	vk VestingKeeper
 }
 
-func NewEthVestingTransactionDecorator(ak evmtypes.AccountKeeper) EthVestingTransactionDecorator {
+func NewEthVestingTransactionDecorator(
+	ak evmtypes.AccountKeeper,
+	bk evmtypes.BankKeeper,
+	ek EvmKeeper,
+	vk VestingKeeper,
+) EthVestingTransactionDecorator {
 	return EthVestingTransactionDecorator{
 		ak: ak,
+		bk: bk,
+		ek: ek,
+		vk: vk,
 	}
 }
 
 // AnteHandle validates that a clawback vesting account has surpassed the
-// vesting cliff and lockup period.
+// vesting cliff and lockup period. If enabled in the vesting params, accounts
+// that surpassed the vesting cliff can perform Ethereum txs with locked coins,
+// as long as the tx doesn't transfer value and the tx fees are covered by the
+// spendable balance.
 //
 // This AnteHandler decorator will fail if:
 //   - the message is not a MsgEthereumTx
@@ -33,6 +47,7 @@ func NewEthVestingTransactionDecorator(ak evmtypes.AccountKeeper) EthVestingTran
 //   - sender account is not a ClawbackvestingAccount
 //   - blocktime is before surpassing vesting cliff end (with zero vested coins) AND
 //   - blocktime is before surpassing all lockup periods (with non-zero locked coins)
+//     and the tx isn't a zero value tx allowed by validateLockedTx
 func (vtd EthVestingTransactionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
@@ -57,25 +72,71 @@ func (vtd EthVestingTransactionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 		// Error if vesting cliff has not passed (with zero vested coins). This
 		// rule does not apply for existing clawback accounts that receive a new
 		// grant while there are already vested coins on the account.
-		vested := clawbackAccount.GetVestedCoins(ctx.BlockTime())
+		vested := clawbackAccount.GetVestedOnly(ctx.BlockTime())
 		if len(vested) == 0 {
 			return ctx, errorsmod.Wrapf(vestingtypes.ErrInsufficientVestedCoins,
 				"cannot perform Ethereum tx with clawback vesting account, that has no vested coins: %s", vested,
 			)
 		}
 
-		// Error if account has locked coins (before surpassing all lockup periods)
+		// Error if account has locked coins (before surpassing all lockup
+		// periods), unless the tx is a zero value tx allowed by the params
 		islocked := clawbackAccount.HasLockedCoins(ctx.BlockTime())
 		if islocked {
-			return ctx, errorsmod.Wrapf(vestingtypes.ErrVestingLockup,
-				"cannot perform Ethereum tx with clawback vesting account, that has locked coins: %s", vested,
-			)
+			if err := vtd.validateLockedTx(ctx, clawbackAccount, msgEthTx); err != nil {
+				return ctx, err
+			}
 		}
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// validateLockedTx checks that an Ethereum tx of a clawback vesting account
+// with locked coins is enabled in the vesting params, doesn't transfer value
+// and that its cost is covered by the simulated spendable balance of the
+// account. The EVM state doesn't account for locked coins, so the spendable
+// balance is the EVM denom balance minus the locked coins.
+func (vtd EthVestingTransactionDecorator) validateLockedTx(
+	ctx sdk.Context,
+	clawbackAccount *vestingtypes.ClawbackVestingAccount,
+	msgEthTx *evmtypes.MsgEthereumTx,
+) error {
+	locked := clawbackAccount.LockedCoins(ctx.BlockTime())
+
+	params := vtd.vk.GetParams(ctx)
+	if !params.EnableZeroValueEthTxs {
+		return errorsmod.Wrapf(vestingtypes.ErrVestingLockup,
+			"cannot perform Ethereum tx with clawback vesting account, that has locked coins: %s", locked,
+		)
+	}
+
+	txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+
+	if value := txData.GetValue(); value != nil && value.Sign() > 0 {
+		return errorsmod.Wrapf(vestingtypes.ErrVestingLockup,
+			"cannot transfer value with Ethereum tx from clawback vesting account, that has locked coins: %s", locked,
+		)
+	}
+
+	denom := vtd.ek.GetParams(ctx).EvmDenom
+	balance := vtd.bk.GetBalance(ctx, clawbackAccount.GetAddress(), denom)
+	spendable := balance.Amount.Sub(locked.AmountOf(denom))
+
+	cost := sdk.NewIntFromBigInt(txData.Cost())
+	if spendable.LT(cost) {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFunds,
+			"spendable balance of clawback vesting account doesn't cover the Ethereum tx cost (%s%s < %s%s)",
+			spendable, denom, cost, denom,
+		)
+	}
+
+	return nil
+}
+
 // TODO: remove once Cosmos SDK is upgraded to v0.46
 
 // VestingDelegationDecorator validates delegation of vested coins
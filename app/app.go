@@ -115,15 +115,18 @@ import (
 
 	"github.com/evmos/evmos/v10/app/ante"
 	v10 "github.com/evmos/evmos/v10/app/upgrades/v10"
+	v11 "github.com/evmos/evmos/v10/app/upgrades/v11"
 	v8 "github.com/evmos/evmos/v10/app/upgrades/v8"
 	v81 "github.com/evmos/evmos/v10/app/upgrades/v8_1"
 	v82 "github.com/evmos/evmos/v10/app/upgrades/v8_2"
 	v9 "github.com/evmos/evmos/v10/app/upgrades/v9"
 	v91 "github.com/evmos/evmos/v10/app/upgrades/v9_1"
 	"github.com/evmos/evmos/v10/x/claims"
+	claimsclient "github.com/evmos/evmos/v10/x/claims/client"
 	claimskeeper "github.com/evmos/evmos/v10/x/claims/keeper"
 	claimstypes "github.com/evmos/evmos/v10/x/claims/types"
 	"github.com/evmos/evmos/v10/x/epochs"
+	epochsclient "github.com/evmos/evmos/v10/x/epochs/client"
 	epochskeeper "github.com/evmos/evmos/v10/x/epochs/keeper"
 	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
 	"github.com/evmos/evmos/v10/x/erc20"
@@ -141,6 +144,7 @@ import (
 	recoverykeeper "github.com/evmos/evmos/v10/x/recovery/keeper"
 	recoverytypes "github.com/evmos/evmos/v10/x/recovery/types"
 	"github.com/evmos/evmos/v10/x/revenue"
+	revenueclient "github.com/evmos/evmos/v10/x/revenue/client"
 	revenuekeeper "github.com/evmos/evmos/v10/x/revenue/keeper"
 	revenuetypes "github.com/evmos/evmos/v10/x/revenue/types"
 	"github.com/evmos/evmos/v10/x/vesting"
@@ -192,7 +196,12 @@ var (
 				ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
 				// Evmos proposal types
 				erc20client.RegisterCoinProposalHandler, erc20client.RegisterERC20ProposalHandler, erc20client.ToggleTokenConversionProposalHandler,
-				incentivesclient.RegisterIncentiveProposalHandler, incentivesclient.CancelIncentiveProposalHandler,
+				incentivesclient.RegisterIncentiveProposalHandler, incentivesclient.RegisterIncentiveSetProposalHandler, incentivesclient.CancelIncentiveProposalHandler,
+				revenueclient.SetDeveloperSharesOverrideProposalHandler, revenueclient.RemoveDeveloperSharesOverrideProposalHandler,
+				revenueclient.SetDeveloperSharesCategoryProposalHandler, revenueclient.RemoveDeveloperSharesCategoryProposalHandler,
+				claimsclient.RegisterCustomActionProposalHandler, claimsclient.RemoveCustomActionProposalHandler,
+				epochsclient.AddEpochProposalHandler, epochsclient.UpdateEpochDurationProposalHandler, epochsclient.SetEpochPausedProposalHandler,
+				epochsclient.SetEpochCatchUpPolicyProposalHandler,
 			},
 		),
 		params.AppModuleBasic{},
@@ -431,6 +440,24 @@ func NewEvmos(
 		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), &stakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
 	)
 
+	// NOTE: the vesting keeper is created before the claims keeper, which pays
+	// the claimed coins into clawback vesting accounts when vesting is enabled
+	app.VestingKeeper = vestingkeeper.NewKeeper(
+		keys[vestingtypes.StoreKey], appCodec, app.GetSubspace(vestingtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, &stakingKeeper, app.EvmKeeper,
+	)
+
+	// NOTE: the claims keeper is created before the governance router, which
+	// references it on the custom actions proposal handler
+	app.ClaimsKeeper = claimskeeper.NewKeeper(
+		appCodec, keys[claimstypes.StoreKey], app.GetSubspace(claimstypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, &stakingKeeper, app.DistrKeeper, app.VestingKeeper,
+	)
+
+	// NOTE: the epochs keeper is created before the governance router, which
+	// references it on the epochs proposal handler. Its hooks are set below.
+	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+
 	// register the proposal types
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
@@ -439,7 +466,10 @@ func NewEvmos(
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(erc20types.RouterKey, erc20.NewErc20ProposalHandler(&app.Erc20Keeper)).
-		AddRoute(incentivestypes.RouterKey, incentives.NewIncentivesProposalHandler(&app.IncentivesKeeper))
+		AddRoute(incentivestypes.RouterKey, incentives.NewIncentivesProposalHandler(&app.IncentivesKeeper)).
+		AddRoute(revenuetypes.RouterKey, revenue.NewRevenueProposalHandler(&app.RevenueKeeper)).
+		AddRoute(claimstypes.RouterKey, claims.NewClaimsProposalHandler(app.ClaimsKeeper)).
+		AddRoute(epochstypes.RouterKey, epochs.NewEpochsProposalHandler(epochsKeeper))
 
 	govConfig := govtypes.DefaultConfig()
 	/*
@@ -458,11 +488,6 @@ func NewEvmos(
 		authtypes.FeeCollectorName,
 	)
 
-	app.ClaimsKeeper = claimskeeper.NewKeeper(
-		appCodec, keys[claimstypes.StoreKey], app.GetSubspace(claimstypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, &stakingKeeper, app.DistrKeeper,
-	)
-
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	// NOTE: Distr, Slashing and Claim must be created before calling the Hooks method to avoid returning a Keeper without its table generated
@@ -474,33 +499,29 @@ func NewEvmos(
 		),
 	)
 
-	app.VestingKeeper = vestingkeeper.NewKeeper(
-		keys[vestingtypes.StoreKey], appCodec,
-		app.AccountKeeper, app.BankKeeper, app.StakingKeeper,
-	)
-
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey], appCodec, app.GetSubspace(erc20types.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.StakingKeeper, app.ClaimsKeeper,
 	)
 
-	app.IncentivesKeeper = incentiveskeeper.NewKeeper(
-		keys[incentivestypes.StoreKey], appCodec, app.GetSubspace(incentivestypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.InflationKeeper, app.StakingKeeper, app.EvmKeeper,
-	)
-
 	app.RevenueKeeper = revenuekeeper.NewKeeper(
 		keys[revenuetypes.StoreKey], appCodec, app.GetSubspace(revenuetypes.ModuleName),
-		app.BankKeeper, app.EvmKeeper,
+		app.BankKeeper, app.EvmKeeper, epochsKeeper,
 		authtypes.FeeCollectorName,
 	)
 
-	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+	app.IncentivesKeeper = incentiveskeeper.NewKeeper(
+		keys[incentivestypes.StoreKey], appCodec, app.GetSubspace(incentivestypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.InflationKeeper, app.StakingKeeper, app.EvmKeeper,
+		app.Erc20Keeper, app.RevenueKeeper,
+	)
+
 	app.EpochsKeeper = *epochsKeeper.SetHooks(
 		epochskeeper.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
 			app.IncentivesKeeper.Hooks(),
 			app.InflationKeeper.Hooks(),
+			app.RevenueKeeper.Hooks(),
 		),
 	)
 
@@ -516,6 +537,7 @@ func NewEvmos(
 			app.IncentivesKeeper.Hooks(),
 			app.RevenueKeeper.Hooks(),
 			app.ClaimsKeeper.Hooks(),
+			app.VestingKeeper.Hooks(),
 		),
 	)
 
@@ -765,6 +787,7 @@ func NewEvmos(
 		FeegrantKeeper:         app.FeeGrantKeeper,
 		IBCKeeper:              app.IBCKeeper,
 		FeeMarketKeeper:        app.FeeMarketKeeper,
+		VestingKeeper:          app.VestingKeeper,
 		SignModeHandler:        encodingConfig.TxConfig.SignModeHandler(),
 		SigGasConsumer:         SigVerificationGasConsumer,
 		Cdc:                    appCodec,
@@ -1053,6 +1076,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(incentivestypes.ModuleName)
 	paramsKeeper.Subspace(recoverytypes.ModuleName)
 	paramsKeeper.Subspace(revenuetypes.ModuleName)
+	paramsKeeper.Subspace(vestingtypes.ModuleName)
 	return paramsKeeper
 }
 
@@ -1108,6 +1132,17 @@ func (app *Evmos) setupUpgradeHandlers() {
 		),
 	)
 
+	// v11 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v11.UpgradeName,
+		v11.CreateUpgradeHandler(
+			app.mm, app.configurator,
+			app.IncentivesKeeper,
+			app.ClaimsKeeper,
+			app.VestingKeeper,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1142,6 +1177,8 @@ func (app *Evmos) setupUpgradeHandlers() {
 		// no store upgrade in v9 or v9.1
 	case v10.UpgradeName:
 		// no store upgrades in v10
+	case v11.UpgradeName:
+		// no store upgrades in v11
 	}
 
 	if storeUpgrades != nil {
@@ -0,0 +1,64 @@
+package v11
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	claimskeeper "github.com/evmos/evmos/v10/x/claims/keeper"
+	claimstypes "github.com/evmos/evmos/v10/x/claims/types"
+	incentiveskeeper "github.com/evmos/evmos/v10/x/incentives/keeper"
+	incentivestypes "github.com/evmos/evmos/v10/x/incentives/types"
+	vestingkeeper "github.com/evmos/evmos/v10/x/vesting/keeper"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v11
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	ik incentiveskeeper.Keeper,
+	ck *claimskeeper.Keeper,
+	vk vestingkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		SetSponsoredIncentiveParams(ctx, ik)
+		SetCampaignCreationFee(ctx, ck)
+
+		if err := InstallSystemContracts(ctx, ik, vk); err != nil {
+			return nil, err
+		}
+
+		// Leave modules are as-is to avoid running InitGenesis.
+		logger.Debug("running module migrations ...")
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// SetSponsoredIncentiveParams sets the limits of the sponsored incentives,
+// which are not present in the param store of existing chains
+func SetSponsoredIncentiveParams(ctx sdk.Context, ik incentiveskeeper.Keeper) {
+	params := ik.GetParams(ctx)
+	params.MinSponsoredEpochReward = incentivestypes.DefaultMinSponsoredEpochReward
+	params.MaxSponsoredEpochs = incentivestypes.DefaultMaxSponsoredEpochs
+	ik.SetParams(ctx, params)
+}
+
+// SetCampaignCreationFee sets the fee paid to create a claims campaign, which
+// is not present in the param store of existing chains
+func SetCampaignCreationFee(ctx sdk.Context, ck *claimskeeper.Keeper) {
+	params := ck.GetParams(ctx)
+	params.CampaignCreationFee = claimstypes.DefaultCampaignCreationFee
+	ck.SetParams(ctx, params)
+}
+
+// InstallSystemContracts installs the incentives and vesting system contracts,
+// which are only installed at genesis on new chains
+func InstallSystemContracts(ctx sdk.Context, ik incentiveskeeper.Keeper, vk vestingkeeper.Keeper) error {
+	if err := ik.InstallSystemContract(ctx); err != nil {
+		return err
+	}
+
+	return vk.InstallSystemContract(ctx)
+}
@@ -0,0 +1,136 @@
+package v11_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	"github.com/tendermint/tendermint/version"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+
+	"github.com/evmos/evmos/v10/app"
+	v11 "github.com/evmos/evmos/v10/app/upgrades/v11"
+	evmostypes "github.com/evmos/evmos/v10/types"
+	claimstypes "github.com/evmos/evmos/v10/x/claims/types"
+	incentivestypes "github.com/evmos/evmos/v10/x/incentives/types"
+	vestingtypes "github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+type UpgradeTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.Evmos
+	consAddress sdk.ConsAddress
+}
+
+func (suite *UpgradeTestSuite) SetupTest(chainID string) {
+	checkTx := false
+
+	// consensus key
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	suite.consAddress = sdk.ConsAddress(priv.PubKey().Address())
+
+	// NOTE: this is the new binary, not the old one.
+	suite.app = app.Setup(checkTx, feemarkettypes.DefaultGenesisState())
+	suite.ctx = suite.app.BaseApp.NewContext(checkTx, tmproto.Header{
+		Height:          1,
+		ChainID:         chainID,
+		Time:            time.Date(2022, 5, 9, 8, 0, 0, 0, time.UTC),
+		ProposerAddress: suite.consAddress.Bytes(),
+
+		Version: tmversion.Consensus{
+			Block: version.BlockProtocol,
+		},
+		LastBlockId: tmproto.BlockID{
+			Hash: tmhash.Sum([]byte("block_id")),
+			PartSetHeader: tmproto.PartSetHeader{
+				Total: 11,
+				Hash:  tmhash.Sum([]byte("partset_header")),
+			},
+		},
+		AppHash:            tmhash.Sum([]byte("app")),
+		DataHash:           tmhash.Sum([]byte("data")),
+		EvidenceHash:       tmhash.Sum([]byte("evidence")),
+		ValidatorsHash:     tmhash.Sum([]byte("validators")),
+		NextValidatorsHash: tmhash.Sum([]byte("next_validators")),
+		ConsensusHash:      tmhash.Sum([]byte("consensus")),
+		LastResultsHash:    tmhash.Sum([]byte("last_result")),
+	})
+
+	cp := suite.app.BaseApp.GetConsensusParams(suite.ctx)
+	suite.ctx = suite.ctx.WithConsensusParams(cp)
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	s := new(UpgradeTestSuite)
+	suite.Run(t, s)
+}
+
+func (suite *UpgradeTestSuite) TestSetSponsoredIncentiveParams() {
+	suite.SetupTest(evmostypes.MainnetChainID + "-4")
+
+	// the sponsored incentive limits are missing on existing chains
+	params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+	params.MinSponsoredEpochReward = sdk.ZeroInt()
+	params.MaxSponsoredEpochs = 0
+	params.EnableClaimableRewards = true
+	suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+	v11.SetSponsoredIncentiveParams(suite.ctx, suite.app.IncentivesKeeper)
+
+	params = suite.app.IncentivesKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(incentivestypes.DefaultMinSponsoredEpochReward, params.MinSponsoredEpochReward)
+	suite.Require().Equal(incentivestypes.DefaultMaxSponsoredEpochs, params.MaxSponsoredEpochs)
+	// other params are untouched
+	suite.Require().True(params.EnableClaimableRewards)
+}
+
+func (suite *UpgradeTestSuite) TestSetCampaignCreationFee() {
+	suite.SetupTest(evmostypes.MainnetChainID + "-4")
+
+	// the campaign creation fee is missing on existing chains
+	params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+	params.CampaignCreationFee = nil
+	suite.app.ClaimsKeeper.SetParams(suite.ctx, params)
+
+	v11.SetCampaignCreationFee(suite.ctx, suite.app.ClaimsKeeper)
+
+	params = suite.app.ClaimsKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(claimstypes.DefaultCampaignCreationFee, params.CampaignCreationFee)
+	// other params are untouched
+	suite.Require().Equal(claimstypes.DefaultClaimsDenom, params.ClaimsDenom)
+}
+
+func (suite *UpgradeTestSuite) TestInstallSystemContracts() {
+	suite.SetupTest(evmostypes.MainnetChainID + "-4")
+
+	addresses := []common.Address{incentivestypes.SystemContractAddress, vestingtypes.SystemContractAddress}
+
+	// existing chains don't have the system contracts
+	for _, address := range addresses {
+		err := suite.app.EvmKeeper.DeleteAccount(suite.ctx, address)
+		suite.Require().NoError(err)
+		suite.Require().Nil(suite.app.EvmKeeper.GetAccount(suite.ctx, address))
+	}
+
+	err := v11.InstallSystemContracts(suite.ctx, suite.app.IncentivesKeeper, suite.app.VestingKeeper)
+	suite.Require().NoError(err)
+
+	for _, address := range addresses {
+		acc := suite.app.EvmKeeper.GetAccount(suite.ctx, address)
+		suite.Require().NotNil(acc)
+		suite.Require().True(acc.IsContract())
+		suite.Require().Equal(evmostypes.SystemContractCode, suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(acc.CodeHash)))
+	}
+}
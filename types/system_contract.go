@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+var (
+	// SystemCallEventID is the first topic of the logs emitted by the system
+	// contracts. The second topic is the caller address and the log data is the
+	// calldata of the call.
+	SystemCallEventID = crypto.Keccak256Hash([]byte("SystemCall(address,bytes)"))
+
+	// SystemContractCode is the runtime bytecode of the system contracts. It
+	// reverts if the call has a value and otherwise emits a log with the caller
+	// and the calldata, which is processed by the EVM hook of the module that
+	// owns the system contract address.
+	SystemContractCode = buildSystemContractCode()
+)
+
+// SystemContractEVMKeeper defines the expected EVM keeper interface used to
+// install a system contract
+type SystemContractEVMKeeper interface {
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error
+	SetCode(ctx sdk.Context, codeHash, code []byte)
+}
+
+// buildSystemContractCode assembles the runtime bytecode of the system
+// contracts
+func buildSystemContractCode() []byte {
+	code := []byte{
+		// revert if the call has a value
+		byte(vm.CALLVALUE), byte(vm.ISZERO), byte(vm.PUSH1), 0x09, byte(vm.JUMPI),
+		byte(vm.PUSH1), 0x00, byte(vm.DUP1), byte(vm.REVERT),
+		byte(vm.JUMPDEST),
+		// copy the calldata to memory
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.CALLDATACOPY),
+		// log the calldata with the event ID and the caller as topics
+		byte(vm.CALLER), byte(vm.PUSH32),
+	}
+	code = append(code, SystemCallEventID.Bytes()...)
+	return append(code,
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.LOG2),
+		byte(vm.STOP),
+	)
+}
+
+// InstallSystemContract sets the runtime bytecode of the system contracts at
+// the given address
+func InstallSystemContract(ctx sdk.Context, evmKeeper SystemContractEVMKeeper, address common.Address) error {
+	codeHash := crypto.Keccak256Hash(SystemContractCode)
+	evmKeeper.SetCode(ctx, codeHash.Bytes(), SystemContractCode)
+
+	account := evmKeeper.GetAccount(ctx, address)
+	if account == nil {
+		account = statedb.NewEmptyAccount()
+	}
+	account.CodeHash = codeHash.Bytes()
+
+	return evmKeeper.SetAccount(ctx, address, *account)
+}
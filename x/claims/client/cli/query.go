@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +29,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryClaimsRecords(),
 		GetCmdQueryClaimsRecord(),
+		GetCmdQueryCampaigns(),
+		GetCmdQueryCampaign(),
+		GetCmdQueryCampaignClaimsRecords(),
+		GetCmdQueryCampaignClaimsRecord(),
+		GetCmdQueryCustomActions(),
+		GetCmdQueryCustomAction(),
 	)
 
 	return claimQueryCmd
@@ -159,3 +166,235 @@ func GetCmdQueryClaimsRecord() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryCampaigns implements the query campaigns command.
+func GetCmdQueryCampaigns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "campaigns",
+		Args:    cobra.NoArgs,
+		Short:   "Query all the airdrop campaigns",
+		Long:    "Query the list of all the airdrop campaigns",
+		Example: fmt.Sprintf("%s query claims campaigns", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCampaignsRequest{
+				Pagination: pageReq,
+			}
+
+			// Query store
+			res, err := queryClient.Campaigns(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "campaigns")
+	return cmd
+}
+
+// GetCmdQueryCampaign implements the query campaign command.
+func GetCmdQueryCampaign() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "campaign CAMPAIGN_ID",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query an airdrop campaign",
+		Long:    "Query the denomination, actions, decay schedule, funder and escrow of an airdrop campaign",
+		Example: fmt.Sprintf("%s query claims campaign 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Query store
+			res, err := queryClient.Campaign(context.Background(), &types.QueryCampaignRequest{CampaignId: campaignID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCampaignClaimsRecords implements the query campaign claims records
+// command.
+func GetCmdQueryCampaignClaimsRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "campaign-records CAMPAIGN_ID",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query all the claims records of an airdrop campaign",
+		Long:    "Query the list of all the claims records of an airdrop campaign",
+		Example: fmt.Sprintf("%s query claims campaign-records 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCampaignClaimsRecordsRequest{
+				CampaignId: campaignID,
+				Pagination: pageReq,
+			}
+
+			// Query store
+			res, err := queryClient.CampaignClaimsRecords(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "campaign claims records")
+	return cmd
+}
+
+// GetCmdQueryCampaignClaimsRecord implements the query campaign claims record
+// command.
+func GetCmdQueryCampaignClaimsRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "campaign-record CAMPAIGN_ID ADDRESS",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Query the claims record of an account for an airdrop campaign.",
+		Long:    "Query the claims record of an account for an airdrop campaign.\nThis contains an address' initial claimable amount, and the claims per action of the campaign.",
+		Example: fmt.Sprintf("%s query claims campaign-record 1 <address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			campaignID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCampaignClaimsRecordRequest{
+				CampaignId: campaignID,
+				Address:    args[1],
+			}
+
+			// Query store
+			res, err := queryClient.CampaignClaimsRecord(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCustomActions implements the query custom actions command.
+func GetCmdQueryCustomActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "custom-actions",
+		Args:    cobra.NoArgs,
+		Short:   "Query all the registered custom actions",
+		Long:    "Query the list of all the custom actions registered by governance that airdrop campaigns can require",
+		Example: fmt.Sprintf("%s query claims custom-actions", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCustomActionsRequest{
+				Pagination: pageReq,
+			}
+
+			// Query store
+			res, err := queryClient.CustomActions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "custom actions")
+	return cmd
+}
+
+// GetCmdQueryCustomAction implements the query custom action command.
+func GetCmdQueryCustomAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "custom-action CUSTOM_ACTION_ID",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query a registered custom action",
+		Long:    "Query the type, contract, event signature and claimable percentage of a registered custom action",
+		Example: fmt.Sprintf("%s query claims custom-action 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			customActionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Query store
+			res, err := queryClient.CustomAction(context.Background(), &types.QueryCustomActionRequest{CustomActionId: customActionID})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
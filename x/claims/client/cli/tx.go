@@ -0,0 +1,597 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/evmos/evmos/v10/x/claims/types"
+)
+
+// flags for the create campaign command
+const (
+	FlagActions            = "actions"
+	FlagCustomActions      = "custom-actions"
+	FlagStartTime          = "start-time"
+	FlagDurationUntilDecay = "duration-until-decay"
+	FlagDurationOfDecay    = "duration-of-decay"
+)
+
+// flags for the grant transfer claims record command
+const (
+	FlagExpiration = "expiration"
+)
+
+// flags for the accept claims delegate command
+const (
+	FlagRevoke = "revoke"
+)
+
+// flags for the register custom action proposal command
+const (
+	FlagContract       = "contract"
+	FlagEventSignature = "event-signature"
+)
+
+// campaignRecipient is the JSON format of a recipient in the claims records
+// file of a campaign
+type campaignRecipient struct {
+	Address string `json:"address"`
+	Amount  string `json:"amount"`
+}
+
+// NewTxCmd returns a root CLI command handler for claims transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateCampaignCmd(),
+		NewClaimWithProofCmd(),
+		NewTransferClaimsRecordCmd(),
+		NewGrantTransferClaimsRecordCmd(),
+		NewSetClaimsDelegateCmd(),
+		NewAcceptClaimsDelegateCmd(),
+	)
+	return txCmd
+}
+
+// NewCreateCampaignCmd returns a CLI command handler for creating an airdrop
+// campaign funded by the sender
+func NewCreateCampaignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-campaign DENOM CLAIMS_RECORDS_FILE",
+		Args:  cobra.ExactArgs(2),
+		Short: "Create an airdrop campaign funded by the sender",
+		Long: `Create an airdrop campaign funded by the sender. The claims records file is a JSON list of recipients with their initial claimable amounts.
+The sum of the amounts is escrowed and any unclaimed remainder is returned to the sender once the campaign ends.`,
+		Example: fmt.Sprintf(`$ %s tx claims create-campaign aevmos records.json --actions=ACTION_VOTE,ACTION_DELEGATE --from=<key_or_address>
+
+Where records.json contains:
+
+[
+  {
+    "address": "evmos1...",
+    "amount": "1000000000000000000"
+  }
+]`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var recipients []campaignRecipient
+			if err := json.Unmarshal(contents, &recipients); err != nil {
+				return err
+			}
+
+			claimsRecords := make([]types.ClaimsRecordAddress, len(recipients))
+			for i, recipient := range recipients {
+				addr, err := sdk.AccAddressFromBech32(recipient.Address)
+				if err != nil {
+					return err
+				}
+
+				amount, ok := sdk.NewIntFromString(recipient.Amount)
+				if !ok {
+					return fmt.Errorf("invalid amount for %s: %s", recipient.Address, recipient.Amount)
+				}
+
+				claimsRecords[i] = types.NewClaimsRecordAddress(addr, amount)
+			}
+
+			actionsStr, err := cmd.Flags().GetStringSlice(FlagActions)
+			if err != nil {
+				return err
+			}
+
+			actions := make([]types.Action, len(actionsStr))
+			for i, actionStr := range actionsStr {
+				action, ok := types.Action_value[strings.TrimSpace(actionStr)]
+				if !ok {
+					return fmt.Errorf("invalid action: %s", actionStr)
+				}
+				actions[i] = types.Action(action)
+			}
+
+			var startTime time.Time
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			if startTimeStr != "" {
+				startTime, err = time.Parse(time.RFC3339, startTimeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			customActionIDs, err := cmd.Flags().GetUintSlice(FlagCustomActions)
+			if err != nil {
+				return err
+			}
+
+			durationUntilDecay, err := cmd.Flags().GetDuration(FlagDurationUntilDecay)
+			if err != nil {
+				return err
+			}
+
+			durationOfDecay, err := cmd.Flags().GetDuration(FlagDurationOfDecay)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateCampaign(
+				clientCtx.GetFromAddress(),
+				denom,
+				actions,
+				toUint64s(customActionIDs),
+				startTime,
+				durationUntilDecay,
+				durationOfDecay,
+				claimsRecords,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(
+		FlagActions,
+		[]string{
+			types.ActionVote.String(),
+			types.ActionDelegate.String(),
+			types.ActionEVM.String(),
+			types.ActionIBCTransfer.String(),
+		},
+		"actions that have to be completed to claim the coins",
+	)
+	cmd.Flags().UintSlice(FlagCustomActions, []uint{}, "identifiers of the registered custom actions that have to be completed to claim the coins")
+	cmd.Flags().String(FlagStartTime, "", "start time of the campaign in RFC3339 format (defaults to the block time of its creation)")
+	cmd.Flags().Duration(FlagDurationUntilDecay, types.DefaultDurationUntilDecay, "duration until the decay of the claimable coins begins")
+	cmd.Flags().Duration(FlagDurationOfDecay, types.DefaultDurationOfDecay, "duration of the decay of the claimable coins")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimWithProofCmd returns a CLI command handler for creating the claims
+// record of a Merkle tree leaf
+func NewClaimWithProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-with-proof ADDRESS AMOUNT [PROOF...]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Create the claims record of a leaf of the airdrop Merkle tree",
+		Long: `Create the claims record of a leaf of the airdrop Merkle tree by providing the hex encoded sibling hashes from the leaf to the root.
+The sender can be any account, the claims record is created for the address of the leaf.`,
+		Example: fmt.Sprintf(`$ %s tx claims claim-with-proof evmos1... 1000000000000000000 0xabc... 0xdef... --from=<key_or_address>`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[1])
+			}
+
+			msg := types.NewMsgClaimWithProof(clientCtx.GetFromAddress(), addr, amount, args[2:])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTransferClaimsRecordCmd returns a CLI command handler for transferring the
+// claims record of the sender to another address
+func NewTransferClaimsRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-claims-record RECIPIENT",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Transfer the claims record of the sender to the recipient",
+		Long:    `Transfer the claims record of the sender to the recipient. If the recipient has a claims record, both records are merged and the actions completed by only one of them are claimed for the other.`,
+		Example: fmt.Sprintf(`$ %s tx claims transfer-claims-record evmos1... --from=<key_or_address>`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferClaimsRecord(clientCtx.GetFromAddress(), recipient)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewGrantTransferClaimsRecordCmd returns a CLI command handler for granting
+// an authorization to transfer the claims record of the sender
+func NewGrantTransferClaimsRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-transfer-claims-record GRANTEE [ALLOWED_RECIPIENT...]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Grant an authorization to transfer the claims record of the sender",
+		Long: `Grant an authorization to the grantee to transfer the claims record of the sender to one of the allowed recipients.
+If no allowed recipient is provided, the claims record can only be transferred to the grantee.`,
+		Example: fmt.Sprintf(`$ %s tx claims grant-transfer-claims-record evmos1... --from=<key_or_address>`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowedRecipients := []sdk.AccAddress{grantee}
+			if len(args) > 1 {
+				allowedRecipients = make([]sdk.AccAddress, len(args)-1)
+				for i, arg := range args[1:] {
+					allowedRecipients[i], err = sdk.AccAddressFromBech32(arg)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			authorization := types.NewTransferClaimsRecordAuthorization(allowedRecipients...)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp != 0 {
+				e := time.Unix(exp, 0)
+				expiration = &e
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagExpiration, 0, "expire time of the authorization as Unix timestamp, zero for no expiry")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetClaimsDelegateCmd returns a CLI command handler for requesting the
+// delegate that completes the actions of the claims records of the sender
+func NewSetClaimsDelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-claims-delegate [DELEGATE]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Request the delegate that completes the actions of the claims records of the sender",
+		Long: `Request the delegate that completes the actions of the claims records of the sender. The claimed coins are transferred to the sender.
+The delegate is set once it accepts the request with accept-claims-delegate. If no delegate is provided, the current delegate and the pending request are removed.`,
+		Example: fmt.Sprintf(`$ %s tx claims set-claims-delegate evmos1... --from=<key_or_address>`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var delegate sdk.AccAddress
+			if len(args) > 0 {
+				delegate, err = sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetClaimsDelegate(clientCtx.GetFromAddress(), delegate)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAcceptClaimsDelegateCmd returns a CLI command handler for accepting the
+// request of a granter to complete the actions of its claims records
+func NewAcceptClaimsDelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-claims-delegate [GRANTER]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Accept the request of a granter to complete the actions of its claims records",
+		Long: `Accept the request of a granter for the sender to complete the actions of its claims records. The claimed coins are transferred to the granter.
+With --revoke, the sender rejects the pending request or stops completing the actions of the granter.`,
+		Example: fmt.Sprintf(`$ %s tx claims accept-claims-delegate evmos1... --from=<key_or_address>`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			revoke, err := cmd.Flags().GetBool(FlagRevoke)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptClaimsDelegate(clientCtx.GetFromAddress(), granter, revoke)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRevoke, false, "reject the request or remove the delegate of the granter")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterCustomActionProposalCmd implements the command to submit a
+// register-custom-action proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewRegisterCustomActionProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-custom-action NAME TYPE CLAIMABLE_PERCENTAGE",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to register a custom action that airdrop campaigns can require",
+		Long: fmt.Sprintf(`Submit a proposal to register a custom action that airdrop campaigns can require. The claimable percentage is the fraction of the initial claimable amount of a claims record released by completing the custom action.
+The type is one of %s, %s or %s.`,
+			types.CustomActionTypeContractInteraction, types.CustomActionTypeERC20Conversion, types.CustomActionTypeEventLog,
+		),
+		Example: fmt.Sprintf(
+			"$ %s tx gov submit-proposal register-custom-action provide-liquidity %s 0.25 --event-signature=<topic> --contract=<contract> --from=<key_or_address>",
+			version.AppName, types.CustomActionTypeEventLog,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			actionType, ok := types.CustomActionType_value[args[1]]
+			if !ok {
+				return fmt.Errorf("invalid custom action type: %s", args[1])
+			}
+
+			claimablePercentage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			contract, err := cmd.Flags().GetString(FlagContract)
+			if err != nil {
+				return err
+			}
+
+			eventSignature, err := cmd.Flags().GetString(FlagEventSignature)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewRegisterCustomActionProposal(
+				title, description, args[0], types.CustomActionType(actionType), contract, eventSignature, claimablePercentage,
+			)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagContract, "", "hex address of the contract that is interacted with, converted via erc20 or that emits the event log")
+	cmd.Flags().String(FlagEventSignature, "", "hex encoded topic of the event log")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewRemoveCustomActionProposalCmd implements the command to submit a
+// remove-custom-action proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewRemoveCustomActionProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-custom-action CUSTOM_ACTION_ID",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to remove a registered custom action",
+		Long:    "Submit a proposal to remove a registered custom action. Existing campaigns keep the custom actions they were created with.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal remove-custom-action 1 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			customActionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewRemoveCustomActionProposal(title, description, customActionID)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// addProposalFlags adds the required governance proposal flags to a command
+//
+//nolint:staticcheck // we use deprecated flags
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
+
+// toUint64s converts the parsed uint flag values to uint64
+func toUint64s(values []uint) []uint64 {
+	res := make([]uint64, len(values))
+	for i, v := range values {
+		res[i] = uint64(v)
+	}
+	return res
+}
@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/evmos/evmos/v10/x/claims/client/cli"
+)
+
+var (
+	RegisterCustomActionProposalHandler = govclient.NewProposalHandler(cli.NewRegisterCustomActionProposalCmd)
+	RemoveCustomActionProposalHandler   = govclient.NewProposalHandler(cli.NewRemoveCustomActionProposalCmd)
+)
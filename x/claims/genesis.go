@@ -28,7 +28,48 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 
 	k.SetParams(ctx, data.Params)
 
-	escrowedCoins := k.GetModuleAccountBalances(ctx)
+	for _, customAction := range data.CustomActions {
+		if customAction.ID > k.GetCustomActionCount(ctx) {
+			k.SetCustomActionCount(ctx, customAction.ID)
+		}
+
+		k.SetCustomAction(ctx, customAction)
+	}
+
+	campaignsEscrow := sdk.Coins{}
+	for _, campaign := range data.Campaigns {
+		if campaign.ID > k.GetCampaignCount(ctx) {
+			k.SetCampaignCount(ctx, campaign.ID)
+		}
+
+		k.SetCampaign(ctx, campaign)
+		k.InsertCampaignEndQueue(ctx, campaign)
+		campaignsEscrow = campaignsEscrow.Add(sdk.Coin{Denom: campaign.Denom, Amount: campaign.Escrow})
+	}
+
+	for _, ccr := range data.CampaignClaimsRecords {
+		addr := sdk.MustAccAddressFromBech32(ccr.ClaimsRecord.Address)
+		cr := types.ClaimsRecord{
+			InitialClaimableAmount: ccr.ClaimsRecord.InitialClaimableAmount,
+			ActionsCompleted:       ccr.ClaimsRecord.ActionsCompleted,
+			CustomActionsCompleted: ccr.ClaimsRecord.CustomActionsCompleted,
+		}
+
+		k.SetCampaignClaimsRecord(ctx, ccr.CampaignID, addr, cr)
+	}
+
+	// NOTE: the escrowed coins of the campaigns are excluded from the airdrop
+	// escrow
+	escrowedCoins, isNeg := k.GetModuleAccountBalances(ctx).SafeSub(campaignsEscrow...)
+	if isNeg {
+		panic(
+			fmt.Errorf(
+				"escrowed module account amount < campaigns escrow (%s < %s)",
+				k.GetModuleAccountBalances(ctx), campaignsEscrow,
+			),
+		)
+	}
+
 	if escrowedCoins != nil {
 		totalEscrowed = escrowedCoins.AmountOfNoDenomValidation(data.Params.ClaimsDenom)
 	}
@@ -56,6 +97,29 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		k.SetClaimsRecord(ctx, addr, cr)
 	}
 
+	merkleClaimed := sdk.ZeroInt()
+	for _, mc := range data.MerkleClaims {
+		addr := sdk.MustAccAddressFromBech32(mc.Address)
+		k.SetMerkleClaim(ctx, addr, mc.Amount)
+		merkleClaimed = merkleClaimed.Add(mc.Amount)
+	}
+
+	for _, cd := range data.ClaimsDelegates {
+		granter := sdk.MustAccAddressFromBech32(cd.Granter)
+		delegate := sdk.MustAccAddressFromBech32(cd.Delegate)
+		k.SetGranterDelegate(ctx, granter, delegate)
+	}
+
+	for _, cd := range data.PendingClaimsDelegates {
+		granter := sdk.MustAccAddressFromBech32(cd.Granter)
+		delegate := sdk.MustAccAddressFromBech32(cd.Delegate)
+		k.SetPendingGranterDelegate(ctx, granter, delegate)
+	}
+
+	// NOTE: the leaves of the Merkle tree without a claims record are escrowed
+	// too
+	sumUnclaimed = sumUnclaimed.Add(data.Params.GetMerkleTotalAmount().Sub(merkleClaimed))
+
 	// check for equal only for unclaimed actions
 	if !sumUnclaimed.Equal(totalEscrowed) {
 		panic(
@@ -70,7 +134,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 // ExportGenesis returns the claim module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:        k.GetParams(ctx),
-		ClaimsRecords: k.GetClaimsRecords(ctx),
+		Params:                 k.GetParams(ctx),
+		ClaimsRecords:          k.GetClaimsRecords(ctx),
+		Campaigns:              k.GetCampaigns(ctx),
+		CampaignClaimsRecords:  k.GetAllCampaignClaimsRecords(ctx),
+		CustomActions:          k.GetCustomActions(ctx),
+		MerkleClaims:           k.GetMerkleClaims(ctx),
+		ClaimsDelegates:        k.GetClaimsDelegates(ctx),
+		PendingClaimsDelegates: k.GetPendingClaimsDelegates(ctx),
 	}
 }
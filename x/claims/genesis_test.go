@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -83,6 +84,10 @@ var (
 )
 
 func (suite *GenesisTestSuite) TestClaimInitGenesis() {
+	merkleParams := suite.genesis.Params
+	merkleParams.MerkleRoot = common.BytesToHash(tmhash.Sum([]byte("root"))).Hex()
+	merkleParams.MerkleTotalAmount = sdk.NewInt(1_000)
+
 	testCases := []struct {
 		name     string
 		genesis  types.GenesisState
@@ -143,6 +148,74 @@ func (suite *GenesisTestSuite) TestClaimInitGenesis() {
 			},
 			false,
 		},
+		{
+			"custom genesis - with campaign escrow",
+			types.GenesisState{
+				Params: suite.genesis.Params,
+				ClaimsRecords: []types.ClaimsRecordAddress{
+					{
+						Address:                acc2.String(),
+						InitialClaimableAmount: sdk.NewInt(400),
+						ActionsCompleted:       []bool{false, false, false, false},
+					},
+				},
+				Campaigns: []types.Campaign{
+					types.NewCampaign(3, acc1, "aevmos", []types.Action{types.ActionVote}, nil, now, time.Hour, time.Hour, sdk.NewInt(1_000)),
+				},
+				CampaignClaimsRecords: []types.CampaignClaimsRecord{
+					{
+						CampaignID:   3,
+						ClaimsRecord: types.NewClaimsRecordAddress(acc2, sdk.NewInt(1_000)),
+					},
+				},
+			},
+			func() {
+				coins := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(1_400)))
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"custom genesis - campaign escrow not funded",
+			types.GenesisState{
+				Params: suite.genesis.Params,
+				Campaigns: []types.Campaign{
+					types.NewCampaign(1, acc1, "acoin", []types.Action{types.ActionVote}, nil, now, time.Hour, time.Hour, sdk.NewInt(1_000)),
+				},
+			},
+			func() {},
+			true,
+		},
+		{
+			"custom genesis - with merkle claims",
+			types.GenesisState{
+				Params: merkleParams,
+				ClaimsRecords: []types.ClaimsRecordAddress{
+					{
+						Address:                acc1.String(),
+						InitialClaimableAmount: sdk.NewInt(400),
+						ActionsCompleted:       []bool{false, false, false, false},
+					},
+				},
+				MerkleClaims: []types.MerkleClaim{types.NewMerkleClaim(acc1, sdk.NewInt(400))},
+			},
+			func() {
+				// claims record of the proven leaf and remaining leaves
+				coins := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(1_000)))
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"custom genesis - merkle leaves not funded",
+			types.GenesisState{
+				Params: merkleParams,
+			},
+			func() {},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -165,6 +238,19 @@ func (suite *GenesisTestSuite) TestClaimInitGenesis() {
 
 				claimsRecords := suite.app.ClaimsKeeper.GetClaimsRecords(suite.ctx)
 				suite.Require().Equal(claimsRecords, tc.genesis.ClaimsRecords)
+
+				campaigns := suite.app.ClaimsKeeper.GetCampaigns(suite.ctx)
+				suite.Require().ElementsMatch(campaigns, tc.genesis.Campaigns)
+
+				campaignClaimsRecords := suite.app.ClaimsKeeper.GetAllCampaignClaimsRecords(suite.ctx)
+				suite.Require().ElementsMatch(campaignClaimsRecords, tc.genesis.CampaignClaimsRecords)
+
+				merkleClaims := suite.app.ClaimsKeeper.GetMerkleClaims(suite.ctx)
+				suite.Require().ElementsMatch(merkleClaims, tc.genesis.MerkleClaims)
+
+				if len(tc.genesis.Campaigns) > 0 {
+					suite.Require().Equal(uint64(3), suite.app.ClaimsKeeper.GetCampaignCount(suite.ctx))
+				}
 			}
 		})
 	}
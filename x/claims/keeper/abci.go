@@ -5,15 +5,18 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	ethermint "github.com/evmos/ethermint/types"
 
 	"github.com/evmos/evmos/v10/x/claims/types"
 )
 
-// EndBlocker checks if the airdrop claiming period has ended in order to
-// process the clawback of unclaimed tokens
+// EndBlocker checks if the airdrop claiming period or any of the campaigns has
+// ended in order to process the clawback of unclaimed tokens
 func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.EndExpiredCampaigns(ctx)
+
 	params := k.GetParams(ctx)
 
 	// NOTE: ignore end of airdrop period check if claiming is disabled
@@ -33,7 +36,8 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 }
 
 // EndAirdrop transfers the unclaimed tokens from the airdrop to the community
-// pool, removes all claims records from state and disables the claims.
+// pool, removes all claims records and Merkle claims from state and disables
+// the claims.
 func (k Keeper) EndAirdrop(ctx sdk.Context, params types.Params) error {
 	logger := k.Logger(ctx)
 	logger.Info("beginning EndAirdrop logic")
@@ -46,6 +50,9 @@ func (k Keeper) EndAirdrop(ctx sdk.Context, params types.Params) error {
 	// claims record state
 	k.ClawbackEmptyAccounts(ctx, params.ClaimsDenom)
 
+	// the Merkle tree leaves can't be claimed anymore
+	k.DeleteMerkleClaims(ctx)
+
 	// set the EnableClaims param to false so that we don't have to compute
 	// duration every block
 	params.EnableClaims = false
@@ -54,13 +61,51 @@ func (k Keeper) EndAirdrop(ctx sdk.Context, params types.Params) error {
 	return nil
 }
 
+// EndExpiredCampaigns ends the campaigns of the end queue whose claiming
+// period has passed and prunes a bounded number of claims records of the ended
+// campaigns. Failures are logged and the campaign is retried on the next block.
+func (k Keeper) EndExpiredCampaigns(ctx sdk.Context) {
+	for _, campaignID := range k.GetExpiredCampaignIDs(ctx, ctx.BlockTime()) {
+		campaign, found := k.GetCampaign(ctx, campaignID)
+		if !found {
+			k.Logger(ctx).Error("expired campaign not found", "campaign-id", campaignID)
+			continue
+		}
+
+		// NOTE: use a cached context to avoid partial state changes on failure
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.EndCampaign(cacheCtx, campaign); err != nil {
+			k.Logger(ctx).Error(
+				"failed to end campaign",
+				"campaign-id", campaign.ID,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		writeCache()
+
+		k.Logger(ctx).Info(
+			"campaign ended",
+			"campaign-id", campaign.ID,
+			"returned", campaign.Escrow.String(),
+		)
+	}
+
+	k.PruneEndedCampaignClaimsRecords(ctx, types.MaxPrunedCampaignClaimsRecords)
+}
+
 // ClawbackEscrowedTokens transfers all the escrowed airdrop tokens on the
-// ModuleAccount to the community pool
+// ModuleAccount to the community pool. The escrowed coins of the campaigns are
+// excluded as they are returned to their funders.
 func (k Keeper) ClawbackEscrowedTokens(ctx sdk.Context) error {
 	logger := k.Logger(ctx)
 
 	moduleAccAddr := k.GetModuleAccountAddress()
-	balances := k.bankKeeper.GetAllBalances(ctx, moduleAccAddr)
+	balances, isNeg := k.bankKeeper.GetAllBalances(ctx, moduleAccAddr).SafeSub(k.GetCampaignsEscrow(ctx)...)
+	if isNeg {
+		return errorsmod.Wrap(errortypes.ErrInsufficientFunds, "escrow balance < campaigns escrow")
+	}
 
 	if balances.IsZero() {
 		logger.Debug("clawback aborted, airdrop escrow account is empty")
@@ -0,0 +1,705 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/evmos/evmos/v10/x/claims/types"
+)
+
+// createCampaign creates a new airdrop campaign and escrows the sum of the
+// initial claimable amounts of its claims records from the funder, who also
+// pays the campaign creation fee to the community pool. The registered custom
+// actions are copied into the campaign so that later governance changes don't
+// affect it.
+func (k Keeper) createCampaign(
+	ctx sdk.Context,
+	funder sdk.AccAddress,
+	denom string,
+	actions []types.Action,
+	customActionIDs []uint64,
+	startTime time.Time,
+	durationUntilDecay,
+	durationOfDecay time.Duration,
+	claimsRecords []types.ClaimsRecordAddress,
+) (types.Campaign, error) {
+	// set the start time to the current block time by default
+	switch {
+	case startTime.IsZero():
+		startTime = ctx.BlockTime()
+	case startTime.Before(ctx.BlockTime()):
+		return types.Campaign{}, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"campaign start time %s cannot be before the block time %s", startTime, ctx.BlockTime(),
+		)
+	}
+
+	customActions := make([]types.CustomAction, len(customActionIDs))
+	for i, customActionID := range customActionIDs {
+		customAction, found := k.GetCustomAction(ctx, customActionID)
+		if !found {
+			return types.Campaign{}, errorsmod.Wrapf(types.ErrCustomActionNotFound, "custom action %d", customActionID)
+		}
+		customActions[i] = customAction
+	}
+
+	escrow := sdk.ZeroInt()
+	for _, cra := range claimsRecords {
+		escrow = escrow.Add(cra.InitialClaimableAmount)
+	}
+
+	id := k.GetCampaignCount(ctx) + 1
+	campaign := types.NewCampaign(id, funder, denom, actions, customActions, startTime, durationUntilDecay, durationOfDecay, escrow)
+	if err := campaign.Validate(); err != nil {
+		return types.Campaign{}, errorsmod.Wrap(types.ErrInvalidAction, err.Error())
+	}
+
+	if fee := k.GetParams(ctx).CampaignCreationFee; !fee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fee, funder); err != nil {
+			return types.Campaign{}, errorsmod.Wrap(err, "failed to pay the campaign creation fee")
+		}
+	}
+
+	coins := sdk.Coins{{Denom: denom, Amount: escrow}}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, coins); err != nil {
+		return types.Campaign{}, errorsmod.Wrap(err, "failed to escrow campaign coins")
+	}
+
+	k.SetCampaignCount(ctx, id)
+	k.SetCampaign(ctx, campaign)
+	k.InsertCampaignEndQueue(ctx, campaign)
+
+	for _, cra := range claimsRecords {
+		addr := sdk.MustAccAddressFromBech32(cra.Address)
+		k.SetCampaignClaimsRecord(ctx, id, addr, types.NewClaimsRecord(cra.InitialClaimableAmount))
+	}
+
+	return campaign, nil
+}
+
+// ClaimCampaignsForAction claims the coins of an action on all the active
+// campaigns that the address has a claims record for. Failed claims are
+// logged and don't prevent the claims on other campaigns.
+func (k Keeper) ClaimCampaignsForAction(ctx sdk.Context, addr sdk.AccAddress, action types.Action) {
+	// NOTE: the campaigns are updated on claim, so they cannot be modified while
+	// iterating over them
+	for _, campaignID := range k.GetAddressCampaignIDs(ctx, addr) {
+		// NOTE: the records of ended campaigns are pruned over several blocks
+		campaign, found := k.GetCampaign(ctx, campaignID)
+		if !found || !campaign.IsActive(ctx.BlockTime()) || !campaign.HasAction(action) {
+			continue
+		}
+
+		claimsRecord, found := k.GetCampaignClaimsRecord(ctx, campaign.ID, addr)
+		if !found {
+			continue
+		}
+
+		// NOTE: use a cached context to avoid partial state changes on failure
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := k.ClaimCampaignCoinsForAction(cacheCtx, addr, campaign, claimsRecord, action); err != nil {
+			k.Logger(ctx).Error(
+				"failed to claim campaign action",
+				"campaign-id", campaign.ID,
+				"address", addr.String(),
+				"action", action.String(),
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		writeCache()
+	}
+}
+
+// ClaimCampaignsForCustomActions claims the coins of the custom actions that
+// match the given condition on all the active campaigns that the address has a
+// claims record for. Failed claims are logged and don't prevent other claims.
+func (k Keeper) ClaimCampaignsForCustomActions(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	matchFn func(customAction types.CustomAction) bool,
+) {
+	for _, campaignID := range k.GetAddressCampaignIDs(ctx, addr) {
+		campaign, found := k.GetCampaign(ctx, campaignID)
+		if !found || len(campaign.CustomActions) == 0 || !campaign.IsActive(ctx.BlockTime()) {
+			continue
+		}
+
+		for _, customAction := range campaign.CustomActions {
+			if !matchFn(customAction) {
+				continue
+			}
+
+			// NOTE: the campaign and the claims record are updated on each claim
+			campaign, _ = k.GetCampaign(ctx, campaign.ID)
+			claimsRecord, _ := k.GetCampaignClaimsRecord(ctx, campaign.ID, addr)
+
+			cacheCtx, writeCache := ctx.CacheContext()
+			if _, err := k.ClaimCampaignCoinsForCustomAction(cacheCtx, addr, campaign, claimsRecord, customAction.ID); err != nil {
+				k.Logger(ctx).Error(
+					"failed to claim campaign custom action",
+					"campaign-id", campaign.ID,
+					"custom-action-id", customAction.ID,
+					"address", addr.String(),
+					"error", err.Error(),
+				)
+				continue
+			}
+
+			writeCache()
+		}
+	}
+}
+
+// ClaimCampaignCoinsForAction marks the action as completed on the campaign
+// claims record and transfers its claimable amount to the user's account. The
+// decayed remainder is kept in the campaign escrow and returned to the funder
+// once the campaign ends.
+func (k Keeper) ClaimCampaignCoinsForAction(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	campaign types.Campaign,
+	claimsRecord types.ClaimsRecord,
+	action types.Action,
+) (math.Int, error) {
+	if !campaign.HasAction(action) {
+		return sdk.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidAction, "action %s is not part of campaign %d", action, campaign.ID)
+	}
+
+	if !campaign.IsActive(ctx.BlockTime()) || claimsRecord.HasClaimedAction(action) {
+		return sdk.ZeroInt(), nil
+	}
+
+	claimableAmount, _ := k.CampaignClaimableAmountForAction(ctx, campaign, claimsRecord, action)
+	claimsRecord.MarkClaimed(action)
+	k.SetCampaignClaimsRecord(ctx, campaign.ID, addr, claimsRecord)
+
+	if err := k.releaseCampaignCoins(ctx, addr, campaign, claimableAmount); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	if claimableAmount.IsPositive() {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaim,
+				sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, claimableAmount.String()+campaign.Denom),
+				sdk.NewAttribute(types.AttributeKeyActionType, action.String()),
+				sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.ID, 10)),
+			),
+		})
+	}
+
+	return claimableAmount, nil
+}
+
+// ClaimCampaignCoinsForCustomAction marks the custom action as completed on
+// the campaign claims record and transfers its claimable amount to the user's
+// account. The decayed remainder is kept in the campaign escrow.
+func (k Keeper) ClaimCampaignCoinsForCustomAction(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	campaign types.Campaign,
+	claimsRecord types.ClaimsRecord,
+	customActionID uint64,
+) (math.Int, error) {
+	customAction, found := campaign.GetCustomAction(customActionID)
+	if !found {
+		return sdk.ZeroInt(), errorsmod.Wrapf(types.ErrCustomActionNotFound, "custom action %d is not part of campaign %d", customActionID, campaign.ID)
+	}
+
+	if !campaign.IsActive(ctx.BlockTime()) || claimsRecord.HasClaimedCustomAction(customActionID) {
+		return sdk.ZeroInt(), nil
+	}
+
+	claimableAmount, _ := k.CampaignClaimableAmountForCustomAction(ctx, campaign, claimsRecord, customActionID)
+	claimsRecord.MarkCustomActionClaimed(customActionID)
+	k.SetCampaignClaimsRecord(ctx, campaign.ID, addr, claimsRecord)
+
+	if err := k.releaseCampaignCoins(ctx, addr, campaign, claimableAmount); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	if claimableAmount.IsPositive() {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaim,
+				sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, claimableAmount.String()+campaign.Denom),
+				sdk.NewAttribute(types.AttributeKeyActionType, customAction.Name),
+				sdk.NewAttribute(types.AttributeKeyCustomActionID, strconv.FormatUint(customActionID, 10)),
+				sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.ID, 10)),
+			),
+		})
+	}
+
+	return claimableAmount, nil
+}
+
+// transferCampaignClaimsRecord transfers the claims record of a campaign from
+// the sender to the recipient. If the recipient has a claims record for the
+// campaign, both records are merged and the actions completed by only one of
+// them are claimed for the other one. As for other campaign claims, the
+// decayed remainder is kept in the campaign escrow.
+func (k Keeper) transferCampaignClaimsRecord(
+	ctx sdk.Context,
+	campaignID uint64,
+	sender,
+	recipient sdk.AccAddress,
+) (sdk.Coins, error) {
+	senderClaimsRecord, found := k.GetCampaignClaimsRecord(ctx, campaignID, sender)
+	if !found {
+		return sdk.Coins{}, nil
+	}
+
+	k.DeleteCampaignClaimsRecord(ctx, campaignID, sender)
+
+	// NOTE: the records of ended campaigns are pruned over several blocks
+	campaign, found := k.GetCampaign(ctx, campaignID)
+	if !found {
+		return sdk.Coins{}, nil
+	}
+
+	recipientClaimsRecord, found := k.GetCampaignClaimsRecord(ctx, campaignID, recipient)
+	if !found {
+		k.SetCampaignClaimsRecord(ctx, campaignID, recipient, senderClaimsRecord)
+		return sdk.Coins{}, nil
+	}
+
+	totalClaimableAmt := senderClaimsRecord.InitialClaimableAmount.Add(recipientClaimsRecord.InitialClaimableAmount)
+	mergedRecord := types.NewClaimsRecord(totalClaimableAmt)
+	claimedAmt := sdk.ZeroInt()
+	isActive := campaign.IsActive(ctx.BlockTime())
+
+	for _, action := range campaign.Actions {
+		senderCompleted := senderClaimsRecord.HasClaimedAction(action)
+		recipientCompleted := recipientClaimsRecord.HasClaimedAction(action)
+		if !senderCompleted && !recipientCompleted {
+			continue
+		}
+
+		if isActive && senderCompleted != recipientCompleted {
+			// claim the action for the record that hasn't completed it
+			pending := senderClaimsRecord
+			if senderCompleted {
+				pending = recipientClaimsRecord
+			}
+			amt, _ := k.CampaignClaimableAmountForAction(ctx, campaign, pending, action)
+			claimedAmt = claimedAmt.Add(amt)
+		}
+
+		mergedRecord.MarkClaimed(action)
+	}
+
+	for _, customAction := range campaign.CustomActions {
+		senderCompleted := senderClaimsRecord.HasClaimedCustomAction(customAction.ID)
+		recipientCompleted := recipientClaimsRecord.HasClaimedCustomAction(customAction.ID)
+		if !senderCompleted && !recipientCompleted {
+			continue
+		}
+
+		if isActive && senderCompleted != recipientCompleted {
+			pending := senderClaimsRecord
+			if senderCompleted {
+				pending = recipientClaimsRecord
+			}
+			amt, _ := k.CampaignClaimableAmountForCustomAction(ctx, campaign, pending, customAction.ID)
+			claimedAmt = claimedAmt.Add(amt)
+		}
+
+		mergedRecord.MarkCustomActionClaimed(customAction.ID)
+	}
+
+	k.SetCampaignClaimsRecord(ctx, campaignID, recipient, mergedRecord)
+
+	if err := k.releaseCampaignCoins(ctx, recipient, campaign, claimedAmt); err != nil {
+		return nil, err
+	}
+
+	if claimedAmt.IsZero() {
+		return sdk.Coins{}, nil
+	}
+
+	return sdk.Coins{{Denom: campaign.Denom, Amount: claimedAmt}}, nil
+}
+
+// releaseCampaignCoins transfers the claimed amount from the campaign escrow to
+// the user's account. The coins are subject to the vesting schedule of the
+// module params when vesting is enabled.
+func (k Keeper) releaseCampaignCoins(ctx sdk.Context, addr sdk.AccAddress, campaign types.Campaign, amount math.Int) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	claimedCoins := sdk.Coins{{Denom: campaign.Denom, Amount: amount}}
+	if err := k.sendClaimedCoins(ctx, addr, claimedCoins, k.GetParams(ctx)); err != nil {
+		return err
+	}
+
+	campaign.Escrow = campaign.Escrow.Sub(amount)
+	k.SetCampaign(ctx, campaign)
+	return nil
+}
+
+// CampaignClaimableAmountForAction returns the claimable amount of a campaign
+// for a specific action done by an address
+func (k Keeper) CampaignClaimableAmountForAction(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	claimsRecord types.ClaimsRecord,
+	action types.Action,
+) (claimableCoins, remainder math.Int) {
+	// return zero if there are no coins to claim
+	if claimsRecord.InitialClaimableAmount.IsNil() || claimsRecord.InitialClaimableAmount.IsZero() {
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	}
+
+	if !campaign.HasAction(action) || claimsRecord.HasClaimedAction(action) {
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	}
+
+	initialClaimablePerAction := sdk.NewDecFromInt(claimsRecord.InitialClaimableAmount).
+		Mul(campaign.ActionsPercentage()).
+		QuoInt64(int64(len(campaign.Actions))).
+		TruncateInt()
+	return decayedAmount(ctx.BlockTime(), initialClaimablePerAction, campaign.DecayStartTime(), campaign.DurationOfDecay)
+}
+
+// CampaignClaimableAmountForCustomAction returns the claimable amount of a
+// campaign for a specific custom action done by an address
+func (k Keeper) CampaignClaimableAmountForCustomAction(
+	ctx sdk.Context,
+	campaign types.Campaign,
+	claimsRecord types.ClaimsRecord,
+	customActionID uint64,
+) (claimableCoins, remainder math.Int) {
+	// return zero if there are no coins to claim
+	if claimsRecord.InitialClaimableAmount.IsNil() || claimsRecord.InitialClaimableAmount.IsZero() {
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	}
+
+	customAction, found := campaign.GetCustomAction(customActionID)
+	if !found || claimsRecord.HasClaimedCustomAction(customActionID) {
+		return sdk.ZeroInt(), sdk.ZeroInt()
+	}
+
+	initialClaimable := sdk.NewDecFromInt(claimsRecord.InitialClaimableAmount).
+		Mul(customAction.ClaimablePercentage).
+		TruncateInt()
+	return decayedAmount(ctx.BlockTime(), initialClaimable, campaign.DecayStartTime(), campaign.DurationOfDecay)
+}
+
+// EndCampaign returns the remaining escrow of a campaign to its funder and
+// removes the campaign from state. Its claims records are deleted over the
+// next blocks by PruneEndedCampaignClaimsRecords.
+func (k Keeper) EndCampaign(ctx sdk.Context, campaign types.Campaign) error {
+	if campaign.Escrow.IsPositive() {
+		funder := sdk.MustAccAddressFromBech32(campaign.Funder)
+		coins := sdk.Coins{{Denom: campaign.Denom, Amount: campaign.Escrow}}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, coins); err != nil {
+			return errorsmod.Wrapf(err, "failed to return the escrow of campaign %d", campaign.ID)
+		}
+	}
+
+	k.DeleteCampaign(ctx, campaign.ID)
+	k.RemoveCampaignEndQueue(ctx, campaign)
+	k.SetEndedCampaign(ctx, campaign.ID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEndCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyFunder, campaign.Funder),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, campaign.Escrow.String()+campaign.Denom),
+		),
+	)
+
+	return nil
+}
+
+// PruneEndedCampaignClaimsRecords deletes up to limit claims records of the
+// ended campaigns. A campaign is removed from the ended campaigns once all its
+// claims records have been deleted.
+func (k Keeper) PruneEndedCampaignClaimsRecords(ctx sdk.Context, limit int) {
+	type prunedCampaign struct {
+		id        uint64
+		addresses []sdk.AccAddress
+		done      bool
+	}
+
+	// NOTE: we cannot delete the records while iterating over them
+	var pruned []prunedCampaign
+	remaining := limit
+	k.IterateEndedCampaigns(ctx, func(campaignID uint64) (stop bool) {
+		if remaining <= 0 {
+			return true
+		}
+
+		pc := prunedCampaign{id: campaignID, done: true}
+		k.IterateCampaignClaimsRecords(ctx, campaignID, func(addr sdk.AccAddress, _ types.ClaimsRecord) (stop bool) {
+			if len(pc.addresses) == remaining {
+				pc.done = false
+				return true
+			}
+			pc.addresses = append(pc.addresses, addr)
+			return false
+		})
+
+		remaining -= len(pc.addresses)
+		pruned = append(pruned, pc)
+		return false
+	})
+
+	for _, pc := range pruned {
+		for _, addr := range pc.addresses {
+			k.DeleteCampaignClaimsRecord(ctx, pc.id, addr)
+		}
+
+		if pc.done {
+			k.DeleteEndedCampaign(ctx, pc.id)
+		}
+	}
+}
+
+// GetCampaignsEscrow returns the sum of the escrowed coins of all campaigns
+func (k Keeper) GetCampaignsEscrow(ctx sdk.Context) sdk.Coins {
+	escrow := sdk.Coins{}
+	k.IterateCampaigns(ctx, func(campaign types.Campaign) (stop bool) {
+		escrow = escrow.Add(sdk.Coin{Denom: campaign.Denom, Amount: campaign.Escrow})
+		return false
+	})
+
+	return escrow
+}
+
+// GetCampaignCount returns the identifier of the last created campaign
+func (k Keeper) GetCampaignCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyCampaignCount)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetCampaignCount stores the identifier of the last created campaign
+func (k Keeper) SetCampaignCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyCampaignCount, sdk.Uint64ToBigEndian(count))
+}
+
+// GetCampaign returns the campaign for a given identifier
+func (k Keeper) GetCampaign(ctx sdk.Context, id uint64) (types.Campaign, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaigns)
+
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if len(bz) == 0 {
+		return types.Campaign{}, false
+	}
+
+	var campaign types.Campaign
+	k.cdc.MustUnmarshal(bz, &campaign)
+
+	return campaign, true
+}
+
+// SetCampaign stores a campaign
+func (k Keeper) SetCampaign(ctx sdk.Context, campaign types.Campaign) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaigns)
+	bz := k.cdc.MustMarshal(&campaign)
+	store.Set(sdk.Uint64ToBigEndian(campaign.ID), bz)
+}
+
+// DeleteCampaign deletes a campaign from the store
+func (k Keeper) DeleteCampaign(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaigns)
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+// IterateCampaigns iterates over all campaigns and performs a callback.
+func (k Keeper) IterateCampaigns(ctx sdk.Context, handlerFn func(campaign types.Campaign) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixCampaigns)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var campaign types.Campaign
+		k.cdc.MustUnmarshal(iterator.Value(), &campaign)
+
+		if handlerFn(campaign) {
+			break
+		}
+	}
+}
+
+// GetCampaigns returns all campaigns
+func (k Keeper) GetCampaigns(ctx sdk.Context) []types.Campaign {
+	campaigns := []types.Campaign{}
+	k.IterateCampaigns(ctx, func(campaign types.Campaign) (stop bool) {
+		campaigns = append(campaigns, campaign)
+		return false
+	})
+
+	return campaigns
+}
+
+// GetCampaignClaimsRecord returns the claims record of a campaign for a
+// specific address
+func (k Keeper) GetCampaignClaimsRecord(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress) (types.ClaimsRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixCampaignClaimsRecords(campaignID))
+
+	bz := store.Get(addr)
+	if len(bz) == 0 {
+		return types.ClaimsRecord{}, false
+	}
+
+	var claimsRecord types.ClaimsRecord
+	k.cdc.MustUnmarshal(bz, &claimsRecord)
+
+	return claimsRecord, true
+}
+
+// SetCampaignClaimsRecord sets the claims record of a campaign for an address
+// in store and indexes the campaign by address
+func (k Keeper) SetCampaignClaimsRecord(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress, claimsRecord types.ClaimsRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixCampaignClaimsRecords(campaignID))
+	bz := k.cdc.MustMarshal(&claimsRecord)
+	store.Set(addr, bz)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixAddressCampaigns(addr))
+	indexStore.Set(sdk.Uint64ToBigEndian(campaignID), []byte{1})
+}
+
+// DeleteCampaignClaimsRecord deletes the claims record of a campaign and its
+// address index from the store
+func (k Keeper) DeleteCampaignClaimsRecord(ctx sdk.Context, campaignID uint64, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixCampaignClaimsRecords(campaignID))
+	store.Delete(addr)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixAddressCampaigns(addr))
+	indexStore.Delete(sdk.Uint64ToBigEndian(campaignID))
+}
+
+// GetAddressCampaignIDs returns the identifiers of the campaigns that an
+// address has a claims record for
+func (k Keeper) GetAddressCampaignIDs(ctx sdk.Context, addr sdk.AccAddress) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixAddressCampaigns(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	campaignIDs := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		campaignIDs = append(campaignIDs, sdk.BigEndianToUint64(iterator.Key()))
+	}
+
+	return campaignIDs
+}
+
+// InsertCampaignEndQueue adds a campaign to the queue of campaigns sorted by
+// end time
+func (k Keeper) InsertCampaignEndQueue(ctx sdk.Context, campaign types.Campaign) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCampaignEndQueueKey(campaign.EndTime(), campaign.ID), []byte{1})
+}
+
+// RemoveCampaignEndQueue removes a campaign from the end queue
+func (k Keeper) RemoveCampaignEndQueue(ctx sdk.Context, campaign types.Campaign) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCampaignEndQueueKey(campaign.EndTime(), campaign.ID))
+}
+
+// GetExpiredCampaignIDs returns the identifiers of the campaigns of the end
+// queue that ended before the given time
+func (k Keeper) GetExpiredCampaignIDs(ctx sdk.Context, blockTime time.Time) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixCampaignEndQueue, types.GetKeyPrefixCampaignEndQueue(blockTime))
+	defer iterator.Close()
+
+	campaignIDs := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		campaignIDs = append(campaignIDs, sdk.BigEndianToUint64(key[len(key)-8:]))
+	}
+
+	return campaignIDs
+}
+
+// SetEndedCampaign marks an ended campaign whose claims records have to be
+// pruned
+func (k Keeper) SetEndedCampaign(ctx sdk.Context, campaignID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEndedCampaigns)
+	store.Set(sdk.Uint64ToBigEndian(campaignID), []byte{1})
+}
+
+// DeleteEndedCampaign removes an ended campaign once its claims records have
+// been pruned
+func (k Keeper) DeleteEndedCampaign(ctx sdk.Context, campaignID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEndedCampaigns)
+	store.Delete(sdk.Uint64ToBigEndian(campaignID))
+}
+
+// IterateEndedCampaigns iterates over the ended campaigns whose claims records
+// haven't been pruned yet and performs a callback.
+func (k Keeper) IterateEndedCampaigns(ctx sdk.Context, handlerFn func(campaignID uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEndedCampaigns)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if handlerFn(sdk.BigEndianToUint64(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// IterateCampaignClaimsRecords iterates over all claims records of a campaign
+// and performs a callback.
+func (k Keeper) IterateCampaignClaimsRecords(
+	ctx sdk.Context,
+	campaignID uint64,
+	handlerFn func(addr sdk.AccAddress, cr types.ClaimsRecord) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixCampaignClaimsRecords(campaignID))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claimsRecord types.ClaimsRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &claimsRecord)
+
+		if handlerFn(sdk.AccAddress(iterator.Key()), claimsRecord) {
+			break
+		}
+	}
+}
+
+// GetAllCampaignClaimsRecords returns the claims records of all campaigns for
+// genesis export
+func (k Keeper) GetAllCampaignClaimsRecords(ctx sdk.Context) []types.CampaignClaimsRecord {
+	claimsRecords := []types.CampaignClaimsRecord{}
+	k.IterateCampaigns(ctx, func(campaign types.Campaign) (stop bool) {
+		k.IterateCampaignClaimsRecords(ctx, campaign.ID, func(addr sdk.AccAddress, cr types.ClaimsRecord) (stop bool) {
+			claimsRecords = append(claimsRecords, types.CampaignClaimsRecord{
+				CampaignID: campaign.ID,
+				ClaimsRecord: types.ClaimsRecordAddress{
+					Address:                addr.String(),
+					InitialClaimableAmount: cr.InitialClaimableAmount,
+					ActionsCompleted:       cr.ActionsCompleted,
+					CustomActionsCompleted: cr.CustomActionsCompleted,
+				},
+			})
+			return false
+		})
+		return false
+	})
+
+	return claimsRecords
+}
@@ -0,0 +1,355 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/claims/types"
+	vestingtypes "github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+const campaignDenom = "acoin"
+
+// createCampaign funds the funder and creates a campaign with a single
+// recipient through the msg server
+func (suite *KeeperTestSuite) createCampaign(
+	funder, recipient sdk.AccAddress,
+	amount int64,
+	actions []types.Action,
+) types.Campaign {
+	fee := suite.app.ClaimsKeeper.GetParams(suite.ctx).CampaignCreationFee
+	coins := sdk.NewCoins(sdk.NewInt64Coin(campaignDenom, amount)).Add(fee...)
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, coins)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgCreateCampaign(
+		funder,
+		campaignDenom,
+		actions,
+		nil,
+		time.Time{},
+		time.Hour,
+		time.Hour,
+		[]types.ClaimsRecordAddress{types.NewClaimsRecordAddress(recipient, sdk.NewInt(amount))},
+	)
+
+	res, err := suite.app.ClaimsKeeper.CreateCampaign(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	campaign, found := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, res.CampaignID)
+	suite.Require().True(found)
+	return campaign
+}
+
+func (suite *KeeperTestSuite) TestCreateCampaign() {
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgCreateCampaign
+		expPass  bool
+	}{
+		{
+			"fail - insufficient funds",
+			func() *types.MsgCreateCampaign {
+				return types.NewMsgCreateCampaign(
+					funder, campaignDenom, []types.Action{types.ActionVote}, nil, time.Time{}, time.Hour, time.Hour,
+					[]types.ClaimsRecordAddress{types.NewClaimsRecordAddress(recipient, sdk.NewInt(1000))},
+				)
+			},
+			false,
+		},
+		{
+			"fail - insufficient funds for the creation fee",
+			func() *types.MsgCreateCampaign {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(campaignDenom, 1000))
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, coins)
+				suite.Require().NoError(err)
+
+				return types.NewMsgCreateCampaign(
+					funder, campaignDenom, []types.Action{types.ActionVote}, nil, time.Time{}, time.Hour, time.Hour,
+					[]types.ClaimsRecordAddress{types.NewClaimsRecordAddress(recipient, sdk.NewInt(1000))},
+				)
+			},
+			false,
+		},
+		{
+			"fail - start time in the past",
+			func() *types.MsgCreateCampaign {
+				fee := suite.app.ClaimsKeeper.GetParams(suite.ctx).CampaignCreationFee
+				coins := sdk.NewCoins(sdk.NewInt64Coin(campaignDenom, 1000)).Add(fee...)
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, coins)
+				suite.Require().NoError(err)
+
+				return types.NewMsgCreateCampaign(
+					funder, campaignDenom, []types.Action{types.ActionVote}, nil, suite.ctx.BlockTime().Add(-time.Second), time.Hour, time.Hour,
+					[]types.ClaimsRecordAddress{types.NewClaimsRecordAddress(recipient, sdk.NewInt(1000))},
+				)
+			},
+			false,
+		},
+		{
+			"pass - escrows the claimable amounts",
+			func() *types.MsgCreateCampaign {
+				fee := suite.app.ClaimsKeeper.GetParams(suite.ctx).CampaignCreationFee
+				coins := sdk.NewCoins(sdk.NewInt64Coin(campaignDenom, 1000)).Add(fee...)
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, coins)
+				suite.Require().NoError(err)
+
+				return types.NewMsgCreateCampaign(
+					funder, campaignDenom, []types.Action{types.ActionVote}, nil, time.Time{}, time.Hour, time.Hour,
+					[]types.ClaimsRecordAddress{types.NewClaimsRecordAddress(recipient, sdk.NewInt(1000))},
+				)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			msg := tc.malleate()
+			res, err := suite.app.ClaimsKeeper.CreateCampaign(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Empty(suite.app.ClaimsKeeper.GetCampaigns(suite.ctx))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), res.CampaignID)
+
+			campaign, found := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, res.CampaignID)
+			suite.Require().True(found)
+			suite.Require().Equal(funder.String(), campaign.Funder)
+			suite.Require().Equal(suite.ctx.BlockTime(), campaign.StartTime)
+			suite.Require().Equal(sdk.NewInt(1000), campaign.Escrow)
+
+			cr, found := suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, res.CampaignID, recipient)
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewClaimsRecord(sdk.NewInt(1000)), cr)
+
+			moduleAddr := suite.app.ClaimsKeeper.GetModuleAccountAddress()
+			suite.Require().Equal(sdk.NewInt(1000), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, campaignDenom).Amount)
+			suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, funder, campaignDenom).IsZero())
+			suite.Require().Equal([]uint64{res.CampaignID}, suite.app.ClaimsKeeper.GetAddressCampaignIDs(suite.ctx, recipient))
+
+			// the creation fee is paid to the community pool
+			fee := suite.app.ClaimsKeeper.GetParams(suite.ctx).CampaignCreationFee
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, funder).IsZero())
+			communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+			suite.Require().True(communityPool.AmountOf(types.DefaultClaimsDenom).GTE(sdk.NewDecFromInt(fee.AmountOf(types.DefaultClaimsDenom))))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClaimCampaignsForAction() {
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	campaign := suite.createCampaign(funder, recipient, 1000, []types.Action{types.ActionVote, types.ActionEVM})
+
+	// action that is not part of the campaign
+	suite.app.ClaimsKeeper.ClaimCampaignsForAction(suite.ctx, recipient, types.ActionDelegate)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, recipient, campaignDenom).IsZero())
+
+	// the claimable amount is split between the actions of the campaign
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, recipient)
+	suite.Require().Equal(sdk.NewInt(500), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, campaignDenom).Amount)
+
+	// the action can only be claimed once
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, recipient)
+	suite.Require().Equal(sdk.NewInt(500), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, campaignDenom).Amount)
+
+	cr, found := suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, campaign.ID, recipient)
+	suite.Require().True(found)
+	suite.Require().True(cr.HasClaimedAction(types.ActionVote))
+	suite.Require().False(cr.HasClaimedAction(types.ActionEVM))
+
+	campaign, found = suite.app.ClaimsKeeper.GetCampaign(suite.ctx, campaign.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(500), campaign.Escrow)
+
+	// the genesis airdrop escrow doesn't include the campaigns escrow
+	res, err := suite.queryClient.TotalUnclaimed(sdk.WrapSDKContext(suite.ctx), &types.QueryTotalUnclaimedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Coins.AmountOf(campaignDenom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestClaimCampaignsWithDecay() {
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	campaign := suite.createCampaign(funder, recipient, 1000, []types.Action{types.ActionVote})
+
+	// half of the decay period has elapsed
+	ctx := suite.ctx.WithBlockTime(campaign.DecayStartTime().Add(30 * time.Minute))
+	suite.app.ClaimsKeeper.ClaimCampaignsForAction(ctx, recipient, types.ActionVote)
+	suite.Require().Equal(sdk.NewInt(500), suite.app.BankKeeper.GetBalance(ctx, recipient, campaignDenom).Amount)
+
+	// the decayed remainder is kept in the campaign escrow
+	campaign, found := suite.app.ClaimsKeeper.GetCampaign(ctx, campaign.ID)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(500), campaign.Escrow)
+}
+
+func (suite *KeeperTestSuite) TestEndExpiredCampaigns() {
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	campaign := suite.createCampaign(funder, recipient, 1000, []types.Action{types.ActionVote, types.ActionEVM})
+
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, recipient)
+
+	// campaign is still active
+	suite.app.ClaimsKeeper.EndExpiredCampaigns(suite.ctx)
+	_, found := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, campaign.ID)
+	suite.Require().True(found)
+
+	ctx := suite.ctx.WithBlockTime(campaign.EndTime().Add(time.Second))
+	suite.app.ClaimsKeeper.EndExpiredCampaigns(ctx)
+
+	_, found = suite.app.ClaimsKeeper.GetCampaign(ctx, campaign.ID)
+	suite.Require().False(found)
+	_, found = suite.app.ClaimsKeeper.GetCampaignClaimsRecord(ctx, campaign.ID, recipient)
+	suite.Require().False(found)
+
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetAddressCampaignIDs(ctx, recipient))
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetExpiredCampaignIDs(ctx, ctx.BlockTime()))
+
+	// the unclaimed coins are returned to the funder
+	suite.Require().Equal(sdk.NewInt(500), suite.app.BankKeeper.GetBalance(ctx, funder, campaignDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestPruneEndedCampaignClaimsRecords() {
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	campaign := suite.createCampaign(funder, recipient, 1000, []types.Action{types.ActionVote})
+
+	recipients := []sdk.AccAddress{recipient}
+	for i := 0; i < 2; i++ {
+		addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+		suite.app.ClaimsKeeper.SetCampaignClaimsRecord(suite.ctx, campaign.ID, addr, types.NewClaimsRecord(sdk.OneInt()))
+		recipients = append(recipients, addr)
+	}
+
+	ctx := suite.ctx.WithBlockTime(campaign.EndTime().Add(time.Second))
+	suite.Require().NoError(suite.app.ClaimsKeeper.EndCampaign(ctx, campaign))
+
+	countRecords := func() int {
+		count := 0
+		suite.app.ClaimsKeeper.IterateCampaignClaimsRecords(ctx, campaign.ID, func(sdk.AccAddress, types.ClaimsRecord) bool {
+			count++
+			return false
+		})
+		return count
+	}
+
+	countEnded := func() int {
+		count := 0
+		suite.app.ClaimsKeeper.IterateEndedCampaigns(ctx, func(uint64) bool {
+			count++
+			return false
+		})
+		return count
+	}
+
+	// the records are deleted over several blocks
+	suite.app.ClaimsKeeper.PruneEndedCampaignClaimsRecords(ctx, 2)
+	suite.Require().Equal(1, countRecords())
+	suite.Require().Equal(1, countEnded())
+
+	suite.app.ClaimsKeeper.PruneEndedCampaignClaimsRecords(ctx, 2)
+	suite.Require().Equal(0, countRecords())
+	suite.Require().Equal(0, countEnded())
+
+	for _, addr := range recipients {
+		suite.Require().Empty(suite.app.ClaimsKeeper.GetAddressCampaignIDs(ctx, addr))
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferCampaignClaimsRecord() {
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	fee := suite.app.ClaimsKeeper.GetParams(suite.ctx).CampaignCreationFee
+	coins := sdk.NewCoins(sdk.NewInt64Coin(campaignDenom, 1600)).Add(fee...)
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, coins)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgCreateCampaign(
+		funder, campaignDenom, []types.Action{types.ActionVote, types.ActionEVM}, nil, time.Time{}, time.Hour, time.Hour,
+		[]types.ClaimsRecordAddress{
+			types.NewClaimsRecordAddress(sender, sdk.NewInt(1000)),
+			types.NewClaimsRecordAddress(recipient, sdk.NewInt(600)),
+		},
+	)
+	res, err := suite.app.ClaimsKeeper.CreateCampaign(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	// each record completes a different action
+	suite.app.ClaimsKeeper.ClaimCampaignsForAction(suite.ctx, sender, types.ActionVote)
+	suite.app.ClaimsKeeper.ClaimCampaignsForAction(suite.ctx, recipient, types.ActionEVM)
+	suite.Require().Equal(sdk.NewInt(500), suite.app.BankKeeper.GetBalance(suite.ctx, sender, campaignDenom).Amount)
+	suite.Require().Equal(sdk.NewInt(300), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, campaignDenom).Amount)
+
+	_, err = suite.app.ClaimsKeeper.TransferClaimsRecord(sdk.WrapSDKContext(suite.ctx), types.NewMsgTransferClaimsRecord(sender, recipient))
+	suite.Require().NoError(err)
+
+	// the pending action of each record is claimed by the recipient
+	suite.Require().Equal(sdk.NewInt(1100), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, campaignDenom).Amount)
+
+	_, found := suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, res.CampaignID, sender)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetAddressCampaignIDs(suite.ctx, sender))
+
+	cr, found := suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, res.CampaignID, recipient)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1600), cr.InitialClaimableAmount)
+	suite.Require().True(cr.HasClaimedAction(types.ActionVote))
+	suite.Require().True(cr.HasClaimedAction(types.ActionEVM))
+
+	campaign, found := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, res.CampaignID)
+	suite.Require().True(found)
+	suite.Require().True(campaign.Escrow.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestClaimCampaignsWithVesting() {
+	suite.SetupTest()
+
+	params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+	params.EnableVesting = true
+	params.VestingLockupDuration = time.Hour
+	params.VestingDuration = 4 * time.Hour
+	params.VestingPeriods = 4
+	suite.app.ClaimsKeeper.SetParams(suite.ctx, params)
+
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	suite.createCampaign(funder, addr, 1000, []types.Action{types.ActionVote, types.ActionDelegate})
+
+	suite.app.ClaimsKeeper.ClaimCampaignsForAction(suite.ctx, addr, types.ActionVote)
+
+	claimed := sdk.NewCoins(sdk.NewInt64Coin(campaignDenom, 500))
+	suite.Require().Equal(claimed, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr))
+
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	va, ok := acc.(*vestingtypes.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().True(va.HasFunder(suite.app.ClaimsKeeper.GetModuleAccountAddress().String()))
+	suite.Require().Equal(claimed, va.GetOriginalVesting())
+	suite.Require().Equal(claimed, va.GetLockedOnly(suite.ctx.BlockTime()))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,7 +43,7 @@ func (k Keeper) ClaimCoinsForAction(
 	claimedCoins := sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: claimableAmount}}
 	remainderCoins := sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: remainderAmount}}
 
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, claimedCoins); err != nil {
+	if err := k.sendClaimedCoins(ctx, addr, claimedCoins, params); err != nil {
 		return sdk.ZeroInt(), err
 	}
 
@@ -76,6 +78,26 @@ func (k Keeper) ClaimCoinsForAction(
 	return claimableAmount, nil
 }
 
+// sendClaimedCoins transfers the claimed coins from the airdrop escrow to the
+// user's account. If the vesting mode is enabled, the coins are granted to a
+// clawback vesting account funded by the claims module, with the lockup and
+// vesting schedule defined in the params starting at the current block time.
+func (k Keeper) sendClaimedCoins(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	claimedCoins sdk.Coins,
+	params types.Params,
+) error {
+	if !params.EnableVesting {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, claimedCoins)
+	}
+
+	lockupPeriods, vestingPeriods := params.VestingSchedule(claimedCoins)
+	return k.vestingKeeper.FundClawbackVestingAccount(
+		ctx, k.GetModuleAccountAddress(), addr, ctx.BlockTime(), lockupPeriods, vestingPeriods,
+	)
+}
+
 // MergeClaimsRecords merges two independent claims records (sender and
 // recipient) into a new instance by summing up the initial claimable amounts
 // from both records.
@@ -90,47 +112,25 @@ func (k Keeper) MergeClaimsRecords(
 	recipientClaimsRecord types.ClaimsRecord,
 	params types.Params,
 ) (mergedRecord types.ClaimsRecord, err error) {
-	claimedAmt := sdk.ZeroInt()
-	remainderAmt := sdk.ZeroInt()
-
-	// new total is the sum of the sender and recipient claims records amounts
-	totalClaimableAmt := senderClaimsRecord.InitialClaimableAmount.Add(recipientClaimsRecord.InitialClaimableAmount)
-	mergedRecord = types.NewClaimsRecord(totalClaimableAmt)
-
-	// iterate over all the available actions and claim the amount if
-	// the recipient or sender has completed an action but the other hasn't
+	// Safety check: the sender record cannot have any claimed actions, as
+	//  - the sender is not an evmos address and can't claim vote, delegation or evm actions
+	//  - the first attempt to perform an ibc callback from the senders account will merge/migrate the entire claims record
 	actions := []types.Action{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer}
 	for _, action := range actions {
-
-		// Safety check: the sender record cannot have any claimed actions, as
-		//  - the sender is not an evmos address and can't claim vote, delegation or evm actions
-		//  - the first attempt to perform an ibc callback from the senders account will merge/migrate the entire claims record
 		if senderClaimsRecord.HasClaimedAction(action) {
 			return types.ClaimsRecord{}, errorsmod.Wrapf(errortypes.ErrNotSupported, "non-evmos sender must not have claimed action: %v", action)
 		}
+	}
 
-		recipientCompleted := recipientClaimsRecord.HasClaimedAction(action)
-
-		if recipientCompleted {
-			// claim action for sender since the recipient completed it
-			amt, remainder := k.GetClaimableAmountForAction(ctx, senderClaimsRecord, action, params)
-			claimedAmt = claimedAmt.Add(amt)
-			remainderAmt = remainderAmt.Add(remainder)
-			mergedRecord.MarkClaimed(action)
-		} else {
-			// Neither sender or recipient completed the action.
-			if action != types.ActionIBCTransfer {
-				// No-op if the action is not IBC transfer
-				continue
-			}
+	mergedRecord, claimedAmt, remainderAmt := k.mergeClaimsRecords(ctx, senderClaimsRecord, recipientClaimsRecord, params)
 
-			// claim IBC action for both sender and recipient
-			amtIBCRecipient, remainderRecipient := k.GetClaimableAmountForAction(ctx, recipientClaimsRecord, action, params)
-			amtIBCSender, remainderSender := k.GetClaimableAmountForAction(ctx, senderClaimsRecord, action, params)
-			claimedAmt = claimedAmt.Add(amtIBCRecipient).Add(amtIBCSender)
-			remainderAmt = remainderAmt.Add(remainderRecipient).Add(remainderSender)
-			mergedRecord.MarkClaimed(action)
-		}
+	// claim IBC action for both sender and recipient if neither completed it
+	if !mergedRecord.HasClaimedAction(types.ActionIBCTransfer) {
+		amtIBCRecipient, remainderRecipient := k.GetClaimableAmountForAction(ctx, recipientClaimsRecord, types.ActionIBCTransfer, params)
+		amtIBCSender, remainderSender := k.GetClaimableAmountForAction(ctx, senderClaimsRecord, types.ActionIBCTransfer, params)
+		claimedAmt = claimedAmt.Add(amtIBCRecipient).Add(amtIBCSender)
+		remainderAmt = remainderAmt.Add(remainderRecipient).Add(remainderSender)
+		mergedRecord.MarkClaimed(types.ActionIBCTransfer)
 	}
 
 	// safety check to prevent error while sending coins from the module escrow balance to the recipient
@@ -138,19 +138,8 @@ func (k Keeper) MergeClaimsRecords(
 		return mergedRecord, nil
 	}
 
-	claimedCoins := sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: claimedAmt}}
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, claimedCoins); err != nil {
-		return types.ClaimsRecord{}, err
-	}
-
-	remainderCoins := sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: remainderAmt}}
-	// short-circuit: don't fund community pool if remainder is 0
-	if remainderCoins.IsZero() {
-		return mergedRecord, nil
-	}
-
-	escrowAddr := k.GetModuleAccountAddress()
-	if err := k.distrKeeper.FundCommunityPool(ctx, remainderCoins, escrowAddr); err != nil {
+	claimedCoins, remainderCoins, err := k.sendMergedCoins(ctx, recipient, claimedAmt, remainderAmt, params)
+	if err != nil {
 		return types.ClaimsRecord{}, err
 	}
 
@@ -166,6 +155,139 @@ func (k Keeper) MergeClaimsRecords(
 	return mergedRecord, nil
 }
 
+// mergeClaimsRecords sums up the initial claimable amounts of the sender and
+// recipient claims records. An action completed by only one of the records is
+// claimed for the other one and marked as completed on the merged record. It
+// returns the merged record and the claimed and decayed amounts, which are
+// transferred by the caller.
+func (k Keeper) mergeClaimsRecords(
+	ctx sdk.Context,
+	senderClaimsRecord,
+	recipientClaimsRecord types.ClaimsRecord,
+	params types.Params,
+) (mergedRecord types.ClaimsRecord, claimedAmt, remainderAmt math.Int) {
+	claimedAmt = sdk.ZeroInt()
+	remainderAmt = sdk.ZeroInt()
+
+	totalClaimableAmt := senderClaimsRecord.InitialClaimableAmount.Add(recipientClaimsRecord.InitialClaimableAmount)
+	mergedRecord = types.NewClaimsRecord(totalClaimableAmt)
+
+	actions := []types.Action{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer}
+	for _, action := range actions {
+		senderCompleted := senderClaimsRecord.HasClaimedAction(action)
+		recipientCompleted := recipientClaimsRecord.HasClaimedAction(action)
+
+		switch {
+		case senderCompleted && recipientCompleted:
+		case senderCompleted:
+			// claim action for recipient since the sender completed it
+			amt, remainder := k.GetClaimableAmountForAction(ctx, recipientClaimsRecord, action, params)
+			claimedAmt = claimedAmt.Add(amt)
+			remainderAmt = remainderAmt.Add(remainder)
+		case recipientCompleted:
+			// claim action for sender since the recipient completed it
+			amt, remainder := k.GetClaimableAmountForAction(ctx, senderClaimsRecord, action, params)
+			claimedAmt = claimedAmt.Add(amt)
+			remainderAmt = remainderAmt.Add(remainder)
+		default:
+			continue
+		}
+
+		mergedRecord.MarkClaimed(action)
+	}
+
+	return mergedRecord, claimedAmt, remainderAmt
+}
+
+// sendMergedCoins transfers the amount claimed on a merge to the recipient and
+// funds the community pool with the decayed remainder
+func (k Keeper) sendMergedCoins(
+	ctx sdk.Context,
+	recipient sdk.AccAddress,
+	claimedAmt,
+	remainderAmt math.Int,
+	params types.Params,
+) (claimedCoins, remainderCoins sdk.Coins, err error) {
+	claimedCoins = sdk.Coins{}
+	if !claimedAmt.IsZero() {
+		claimedCoins = sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: claimedAmt}}
+		if err := k.sendClaimedCoins(ctx, recipient, claimedCoins, params); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	remainderCoins = sdk.Coins{}
+	if !remainderAmt.IsZero() {
+		remainderCoins = sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: remainderAmt}}
+		if err := k.distrKeeper.FundCommunityPool(ctx, remainderCoins, k.GetModuleAccountAddress()); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return claimedCoins, remainderCoins, nil
+}
+
+// transferClaimsRecord transfers the claims records of the sender to the
+// recipient, both the genesis airdrop one and the ones of the campaigns. If
+// the recipient has a claims record, both records are merged by summing up
+// their initial claimable amounts. An action completed by only one of the
+// records is claimed for the other one and the coins are transferred to the
+// recipient, as in MergeClaimsRecords.
+func (k Keeper) transferClaimsRecord(
+	ctx sdk.Context,
+	sender,
+	recipient sdk.AccAddress,
+) (types.ClaimsRecord, sdk.Coins, error) {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return types.ClaimsRecord{}, nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized, "recipient address %s is in the deny list for receiving funds", recipient,
+		)
+	}
+
+	senderClaimsRecord, found := k.GetClaimsRecord(ctx, sender)
+	campaignIDs := k.GetAddressCampaignIDs(ctx, sender)
+	if !found && len(campaignIDs) == 0 {
+		return types.ClaimsRecord{}, nil, errorsmod.Wrapf(types.ErrClaimsRecordNotFound, "address %s", sender)
+	}
+
+	mergedRecord := types.ClaimsRecord{}
+	claimedCoins := sdk.Coins{}
+
+	if found {
+		params := k.GetParams(ctx)
+		if !params.IsClaimsActive(ctx.BlockTime()) {
+			return types.ClaimsRecord{}, nil, types.ErrClaimsNotActive
+		}
+
+		recipientClaimsRecord, found := k.GetClaimsRecord(ctx, recipient)
+		if !found {
+			mergedRecord = senderClaimsRecord
+		} else {
+			var claimedAmt, remainderAmt math.Int
+			mergedRecord, claimedAmt, remainderAmt = k.mergeClaimsRecords(ctx, senderClaimsRecord, recipientClaimsRecord, params)
+
+			var err error
+			claimedCoins, _, err = k.sendMergedCoins(ctx, recipient, claimedAmt, remainderAmt, params)
+			if err != nil {
+				return types.ClaimsRecord{}, nil, err
+			}
+		}
+
+		k.SetClaimsRecord(ctx, recipient, mergedRecord)
+		k.DeleteClaimsRecord(ctx, sender)
+	}
+
+	for _, campaignID := range campaignIDs {
+		campaignCoins, err := k.transferCampaignClaimsRecord(ctx, campaignID, sender, recipient)
+		if err != nil {
+			return types.ClaimsRecord{}, nil, err
+		}
+		claimedCoins = claimedCoins.Add(campaignCoins...)
+	}
+
+	return mergedRecord, claimedCoins, nil
+}
+
 // GetClaimableAmountForAction returns claimable amount for a specific action
 // done by an address
 // returns zero if airdrop didn't start, isn't enabled or has finished
@@ -206,9 +328,19 @@ func (k Keeper) ClaimableAmountForAction(
 	actionsCount := int64(len(types.Action_name) - 1)
 	initialClaimablePerAction := claimsRecord.InitialClaimableAmount.QuoRaw(actionsCount)
 
+	return decayedAmount(ctx.BlockTime(), initialClaimablePerAction, params.DecayStartTime(), params.DurationOfDecay)
+}
+
+// decayedAmount returns the amount that can be claimed at the given block time
+// out of the initial claimable amount per action, and the decayed remainder.
+func decayedAmount(
+	blockTime time.Time,
+	initialClaimablePerAction math.Int,
+	decayStartTime time.Time,
+	durationOfDecay time.Duration,
+) (claimableCoins, remainder math.Int) {
 	// return full claim amount if the elapsed time <= decay start time
-	decayStartTime := params.DecayStartTime()
-	if !ctx.BlockTime().After(decayStartTime) {
+	if !blockTime.After(decayStartTime) {
 		return initialClaimablePerAction, sdk.ZeroInt()
 	}
 
@@ -218,8 +350,8 @@ func (k Keeper) ClaimableAmountForAction(
 	// more coins than if you claim at the end of it.
 	//
 	// Claimable percent = (1 - elapsed decay) x 100
-	elapsedDecay := ctx.BlockTime().Sub(decayStartTime)
-	elapsedDecayRatio := sdk.NewDec(elapsedDecay.Nanoseconds()).QuoInt64(params.DurationOfDecay.Nanoseconds())
+	elapsedDecay := blockTime.Sub(decayStartTime)
+	elapsedDecayRatio := sdk.NewDec(elapsedDecay.Nanoseconds()).QuoInt64(durationOfDecay.Nanoseconds())
 	claimableRatio := sdk.OneDec().Sub(elapsedDecayRatio)
 
 	// calculate the claimable coins, while rounding the decimals
@@ -17,6 +17,7 @@ import (
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/evmos/v10/testutil"
 	inflationtypes "github.com/evmos/evmos/v10/x/inflation/types"
+	vestingtypes "github.com/evmos/evmos/v10/x/vesting/types"
 
 	"github.com/evmos/evmos/v10/x/claims/types"
 )
@@ -1125,3 +1126,231 @@ func (suite *KeeperTestSuite) getUserTotalClaimable(ctx sdk.Context, addr sdk.Ac
 
 	return totalClaimable
 }
+
+func (suite *KeeperTestSuite) TestTransferClaimsRecord() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name       string
+		malleate   func() sdk.AccAddress
+		expPass    bool
+		expRecord  types.ClaimsRecord
+		expClaimed math.Int
+	}{
+		{
+			"fail - sender without claims record",
+			func() sdk.AccAddress { return recipient },
+			false,
+			types.ClaimsRecord{},
+			sdk.ZeroInt(),
+		},
+		{
+			"fail - claims disabled",
+			func() sdk.AccAddress {
+				suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, sender, types.NewClaimsRecord(sdk.NewInt(400)))
+				params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+				params.EnableClaims = false
+				suite.app.ClaimsKeeper.SetParams(suite.ctx, params)
+				return recipient
+			},
+			false,
+			types.ClaimsRecord{},
+			sdk.ZeroInt(),
+		},
+		{
+			"fail - blocked recipient",
+			func() sdk.AccAddress {
+				suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, sender, types.NewClaimsRecord(sdk.NewInt(400)))
+				return authtypes.NewModuleAddress(distrtypes.ModuleName)
+			},
+			false,
+			types.ClaimsRecord{},
+			sdk.ZeroInt(),
+		},
+		{
+			"pass - migrate to recipient without claims record",
+			func() sdk.AccAddress {
+				cr := types.NewClaimsRecord(sdk.NewInt(400))
+				cr.MarkClaimed(types.ActionVote)
+				suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, sender, cr)
+				return recipient
+			},
+			true,
+			types.ClaimsRecord{
+				InitialClaimableAmount: sdk.NewInt(400),
+				ActionsCompleted:       []bool{true, false, false, false},
+			},
+			sdk.ZeroInt(),
+		},
+		{
+			"pass - merge with recipient claims record",
+			func() sdk.AccAddress {
+				senderRecord := types.NewClaimsRecord(sdk.NewInt(400))
+				senderRecord.MarkClaimed(types.ActionVote)
+				senderRecord.MarkClaimed(types.ActionDelegate)
+				suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, sender, senderRecord)
+
+				recipientRecord := types.NewClaimsRecord(sdk.NewInt(800))
+				recipientRecord.MarkClaimed(types.ActionDelegate)
+				recipientRecord.MarkClaimed(types.ActionEVM)
+				suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, recipient, recipientRecord)
+				return recipient
+			},
+			true,
+			types.ClaimsRecord{
+				InitialClaimableAmount: sdk.NewInt(1200),
+				ActionsCompleted:       []bool{true, true, true, false},
+			},
+			// vote action of the recipient (200) and EVM action of the sender (100)
+			sdk.NewInt(300),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTestWithEscrow() // reset
+
+			to := tc.malleate()
+			msg := types.NewMsgTransferClaimsRecord(sender, to)
+			res, err := suite.app.ClaimsKeeper.TransferClaimsRecord(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRecord, res.ClaimsRecord)
+
+			suite.Require().False(suite.app.ClaimsKeeper.HasClaimsRecord(suite.ctx, sender))
+			cr, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, to)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expRecord, cr)
+
+			params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, to, params.ClaimsDenom)
+			suite.Require().Equal(tc.expClaimed, balance.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTransferClaimsRecordWithAuthz() {
+	suite.SetupTestWithEscrow()
+
+	cold := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	hot := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, cold, types.NewClaimsRecord(sdk.NewInt(400)))
+
+	// the hot wallet can't transfer the claims record without a grant
+	msgs := []sdk.Msg{types.NewMsgTransferClaimsRecord(cold, hot)}
+	_, err := suite.app.AuthzKeeper.DispatchActions(suite.ctx, hot, msgs)
+	suite.Require().Error(err)
+
+	authorization := types.NewTransferClaimsRecordAuthorization(hot)
+	err = suite.app.AuthzKeeper.SaveGrant(suite.ctx, hot, cold, authorization, nil)
+	suite.Require().NoError(err)
+
+	// the claims record can only be transferred to the allowed recipients
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, hot, []sdk.Msg{types.NewMsgTransferClaimsRecord(cold, other)})
+	suite.Require().Error(err)
+
+	_, err = suite.app.AuthzKeeper.DispatchActions(suite.ctx, hot, msgs)
+	suite.Require().NoError(err)
+
+	suite.Require().False(suite.app.ClaimsKeeper.HasClaimsRecord(suite.ctx, cold))
+	cr, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, hot)
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewClaimsRecord(sdk.NewInt(400)), cr)
+}
+
+func (suite *KeeperTestSuite) TestClaimCoinsForActionWithVesting() {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"pass - new account",
+			func() {},
+			true,
+		},
+		{
+			"pass - convert Ethereum EOA",
+			func() {
+				baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+				acc := &ethermint.EthAccount{BaseAccount: baseAcc, CodeHash: common.BytesToHash(crypto.Keccak256(nil)).Hex()}
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			true,
+		},
+		{
+			"pass - merge into clawback vesting account of the claims module",
+			func() {
+				claimsAddr := suite.app.ClaimsKeeper.GetModuleAccountAddress()
+				baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+				acc := vestingtypes.NewClawbackVestingAccount(baseAcc, claimsAddr, sdk.NewCoins(), suite.ctx.BlockTime(), nil, nil)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			true,
+		},
+		{
+			"pass - add grant to clawback vesting account of another funder",
+			func() {
+				baseAcc := authtypes.NewBaseAccountWithAddress(addr)
+				acc := vestingtypes.NewClawbackVestingAccount(baseAcc, funder, sdk.NewCoins(), suite.ctx.BlockTime(), nil, nil)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTestWithEscrow()
+
+			params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+			params.EnableVesting = true
+			params.VestingLockupDuration = time.Hour
+			params.VestingDuration = 4 * time.Hour
+			params.VestingPeriods = 4
+			suite.app.ClaimsKeeper.SetParams(suite.ctx, params)
+
+			tc.malleate()
+
+			cr := types.NewClaimsRecord(sdk.NewInt(400))
+			suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, addr, cr)
+
+			amt, err := suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr, cr, types.ActionVote, params)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr).IsZero())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(100), amt)
+
+			cr, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, addr)
+			suite.Require().True(found)
+			amt, err = suite.app.ClaimsKeeper.ClaimCoinsForAction(suite.ctx, addr, cr, types.ActionDelegate, params)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(100), amt)
+
+			claimed := sdk.NewCoins(sdk.NewCoin(params.ClaimsDenom, sdk.NewInt(200)))
+			balances := suite.app.BankKeeper.GetAllBalances(suite.ctx, addr)
+			suite.Require().Equal(claimed, balances)
+
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+			va, ok := acc.(*vestingtypes.ClawbackVestingAccount)
+			suite.Require().True(ok)
+			suite.Require().True(va.HasFunder(suite.app.ClaimsKeeper.GetModuleAccountAddress().String()))
+			suite.Require().Equal(claimed, va.GetOriginalVesting())
+			suite.Require().Equal(claimed, va.GetLockedOnly(suite.ctx.BlockTime()))
+			suite.Require().True(va.GetVestedOnly(suite.ctx.BlockTime()).IsZero())
+			suite.Require().True(va.GetVestedOnly(suite.ctx.BlockTime().Add(5 * time.Hour)).IsAllGTE(claimed))
+		})
+	}
+}
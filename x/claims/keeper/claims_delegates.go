@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/x/claims/types"
+)
+
+// requestClaimsDelegate stores the request of the granter for the delegate to
+// complete the actions of its claims records, replacing the previous request.
+// The current delegate of the granter is kept until the request is accepted.
+// A nil delegate removes both the delegate and the request of the granter.
+func (k Keeper) requestClaimsDelegate(ctx sdk.Context, granter, delegate sdk.AccAddress) {
+	if delegate == nil {
+		k.DeleteGranterDelegate(ctx, granter)
+		k.DeletePendingGranterDelegate(ctx, granter)
+		return
+	}
+
+	if current, found := k.GetGranterDelegate(ctx, granter); found && current.Equals(delegate) {
+		k.DeletePendingGranterDelegate(ctx, granter)
+		return
+	}
+
+	k.SetPendingGranterDelegate(ctx, granter, delegate)
+}
+
+// acceptClaimsDelegate sets the delegate of the granter if the granter
+// requested it, replacing the previous delegate of the granter
+func (k Keeper) acceptClaimsDelegate(ctx sdk.Context, delegate, granter sdk.AccAddress) error {
+	pending, found := k.GetPendingGranterDelegate(ctx, granter)
+	if !found || !pending.Equals(delegate) {
+		return errorsmod.Wrapf(
+			types.ErrClaimsDelegateNotFound, "granter %s didn't request delegate %s", granter, delegate,
+		)
+	}
+
+	k.DeletePendingGranterDelegate(ctx, granter)
+	k.SetGranterDelegate(ctx, granter, delegate)
+	return nil
+}
+
+// revokeClaimsDelegate rejects the pending request of the granter and removes
+// the delegate from the granter if set
+func (k Keeper) revokeClaimsDelegate(ctx sdk.Context, delegate, granter sdk.AccAddress) error {
+	revoked := false
+	if pending, found := k.GetPendingGranterDelegate(ctx, granter); found && pending.Equals(delegate) {
+		k.DeletePendingGranterDelegate(ctx, granter)
+		revoked = true
+	}
+
+	if current, found := k.GetGranterDelegate(ctx, granter); found && current.Equals(delegate) {
+		k.DeleteGranterDelegate(ctx, granter)
+		revoked = true
+	}
+
+	if !revoked {
+		return errorsmod.Wrapf(
+			types.ErrClaimsDelegateNotFound, "%s is not the delegate of granter %s", delegate, granter,
+		)
+	}
+
+	return nil
+}
+
+// getClaimers returns the address that completed an action followed by the
+// granters whose request to be their claims delegate it accepted
+func (k Keeper) getClaimers(ctx sdk.Context, addr sdk.AccAddress) []sdk.AccAddress {
+	return append([]sdk.AccAddress{addr}, k.GetDelegateGranters(ctx, addr)...)
+}
+
+// GetGranterDelegate returns the delegate of a granter
+func (k Keeper) GetGranterDelegate(ctx sdk.Context, granter sdk.AccAddress) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsDelegates)
+
+	bz := store.Get(granter)
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	return sdk.AccAddress(bz), true
+}
+
+// SetGranterDelegate stores the delegate of a granter and indexes the granter
+// by delegate. The previous delegate of the granter is removed.
+func (k Keeper) SetGranterDelegate(ctx sdk.Context, granter, delegate sdk.AccAddress) {
+	k.DeleteGranterDelegate(ctx, granter)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsDelegates)
+	store.Set(granter, delegate)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixClaimsDelegateGranters(delegate))
+	indexStore.Set(granter, []byte{1})
+}
+
+// DeleteGranterDelegate removes the delegate of a granter and its index
+func (k Keeper) DeleteGranterDelegate(ctx sdk.Context, granter sdk.AccAddress) {
+	delegate, found := k.GetGranterDelegate(ctx, granter)
+	if !found {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsDelegates)
+	store.Delete(granter)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixClaimsDelegateGranters(delegate))
+	indexStore.Delete(granter)
+}
+
+// GetDelegateGranters returns the granters whose actions are completed
+// by a delegate
+func (k Keeper) GetDelegateGranters(ctx sdk.Context, delegate sdk.AccAddress) []sdk.AccAddress {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixClaimsDelegateGranters(delegate))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	granters := []sdk.AccAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		granters = append(granters, sdk.AccAddress(iterator.Key()))
+	}
+
+	return granters
+}
+
+// GetClaimsDelegates returns all the claims delegates for genesis export
+func (k Keeper) GetClaimsDelegates(ctx sdk.Context) []types.ClaimsDelegate {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixClaimsDelegates)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	delegates := []types.ClaimsDelegate{}
+	for ; iterator.Valid(); iterator.Next() {
+		delegates = append(delegates, types.NewClaimsDelegate(iterator.Key(), iterator.Value()))
+	}
+
+	return delegates
+}
+
+// GetPendingGranterDelegate returns the delegate requested by a granter
+func (k Keeper) GetPendingGranterDelegate(ctx sdk.Context, granter sdk.AccAddress) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingClaimsDelegates)
+
+	bz := store.Get(granter)
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	return sdk.AccAddress(bz), true
+}
+
+// SetPendingGranterDelegate stores the delegate requested by a granter
+func (k Keeper) SetPendingGranterDelegate(ctx sdk.Context, granter, delegate sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingClaimsDelegates)
+	store.Set(granter, delegate)
+}
+
+// DeletePendingGranterDelegate removes the delegate requested by a granter
+func (k Keeper) DeletePendingGranterDelegate(ctx sdk.Context, granter sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingClaimsDelegates)
+	store.Delete(granter)
+}
+
+// GetPendingClaimsDelegates returns all the pending claims delegates for
+// genesis export
+func (k Keeper) GetPendingClaimsDelegates(ctx sdk.Context) []types.ClaimsDelegate {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingClaimsDelegates)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	delegates := []types.ClaimsDelegate{}
+	for ; iterator.Valid(); iterator.Next() {
+		delegates = append(delegates, types.NewClaimsDelegate(iterator.Key(), iterator.Value()))
+	}
+
+	return delegates
+}
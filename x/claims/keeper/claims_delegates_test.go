@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/x/claims/types"
+)
+
+func (suite *KeeperTestSuite) TestSetClaimsDelegate() {
+	suite.SetupTest()
+
+	granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	delegate := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	// the delegate can't accept without a request of the granter
+	_, err := suite.app.ClaimsKeeper.AcceptClaimsDelegate(goCtx, types.NewMsgAcceptClaimsDelegate(delegate, granter, false))
+	suite.Require().ErrorIs(err, types.ErrClaimsDelegateNotFound)
+
+	// the request doesn't set the delegate until it is accepted
+	_, err = suite.app.ClaimsKeeper.SetClaimsDelegate(goCtx, types.NewMsgSetClaimsDelegate(granter, delegate))
+	suite.Require().NoError(err)
+	_, found := suite.app.ClaimsKeeper.GetGranterDelegate(suite.ctx, granter)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetDelegateGranters(suite.ctx, delegate))
+	suite.Require().Equal([]types.ClaimsDelegate{types.NewClaimsDelegate(granter, delegate)}, suite.app.ClaimsKeeper.GetPendingClaimsDelegates(suite.ctx))
+
+	// only the requested delegate can accept
+	_, err = suite.app.ClaimsKeeper.AcceptClaimsDelegate(goCtx, types.NewMsgAcceptClaimsDelegate(other, granter, false))
+	suite.Require().ErrorIs(err, types.ErrClaimsDelegateNotFound)
+
+	_, err = suite.app.ClaimsKeeper.AcceptClaimsDelegate(goCtx, types.NewMsgAcceptClaimsDelegate(delegate, granter, false))
+	suite.Require().NoError(err)
+	current, found := suite.app.ClaimsKeeper.GetGranterDelegate(suite.ctx, granter)
+	suite.Require().True(found)
+	suite.Require().Equal(delegate, current)
+	suite.Require().Equal([]sdk.AccAddress{granter}, suite.app.ClaimsKeeper.GetDelegateGranters(suite.ctx, delegate))
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetPendingClaimsDelegates(suite.ctx))
+
+	// the delegate is kept until the new delegate accepts and is then replaced
+	_, err = suite.app.ClaimsKeeper.SetClaimsDelegate(goCtx, types.NewMsgSetClaimsDelegate(granter, other))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{granter}, suite.app.ClaimsKeeper.GetDelegateGranters(suite.ctx, delegate))
+
+	_, err = suite.app.ClaimsKeeper.AcceptClaimsDelegate(goCtx, types.NewMsgAcceptClaimsDelegate(other, granter, false))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetDelegateGranters(suite.ctx, delegate))
+	suite.Require().Equal([]sdk.AccAddress{granter}, suite.app.ClaimsKeeper.GetDelegateGranters(suite.ctx, other))
+	suite.Require().Equal([]types.ClaimsDelegate{types.NewClaimsDelegate(granter, other)}, suite.app.ClaimsKeeper.GetClaimsDelegates(suite.ctx))
+
+	// the delegate stops completing the actions of the granter
+	_, err = suite.app.ClaimsKeeper.AcceptClaimsDelegate(goCtx, types.NewMsgAcceptClaimsDelegate(delegate, granter, true))
+	suite.Require().ErrorIs(err, types.ErrClaimsDelegateNotFound)
+	_, err = suite.app.ClaimsKeeper.AcceptClaimsDelegate(goCtx, types.NewMsgAcceptClaimsDelegate(other, granter, true))
+	suite.Require().NoError(err)
+	_, found = suite.app.ClaimsKeeper.GetGranterDelegate(suite.ctx, granter)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetDelegateGranters(suite.ctx, other))
+
+	// the delegate rejects the request of the granter
+	_, err = suite.app.ClaimsKeeper.SetClaimsDelegate(goCtx, types.NewMsgSetClaimsDelegate(granter, delegate))
+	suite.Require().NoError(err)
+	_, err = suite.app.ClaimsKeeper.AcceptClaimsDelegate(goCtx, types.NewMsgAcceptClaimsDelegate(delegate, granter, true))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetPendingClaimsDelegates(suite.ctx))
+
+	// the granter removes the delegate and the pending request
+	_, err = suite.app.ClaimsKeeper.SetClaimsDelegate(goCtx, types.NewMsgSetClaimsDelegate(granter, delegate))
+	suite.Require().NoError(err)
+	_, err = suite.app.ClaimsKeeper.AcceptClaimsDelegate(goCtx, types.NewMsgAcceptClaimsDelegate(delegate, granter, false))
+	suite.Require().NoError(err)
+	_, err = suite.app.ClaimsKeeper.SetClaimsDelegate(goCtx, types.NewMsgSetClaimsDelegate(granter, other))
+	suite.Require().NoError(err)
+	_, err = suite.app.ClaimsKeeper.SetClaimsDelegate(goCtx, types.NewMsgSetClaimsDelegate(granter, nil))
+	suite.Require().NoError(err)
+	_, found = suite.app.ClaimsKeeper.GetGranterDelegate(suite.ctx, granter)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetDelegateGranters(suite.ctx, delegate))
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetPendingClaimsDelegates(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestClaimsDelegateCompletesActions() {
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	cold := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	hot := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	suite.createCampaign(funder, cold, 1000, []types.Action{types.ActionVote, types.ActionDelegate})
+
+	// the actions of the hot wallet don't count for the cold wallet without
+	// delegate
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, hot)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, cold, campaignDenom).IsZero())
+
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	_, err := suite.app.ClaimsKeeper.SetClaimsDelegate(goCtx, types.NewMsgSetClaimsDelegate(cold, hot))
+	suite.Require().NoError(err)
+
+	// the actions don't count for the cold wallet until the hot wallet accepts
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, hot)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, cold, campaignDenom).IsZero())
+
+	_, err = suite.app.ClaimsKeeper.AcceptClaimsDelegate(goCtx, types.NewMsgAcceptClaimsDelegate(hot, cold, false))
+	suite.Require().NoError(err)
+
+	// the coins are claimed for the cold wallet
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, hot)
+	suite.Require().Equal(sdk.NewInt(500), suite.app.BankKeeper.GetBalance(suite.ctx, cold, campaignDenom).Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, hot, campaignDenom).IsZero())
+
+	err = suite.app.ClaimsKeeper.AfterDelegationModified(suite.ctx, hot, sdk.ValAddress(hot))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), suite.app.BankKeeper.GetBalance(suite.ctx, cold, campaignDenom).Amount)
+}
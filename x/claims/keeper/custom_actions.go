@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v10/x/claims/types"
+)
+
+// RegisterCustomAction registers a new custom action with the next available
+// identifier
+func (k Keeper) RegisterCustomAction(
+	ctx sdk.Context,
+	name string,
+	actionType types.CustomActionType,
+	contract string,
+	eventSignature string,
+	claimablePercentage sdk.Dec,
+) (types.CustomAction, error) {
+	id := k.GetCustomActionCount(ctx) + 1
+	customAction := types.NewCustomAction(id, name, actionType, contract, eventSignature, claimablePercentage)
+	if err := customAction.Validate(); err != nil {
+		return types.CustomAction{}, errorsmod.Wrap(types.ErrInvalidAction, err.Error())
+	}
+
+	k.SetCustomActionCount(ctx, id)
+	k.SetCustomAction(ctx, customAction)
+
+	return customAction, nil
+}
+
+// RemoveCustomAction deletes a registered custom action. Campaigns created
+// with the custom action keep their copy of it.
+func (k Keeper) RemoveCustomAction(ctx sdk.Context, id uint64) (types.CustomAction, error) {
+	customAction, found := k.GetCustomAction(ctx, id)
+	if !found {
+		return types.CustomAction{}, errorsmod.Wrapf(types.ErrCustomActionNotFound, "custom action %d", id)
+	}
+
+	k.DeleteCustomAction(ctx, id)
+	return customAction, nil
+}
+
+// AfterERC20Conversion is called by the erc20 module after the coins or
+// tokens of a token pair are converted. It claims the coins of the matching
+// ERC20 conversion custom actions of the campaigns of the sender and of its
+// granters.
+func (k Keeper) AfterERC20Conversion(ctx sdk.Context, sender sdk.AccAddress, erc20 common.Address) {
+	for _, claimer := range k.getClaimers(ctx, sender) {
+		k.ClaimCampaignsForCustomActions(ctx, claimer, func(customAction types.CustomAction) bool {
+			return customAction.MatchesERC20Conversion(erc20)
+		})
+	}
+}
+
+// claimEVMCustomActions claims the coins of the custom actions completed by an
+// EVM transaction on the campaigns of the sender and of its granters
+func (k Keeper) claimEVMCustomActions(ctx sdk.Context, sender sdk.AccAddress, msg core.Message, receipt *ethtypes.Receipt) {
+	for _, claimer := range k.getClaimers(ctx, sender) {
+		k.ClaimCampaignsForCustomActions(ctx, claimer, func(customAction types.CustomAction) bool {
+			return customAction.MatchesEVMTx(msg, receipt)
+		})
+	}
+}
+
+// GetCustomActionCount returns the identifier of the last registered custom
+// action
+func (k Keeper) GetCustomActionCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyCustomActionCount)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetCustomActionCount stores the identifier of the last registered custom
+// action
+func (k Keeper) SetCustomActionCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyCustomActionCount, sdk.Uint64ToBigEndian(count))
+}
+
+// GetCustomAction returns the custom action for a given identifier
+func (k Keeper) GetCustomAction(ctx sdk.Context, id uint64) (types.CustomAction, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCustomActions)
+
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if len(bz) == 0 {
+		return types.CustomAction{}, false
+	}
+
+	var customAction types.CustomAction
+	k.cdc.MustUnmarshal(bz, &customAction)
+
+	return customAction, true
+}
+
+// SetCustomAction stores a custom action
+func (k Keeper) SetCustomAction(ctx sdk.Context, customAction types.CustomAction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCustomActions)
+	bz := k.cdc.MustMarshal(&customAction)
+	store.Set(sdk.Uint64ToBigEndian(customAction.ID), bz)
+}
+
+// DeleteCustomAction deletes a custom action from the store
+func (k Keeper) DeleteCustomAction(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCustomActions)
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+// IterateCustomActions iterates over all custom actions and performs a
+// callback.
+func (k Keeper) IterateCustomActions(ctx sdk.Context, handlerFn func(customAction types.CustomAction) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixCustomActions)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var customAction types.CustomAction
+		k.cdc.MustUnmarshal(iterator.Value(), &customAction)
+
+		if handlerFn(customAction) {
+			break
+		}
+	}
+}
+
+// GetCustomActions returns all custom actions
+func (k Keeper) GetCustomActions(ctx sdk.Context) []types.CustomAction {
+	customActions := []types.CustomAction{}
+	k.IterateCustomActions(ctx, func(customAction types.CustomAction) (stop bool) {
+		customActions = append(customActions, customAction)
+		return false
+	})
+
+	return customActions
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/claims/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterCustomAction() {
+	suite.SetupTest()
+
+	contract := tests.GenerateAddress().Hex()
+
+	_, err := suite.app.ClaimsKeeper.RegisterCustomAction(suite.ctx, "swap", types.CustomActionTypeUnspecified, contract, "", sdk.NewDecWithPrec(25, 2))
+	suite.Require().Error(err)
+
+	first, err := suite.app.ClaimsKeeper.RegisterCustomAction(suite.ctx, "swap", types.CustomActionTypeContractInteraction, contract, "", sdk.NewDecWithPrec(25, 2))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), first.ID)
+
+	second, err := suite.app.ClaimsKeeper.RegisterCustomAction(suite.ctx, "convert", types.CustomActionTypeERC20Conversion, contract, "", sdk.NewDecWithPrec(25, 2))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), second.ID)
+	suite.Require().Len(suite.app.ClaimsKeeper.GetCustomActions(suite.ctx), 2)
+
+	_, err = suite.app.ClaimsKeeper.RemoveCustomAction(suite.ctx, first.ID)
+	suite.Require().NoError(err)
+	_, found := suite.app.ClaimsKeeper.GetCustomAction(suite.ctx, first.ID)
+	suite.Require().False(found)
+
+	_, err = suite.app.ClaimsKeeper.RemoveCustomAction(suite.ctx, first.ID)
+	suite.Require().ErrorIs(err, types.ErrCustomActionNotFound)
+
+	// identifiers are not reused
+	third, err := suite.app.ClaimsKeeper.RegisterCustomAction(suite.ctx, "swap", types.CustomActionTypeContractInteraction, contract, "", sdk.NewDecWithPrec(25, 2))
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), third.ID)
+}
+
+func (suite *KeeperTestSuite) TestClaimCampaignsForCustomActions() {
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	from := tests.GenerateAddress()
+	recipient := sdk.AccAddress(from.Bytes())
+	contract := tests.GenerateAddress()
+	erc20 := tests.GenerateAddress()
+
+	interaction, err := suite.app.ClaimsKeeper.RegisterCustomAction(
+		suite.ctx, "swap", types.CustomActionTypeContractInteraction, contract.Hex(), "", sdk.NewDecWithPrec(25, 2),
+	)
+	suite.Require().NoError(err)
+	conversion, err := suite.app.ClaimsKeeper.RegisterCustomAction(
+		suite.ctx, "convert", types.CustomActionTypeERC20Conversion, erc20.Hex(), "", sdk.NewDecWithPrec(25, 2),
+	)
+	suite.Require().NoError(err)
+
+	fee := suite.app.ClaimsKeeper.GetParams(suite.ctx).CampaignCreationFee
+	coins := sdk.NewCoins(sdk.NewInt64Coin(campaignDenom, 1000)).Add(fee...)
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, coins)
+	suite.Require().NoError(err)
+
+	records := []types.ClaimsRecordAddress{types.NewClaimsRecordAddress(recipient, sdk.NewInt(1000))}
+
+	// unregistered custom actions cannot be required
+	msg := types.NewMsgCreateCampaign(funder, campaignDenom, []types.Action{types.ActionVote}, []uint64{3}, time.Time{}, time.Hour, time.Hour, records)
+	_, err = suite.app.ClaimsKeeper.CreateCampaign(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrCustomActionNotFound)
+
+	msg = types.NewMsgCreateCampaign(
+		funder, campaignDenom, []types.Action{types.ActionVote}, []uint64{interaction.ID, conversion.ID}, time.Time{}, time.Hour, time.Hour, records,
+	)
+	res, err := suite.app.ClaimsKeeper.CreateCampaign(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	// the campaign keeps its custom actions after they are removed
+	_, err = suite.app.ClaimsKeeper.RemoveCustomAction(suite.ctx, interaction.ID)
+	suite.Require().NoError(err)
+
+	// EVM tx to another contract
+	other := tests.GenerateAddress()
+	err = suite.app.ClaimsKeeper.PostTxProcessing(suite.ctx, ethtypes.NewMessage(from, &other, 0, nil, 0, nil, nil, nil, nil, nil, false), &ethtypes.Receipt{})
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, recipient, campaignDenom).IsZero())
+
+	// EVM tx to the contract
+	err = suite.app.ClaimsKeeper.PostTxProcessing(suite.ctx, ethtypes.NewMessage(from, &contract, 0, nil, 0, nil, nil, nil, nil, nil, false), &ethtypes.Receipt{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(250), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, campaignDenom).Amount)
+
+	// the custom action can only be claimed once
+	err = suite.app.ClaimsKeeper.PostTxProcessing(suite.ctx, ethtypes.NewMessage(from, &contract, 0, nil, 0, nil, nil, nil, nil, nil, false), &ethtypes.Receipt{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(250), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, campaignDenom).Amount)
+
+	// conversion of the token pair
+	suite.app.ClaimsKeeper.AfterERC20Conversion(suite.ctx, recipient, erc20)
+	suite.Require().Equal(sdk.NewInt(500), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, campaignDenom).Amount)
+
+	// the built-in action gets the remaining percentage
+	suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, recipient)
+	suite.Require().Equal(sdk.NewInt(1000), suite.app.BankKeeper.GetBalance(suite.ctx, recipient, campaignDenom).Amount)
+
+	cr, found := suite.app.ClaimsKeeper.GetCampaignClaimsRecord(suite.ctx, res.CampaignID, recipient)
+	suite.Require().True(found)
+	suite.Require().ElementsMatch([]uint64{interaction.ID, conversion.ID}, cr.CustomActionsCompleted)
+
+	campaign, found := suite.app.ClaimsKeeper.GetCampaign(suite.ctx, res.CampaignID)
+	suite.Require().True(found)
+	suite.Require().True(campaign.Escrow.IsZero())
+
+	queryRes, err := suite.queryClient.CampaignClaimsRecord(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryCampaignClaimsRecordRequest{CampaignId: res.CampaignID, Address: recipient.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.CustomClaims, 2)
+	for _, customClaim := range queryRes.CustomClaims {
+		suite.Require().True(customClaim.Completed)
+		suite.Require().True(customClaim.ClaimableAmount.IsZero())
+	}
+}
@@ -14,13 +14,14 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
-// TotalUnclaimed returns the total amount unclaimed from the airdrop
+// TotalUnclaimed returns the total amount unclaimed from the airdrop, excluding
+// the escrowed coins of the campaigns
 func (k Keeper) TotalUnclaimed(
 	c context.Context,
 	_ *types.QueryTotalUnclaimedRequest,
 ) (*types.QueryTotalUnclaimedResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	moduleAccBal := k.GetModuleAccountBalances(ctx)
+	moduleAccBal, _ := k.GetModuleAccountBalances(ctx).SafeSub(k.GetCampaignsEscrow(ctx)...)
 
 	return &types.QueryTotalUnclaimedResponse{
 		Coins: moduleAccBal,
@@ -67,6 +68,7 @@ func (k Keeper) ClaimsRecords(
 				Address:                sdk.AccAddress(key).String(),
 				InitialClaimableAmount: cr.InitialClaimableAmount,
 				ActionsCompleted:       cr.ActionsCompleted,
+				CustomActionsCompleted: cr.CustomActionsCompleted,
 			}
 
 			claimsRecords = append(claimsRecords, cra)
@@ -125,3 +127,229 @@ func (k Keeper) ClaimsRecord(
 		Claims:                 claims,
 	}, nil
 }
+
+// Campaigns returns all airdrop campaigns
+func (k Keeper) Campaigns(
+	c context.Context,
+	req *types.QueryCampaignsRequest,
+) (*types.QueryCampaignsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCampaigns)
+
+	campaigns := []types.Campaign{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_, value []byte) error {
+			var campaign types.Campaign
+			if err := k.cdc.Unmarshal(value, &campaign); err != nil {
+				return err
+			}
+
+			campaigns = append(campaigns, campaign)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCampaignsResponse{
+		Campaigns:  campaigns,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Campaign returns the airdrop campaign for a given identifier
+func (k Keeper) Campaign(
+	c context.Context,
+	req *types.QueryCampaignRequest,
+) (*types.QueryCampaignResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaign, found := k.GetCampaign(ctx, req.CampaignId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "campaign '%d'", req.CampaignId)
+	}
+
+	return &types.QueryCampaignResponse{Campaign: campaign}, nil
+}
+
+// CampaignClaimsRecords returns all claims records of a campaign
+func (k Keeper) CampaignClaimsRecords(
+	c context.Context,
+	req *types.QueryCampaignClaimsRecordsRequest,
+) (*types.QueryCampaignClaimsRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetCampaign(ctx, req.CampaignId); !found {
+		return nil, status.Errorf(codes.NotFound, "campaign '%d'", req.CampaignId)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixCampaignClaimsRecords(req.CampaignId))
+
+	claimsRecords := []types.ClaimsRecordAddress{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key, value []byte) error {
+			var cr types.ClaimsRecord
+			if err := k.cdc.Unmarshal(value, &cr); err != nil {
+				return err
+			}
+
+			cra := types.ClaimsRecordAddress{
+				Address:                sdk.AccAddress(key).String(),
+				InitialClaimableAmount: cr.InitialClaimableAmount,
+				ActionsCompleted:       cr.ActionsCompleted,
+			}
+
+			claimsRecords = append(claimsRecords, cra)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCampaignClaimsRecordsResponse{
+		Claims:     claimsRecords,
+		Pagination: pageRes,
+	}, nil
+}
+
+// CampaignClaimsRecord returns the initial claimable amount of a user for a
+// campaign and the claims per action of the campaign. Claimable amount per
+// action will be 0 before the start time or after end time of the campaign.
+func (k Keeper) CampaignClaimsRecord(
+	c context.Context,
+	req *types.QueryCampaignClaimsRecordRequest,
+) (*types.QueryCampaignClaimsRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	campaign, found := k.GetCampaign(ctx, req.CampaignId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "campaign '%d'", req.CampaignId)
+	}
+
+	claimsRecord, found := k.GetCampaignClaimsRecord(ctx, campaign.ID, addr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "claims record for address '%s' in campaign '%d'", req.Address, req.CampaignId)
+	}
+
+	isActive := campaign.IsActive(ctx.BlockTime())
+
+	claims := make([]types.Claim, len(campaign.Actions))
+	for i, action := range campaign.Actions {
+		claimableAmt := sdk.ZeroInt()
+		if isActive {
+			claimableAmt, _ = k.CampaignClaimableAmountForAction(ctx, campaign, claimsRecord, action)
+		}
+
+		claims[i] = types.Claim{
+			Action:          action,
+			Completed:       claimsRecord.HasClaimedAction(action),
+			ClaimableAmount: claimableAmt,
+		}
+	}
+
+	customClaims := make([]types.CustomClaim, len(campaign.CustomActions))
+	for i, customAction := range campaign.CustomActions {
+		claimableAmt := sdk.ZeroInt()
+		if isActive {
+			claimableAmt, _ = k.CampaignClaimableAmountForCustomAction(ctx, campaign, claimsRecord, customAction.ID)
+		}
+
+		customClaims[i] = types.CustomClaim{
+			CustomActionID:  customAction.ID,
+			Name:            customAction.Name,
+			Completed:       claimsRecord.HasClaimedCustomAction(customAction.ID),
+			ClaimableAmount: claimableAmt,
+		}
+	}
+
+	return &types.QueryCampaignClaimsRecordResponse{
+		InitialClaimableAmount: claimsRecord.InitialClaimableAmount,
+		Claims:                 claims,
+		CustomClaims:           customClaims,
+	}, nil
+}
+
+// CustomActions returns all the registered custom actions
+func (k Keeper) CustomActions(
+	c context.Context,
+	req *types.QueryCustomActionsRequest,
+) (*types.QueryCustomActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCustomActions)
+
+	customActions := []types.CustomAction{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_, value []byte) error {
+			var customAction types.CustomAction
+			if err := k.cdc.Unmarshal(value, &customAction); err != nil {
+				return err
+			}
+
+			customActions = append(customActions, customAction)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCustomActionsResponse{
+		CustomActions: customActions,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// CustomAction returns the registered custom action for a given identifier
+func (k Keeper) CustomAction(
+	c context.Context,
+	req *types.QueryCustomActionRequest,
+) (*types.QueryCustomActionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	customAction, found := k.GetCustomAction(ctx, req.CustomActionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "custom action '%d'", req.CustomActionId)
+	}
+
+	return &types.QueryCustomActionResponse{CustomAction: customAction}, nil
+}
@@ -231,3 +231,66 @@ func (suite *KeeperTestSuite) TestClaimsRecord() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestCampaignClaimsRecord() {
+	var req *types.QueryCampaignClaimsRecordRequest
+
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expErr    bool
+		expClaims []types.Claim
+	}{
+		{
+			"campaign not found",
+			func() {
+				req = &types.QueryCampaignClaimsRecordRequest{CampaignId: 1, Address: addr.String()}
+			},
+			true,
+			nil,
+		},
+		{
+			"claims record not found for address",
+			func() {
+				suite.createCampaign(funder, funder, 1000, []types.Action{types.ActionVote})
+				req = &types.QueryCampaignClaimsRecordRequest{CampaignId: 1, Address: addr.String()}
+			},
+			true,
+			nil,
+		},
+		{
+			"valid, claims of the campaign actions",
+			func() {
+				campaign := suite.createCampaign(funder, addr, 1000, []types.Action{types.ActionVote, types.ActionEVM})
+				suite.app.ClaimsKeeper.AfterProposalVote(suite.ctx, 1, addr)
+				req = &types.QueryCampaignClaimsRecordRequest{CampaignId: campaign.ID, Address: addr.String()}
+			},
+			false,
+			[]types.Claim{
+				{Action: types.ActionVote, Completed: true, ClaimableAmount: sdk.ZeroInt()},
+				{Action: types.ActionEVM, Completed: false, ClaimableAmount: sdk.NewInt(500)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			res, err := suite.queryClient.CampaignClaimsRecord(sdk.WrapSDKContext(suite.ctx), req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(1000), res.InitialClaimableAmount)
+			suite.Require().Equal(tc.expClaims, res.Claims)
+		})
+	}
+}
@@ -43,21 +43,7 @@ func (h Hooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr s
 // is successfully included, the claimable amount for the user's claims record
 // vote action is claimed and the transferred to the user address.
 func (k Keeper) AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
-	params := k.GetParams(ctx)
-
-	claimsRecord, found := k.GetClaimsRecord(ctx, voterAddr)
-	if !found {
-		return
-	}
-
-	_, err := k.ClaimCoinsForAction(ctx, voterAddr, claimsRecord, types.ActionVote, params)
-	if err != nil {
-		k.Logger(ctx).Error(
-			"failed to claim Vote action",
-			"address", voterAddr.String(),
-			"error", err.Error(),
-		)
-	}
+	k.claimAction(ctx, voterAddr, types.ActionVote)
 }
 
 // AfterDelegationModified is a wrapper for calling the Staking AfterDelegationModified
@@ -72,22 +58,7 @@ func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress,
 // user's claims record delegation action is claimed and transferred to the user
 // address.
 func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
-	params := k.GetParams(ctx)
-
-	claimsRecord, found := k.GetClaimsRecord(ctx, delAddr)
-	if !found {
-		return nil
-	}
-
-	_, err := k.ClaimCoinsForAction(ctx, delAddr, claimsRecord, types.ActionDelegate, params)
-	if err != nil {
-		k.Logger(ctx).Error(
-			"failed to claim Delegation action",
-			"address", delAddr.String(),
-			"error", err.Error(),
-		)
-		return nil
-	}
+	k.claimAction(ctx, delAddr, types.ActionDelegate)
 	return nil
 }
 
@@ -100,28 +71,43 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 // PostTxProcessing implements the ethermint evm PostTxProcessing hook.
 // After a EVM state transition is successfully processed, the claimable amount
 // for the users's claims record evm action is claimed and transferred to the
-// user address.
+// user address. The campaign custom actions completed by the transaction are
+// claimed as well.
 func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
-	params := k.GetParams(ctx)
 	fromAddr := sdk.AccAddress(msg.From().Bytes())
 
-	claimsRecord, found := k.GetClaimsRecord(ctx, fromAddr)
-	if !found {
-		return nil
-	}
-
-	_, err := k.ClaimCoinsForAction(ctx, fromAddr, claimsRecord, types.ActionEVM, params)
-	if err != nil {
-		k.Logger(ctx).Error(
-			"failed to claim EVM action",
-			"address", fromAddr.String(),
-			"error", err.Error(),
-		)
-	}
+	k.claimEVMCustomActions(ctx, fromAddr, msg, receipt)
+	k.claimAction(ctx, fromAddr, types.ActionEVM)
 
 	return nil
 }
 
+// claimAction claims an action completed by an address on its claims records
+// of the genesis airdrop and the campaigns. The action is also claimed on the
+// claims records of the granters that set the address as their claims
+// delegate, transferring the coins to the granters.
+func (k Keeper) claimAction(ctx sdk.Context, addr sdk.AccAddress, action types.Action) {
+	params := k.GetParams(ctx)
+
+	for _, claimer := range k.getClaimers(ctx, addr) {
+		k.ClaimCampaignsForAction(ctx, claimer, action)
+
+		claimsRecord, found := k.GetClaimsRecord(ctx, claimer)
+		if !found {
+			continue
+		}
+
+		if _, err := k.ClaimCoinsForAction(ctx, claimer, claimsRecord, action, params); err != nil {
+			k.Logger(ctx).Error(
+				"failed to claim action",
+				"address", claimer.String(),
+				"action", action.String(),
+				"error", err.Error(),
+			)
+		}
+	}
+}
+
 // ________________________________________________________________________________________
 
 // governance hooks
@@ -27,6 +27,8 @@ func (k Keeper) OnAcknowledgementPacket(
 ) error {
 	params := k.GetParams(ctx)
 
+	k.claimCampaignsOnAcknowledgement(ctx, packet, acknowledgement)
+
 	// short circuit in case claim is not active (no-op)
 	if !params.IsClaimsActive(ctx.BlockTime()) {
 		return nil
@@ -79,6 +81,14 @@ func (k Keeper) OnRecvPacket(
 	logger := k.Logger(ctx)
 	params := k.GetParams(ctx)
 
+	// claim the IBC transfer action of the campaigns for the recipient. The
+	// claims are reverted if an error acknowledgement is returned.
+	if ack.Success() {
+		if _, recipient, _, _, err := ibc.GetTransferSenderRecipient(packet); err == nil {
+			k.ClaimCampaignsForAction(ctx, recipient, types.ActionIBCTransfer)
+		}
+	}
+
 	// short (no-op) circuit by returning original ACK in case claims are not active
 	if !params.IsClaimsActive(ctx.BlockTime()) {
 		return ack
@@ -213,3 +223,26 @@ func (k Keeper) OnRecvPacket(
 	// return the original success acknowledgement
 	return ack
 }
+
+// claimCampaignsOnAcknowledgement claims the IBC transfer action of the
+// campaigns for the sender of a successful transfer. It performs a no-op if the
+// acknowledgement or the packet can't be decoded.
+func (k Keeper) claimCampaignsOnAcknowledgement(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+) {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || !ack.Success() {
+		return
+	}
+
+	sender, _, _, _, err := ibc.GetTransferSenderRecipient(packet)
+	if err != nil {
+		return
+	}
+
+	for _, claimer := range k.getClaimers(ctx, sender) {
+		k.ClaimCampaignsForAction(ctx, claimer, types.ActionIBCTransfer)
+	}
+}
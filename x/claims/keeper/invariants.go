@@ -14,7 +14,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 }
 
 // ClaimsInvariant checks that the total amount of all unclaimed coins held in
-// claims records is equal to the escrowed balance held in the claims module account
+// claims records and in the unclaimed Merkle tree leaves is equal to the
+// escrowed balance held in the claims module account
 func (k Keeper) ClaimsInvariant() sdk.Invariant {
 	return func(ctx sdk.Context) (msg string, broken bool) {
 		expectedUnclaimed := sdk.ZeroDec()
@@ -38,8 +39,13 @@ func (k Keeper) ClaimsInvariant() sdk.Invariant {
 			return false
 		})
 
+		// NOTE: the leaves of the Merkle tree without a claims record are escrowed too
+		expectedUnclaimed = expectedUnclaimed.Add(sdk.NewDecFromInt(k.GetMerkleUnclaimed(ctx, params)))
+
 		moduleAccAddr := k.GetModuleAccountAddress()
 		balance := k.bankKeeper.GetBalance(ctx, moduleAccAddr, params.ClaimsDenom)
+		// NOTE: exclude the escrowed coins of the campaigns
+		balance.Amount = balance.Amount.Sub(k.GetCampaignsEscrow(ctx).AmountOf(params.ClaimsDenom))
 
 		isInvariantBroken := !expectedUnclaimed.Equal(sdk.NewDecFromInt(balance.Amount))
 		msg = sdk.FormatInvariant(
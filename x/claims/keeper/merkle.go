@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/claims/types"
+)
+
+// claimWithProof verifies the inclusion of the leaf (address, amount) in the
+// Merkle tree of the airdrop and creates the claims record of the address. If
+// the address already has a claims record (eg: from genesis or a migration),
+// the amount is added to it and the share of the leaf for the actions that
+// have already been completed is claimed.
+func (k Keeper) claimWithProof(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	amount math.Int,
+	proof []common.Hash,
+) (types.ClaimsRecord, error) {
+	params := k.GetParams(ctx)
+
+	if !params.IsClaimsActive(ctx.BlockTime()) {
+		return types.ClaimsRecord{}, types.ErrClaimsNotActive
+	}
+
+	if !params.IsMerkleEnabled() {
+		return types.ClaimsRecord{}, errorsmod.Wrap(types.ErrClaimsNotActive, "merkle root is not set")
+	}
+
+	if k.HasMerkleClaim(ctx, addr) {
+		return types.ClaimsRecord{}, errorsmod.Wrapf(types.ErrMerkleLeafClaimed, "address %s", addr)
+	}
+
+	root := common.HexToHash(params.MerkleRoot)
+	if !types.VerifyMerkleProof(root, types.MerkleLeaf(addr, amount), proof) {
+		return types.ClaimsRecord{}, errorsmod.Wrapf(
+			types.ErrInvalidMerkleProof, "leaf (%s, %s) is not included in root %s", addr, amount, params.MerkleRoot,
+		)
+	}
+
+	leafRecord := types.NewClaimsRecord(amount)
+	claimsRecord, found := k.GetClaimsRecord(ctx, addr)
+	if !found {
+		claimsRecord = leafRecord
+	} else {
+		if err := k.claimCompletedActions(ctx, addr, claimsRecord, leafRecord, params); err != nil {
+			return types.ClaimsRecord{}, err
+		}
+		claimsRecord.InitialClaimableAmount = claimsRecord.InitialClaimableAmount.Add(amount)
+	}
+
+	k.SetMerkleClaim(ctx, addr, amount)
+	k.SetClaimsRecord(ctx, addr, claimsRecord)
+
+	return claimsRecord, nil
+}
+
+// claimCompletedActions claims the amounts of the leaf record for the actions
+// that have already been completed on the existing claims record of the
+// address. The decayed remainder is transferred to the community pool.
+func (k Keeper) claimCompletedActions(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	claimsRecord,
+	leafRecord types.ClaimsRecord,
+	params types.Params,
+) error {
+	claimedAmt := sdk.ZeroInt()
+	remainderAmt := sdk.ZeroInt()
+
+	actions := []types.Action{types.ActionVote, types.ActionDelegate, types.ActionEVM, types.ActionIBCTransfer}
+	for _, action := range actions {
+		if !claimsRecord.HasClaimedAction(action) {
+			continue
+		}
+
+		amt, remainder := k.GetClaimableAmountForAction(ctx, leafRecord, action, params)
+		claimedAmt = claimedAmt.Add(amt)
+		remainderAmt = remainderAmt.Add(remainder)
+	}
+
+	if !claimedAmt.IsZero() {
+		claimedCoins := sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: claimedAmt}}
+		if err := k.sendClaimedCoins(ctx, addr, claimedCoins, params); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClaim,
+				sdk.NewAttribute(sdk.AttributeKeySender, addr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, claimedCoins.String()),
+			),
+		)
+	}
+
+	if !remainderAmt.IsZero() {
+		remainderCoins := sdk.Coins{sdk.Coin{Denom: params.ClaimsDenom, Amount: remainderAmt}}
+		if err := k.distrKeeper.FundCommunityPool(ctx, remainderCoins, k.GetModuleAccountAddress()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetMerkleClaim returns the amount of the Merkle tree leaf claimed by an
+// address
+func (k Keeper) GetMerkleClaim(ctx sdk.Context, addr sdk.AccAddress) (math.Int, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMerkleClaims)
+
+	bz := store.Get(addr)
+	if len(bz) == 0 {
+		return sdk.ZeroInt(), false
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return amount, true
+}
+
+// HasMerkleClaim returns true if the Merkle tree leaf of an address has been
+// claimed
+func (k Keeper) HasMerkleClaim(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMerkleClaims)
+	return store.Has(addr)
+}
+
+// SetMerkleClaim stores the amount of the Merkle tree leaf claimed by an
+// address
+func (k Keeper) SetMerkleClaim(ctx sdk.Context, addr sdk.AccAddress, amount math.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMerkleClaims)
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(addr, bz)
+}
+
+// DeleteMerkleClaim deletes the Merkle tree leaf claim of an address from the
+// store
+func (k Keeper) DeleteMerkleClaim(ctx sdk.Context, addr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMerkleClaims)
+	store.Delete(addr)
+}
+
+// DeleteMerkleClaims deletes all the Merkle tree leaf claims from the store
+func (k Keeper) DeleteMerkleClaims(ctx sdk.Context) {
+	var addresses []sdk.AccAddress
+	k.IterateMerkleClaims(ctx, func(addr sdk.AccAddress, _ math.Int) (stop bool) {
+		addresses = append(addresses, addr)
+		return false
+	})
+
+	for _, addr := range addresses {
+		k.DeleteMerkleClaim(ctx, addr)
+	}
+}
+
+// IterateMerkleClaims iterates over all the claimed Merkle tree leaves and
+// performs a callback.
+func (k Keeper) IterateMerkleClaims(ctx sdk.Context, handlerFn func(addr sdk.AccAddress, amount math.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixMerkleClaims)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key()[1:])
+
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if handlerFn(addr, amount) {
+			break
+		}
+	}
+}
+
+// GetMerkleClaims returns all the claimed Merkle tree leaves
+func (k Keeper) GetMerkleClaims(ctx sdk.Context) []types.MerkleClaim {
+	merkleClaims := []types.MerkleClaim{}
+	k.IterateMerkleClaims(ctx, func(addr sdk.AccAddress, amount math.Int) (stop bool) {
+		merkleClaims = append(merkleClaims, types.NewMerkleClaim(addr, amount))
+		return false
+	})
+
+	return merkleClaims
+}
+
+// GetMerkleUnclaimed returns the sum of the amounts of the Merkle tree leaves
+// whose claims records haven't been created yet. These coins are held in the
+// airdrop escrow.
+func (k Keeper) GetMerkleUnclaimed(ctx sdk.Context, params types.Params) math.Int {
+	claimed := sdk.ZeroInt()
+	k.IterateMerkleClaims(ctx, func(_ sdk.AccAddress, amount math.Int) (stop bool) {
+		claimed = claimed.Add(amount)
+		return false
+	})
+
+	unclaimed := params.GetMerkleTotalAmount().Sub(claimed)
+	if unclaimed.IsNegative() {
+		return sdk.ZeroInt()
+	}
+
+	return unclaimed
+}
@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/claims/types"
+)
+
+// setupMerkleAirdrop sets a Merkle tree with two leaves as the root of the
+// airdrop, funds the escrow with their amounts and returns the proofs
+func (suite *KeeperTestSuite) setupMerkleAirdrop(
+	addrs [2]sdk.AccAddress,
+	amounts [2]math.Int,
+) [2][]string {
+	leaves := [2]common.Hash{
+		types.MerkleLeaf(addrs[0], amounts[0]),
+		types.MerkleLeaf(addrs[1], amounts[1]),
+	}
+	root := types.MerkleParent(leaves[0], leaves[1])
+
+	params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+	params.MerkleRoot = root.Hex()
+	params.MerkleTotalAmount = amounts[0].Add(amounts[1])
+	suite.app.ClaimsKeeper.SetParams(suite.ctx, params)
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.ClaimsDenom, params.MerkleTotalAmount))
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
+	suite.Require().NoError(err)
+
+	return [2][]string{
+		{leaves[1].Hex()},
+		{leaves[0].Hex()},
+	}
+}
+
+func (suite *KeeperTestSuite) TestClaimWithProof() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	addrs := [2]sdk.AccAddress{
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	}
+	amounts := [2]math.Int{sdk.NewInt(400), sdk.NewInt(800)}
+
+	testCases := []struct {
+		name     string
+		malleate func(proofs [2][]string) *types.MsgClaimWithProof
+		expPass  bool
+	}{
+		{
+			"fail - merkle root not set",
+			func(proofs [2][]string) *types.MsgClaimWithProof {
+				params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+				params.MerkleRoot = ""
+				suite.app.ClaimsKeeper.SetParams(suite.ctx, params)
+				return types.NewMsgClaimWithProof(sender, addrs[0], amounts[0], proofs[0])
+			},
+			false,
+		},
+		{
+			"fail - claims disabled",
+			func(proofs [2][]string) *types.MsgClaimWithProof {
+				params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+				params.EnableClaims = false
+				suite.app.ClaimsKeeper.SetParams(suite.ctx, params)
+				return types.NewMsgClaimWithProof(sender, addrs[0], amounts[0], proofs[0])
+			},
+			false,
+		},
+		{
+			"fail - wrong amount",
+			func(proofs [2][]string) *types.MsgClaimWithProof {
+				return types.NewMsgClaimWithProof(sender, addrs[0], amounts[1], proofs[0])
+			},
+			false,
+		},
+		{
+			"fail - proof of another leaf",
+			func(proofs [2][]string) *types.MsgClaimWithProof {
+				return types.NewMsgClaimWithProof(sender, addrs[0], amounts[0], proofs[1])
+			},
+			false,
+		},
+		{
+			"fail - leaf already claimed",
+			func(proofs [2][]string) *types.MsgClaimWithProof {
+				msg := types.NewMsgClaimWithProof(sender, addrs[0], amounts[0], proofs[0])
+				_, err := suite.app.ClaimsKeeper.ClaimWithProof(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				// the claims record is migrated away from the address
+				suite.app.ClaimsKeeper.DeleteClaimsRecord(suite.ctx, addrs[0])
+				return msg
+			},
+			false,
+		},
+		{
+			"pass - claims record created by another sender",
+			func(proofs [2][]string) *types.MsgClaimWithProof {
+				return types.NewMsgClaimWithProof(sender, addrs[0], amounts[0], proofs[0])
+			},
+			true,
+		},
+		{
+			"pass - claims record created by the leaf address",
+			func(proofs [2][]string) *types.MsgClaimWithProof {
+				return types.NewMsgClaimWithProof(addrs[1], addrs[1], amounts[1], proofs[1])
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			proofs := suite.setupMerkleAirdrop(addrs, amounts)
+			msg := tc.malleate(proofs)
+
+			res, err := suite.app.ClaimsKeeper.ClaimWithProof(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.NewClaimsRecord(msg.Amount), res.ClaimsRecord)
+
+			addr := sdk.MustAccAddressFromBech32(msg.Address)
+			cr, found := suite.app.ClaimsKeeper.GetClaimsRecord(suite.ctx, addr)
+			suite.Require().True(found)
+			suite.Require().Equal(res.ClaimsRecord, cr)
+
+			amount, found := suite.app.ClaimsKeeper.GetMerkleClaim(suite.ctx, addr)
+			suite.Require().True(found)
+			suite.Require().Equal(msg.Amount, amount)
+
+			_, broken := suite.app.ClaimsKeeper.ClaimsInvariant()(suite.ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClaimWithProofExistingRecord() {
+	suite.SetupTest()
+
+	addrs := [2]sdk.AccAddress{
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	}
+	amounts := [2]math.Int{sdk.NewInt(400), sdk.NewInt(800)}
+	proofs := suite.setupMerkleAirdrop(addrs, amounts)
+
+	// existing claims record with the vote action completed
+	params := suite.app.ClaimsKeeper.GetParams(suite.ctx)
+	coins := sdk.NewCoins(sdk.NewCoin(params.ClaimsDenom, sdk.NewInt(300)))
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
+	suite.Require().NoError(err)
+
+	cr := types.NewClaimsRecord(sdk.NewInt(400))
+	cr.MarkClaimed(types.ActionVote)
+	suite.app.ClaimsKeeper.SetClaimsRecord(suite.ctx, addrs[0], cr)
+
+	msg := types.NewMsgClaimWithProof(addrs[0], addrs[0], amounts[0], proofs[0])
+	res, err := suite.app.ClaimsKeeper.ClaimWithProof(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	// the amounts are added and the share of the leaf for the completed action
+	// is claimed
+	suite.Require().Equal(sdk.NewInt(800), res.ClaimsRecord.InitialClaimableAmount)
+	suite.Require().True(res.ClaimsRecord.HasClaimedAction(types.ActionVote))
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, addrs[0], params.ClaimsDenom)
+	suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+
+	_, broken := suite.app.ClaimsKeeper.ClaimsInvariant()(suite.ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestEndAirdropDeletesMerkleClaims() {
+	suite.SetupTest()
+
+	addrs := [2]sdk.AccAddress{
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	}
+	amounts := [2]math.Int{sdk.NewInt(400), sdk.NewInt(800)}
+	proofs := suite.setupMerkleAirdrop(addrs, amounts)
+
+	msg := types.NewMsgClaimWithProof(addrs[0], addrs[0], amounts[0], proofs[0])
+	_, err := suite.app.ClaimsKeeper.ClaimWithProof(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(amounts[1], suite.app.ClaimsKeeper.GetMerkleUnclaimed(suite.ctx, suite.app.ClaimsKeeper.GetParams(suite.ctx)))
+
+	err = suite.app.ClaimsKeeper.EndAirdrop(suite.ctx, suite.app.ClaimsKeeper.GetParams(suite.ctx))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.ClaimsKeeper.GetMerkleClaims(suite.ctx))
+
+	// the unclaimed leaves are transferred to the community pool
+	moduleAddr := suite.app.ClaimsKeeper.GetModuleAccountAddress()
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAddr).IsZero())
+}
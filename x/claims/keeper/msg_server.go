@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/x/claims/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateCampaign creates an airdrop campaign funded by the sender
+func (k Keeper) CreateCampaign(
+	goCtx context.Context,
+	msg *types.MsgCreateCampaign,
+) (*types.MsgCreateCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	funder := sdk.MustAccAddressFromBech32(msg.Funder)
+	campaign, err := k.createCampaign(
+		ctx,
+		funder,
+		msg.Denom,
+		msg.Actions,
+		msg.CustomActionIDs,
+		msg.StartTime,
+		msg.DurationUntilDecay,
+		msg.DurationOfDecay,
+		msg.ClaimsRecords,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreateCampaign,
+				sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.ID, 10)),
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.Funder),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, campaign.Escrow.String()+campaign.Denom),
+			),
+		},
+	)
+
+	return &types.MsgCreateCampaignResponse{CampaignID: campaign.ID}, nil
+}
+
+// ClaimWithProof creates the claims record of a Merkle tree leaf
+func (k Keeper) ClaimWithProof(
+	goCtx context.Context,
+	msg *types.MsgClaimWithProof,
+) (*types.MsgClaimWithProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr := sdk.MustAccAddressFromBech32(msg.Address)
+	proof, err := types.ParseMerkleProof(msg.Proof)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMerkleProof, err.Error())
+	}
+
+	claimsRecord, err := k.claimWithProof(ctx, addr, msg.Amount, proof)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaimWithProof,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			),
+		},
+	)
+
+	return &types.MsgClaimWithProofResponse{ClaimsRecord: claimsRecord}, nil
+}
+
+// TransferClaimsRecord transfers the claims record of the sender to the
+// recipient
+func (k Keeper) TransferClaimsRecord(
+	goCtx context.Context,
+	msg *types.MsgTransferClaimsRecord,
+) (*types.MsgTransferClaimsRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	recipient := sdk.MustAccAddressFromBech32(msg.Recipient)
+
+	claimsRecord, claimedCoins, err := k.transferClaimsRecord(ctx, sender, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeTransferClaimsRecord,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+				sdk.NewAttribute(types.AttributeKeyClaimedCoins, claimedCoins.String()),
+			),
+		},
+	)
+
+	return &types.MsgTransferClaimsRecordResponse{ClaimsRecord: claimsRecord}, nil
+}
+
+// SetClaimsDelegate requests the delegate that completes the actions of the
+// claims records of the granter
+func (k Keeper) SetClaimsDelegate(
+	goCtx context.Context,
+	msg *types.MsgSetClaimsDelegate,
+) (*types.MsgSetClaimsDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter := sdk.MustAccAddressFromBech32(msg.Granter)
+	var delegate sdk.AccAddress
+	if msg.Delegate != "" {
+		delegate = sdk.MustAccAddressFromBech32(msg.Delegate)
+	}
+
+	k.requestClaimsDelegate(ctx, granter, delegate)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSetClaimsDelegate,
+				sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+				sdk.NewAttribute(types.AttributeKeyDelegate, msg.Delegate),
+			),
+		},
+	)
+
+	return &types.MsgSetClaimsDelegateResponse{}, nil
+}
+
+// AcceptClaimsDelegate accepts the request of the granter for the delegate to
+// complete the actions of its claims records, or revokes it
+func (k Keeper) AcceptClaimsDelegate(
+	goCtx context.Context,
+	msg *types.MsgAcceptClaimsDelegate,
+) (*types.MsgAcceptClaimsDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegate := sdk.MustAccAddressFromBech32(msg.Delegate)
+	granter := sdk.MustAccAddressFromBech32(msg.Granter)
+
+	eventType := types.EventTypeAcceptClaimsDelegate
+	if msg.Revoke {
+		eventType = types.EventTypeRevokeClaimsDelegate
+		if err := k.revokeClaimsDelegate(ctx, delegate, granter); err != nil {
+			return nil, err
+		}
+	} else if err := k.acceptClaimsDelegate(ctx, delegate, granter); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter),
+				sdk.NewAttribute(types.AttributeKeyDelegate, msg.Delegate),
+			),
+		},
+	)
+
+	return &types.MsgAcceptClaimsDelegateResponse{}, nil
+}
@@ -0,0 +1,69 @@
+package claims
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/evmos/evmos/v10/x/claims/keeper"
+	"github.com/evmos/evmos/v10/x/claims/types"
+)
+
+// NewClaimsProposalHandler creates a governance handler to manage new
+// proposal types.
+func NewClaimsProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		switch c := content.(type) {
+		case *types.RegisterCustomActionProposal:
+			return handleRegisterCustomActionProposal(ctx, k, c)
+		case *types.RemoveCustomActionProposal:
+			return handleRemoveCustomActionProposal(ctx, k, c)
+		default:
+			return errorsmod.Wrapf(
+				errortypes.ErrUnknownRequest,
+				"unrecognized %s proposal content type: %T", types.ModuleName, c,
+			)
+		}
+	}
+}
+
+func handleRegisterCustomActionProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.RegisterCustomActionProposal,
+) error {
+	customAction, err := k.RegisterCustomAction(ctx, p.Name, p.Type, p.Contract, p.EventSignature, p.ClaimablePercentage)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCustomAction,
+			sdk.NewAttribute(types.AttributeKeyCustomActionID, strconv.FormatUint(customAction.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyCustomActionName, customAction.Name),
+		),
+	)
+	return nil
+}
+
+func handleRemoveCustomActionProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.RemoveCustomActionProposal,
+) error {
+	customAction, err := k.RemoveCustomAction(ctx, p.CustomActionID)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveCustomAction,
+			sdk.NewAttribute(types.AttributeKeyCustomActionID, strconv.FormatUint(customAction.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyCustomActionName, customAction.Name),
+		),
+	)
+	return nil
+}
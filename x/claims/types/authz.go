@@ -0,0 +1,67 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &TransferClaimsRecordAuthorization{}
+
+// NewTransferClaimsRecordAuthorization creates a new
+// TransferClaimsRecordAuthorization instance
+func NewTransferClaimsRecordAuthorization(allowedRecipients ...sdk.AccAddress) *TransferClaimsRecordAuthorization {
+	recipients := make([]string, len(allowedRecipients))
+	for i, recipient := range allowedRecipients {
+		recipients[i] = recipient.String()
+	}
+
+	return &TransferClaimsRecordAuthorization{
+		AllowedRecipients: recipients,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a TransferClaimsRecordAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgTransferClaimsRecord{})
+}
+
+// Accept implements Authorization.Accept. It only accepts transfers to one of
+// the allowed recipients.
+func (a TransferClaimsRecordAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	transferMsg, ok := msg.(*MsgTransferClaimsRecord)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrap(errortypes.ErrInvalidType, "type mismatch")
+	}
+
+	for _, recipient := range a.AllowedRecipients {
+		if recipient == transferMsg.Recipient {
+			return authz.AcceptResponse{Accept: true}, nil
+		}
+	}
+
+	return authz.AcceptResponse{}, errorsmod.Wrapf(
+		errortypes.ErrUnauthorized, "cannot transfer claims record to %s", transferMsg.Recipient,
+	)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a TransferClaimsRecordAuthorization) ValidateBasic() error {
+	if len(a.AllowedRecipients) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "allowed recipients cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, recipient := range a.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return errorsmod.Wrapf(err, "invalid recipient address %s", recipient)
+		}
+		if seen[recipient] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated recipient %s", recipient)
+		}
+		seen[recipient] = true
+	}
+
+	return nil
+}
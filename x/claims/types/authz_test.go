@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferClaimsRecordAuthorizationValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name          string
+		authorization *TransferClaimsRecordAuthorization
+		expError      bool
+	}{
+		{"fail - empty allowed recipients", NewTransferClaimsRecordAuthorization(), true},
+		{"fail - invalid recipient", &TransferClaimsRecordAuthorization{AllowedRecipients: []string{"badaddress"}}, true},
+		{"fail - duplicated recipient", NewTransferClaimsRecordAuthorization(addr, addr), true},
+		{"success", NewTransferClaimsRecordAuthorization(addr), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.authorization.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestTransferClaimsRecordAuthorizationAccept(t *testing.T) {
+	granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	grantee := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	authorization := NewTransferClaimsRecordAuthorization(grantee)
+	require.Equal(t, "/evmos.claims.v1.MsgTransferClaimsRecord", authorization.MsgTypeURL())
+
+	res, err := authorization.Accept(sdk.Context{}, NewMsgTransferClaimsRecord(granter, grantee))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+
+	_, err = authorization.Accept(sdk.Context{}, NewMsgTransferClaimsRecord(granter, other))
+	require.Error(t, err)
+
+	_, err = authorization.Accept(sdk.Context{}, &banktypes.MsgSend{})
+	require.Error(t, err)
+}
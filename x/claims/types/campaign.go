@@ -0,0 +1,169 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxPrunedCampaignClaimsRecords is the maximum number of claims records of
+// ended campaigns that are deleted on each block
+const MaxPrunedCampaignClaimsRecords = 1000
+
+// NewCampaign creates a new campaign instance
+func NewCampaign(
+	id uint64,
+	funder sdk.AccAddress,
+	denom string,
+	actions []Action,
+	customActions []CustomAction,
+	startTime time.Time,
+	durationUntilDecay,
+	durationOfDecay time.Duration,
+	escrow math.Int,
+) Campaign {
+	return Campaign{
+		ID:                 id,
+		Funder:             funder.String(),
+		Denom:              denom,
+		Actions:            actions,
+		StartTime:          startTime,
+		DurationUntilDecay: durationUntilDecay,
+		DurationOfDecay:    durationOfDecay,
+		Escrow:             escrow,
+		CustomActions:      customActions,
+	}
+}
+
+// Validate performs a stateless validation of the fields
+func (c Campaign) Validate() error {
+	if c.ID == 0 {
+		return errors.New("campaign identifier cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Funder); err != nil {
+		return fmt.Errorf("invalid funder address %s: %w", c.Funder, err)
+	}
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return err
+	}
+	if len(c.Actions) == 0 && len(c.CustomActions) == 0 {
+		return errors.New("campaign actions cannot be empty")
+	}
+	if err := ValidateCampaignActions(c.Actions); err != nil {
+		return err
+	}
+	if err := c.validateCustomActions(); err != nil {
+		return err
+	}
+	if err := validateDuration(c.DurationUntilDecay); err != nil {
+		return err
+	}
+	if err := validateDuration(c.DurationOfDecay); err != nil {
+		return err
+	}
+	if c.Escrow.IsNil() || c.Escrow.IsNegative() {
+		return fmt.Errorf("campaign escrow cannot be nil or negative: %s", c.Escrow)
+	}
+
+	return nil
+}
+
+// DecayStartTime returns the time at which the decay period of the campaign
+// starts
+func (c Campaign) DecayStartTime() time.Time {
+	return c.StartTime.Add(c.DurationUntilDecay)
+}
+
+// EndTime returns the time at which no further claims of the campaign will be
+// processed
+func (c Campaign) EndTime() time.Time {
+	return c.DecayStartTime().Add(c.DurationOfDecay)
+}
+
+// IsActive returns true if the block time is between the start and end time of
+// the campaign (both inclusive)
+func (c Campaign) IsActive(blockTime time.Time) bool {
+	return !blockTime.Before(c.StartTime) && !blockTime.After(c.EndTime())
+}
+
+// HasAction returns true if the action has to be completed to claim the coins
+// of the campaign
+func (c Campaign) HasAction(action Action) bool {
+	for _, a := range c.Actions {
+		if a == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetCustomAction returns the custom action of the campaign with the given
+// identifier
+func (c Campaign) GetCustomAction(id uint64) (CustomAction, bool) {
+	for _, ca := range c.CustomActions {
+		if ca.ID == id {
+			return ca, true
+		}
+	}
+
+	return CustomAction{}, false
+}
+
+// ActionsPercentage returns the fraction of the initial claimable amount that
+// is split evenly between the built-in actions of the campaign, i.e. the
+// fraction that isn't allocated to its custom actions
+func (c Campaign) ActionsPercentage() sdk.Dec {
+	percentage := sdk.OneDec()
+	for _, ca := range c.CustomActions {
+		percentage = percentage.Sub(ca.ClaimablePercentage)
+	}
+
+	return percentage
+}
+
+// validateCustomActions checks that the custom actions of the campaign are
+// valid, unique and that their claimable percentages leave a positive
+// percentage for the built-in actions, if any
+func (c Campaign) validateCustomActions() error {
+	seenCustomActions := make(map[uint64]bool)
+	for _, ca := range c.CustomActions {
+		if seenCustomActions[ca.ID] {
+			return fmt.Errorf("duplicated campaign custom action %d", ca.ID)
+		}
+		if err := ca.Validate(); err != nil {
+			return err
+		}
+		seenCustomActions[ca.ID] = true
+	}
+
+	percentage := c.ActionsPercentage()
+	switch {
+	case percentage.IsNegative():
+		return fmt.Errorf("claimable percentages of the custom actions exceed 1 by %s", percentage.Neg())
+	case len(c.Actions) > 0 && !percentage.IsPositive():
+		return errors.New("claimable percentages of the custom actions leave no claimable amount for the actions")
+	}
+
+	return nil
+}
+
+// ValidateCampaignActions checks that the list of actions of a campaign only
+// contains valid actions without duplicates
+func ValidateCampaignActions(actions []Action) error {
+	seenActions := make(map[Action]bool)
+	for _, action := range actions {
+		if action == ActionUnspecified || int(action) > len(Action_value)-1 {
+			return fmt.Errorf("invalid campaign action %d", action)
+		}
+		if seenActions[action] {
+			return fmt.Errorf("duplicated campaign action %s", action)
+		}
+		seenActions[action] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCampaignValidate(t *testing.T) {
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	actions := []Action{ActionVote, ActionEVM}
+
+	testCases := []struct {
+		name     string
+		campaign Campaign
+		expError bool
+	}{
+		{
+			"fail - empty",
+			Campaign{},
+			true,
+		},
+		{
+			"fail - zero identifier",
+			NewCampaign(0, funder, "acoin", actions, nil, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			true,
+		},
+		{
+			"fail - no actions nor custom actions",
+			NewCampaign(1, funder, "acoin", nil, nil, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			true,
+		},
+		{
+			"fail - custom action percentages leave nothing for the actions",
+			NewCampaign(1, funder, "acoin", actions, []CustomAction{
+				NewCustomAction(1, "swap", CustomActionTypeContractInteraction, tests.GenerateAddress().Hex(), "", sdk.OneDec()),
+			}, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			true,
+		},
+		{
+			"fail - custom action percentages exceed 1",
+			NewCampaign(1, funder, "acoin", nil, []CustomAction{
+				NewCustomAction(1, "swap", CustomActionTypeContractInteraction, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(6, 1)),
+				NewCustomAction(2, "convert", CustomActionTypeERC20Conversion, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(6, 1)),
+			}, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			true,
+		},
+		{
+			"fail - duplicated custom action",
+			NewCampaign(1, funder, "acoin", nil, []CustomAction{
+				NewCustomAction(1, "swap", CustomActionTypeContractInteraction, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(1, 1)),
+				NewCustomAction(1, "swap", CustomActionTypeContractInteraction, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(1, 1)),
+			}, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			true,
+		},
+		{
+			"pass - only custom actions",
+			NewCampaign(1, funder, "acoin", nil, []CustomAction{
+				NewCustomAction(1, "swap", CustomActionTypeContractInteraction, tests.GenerateAddress().Hex(), "", sdk.OneDec()),
+			}, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			false,
+		},
+		{
+			"fail - invalid funder",
+			Campaign{
+				ID:                 1,
+				Funder:             "badaddress",
+				Denom:              "acoin",
+				Actions:            actions,
+				DurationUntilDecay: time.Hour,
+				DurationOfDecay:    time.Hour,
+				Escrow:             sdk.OneInt(),
+			},
+			true,
+		},
+		{
+			"fail - invalid denom",
+			NewCampaign(1, funder, "", actions, nil, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			true,
+		},
+		{
+			"fail - empty actions",
+			NewCampaign(1, funder, "acoin", []Action{}, nil, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			true,
+		},
+		{
+			"fail - unspecified action",
+			NewCampaign(1, funder, "acoin", []Action{ActionUnspecified}, nil, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			true,
+		},
+		{
+			"fail - duplicated action",
+			NewCampaign(1, funder, "acoin", []Action{ActionVote, ActionVote}, nil, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			true,
+		},
+		{
+			"fail - zero duration of decay",
+			NewCampaign(1, funder, "acoin", actions, nil, time.Now(), time.Hour, 0, sdk.OneInt()),
+			true,
+		},
+		{
+			"fail - negative escrow",
+			NewCampaign(1, funder, "acoin", actions, nil, time.Now(), time.Hour, time.Hour, sdk.NewInt(-1)),
+			true,
+		},
+		{
+			"success - valid instance",
+			NewCampaign(1, funder, "acoin", actions, nil, time.Now(), time.Hour, time.Hour, sdk.OneInt()),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.campaign.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestCampaignIsActive(t *testing.T) {
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	startTime := time.Now().UTC()
+	campaign := NewCampaign(1, funder, "acoin", []Action{ActionVote}, nil, startTime, time.Hour, time.Hour, sdk.OneInt())
+
+	require.Equal(t, startTime.Add(time.Hour), campaign.DecayStartTime())
+	require.Equal(t, startTime.Add(2*time.Hour), campaign.EndTime())
+
+	require.False(t, campaign.IsActive(startTime.Add(-time.Second)))
+	require.True(t, campaign.IsActive(startTime))
+	require.True(t, campaign.IsActive(campaign.EndTime()))
+	require.False(t, campaign.IsActive(campaign.EndTime().Add(time.Second)))
+
+	require.True(t, campaign.HasAction(ActionVote))
+	require.False(t, campaign.HasAction(ActionEVM))
+}
+
+func TestCampaignActionsPercentage(t *testing.T) {
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	campaign := NewCampaign(1, funder, "acoin", []Action{ActionVote}, nil, time.Now(), time.Hour, time.Hour, sdk.OneInt())
+	require.Equal(t, sdk.OneDec(), campaign.ActionsPercentage())
+
+	campaign.CustomActions = []CustomAction{
+		NewCustomAction(1, "swap", CustomActionTypeContractInteraction, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(25, 2)),
+		NewCustomAction(2, "convert", CustomActionTypeERC20Conversion, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(25, 2)),
+	}
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), campaign.ActionsPercentage())
+
+	customAction, found := campaign.GetCustomAction(2)
+	require.True(t, found)
+	require.Equal(t, "convert", customAction.Name)
+
+	_, found = campaign.GetCustomAction(3)
+	require.False(t, found)
+}
@@ -8,15 +8,20 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -64,6 +69,133 @@ func (Action) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a7153f2307523893, []int{0}
 }
 
+// CustomActionType defines the type of event that completes a custom action
+type CustomActionType int32
+
+const (
+	// CUSTOM_ACTION_TYPE_UNSPECIFIED defines an invalid custom action type.
+	CustomActionTypeUnspecified CustomActionType = 0
+	// CUSTOM_ACTION_TYPE_CONTRACT_INTERACTION defines an EVM transaction that
+	// calls the contract or causes it to emit a log.
+	CustomActionTypeContractInteraction CustomActionType = 1
+	// CUSTOM_ACTION_TYPE_ERC20_CONVERSION defines a conversion of the token pair
+	// of the ERC20 contract via the erc20 module.
+	CustomActionTypeERC20Conversion CustomActionType = 2
+	// CUSTOM_ACTION_TYPE_EVENT_LOG defines an EVM transaction whose receipt
+	// contains a log with the event signature emitted by the contract.
+	CustomActionTypeEventLog CustomActionType = 3
+)
+
+var CustomActionType_name = map[int32]string{
+	0: "CUSTOM_ACTION_TYPE_UNSPECIFIED",
+	1: "CUSTOM_ACTION_TYPE_CONTRACT_INTERACTION",
+	2: "CUSTOM_ACTION_TYPE_ERC20_CONVERSION",
+	3: "CUSTOM_ACTION_TYPE_EVENT_LOG",
+}
+
+var CustomActionType_value = map[string]int32{
+	"CUSTOM_ACTION_TYPE_UNSPECIFIED":          0,
+	"CUSTOM_ACTION_TYPE_CONTRACT_INTERACTION": 1,
+	"CUSTOM_ACTION_TYPE_ERC20_CONVERSION":     2,
+	"CUSTOM_ACTION_TYPE_EVENT_LOG":            3,
+}
+
+func (x CustomActionType) String() string {
+	return proto.EnumName(CustomActionType_name, int32(x))
+}
+
+func (CustomActionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{1}
+}
+
+// CustomAction defines a governance-registered action that can be required by
+// airdrop campaigns in addition to the built-in actions.
+type CustomAction struct {
+	// id is the unique identifier of the custom action
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is a short human readable name of the custom action
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// type defines the event that completes the custom action
+	Type CustomActionType `protobuf:"varint,3,opt,name=type,proto3,enum=evmos.claims.v1.CustomActionType" json:"type,omitempty"`
+	// contract is the hex address of the contract that is interacted with, the
+	// ERC20 contract of the converted token pair or the emitter of the event log
+	Contract string `protobuf:"bytes,4,opt,name=contract,proto3" json:"contract,omitempty"`
+	// event_signature is the hex encoded topic of the event log. Only used by
+	// the event log type.
+	EventSignature string `protobuf:"bytes,5,opt,name=event_signature,json=eventSignature,proto3" json:"event_signature,omitempty"`
+	// claimable_percentage is the fraction of the initial claimable amount of a
+	// claims record that is released by completing the custom action
+	ClaimablePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=claimable_percentage,json=claimablePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"claimable_percentage"`
+}
+
+func (m *CustomAction) Reset()         { *m = CustomAction{} }
+func (m *CustomAction) String() string { return proto.CompactTextString(m) }
+func (*CustomAction) ProtoMessage()    {}
+func (*CustomAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{0}
+}
+func (m *CustomAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CustomAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomAction.Merge(m, src)
+}
+func (m *CustomAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomAction proto.InternalMessageInfo
+
+func (m *CustomAction) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *CustomAction) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CustomAction) GetType() CustomActionType {
+	if m != nil {
+		return m.Type
+	}
+	return CustomActionTypeUnspecified
+}
+
+func (m *CustomAction) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *CustomAction) GetEventSignature() string {
+	if m != nil {
+		return m.EventSignature
+	}
+	return ""
+}
+
 // Claim defines the action, completed flag and the remaining claimable amount
 // for a given user. This is only used during client queries.
 type Claim struct {
@@ -79,7 +211,7 @@ func (m *Claim) Reset()         { *m = Claim{} }
 func (m *Claim) String() string { return proto.CompactTextString(m) }
 func (*Claim) ProtoMessage()    {}
 func (*Claim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7153f2307523893, []int{0}
+	return fileDescriptor_a7153f2307523893, []int{1}
 }
 func (m *Claim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -131,13 +263,16 @@ type ClaimsRecordAddress struct {
 	InitialClaimableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=initial_claimable_amount,json=initialClaimableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_claimable_amount"`
 	// actions_completed is a slice that describes which actions were completed
 	ActionsCompleted []bool `protobuf:"varint,3,rep,packed,name=actions_completed,json=actionsCompleted,proto3" json:"actions_completed,omitempty"`
+	// custom_actions_completed is the list of identifiers of the completed
+	// custom actions
+	CustomActionsCompleted []uint64 `protobuf:"varint,4,rep,packed,name=custom_actions_completed,json=customActionsCompleted,proto3" json:"custom_actions_completed,omitempty"`
 }
 
 func (m *ClaimsRecordAddress) Reset()         { *m = ClaimsRecordAddress{} }
 func (m *ClaimsRecordAddress) String() string { return proto.CompactTextString(m) }
 func (*ClaimsRecordAddress) ProtoMessage()    {}
 func (*ClaimsRecordAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7153f2307523893, []int{1}
+	return fileDescriptor_a7153f2307523893, []int{2}
 }
 func (m *ClaimsRecordAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,6 +315,13 @@ func (m *ClaimsRecordAddress) GetActionsCompleted() []bool {
 	return nil
 }
 
+func (m *ClaimsRecordAddress) GetCustomActionsCompleted() []uint64 {
+	if m != nil {
+		return m.CustomActionsCompleted
+	}
+	return nil
+}
+
 // ClaimsRecord defines the initial claimable airdrop amount and the list of
 // completed actions to claim the tokens.
 type ClaimsRecord struct {
@@ -187,13 +329,16 @@ type ClaimsRecord struct {
 	InitialClaimableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=initial_claimable_amount,json=initialClaimableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_claimable_amount"`
 	// actions_completed is a slice that describes which actions were completed
 	ActionsCompleted []bool `protobuf:"varint,2,rep,packed,name=actions_completed,json=actionsCompleted,proto3" json:"actions_completed,omitempty"`
+	// custom_actions_completed is the list of identifiers of the completed
+	// custom actions
+	CustomActionsCompleted []uint64 `protobuf:"varint,3,rep,packed,name=custom_actions_completed,json=customActionsCompleted,proto3" json:"custom_actions_completed,omitempty"`
 }
 
 func (m *ClaimsRecord) Reset()         { *m = ClaimsRecord{} }
 func (m *ClaimsRecord) String() string { return proto.CompactTextString(m) }
 func (*ClaimsRecord) ProtoMessage()    {}
 func (*ClaimsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a7153f2307523893, []int{2}
+	return fileDescriptor_a7153f2307523893, []int{3}
 }
 func (m *ClaimsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,265 +374,3221 @@ func (m *ClaimsRecord) GetActionsCompleted() []bool {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("evmos.claims.v1.Action", Action_name, Action_value)
-	proto.RegisterType((*Claim)(nil), "evmos.claims.v1.Claim")
-	proto.RegisterType((*ClaimsRecordAddress)(nil), "evmos.claims.v1.ClaimsRecordAddress")
-	proto.RegisterType((*ClaimsRecord)(nil), "evmos.claims.v1.ClaimsRecord")
+func (m *ClaimsRecord) GetCustomActionsCompleted() []uint64 {
+	if m != nil {
+		return m.CustomActionsCompleted
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("evmos/claims/v1/claims.proto", fileDescriptor_a7153f2307523893) }
+// Campaign defines an airdrop campaign that runs independently from the genesis
+// airdrop, with its own denomination, actions, decay schedule and claims
+// records. The claimable coins are escrowed from the funder on creation and the
+// remaining escrow is returned to the funder once the campaign ends.
+type Campaign struct {
+	// id is the unique identifier of the campaign
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// funder is the bech32 address of the account that funds the campaign
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+	// denom is the denomination of the claimable coin
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// actions is the list of actions that have to be completed to claim the
+	// coins. The claimable amount that isn't allocated to the custom actions is
+	// split evenly between them.
+	Actions []Action `protobuf:"varint,4,rep,packed,name=actions,proto3,enum=evmos.claims.v1.Action" json:"actions,omitempty"`
+	// start_time defines the timestamp of the campaign start
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// duration_until_decay of claimable tokens begin
+	DurationUntilDecay time.Duration `protobuf:"bytes,6,opt,name=duration_until_decay,json=durationUntilDecay,proto3,stdduration" json:"duration_until_decay"`
+	// duration_of_decay for token claim decay period
+	DurationOfDecay time.Duration `protobuf:"bytes,7,opt,name=duration_of_decay,json=durationOfDecay,proto3,stdduration" json:"duration_of_decay"`
+	// escrow is the amount of coins of the campaign that are held by the module
+	// account and haven't been claimed yet
+	Escrow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=escrow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"escrow"`
+	// custom_actions is the list of custom actions of the campaign, as they were
+	// registered when the campaign was created
+	CustomActions []CustomAction `protobuf:"bytes,9,rep,name=custom_actions,json=customActions,proto3" json:"custom_actions"`
+}
 
-var fileDescriptor_a7153f2307523893 = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4d, 0x6e, 0xd3, 0x40,
-	0x14, 0xc7, 0x3d, 0x49, 0x09, 0xcd, 0x00, 0x89, 0x3b, 0xe5, 0x23, 0xb2, 0x8a, 0x63, 0x65, 0x01,
-	0x01, 0x54, 0x9b, 0xc0, 0x09, 0x1c, 0xc7, 0x45, 0x96, 0x68, 0x8a, 0x5c, 0x37, 0x12, 0x6c, 0x2c,
-	0xc7, 0x9e, 0xa6, 0x16, 0xb6, 0x27, 0xf2, 0x4c, 0x23, 0xb8, 0x01, 0xca, 0x8a, 0x0b, 0x64, 0x85,
-	0x38, 0x00, 0x97, 0x40, 0x5d, 0x76, 0x85, 0x10, 0x8b, 0x0a, 0x25, 0x17, 0x41, 0x9d, 0x71, 0x68,
-	0xf8, 0x10, 0x0b, 0xa4, 0x6e, 0xec, 0x37, 0xef, 0xff, 0x9b, 0xa7, 0xff, 0x7b, 0xa3, 0x07, 0xb7,
-	0xf0, 0x24, 0x25, 0xd4, 0x08, 0x93, 0x20, 0x4e, 0xa9, 0x31, 0xe9, 0x14, 0x91, 0x3e, 0xce, 0x09,
-	0x23, 0xa8, 0xce, 0x55, 0xbd, 0xc8, 0x4d, 0x3a, 0xca, 0xcd, 0x11, 0x19, 0x11, 0xae, 0x19, 0xe7,
-	0x91, 0xc0, 0x5a, 0x9f, 0x00, 0xbc, 0x62, 0x9d, 0x33, 0xc8, 0x80, 0x95, 0x20, 0x64, 0x31, 0xc9,
-	0x1a, 0x40, 0x03, 0xed, 0xda, 0x93, 0x3b, 0xfa, 0x6f, 0x15, 0x74, 0x93, 0xcb, 0x6e, 0x81, 0xa1,
-	0x2d, 0x58, 0x0d, 0x49, 0x3a, 0x4e, 0x30, 0xc3, 0x51, 0xa3, 0xa4, 0x81, 0xf6, 0xba, 0x7b, 0x91,
-	0x40, 0x2f, 0xa1, 0xcc, 0x6f, 0x06, 0xc3, 0x04, 0xfb, 0x41, 0x4a, 0x8e, 0x33, 0xd6, 0x28, 0x6b,
-	0xa0, 0x5d, 0xed, 0xea, 0x27, 0x67, 0x4d, 0xe9, 0xdb, 0x59, 0xf3, 0xde, 0x28, 0x66, 0x47, 0xc7,
-	0x43, 0x3d, 0x24, 0xa9, 0x11, 0x12, 0xca, 0x7b, 0xe1, 0xbf, 0x6d, 0x1a, 0xbd, 0x36, 0xd8, 0xdb,
-	0x31, 0xa6, 0xba, 0x93, 0x31, 0xb7, 0xfe, 0xb3, 0x8e, 0xc9, 0xcb, 0xb4, 0x3e, 0x03, 0xb8, 0xc9,
-	0x3d, 0x53, 0x17, 0x87, 0x24, 0x8f, 0xcc, 0x28, 0xca, 0x31, 0xa5, 0xa8, 0x01, 0xaf, 0x06, 0x22,
-	0xe4, 0x2d, 0x54, 0xdd, 0xe5, 0x11, 0x1d, 0xc1, 0x46, 0x9c, 0xc5, 0x2c, 0x0e, 0x12, 0xff, 0x0f,
-	0x53, 0xa5, 0xff, 0x32, 0x75, 0xbb, 0xa8, 0x67, 0xfd, 0xea, 0x0d, 0x3d, 0x82, 0x1b, 0x62, 0x3c,
-	0xd4, 0xbf, 0x18, 0x4e, 0x59, 0x2b, 0xb7, 0xd7, 0x5d, 0xb9, 0x10, 0xac, 0x65, 0xbe, 0xf5, 0x11,
-	0xc0, 0xeb, 0xab, 0x8d, 0xfc, 0xd3, 0x27, 0xb8, 0x7c, 0x9f, 0xa5, 0xbf, 0xfb, 0x7c, 0xf8, 0x05,
-	0xc0, 0x8a, 0x78, 0x7c, 0xb4, 0x0d, 0x91, 0x69, 0x79, 0xce, 0x5e, 0xdf, 0x3f, 0xe8, 0xef, 0xbf,
-	0xb0, 0x2d, 0x67, 0xc7, 0xb1, 0x7b, 0xb2, 0xa4, 0xdc, 0x9a, 0xce, 0xb4, 0x0d, 0xc1, 0x1c, 0x64,
-	0x74, 0x8c, 0xc3, 0xf8, 0x30, 0xc6, 0x11, 0x6a, 0xc2, 0x6b, 0x05, 0x3e, 0xd8, 0xf3, 0x6c, 0x19,
-	0x28, 0xb5, 0xe9, 0x4c, 0x83, 0x82, 0x1b, 0x10, 0x86, 0xd1, 0x7d, 0x58, 0x2f, 0x80, 0x9e, 0xfd,
-	0xdc, 0x7e, 0x66, 0x7a, 0xb6, 0x5c, 0x52, 0xd0, 0x74, 0xa6, 0xd5, 0x04, 0xd4, 0xc3, 0x09, 0x1e,
-	0x05, 0x0c, 0xa3, 0xbb, 0x10, 0x16, 0xa0, 0x3d, 0xd8, 0x95, 0xcb, 0xca, 0x8d, 0xe9, 0x4c, 0xab,
-	0x0a, 0xc6, 0x1e, 0xec, 0x22, 0x1d, 0x6e, 0x16, 0xb2, 0xd3, 0xb5, 0x7c, 0xcf, 0x35, 0xfb, 0xfb,
-	0x3b, 0xb6, 0x2b, 0xaf, 0xad, 0x1a, 0x73, 0xba, 0x96, 0x97, 0x07, 0x19, 0x3d, 0xc4, 0xb9, 0xb2,
-	0xf6, 0xee, 0x83, 0x2a, 0x75, 0xad, 0x93, 0xb9, 0x0a, 0x4e, 0xe7, 0x2a, 0xf8, 0x3e, 0x57, 0xc1,
-	0xfb, 0x85, 0x2a, 0x9d, 0x2e, 0x54, 0xe9, 0xeb, 0x42, 0x95, 0x5e, 0x3d, 0x58, 0x99, 0xaf, 0xd8,
-	0x33, 0xf1, 0x9d, 0x74, 0x1e, 0x1b, 0x6f, 0x96, 0x3b, 0xc7, 0xc7, 0x3c, 0xac, 0xf0, 0x4d, 0x7a,
-	0xfa, 0x23, 0x00, 0x00, 0xff, 0xff, 0x45, 0x87, 0x73, 0x57, 0x90, 0x03, 0x00, 0x00,
+func (m *Campaign) Reset()         { *m = Campaign{} }
+func (m *Campaign) String() string { return proto.CompactTextString(m) }
+func (*Campaign) ProtoMessage()    {}
+func (*Campaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{4}
+}
+func (m *Campaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Campaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Campaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Campaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Campaign.Merge(m, src)
+}
+func (m *Campaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *Campaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_Campaign.DiscardUnknown(m)
 }
 
-func (m *Claim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Campaign proto.InternalMessageInfo
+
+func (m *Campaign) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Claim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Campaign) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
 }
 
-func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ClaimableAmount.Size()
-		i -= size
-		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintClaims(dAtA, i, uint64(size))
+func (m *Campaign) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Completed {
-		i--
-		if m.Completed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *Campaign) GetActions() []Action {
+	if m != nil {
+		return m.Actions
 	}
-	if m.Action != 0 {
-		i = encodeVarintClaims(dAtA, i, uint64(m.Action))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *Campaign) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *ClaimsRecordAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Campaign) GetDurationUntilDecay() time.Duration {
+	if m != nil {
+		return m.DurationUntilDecay
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ClaimsRecordAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Campaign) GetDurationOfDecay() time.Duration {
+	if m != nil {
+		return m.DurationOfDecay
+	}
+	return 0
 }
 
-func (m *ClaimsRecordAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActionsCompleted) > 0 {
-		for iNdEx := len(m.ActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.ActionsCompleted[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarintClaims(dAtA, i, uint64(len(m.ActionsCompleted)))
-		i--
-		dAtA[i] = 0x1a
+func (m *Campaign) GetCustomActions() []CustomAction {
+	if m != nil {
+		return m.CustomActions
 	}
-	{
-		size := m.InitialClaimableAmount.Size()
-		i -= size
-		if _, err := m.InitialClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+// CampaignClaimsRecord is the claims record of an address for a given campaign
+// that is used at Genesis.
+type CampaignClaimsRecord struct {
+	// campaign_id is the identifier of the campaign
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// claims_record of the address
+	ClaimsRecord ClaimsRecordAddress `protobuf:"bytes,2,opt,name=claims_record,json=claimsRecord,proto3" json:"claims_record"`
+}
+
+func (m *CampaignClaimsRecord) Reset()         { *m = CampaignClaimsRecord{} }
+func (m *CampaignClaimsRecord) String() string { return proto.CompactTextString(m) }
+func (*CampaignClaimsRecord) ProtoMessage()    {}
+func (*CampaignClaimsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{5}
+}
+func (m *CampaignClaimsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CampaignClaimsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CampaignClaimsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintClaims(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintClaims(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *CampaignClaimsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CampaignClaimsRecord.Merge(m, src)
+}
+func (m *CampaignClaimsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CampaignClaimsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CampaignClaimsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CampaignClaimsRecord proto.InternalMessageInfo
+
+func (m *CampaignClaimsRecord) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ClaimsRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CampaignClaimsRecord) GetClaimsRecord() ClaimsRecordAddress {
+	if m != nil {
+		return m.ClaimsRecord
 	}
-	return dAtA[:n], nil
+	return ClaimsRecordAddress{}
 }
 
-func (m *ClaimsRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MerkleClaim is the Merkle tree leaf of an address that has been proven with
+// a MsgClaimWithProof and is used at Genesis.
+type MerkleClaim struct {
+	// address of the leaf
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount of the leaf
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *ClaimsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActionsCompleted) > 0 {
-		for iNdEx := len(m.ActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.ActionsCompleted[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+func (m *MerkleClaim) Reset()         { *m = MerkleClaim{} }
+func (m *MerkleClaim) String() string { return proto.CompactTextString(m) }
+func (*MerkleClaim) ProtoMessage()    {}
+func (*MerkleClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{6}
+}
+func (m *MerkleClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintClaims(dAtA, i, uint64(len(m.ActionsCompleted)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	{
-		size := m.InitialClaimableAmount.Size()
+}
+func (m *MerkleClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleClaim.Merge(m, src)
+}
+func (m *MerkleClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleClaim proto.InternalMessageInfo
+
+func (m *MerkleClaim) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ClaimsDelegate is the delegate, or the pending request of a delegate, that
+// completes the actions of the claims records of a granter and is used at
+// Genesis.
+type ClaimsDelegate struct {
+	// granter is the bech32 address of the holder of the claims records
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// delegate is the bech32 address that completes the actions
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *ClaimsDelegate) Reset()         { *m = ClaimsDelegate{} }
+func (m *ClaimsDelegate) String() string { return proto.CompactTextString(m) }
+func (*ClaimsDelegate) ProtoMessage()    {}
+func (*ClaimsDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{7}
+}
+func (m *ClaimsDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimsDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimsDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimsDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimsDelegate.Merge(m, src)
+}
+func (m *ClaimsDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimsDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimsDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimsDelegate proto.InternalMessageInfo
+
+func (m *ClaimsDelegate) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *ClaimsDelegate) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// CustomClaim defines the custom action, completed flag and the remaining
+// claimable amount for a given user. This is only used during client queries.
+type CustomClaim struct {
+	// custom_action_id is the identifier of the custom action
+	CustomActionID uint64 `protobuf:"varint,1,opt,name=custom_action_id,json=customActionId,proto3" json:"custom_action_id,omitempty"`
+	// name of the custom action
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// completed is true if the custom action has been completed
+	Completed bool `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	// claimable_amount of tokens for the custom action. Zero if completed
+	ClaimableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=claimable_amount,json=claimableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimable_amount"`
+}
+
+func (m *CustomClaim) Reset()         { *m = CustomClaim{} }
+func (m *CustomClaim) String() string { return proto.CompactTextString(m) }
+func (*CustomClaim) ProtoMessage()    {}
+func (*CustomClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{8}
+}
+func (m *CustomClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustomClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustomClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CustomClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustomClaim.Merge(m, src)
+}
+func (m *CustomClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustomClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustomClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustomClaim proto.InternalMessageInfo
+
+func (m *CustomClaim) GetCustomActionID() uint64 {
+	if m != nil {
+		return m.CustomActionID
+	}
+	return 0
+}
+
+func (m *CustomClaim) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CustomClaim) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+// RegisterCustomActionProposal is a gov Content type to register a custom
+// action that campaigns can require to claim their coins
+type RegisterCustomActionProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name is a short human readable name of the custom action
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// type defines the event that completes the custom action
+	Type CustomActionType `protobuf:"varint,4,opt,name=type,proto3,enum=evmos.claims.v1.CustomActionType" json:"type,omitempty"`
+	// contract is the hex address of the contract of the custom action
+	Contract string `protobuf:"bytes,5,opt,name=contract,proto3" json:"contract,omitempty"`
+	// event_signature is the hex encoded topic of the event log
+	EventSignature string `protobuf:"bytes,6,opt,name=event_signature,json=eventSignature,proto3" json:"event_signature,omitempty"`
+	// claimable_percentage is the fraction of the initial claimable amount that
+	// is released by completing the custom action
+	ClaimablePercentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=claimable_percentage,json=claimablePercentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"claimable_percentage"`
+}
+
+func (m *RegisterCustomActionProposal) Reset()         { *m = RegisterCustomActionProposal{} }
+func (m *RegisterCustomActionProposal) String() string { return proto.CompactTextString(m) }
+func (*RegisterCustomActionProposal) ProtoMessage()    {}
+func (*RegisterCustomActionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{9}
+}
+func (m *RegisterCustomActionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterCustomActionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterCustomActionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterCustomActionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterCustomActionProposal.Merge(m, src)
+}
+func (m *RegisterCustomActionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterCustomActionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterCustomActionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterCustomActionProposal proto.InternalMessageInfo
+
+func (m *RegisterCustomActionProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RegisterCustomActionProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RegisterCustomActionProposal) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RegisterCustomActionProposal) GetType() CustomActionType {
+	if m != nil {
+		return m.Type
+	}
+	return CustomActionTypeUnspecified
+}
+
+func (m *RegisterCustomActionProposal) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *RegisterCustomActionProposal) GetEventSignature() string {
+	if m != nil {
+		return m.EventSignature
+	}
+	return ""
+}
+
+// RemoveCustomActionProposal is a gov Content type to remove a custom action.
+// Existing campaigns keep the custom actions they were created with.
+type RemoveCustomActionProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// custom_action_id is the identifier of the custom action
+	CustomActionID uint64 `protobuf:"varint,3,opt,name=custom_action_id,json=customActionId,proto3" json:"custom_action_id,omitempty"`
+}
+
+func (m *RemoveCustomActionProposal) Reset()         { *m = RemoveCustomActionProposal{} }
+func (m *RemoveCustomActionProposal) String() string { return proto.CompactTextString(m) }
+func (*RemoveCustomActionProposal) ProtoMessage()    {}
+func (*RemoveCustomActionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a7153f2307523893, []int{10}
+}
+func (m *RemoveCustomActionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveCustomActionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveCustomActionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveCustomActionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCustomActionProposal.Merge(m, src)
+}
+func (m *RemoveCustomActionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveCustomActionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCustomActionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveCustomActionProposal proto.InternalMessageInfo
+
+func (m *RemoveCustomActionProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RemoveCustomActionProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RemoveCustomActionProposal) GetCustomActionID() uint64 {
+	if m != nil {
+		return m.CustomActionID
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("evmos.claims.v1.Action", Action_name, Action_value)
+	proto.RegisterEnum("evmos.claims.v1.CustomActionType", CustomActionType_name, CustomActionType_value)
+	proto.RegisterType((*CustomAction)(nil), "evmos.claims.v1.CustomAction")
+	proto.RegisterType((*Claim)(nil), "evmos.claims.v1.Claim")
+	proto.RegisterType((*ClaimsRecordAddress)(nil), "evmos.claims.v1.ClaimsRecordAddress")
+	proto.RegisterType((*ClaimsRecord)(nil), "evmos.claims.v1.ClaimsRecord")
+	proto.RegisterType((*Campaign)(nil), "evmos.claims.v1.Campaign")
+	proto.RegisterType((*CampaignClaimsRecord)(nil), "evmos.claims.v1.CampaignClaimsRecord")
+	proto.RegisterType((*MerkleClaim)(nil), "evmos.claims.v1.MerkleClaim")
+	proto.RegisterType((*ClaimsDelegate)(nil), "evmos.claims.v1.ClaimsDelegate")
+	proto.RegisterType((*CustomClaim)(nil), "evmos.claims.v1.CustomClaim")
+	proto.RegisterType((*RegisterCustomActionProposal)(nil), "evmos.claims.v1.RegisterCustomActionProposal")
+	proto.RegisterType((*RemoveCustomActionProposal)(nil), "evmos.claims.v1.RemoveCustomActionProposal")
+}
+
+func init() { proto.RegisterFile("evmos/claims/v1/claims.proto", fileDescriptor_a7153f2307523893) }
+
+var fileDescriptor_a7153f2307523893 = []byte{
+	// 1245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x15, 0x25, 0x5a, 0xb6, 0x47, 0x89, 0xac, 0x6c, 0x5c, 0x97, 0x65, 0x1d, 0x89, 0x55, 0x8a,
+	0xc6, 0x4d, 0x11, 0x29, 0x56, 0x51, 0xa0, 0x28, 0x8a, 0x02, 0x12, 0x45, 0x07, 0x2c, 0x6c, 0xcb,
+	0xa0, 0x69, 0x03, 0xe9, 0x85, 0xa0, 0xc9, 0xb5, 0x42, 0x44, 0x22, 0x05, 0x72, 0xa5, 0x36, 0xb7,
+	0x1e, 0x7a, 0x28, 0x74, 0xca, 0x31, 0x3d, 0x08, 0x28, 0xd0, 0x2f, 0xe8, 0x27, 0xf4, 0x96, 0x5b,
+	0x73, 0x2a, 0x82, 0x1e, 0x9c, 0xc2, 0xb9, 0xf4, 0x33, 0x0a, 0xee, 0x2e, 0x65, 0x59, 0x52, 0xdc,
+	0xc4, 0x4d, 0x2f, 0x36, 0x77, 0xe6, 0xcd, 0xdb, 0x99, 0xd9, 0x99, 0xd9, 0x15, 0xac, 0xe3, 0x41,
+	0x37, 0x88, 0xaa, 0x4e, 0xc7, 0xf6, 0xba, 0x51, 0x75, 0xb0, 0xc9, 0xbf, 0x2a, 0xbd, 0x30, 0x20,
+	0x01, 0x5a, 0xa1, 0xda, 0x0a, 0x97, 0x0d, 0x36, 0xe5, 0xd5, 0x76, 0xd0, 0x0e, 0xa8, 0xae, 0x1a,
+	0x7f, 0x31, 0x98, 0x5c, 0x6c, 0x07, 0x41, 0xbb, 0x83, 0xab, 0x74, 0x75, 0xd4, 0x3f, 0xae, 0xba,
+	0xfd, 0xd0, 0x26, 0x5e, 0xe0, 0x73, 0x7d, 0x69, 0x5a, 0x4f, 0xbc, 0x2e, 0x8e, 0x88, 0xdd, 0xed,
+	0x31, 0x40, 0xf9, 0xa7, 0x34, 0x5c, 0x51, 0xfb, 0x11, 0x09, 0xba, 0x75, 0x27, 0xb6, 0x43, 0x6b,
+	0x90, 0xf6, 0x5c, 0x49, 0x50, 0x84, 0x0d, 0xb1, 0x91, 0x3d, 0x3d, 0x29, 0xa5, 0xf5, 0xa6, 0x91,
+	0xf6, 0x5c, 0x84, 0x40, 0xf4, 0xed, 0x2e, 0x96, 0xd2, 0x8a, 0xb0, 0xb1, 0x6c, 0xd0, 0x6f, 0xf4,
+	0x19, 0x88, 0xe4, 0x51, 0x0f, 0x4b, 0x19, 0x45, 0xd8, 0xc8, 0xd7, 0x3e, 0xa8, 0x4c, 0xf9, 0x5c,
+	0x99, 0x24, 0x36, 0x1f, 0xf5, 0xb0, 0x41, 0xe1, 0x48, 0x86, 0x25, 0x27, 0xf0, 0x49, 0x68, 0x3b,
+	0x44, 0x12, 0x29, 0xdd, 0x78, 0x8d, 0x6e, 0xc1, 0x0a, 0x1e, 0x60, 0x9f, 0x58, 0x91, 0xd7, 0xf6,
+	0x6d, 0xd2, 0x0f, 0xb1, 0xb4, 0x40, 0x21, 0x79, 0x2a, 0xde, 0x4f, 0xa4, 0xc8, 0x86, 0x55, 0xba,
+	0x91, 0x7d, 0xd4, 0xc1, 0x56, 0x0f, 0x87, 0x0e, 0xf6, 0x89, 0xdd, 0xc6, 0x52, 0x36, 0x46, 0x37,
+	0x2a, 0x4f, 0x4f, 0x4a, 0xa9, 0x3f, 0x4f, 0x4a, 0x1f, 0xb5, 0x3d, 0xf2, 0xa0, 0x7f, 0x54, 0x71,
+	0x82, 0x6e, 0xd5, 0x09, 0x22, 0x9a, 0x70, 0xfa, 0xef, 0x4e, 0xe4, 0x3e, 0xac, 0xc6, 0xde, 0x44,
+	0x95, 0x26, 0x76, 0x8c, 0xeb, 0x63, 0xae, 0xbd, 0x31, 0x55, 0xf9, 0x57, 0x01, 0x16, 0xd4, 0x58,
+	0x8e, 0xaa, 0x90, 0xb5, 0x69, 0x14, 0x34, 0x31, 0xf9, 0xda, 0xbb, 0x33, 0xa1, 0xb2, 0x20, 0x0d,
+	0x0e, 0x43, 0xeb, 0xb0, 0xec, 0x04, 0xdd, 0x5e, 0x07, 0x13, 0xec, 0xd2, 0x94, 0x2d, 0x19, 0x67,
+	0x02, 0x74, 0x1f, 0x0a, 0x67, 0xbe, 0xdb, 0xdd, 0xa0, 0xef, 0x13, 0x29, 0xf3, 0xc6, 0x7e, 0xeb,
+	0x3e, 0x31, 0x56, 0xc6, 0x3c, 0x75, 0x4a, 0x53, 0xfe, 0x3e, 0x0d, 0xd7, 0xa9, 0xcf, 0x91, 0x81,
+	0x9d, 0x20, 0x74, 0xeb, 0xae, 0x1b, 0xe2, 0x28, 0x42, 0x12, 0x2c, 0xda, 0xec, 0x93, 0x86, 0xb0,
+	0x6c, 0x24, 0x4b, 0xf4, 0x00, 0x24, 0xcf, 0xf7, 0x88, 0x67, 0x77, 0xac, 0x19, 0xa7, 0xd2, 0x97,
+	0x72, 0x6a, 0x8d, 0xf3, 0xa9, 0xe7, 0x7d, 0x43, 0x9f, 0xc0, 0x35, 0x96, 0x9e, 0xc8, 0x3a, 0x4b,
+	0x4e, 0x46, 0xc9, 0x6c, 0x2c, 0x19, 0x05, 0xae, 0x50, 0xc7, 0x39, 0xfa, 0x1c, 0x24, 0x87, 0x96,
+	0x8f, 0x35, 0x6b, 0x23, 0x2a, 0x99, 0x0d, 0xd1, 0x58, 0x73, 0x26, 0xca, 0xeb, 0xcc, 0xb2, 0xfc,
+	0x42, 0x80, 0x2b, 0x93, 0x29, 0xb8, 0x30, 0x42, 0xe1, 0xff, 0x8f, 0x30, 0x7d, 0x89, 0x08, 0x33,
+	0x17, 0x46, 0xf8, 0x83, 0x08, 0x4b, 0xaa, 0xdd, 0xed, 0xd9, 0x5e, 0xfb, 0xd5, 0x0d, 0xbb, 0x06,
+	0xd9, 0xe3, 0xbe, 0xef, 0xe2, 0x90, 0xb7, 0x2c, 0x5f, 0xa1, 0x55, 0x58, 0x70, 0xb1, 0x1f, 0x74,
+	0x59, 0xc5, 0x19, 0x6c, 0x81, 0x36, 0x61, 0x91, 0x7b, 0x41, 0xb3, 0x7b, 0x41, 0x89, 0x27, 0x38,
+	0xa4, 0x02, 0x44, 0xc4, 0x0e, 0x89, 0x15, 0xcf, 0x14, 0xda, 0xa5, 0xb9, 0x9a, 0x5c, 0x61, 0x03,
+	0xa7, 0x92, 0x0c, 0x9c, 0x8a, 0x99, 0x0c, 0x9c, 0xc6, 0x52, 0x9c, 0xe4, 0xc7, 0x2f, 0x4a, 0x82,
+	0xb1, 0x4c, 0xed, 0x62, 0x0d, 0x3a, 0x80, 0xd5, 0x64, 0x64, 0x59, 0x7d, 0x9f, 0x78, 0x1d, 0xcb,
+	0xc5, 0x8e, 0xfd, 0x88, 0xb6, 0x71, 0xae, 0xf6, 0xde, 0x0c, 0x5d, 0x93, 0x83, 0x19, 0xdb, 0x93,
+	0x98, 0x0d, 0x25, 0x04, 0x07, 0xb1, 0x7d, 0x33, 0x36, 0x47, 0x2d, 0xb8, 0x36, 0xa6, 0x0d, 0x8e,
+	0x39, 0xe7, 0xe2, 0xeb, 0x73, 0xae, 0x24, 0xd6, 0xad, 0x63, 0x46, 0xb8, 0x05, 0x59, 0x1c, 0x39,
+	0x61, 0xf0, 0xad, 0xb4, 0x74, 0xa9, 0x8a, 0xe1, 0xd6, 0xe8, 0x6b, 0xc8, 0x9f, 0x3f, 0x74, 0x69,
+	0x59, 0xc9, 0x6c, 0xe4, 0x6a, 0x37, 0x2e, 0x1c, 0x9e, 0x0d, 0x31, 0xde, 0xce, 0xb8, 0x7a, 0xae,
+	0x1e, 0xca, 0x4f, 0x04, 0x58, 0x4d, 0xca, 0xe0, 0x5c, 0xc1, 0x57, 0x21, 0xe7, 0x70, 0xb9, 0x35,
+	0xae, 0x8d, 0xfc, 0xe9, 0x49, 0x09, 0x12, 0xb8, 0xde, 0x34, 0x20, 0x81, 0xe8, 0x2e, 0x6a, 0xc1,
+	0x55, 0xb6, 0xb1, 0x15, 0x52, 0x06, 0x5a, 0x32, 0xb9, 0xda, 0x87, 0xb3, 0x4e, 0xcd, 0x8e, 0x16,
+	0xee, 0xdb, 0x15, 0x67, 0x42, 0x55, 0x0e, 0x20, 0xb7, 0x83, 0xc3, 0x87, 0x1d, 0xcc, 0xe6, 0xe7,
+	0xab, 0xa7, 0xcf, 0x16, 0x64, 0xff, 0xd3, 0xac, 0xe1, 0xd6, 0xe5, 0x2d, 0xc8, 0x33, 0xdf, 0x9a,
+	0xb8, 0x83, 0xdb, 0x36, 0xc1, 0xf1, 0x9e, 0xed, 0xd0, 0xf6, 0x09, 0x0e, 0x93, 0x3d, 0xf9, 0x32,
+	0xbe, 0x7f, 0x5c, 0x8e, 0xe2, 0xbd, 0x31, 0x5e, 0x97, 0x9f, 0x0b, 0x90, 0x63, 0x99, 0x67, 0x9e,
+	0x7f, 0x09, 0x85, 0x73, 0xe7, 0x75, 0x96, 0x4f, 0x74, 0x7a, 0x52, 0xca, 0x4f, 0x1e, 0x92, 0xde,
+	0x34, 0xf2, 0x93, 0x07, 0xa4, 0xcf, 0xbf, 0x34, 0xcf, 0x5d, 0x0d, 0x99, 0xd7, 0xb9, 0x1a, 0xc4,
+	0xb7, 0x73, 0x35, 0xfc, 0x9e, 0x86, 0x75, 0x03, 0xb7, 0xbd, 0x88, 0xe0, 0x70, 0xd2, 0xef, 0xbd,
+	0x30, 0xe8, 0x05, 0x91, 0xdd, 0x89, 0x27, 0x03, 0xf1, 0x48, 0x07, 0xf3, 0x7c, 0xb1, 0x05, 0x52,
+	0x20, 0xe7, 0xc6, 0xc5, 0xeb, 0xf5, 0xe8, 0x05, 0xc8, 0x42, 0x99, 0x14, 0x8d, 0xa3, 0xcc, 0xcc,
+	0x79, 0x1a, 0x88, 0x97, 0x7f, 0x1a, 0x2c, 0xfc, 0xfb, 0xd3, 0x20, 0xfb, 0x46, 0x4f, 0x83, 0xc5,
+	0xb7, 0xf6, 0x34, 0xf8, 0x42, 0xfc, 0xfb, 0xe7, 0x52, 0x2a, 0x6e, 0x40, 0xd9, 0xc0, 0xdd, 0x60,
+	0x80, 0xdf, 0x6a, 0x3e, 0xe7, 0xd5, 0x5c, 0xe6, 0x75, 0x6b, 0x8e, 0xb9, 0x76, 0xfb, 0x0f, 0x01,
+	0xb2, 0x4c, 0x84, 0xee, 0x00, 0xaa, 0xab, 0xa6, 0xde, 0xda, 0xb5, 0x0e, 0x76, 0xf7, 0xf7, 0x34,
+	0x55, 0xdf, 0xd2, 0xb5, 0x66, 0x21, 0x25, 0xbf, 0x33, 0x1c, 0x29, 0xd7, 0x18, 0xe6, 0xc0, 0x8f,
+	0x7a, 0xd8, 0xf1, 0x8e, 0x3d, 0xec, 0xa2, 0x12, 0xe4, 0x38, 0xfc, 0xb0, 0x65, 0x6a, 0x05, 0x41,
+	0xce, 0x0f, 0x47, 0x0a, 0x30, 0xdc, 0x61, 0x40, 0x70, 0x7c, 0x0e, 0x1c, 0xd0, 0xd4, 0xb6, 0xb5,
+	0x7b, 0x75, 0x53, 0x2b, 0xa4, 0x65, 0x34, 0x1c, 0x29, 0x79, 0x06, 0x1a, 0x77, 0xe0, 0x0d, 0x00,
+	0x0e, 0xd4, 0x0e, 0x77, 0x0a, 0x19, 0xf9, 0xea, 0x70, 0xa4, 0x2c, 0x33, 0x8c, 0x76, 0xb8, 0x83,
+	0x2a, 0x70, 0x9d, 0xab, 0xf5, 0x86, 0x6a, 0x99, 0x46, 0x7d, 0x77, 0x7f, 0x4b, 0x33, 0x0a, 0xe2,
+	0xa4, 0x63, 0x7a, 0x43, 0x35, 0x43, 0xdb, 0x8f, 0x8e, 0x71, 0x28, 0x8b, 0x3f, 0xfe, 0x52, 0x4c,
+	0xdd, 0xfe, 0x2d, 0x0d, 0x85, 0xe9, 0xe2, 0x41, 0x2a, 0x14, 0xd5, 0x83, 0x7d, 0xb3, 0xb5, 0x63,
+	0x71, 0x46, 0xf3, 0xfe, 0x9e, 0x36, 0x15, 0x6e, 0x69, 0x38, 0x52, 0xde, 0x9f, 0xb6, 0x9c, 0x0c,
+	0xdc, 0x84, 0x5b, 0x73, 0x48, 0xd4, 0xd6, 0xae, 0x69, 0xd4, 0x55, 0xd3, 0xd2, 0x77, 0x4d, 0xcd,
+	0x60, 0x9a, 0x82, 0x20, 0xdf, 0x1a, 0x8e, 0x94, 0x9b, 0xd3, 0x6c, 0x2a, 0x2f, 0x55, 0x3d, 0x1e,
+	0x33, 0xfc, 0x25, 0xb8, 0x0d, 0x37, 0xe7, 0xb0, 0x6a, 0x86, 0x5a, 0xbb, 0x1b, 0x73, 0x1f, 0x6a,
+	0xc6, 0x7e, 0xcc, 0x98, 0x96, 0x6f, 0x0e, 0x47, 0x4a, 0x69, 0x9a, 0x91, 0xe2, 0xd4, 0xc0, 0x1f,
+	0xe0, 0x30, 0x8a, 0xd9, 0xbe, 0x82, 0xf5, 0x79, 0x6c, 0x87, 0xda, 0xae, 0x69, 0x6d, 0xb7, 0xee,
+	0x15, 0x32, 0xf2, 0xfa, 0x70, 0xa4, 0x48, 0x33, 0x34, 0x71, 0x83, 0x6c, 0x07, 0x6d, 0x96, 0xc3,
+	0x86, 0xfa, 0xf4, 0xb4, 0x28, 0x3c, 0x3b, 0x2d, 0x0a, 0x7f, 0x9d, 0x16, 0x85, 0xc7, 0x2f, 0x8b,
+	0xa9, 0x67, 0x2f, 0x8b, 0xa9, 0xe7, 0x2f, 0x8b, 0xa9, 0x6f, 0x3e, 0x9e, 0x68, 0x0a, 0xf6, 0xfb,
+	0x84, 0xfd, 0x1d, 0x6c, 0xde, 0xad, 0x7e, 0x97, 0xfc, 0x56, 0xa1, 0xbd, 0x71, 0x94, 0xa5, 0xf7,
+	0xe7, 0xa7, 0xff, 0x0c, 0x00, 0xd0, 0x72, 0xeb, 0x9d, 0xc8, 0x0c, 0x00, 0x00,
+}
+
+func (m *CustomAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ClaimablePercentage.Size()
 		i -= size
-		if _, err := m.InitialClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ClaimablePercentage.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintClaims(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x32
+	if len(m.EventSignature) > 0 {
+		i -= len(m.EventSignature)
+		copy(dAtA[i:], m.EventSignature)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.EventSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintClaims(dAtA []byte, offset int, v uint64) int {
-	offset -= sovClaims(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Claim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Claim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Claim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Claim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Action != 0 {
-		n += 1 + sovClaims(uint64(m.Action))
+	{
+		size := m.ClaimableAmount.Size()
+		i -= size
+		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Completed {
-		n += 2
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Action != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimsRecordAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimsRecordAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimsRecordAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CustomActionsCompleted) > 0 {
+		dAtA2 := make([]byte, len(m.CustomActionsCompleted)*10)
+		var j1 int
+		for _, num := range m.CustomActionsCompleted {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintClaims(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActionsCompleted) > 0 {
+		for iNdEx := len(m.ActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ActionsCompleted[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.ActionsCompleted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.InitialClaimableAmount.Size()
+		i -= size
+		if _, err := m.InitialClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CustomActionsCompleted) > 0 {
+		dAtA4 := make([]byte, len(m.CustomActionsCompleted)*10)
+		var j3 int
+		for _, num := range m.CustomActionsCompleted {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintClaims(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ActionsCompleted) > 0 {
+		for iNdEx := len(m.ActionsCompleted) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ActionsCompleted[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.ActionsCompleted)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.InitialClaimableAmount.Size()
+		i -= size
+		if _, err := m.InitialClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Campaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Campaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Campaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CustomActions) > 0 {
+		for iNdEx := len(m.CustomActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CustomActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClaims(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.Escrow.Size()
+		i -= size
+		if _, err := m.Escrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintClaims(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintClaims(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintClaims(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Actions) > 0 {
+		dAtA9 := make([]byte, len(m.Actions)*10)
+		var j8 int
+		for _, num := range m.Actions {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintClaims(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CampaignClaimsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CampaignClaimsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CampaignClaimsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimsRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CampaignID != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MerkleClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimsDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimsDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimsDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CustomClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustomClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustomClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ClaimableAmount.Size()
+		i -= size
+		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CustomActionID != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.CustomActionID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterCustomActionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCustomActionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterCustomActionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ClaimablePercentage.Size()
+		i -= size
+		if _, err := m.ClaimablePercentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintClaims(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.EventSignature) > 0 {
+		i -= len(m.EventSignature)
+		copy(dAtA[i:], m.EventSignature)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.EventSignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveCustomActionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveCustomActionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveCustomActionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CustomActionID != 0 {
+		i = encodeVarintClaims(dAtA, i, uint64(m.CustomActionID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintClaims(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClaims(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClaims(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CustomAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovClaims(uint64(m.ID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovClaims(uint64(m.Type))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.EventSignature)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = m.ClaimablePercentage.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func (m *Claim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovClaims(uint64(m.Action))
+	}
+	if m.Completed {
+		n += 2
+	}
+	l = m.ClaimableAmount.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func (m *ClaimsRecordAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = m.InitialClaimableAmount.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	if len(m.ActionsCompleted) > 0 {
+		n += 1 + sovClaims(uint64(len(m.ActionsCompleted))) + len(m.ActionsCompleted)*1
+	}
+	if len(m.CustomActionsCompleted) > 0 {
+		l = 0
+		for _, e := range m.CustomActionsCompleted {
+			l += sovClaims(uint64(e))
+		}
+		n += 1 + sovClaims(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ClaimsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialClaimableAmount.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	if len(m.ActionsCompleted) > 0 {
+		n += 1 + sovClaims(uint64(len(m.ActionsCompleted))) + len(m.ActionsCompleted)*1
+	}
+	if len(m.CustomActionsCompleted) > 0 {
+		l = 0
+		for _, e := range m.CustomActionsCompleted {
+			l += sovClaims(uint64(e))
+		}
+		n += 1 + sovClaims(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Campaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovClaims(uint64(m.ID))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		l = 0
+		for _, e := range m.Actions {
+			l += sovClaims(uint64(e))
+		}
+		n += 1 + sovClaims(uint64(l)) + l
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovClaims(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay)
+	n += 1 + l + sovClaims(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay)
+	n += 1 + l + sovClaims(uint64(l))
+	l = m.Escrow.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	if len(m.CustomActions) > 0 {
+		for _, e := range m.CustomActions {
+			l = e.Size()
+			n += 1 + l + sovClaims(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CampaignClaimsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovClaims(uint64(m.CampaignID))
+	}
+	l = m.ClaimsRecord.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func (m *MerkleClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func (m *ClaimsDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	return n
+}
+
+func (m *CustomClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CustomActionID != 0 {
+		n += 1 + sovClaims(uint64(m.CustomActionID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	if m.Completed {
+		n += 2
+	}
+	l = m.ClaimableAmount.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func (m *RegisterCustomActionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovClaims(uint64(m.Type))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.EventSignature)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = m.ClaimablePercentage.Size()
+	n += 1 + l + sovClaims(uint64(l))
+	return n
+}
+
+func (m *RemoveCustomActionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovClaims(uint64(l))
+	}
+	if m.CustomActionID != 0 {
+		n += 1 + sovClaims(uint64(m.CustomActionID))
+	}
+	return n
+}
+
+func sovClaims(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClaims(x uint64) (n int) {
+	return sovClaims(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CustomAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= CustomActionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimablePercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimablePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Claim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Claim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimsRecordAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimsRecordAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
+					m.ActionsCompleted = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CustomActionsCompleted = append(m.CustomActionsCompleted, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CustomActionsCompleted) == 0 {
+					m.CustomActionsCompleted = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CustomActionsCompleted = append(m.CustomActionsCompleted, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomActionsCompleted", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
+					m.ActionsCompleted = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CustomActionsCompleted = append(m.CustomActionsCompleted, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CustomActionsCompleted) == 0 {
+					m.CustomActionsCompleted = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CustomActionsCompleted = append(m.CustomActionsCompleted, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomActionsCompleted", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Campaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Campaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Campaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v Action
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Action(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Actions = append(m.Actions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowClaims
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthClaims
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthClaims
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Actions) == 0 {
+					m.Actions = make([]Action, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Action
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowClaims
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Action(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Actions = append(m.Actions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationUntilDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DurationUntilDecay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationOfDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DurationOfDecay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomActions = append(m.CustomActions, CustomAction{})
+			if err := m.CustomActions[len(m.CustomActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CampaignClaimsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CampaignClaimsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CampaignClaimsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimsRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MerkleClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimsDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimsDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimsDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.ClaimableAmount.Size()
-	n += 1 + l + sovClaims(uint64(l))
-	return n
-}
 
-func (m *ClaimsRecordAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovClaims(uint64(l))
-	}
-	l = m.InitialClaimableAmount.Size()
-	n += 1 + l + sovClaims(uint64(l))
-	if len(m.ActionsCompleted) > 0 {
-		n += 1 + sovClaims(uint64(len(m.ActionsCompleted))) + len(m.ActionsCompleted)*1
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *ClaimsRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InitialClaimableAmount.Size()
-	n += 1 + l + sovClaims(uint64(l))
-	if len(m.ActionsCompleted) > 0 {
-		n += 1 + sovClaims(uint64(len(m.ActionsCompleted))) + len(m.ActionsCompleted)*1
+func (m *CustomClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClaims
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustomClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustomClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomActionID", wireType)
+			}
+			m.CustomActionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CustomActionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClaims(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovClaims(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozClaims(x uint64) (n int) {
-	return sovClaims(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Claim) Unmarshal(dAtA []byte) error {
+func (m *RegisterCustomActionProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -510,17 +3611,17 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Claim: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterCustomActionProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Claim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterCustomActionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.Action = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -530,16 +3631,93 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Action |= Action(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var v int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClaims
@@ -549,15 +3727,14 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Type |= CustomActionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Completed = bool(v != 0)
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -585,63 +3762,11 @@ func (m *Claim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipClaims(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthClaims
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowClaims
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimsRecordAddress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimsRecordAddress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventSignature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -669,11 +3794,11 @@ func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.EventSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimablePercentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -701,80 +3826,10 @@ func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClaimablePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthClaims
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthClaims
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
-					m.ActionsCompleted = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClaims
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaims(dAtA[iNdEx:])
@@ -796,7 +3851,7 @@ func (m *ClaimsRecordAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
+func (m *RemoveCustomActionProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -819,15 +3874,15 @@ func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveCustomActionProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveCustomActionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -855,79 +3910,58 @@ func (m *ClaimsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
 				}
-				m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowClaims
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthClaims
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthClaims
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaims
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaims
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomActionID", wireType)
+			}
+			m.CustomActionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaims
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.ActionsCompleted) == 0 {
-					m.ActionsCompleted = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowClaims
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActionsCompleted = append(m.ActionsCompleted, bool(v != 0))
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CustomActionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionsCompleted", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewClaimsDelegate creates a new claims delegate instance
+func NewClaimsDelegate(granter, delegate sdk.AccAddress) ClaimsDelegate {
+	return ClaimsDelegate{
+		Granter:  granter.String(),
+		Delegate: delegate.String(),
+	}
+}
+
+// Validate performs a stateless validation of the fields
+func (cd ClaimsDelegate) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cd.Granter); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(cd.Delegate); err != nil {
+		return err
+	}
+	if cd.Granter == cd.Delegate {
+		return errors.New("granter and delegate cannot be the same")
+	}
+	return nil
+}
@@ -66,6 +66,27 @@ func (cr ClaimsRecord) HasClaimedAction(action Action) bool {
 	}
 }
 
+// MarkCustomActionClaimed marks the given custom action as completed (i.e
+// claimed). It performs a no-op if the custom action has already been claimed.
+func (cr *ClaimsRecord) MarkCustomActionClaimed(id uint64) {
+	if cr.HasClaimedCustomAction(id) {
+		return
+	}
+
+	cr.CustomActionsCompleted = append(cr.CustomActionsCompleted, id)
+}
+
+// HasClaimedCustomAction checks if the user has claimed a given custom action
+func (cr ClaimsRecord) HasClaimedCustomAction(id uint64) bool {
+	for _, completed := range cr.CustomActionsCompleted {
+		if completed == id {
+			return true
+		}
+	}
+
+	return false
+}
+
 // HasClaimedAny returns true if the user has claimed at least one reward from
 // the available actions
 func (cr ClaimsRecord) HasClaimedAny() bool {
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global claims module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	//
+	// The actual codec used for serialization should be provided to
+	// modules/claims and defined at the application level.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	createCampaignName        = "evmos/MsgCreateCampaign"
+	claimWithProofName        = "evmos/MsgClaimWithProof"
+	transferClaimsRecordName  = "evmos/MsgTransferClaimsRecord"
+	setClaimsDelegateName     = "evmos/MsgSetClaimsDelegate"
+	acceptClaimsDelegateName  = "evmos/MsgAcceptClaimsDelegate"
+	transferAuthorizationName = "evmos/TransferClaimsRecordAuthorization"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateCampaign{},
+		&MsgClaimWithProof{},
+		&MsgTransferClaimsRecord{},
+		&MsgSetClaimsDelegate{},
+		&MsgAcceptClaimsDelegate{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&TransferClaimsRecordAuthorization{},
+	)
+
+	registry.RegisterImplementations(
+		(*govv1beta1.Content)(nil),
+		&RegisterCustomActionProposal{},
+		&RemoveCustomActionProposal{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/claims interfaces and
+// concrete types on the provided LegacyAmino codec. These types are used for
+// Amino JSON serialization and EIP-712 compatibility.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateCampaign{}, createCampaignName, nil)
+	cdc.RegisterConcrete(&MsgClaimWithProof{}, claimWithProofName, nil)
+	cdc.RegisterConcrete(&MsgTransferClaimsRecord{}, transferClaimsRecordName, nil)
+	cdc.RegisterConcrete(&MsgSetClaimsDelegate{}, setClaimsDelegateName, nil)
+	cdc.RegisterConcrete(&MsgAcceptClaimsDelegate{}, acceptClaimsDelegateName, nil)
+	cdc.RegisterConcrete(&TransferClaimsRecordAuthorization{}, transferAuthorizationName, nil)
+}
@@ -0,0 +1,129 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewCustomAction creates a new custom action instance
+func NewCustomAction(
+	id uint64,
+	name string,
+	actionType CustomActionType,
+	contract string,
+	eventSignature string,
+	claimablePercentage sdk.Dec,
+) CustomAction {
+	return CustomAction{
+		ID:                  id,
+		Name:                name,
+		Type:                actionType,
+		Contract:            contract,
+		EventSignature:      eventSignature,
+		ClaimablePercentage: claimablePercentage,
+	}
+}
+
+// Validate performs a stateless validation of the fields
+func (ca CustomAction) Validate() error {
+	if ca.ID == 0 {
+		return errors.New("custom action identifier cannot be zero")
+	}
+
+	return ValidateCustomActionFields(ca.Name, ca.Type, ca.Contract, ca.EventSignature, ca.ClaimablePercentage)
+}
+
+// ContractAddress returns the contract of the custom action as a hex address
+func (ca CustomAction) ContractAddress() common.Address {
+	return common.HexToAddress(ca.Contract)
+}
+
+// MatchesEVMTx returns true if the EVM transaction message and its receipt
+// complete the custom action
+func (ca CustomAction) MatchesEVMTx(msg core.Message, receipt *ethtypes.Receipt) bool {
+	contract := ca.ContractAddress()
+
+	switch ca.Type {
+	case CustomActionTypeContractInteraction:
+		if msg.To() != nil && *msg.To() == contract {
+			return true
+		}
+		// the contract can also be reached through internal calls
+		for _, log := range receipt.Logs {
+			if log.Address == contract {
+				return true
+			}
+		}
+	case CustomActionTypeEventLog:
+		signature := common.HexToHash(ca.EventSignature)
+		for _, log := range receipt.Logs {
+			if log.Address == contract && len(log.Topics) > 0 && log.Topics[0] == signature {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// MatchesERC20Conversion returns true if the conversion of the token pair of
+// the ERC20 contract completes the custom action
+func (ca CustomAction) MatchesERC20Conversion(erc20 common.Address) bool {
+	return ca.Type == CustomActionTypeERC20Conversion && ca.ContractAddress() == erc20
+}
+
+// ValidateCustomActionFields performs a stateless validation of the fields
+// that define a custom action
+func ValidateCustomActionFields(
+	name string,
+	actionType CustomActionType,
+	contract string,
+	eventSignature string,
+	claimablePercentage sdk.Dec,
+) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("custom action name cannot be blank")
+	}
+
+	switch actionType {
+	case CustomActionTypeContractInteraction, CustomActionTypeERC20Conversion:
+		if err := ethermint.ValidateNonZeroAddress(contract); err != nil {
+			return err
+		}
+		if eventSignature != "" {
+			return fmt.Errorf("event signature must be empty for custom action type %s", actionType)
+		}
+	case CustomActionTypeEventLog:
+		// NOTE: the emitter is required as any contract can emit a log with the
+		// same signature
+		if err := ethermint.ValidateNonZeroAddress(contract); err != nil {
+			return err
+		}
+		signature, err := hexutil.Decode(eventSignature)
+		if err != nil {
+			return fmt.Errorf("invalid event signature %s: %w", eventSignature, err)
+		}
+		if len(signature) != common.HashLength {
+			return fmt.Errorf("invalid event signature length, expected %d bytes, got %d", common.HashLength, len(signature))
+		}
+	default:
+		return fmt.Errorf("invalid custom action type %d", actionType)
+	}
+
+	if claimablePercentage.IsNil() || !claimablePercentage.IsPositive() {
+		return fmt.Errorf("claimable percentage must be positive: %s", claimablePercentage)
+	}
+	if claimablePercentage.GT(sdk.OneDec()) {
+		return fmt.Errorf("claimable percentage cannot be greater than 1: %s", claimablePercentage)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomActionValidate(t *testing.T) {
+	contract := tests.GenerateAddress().Hex()
+	signature := crypto.Keccak256Hash([]byte("Mint(address,uint256,uint256)")).Hex()
+	percentage := sdk.NewDecWithPrec(25, 2)
+
+	testCases := []struct {
+		name         string
+		customAction CustomAction
+		expError     bool
+	}{
+		{
+			"fail - zero identifier",
+			NewCustomAction(0, "swap", CustomActionTypeContractInteraction, contract, "", percentage),
+			true,
+		},
+		{
+			"fail - blank name",
+			NewCustomAction(1, " ", CustomActionTypeContractInteraction, contract, "", percentage),
+			true,
+		},
+		{
+			"fail - unspecified type",
+			NewCustomAction(1, "swap", CustomActionTypeUnspecified, contract, "", percentage),
+			true,
+		},
+		{
+			"fail - contract interaction without contract",
+			NewCustomAction(1, "swap", CustomActionTypeContractInteraction, "", "", percentage),
+			true,
+		},
+		{
+			"fail - contract interaction with event signature",
+			NewCustomAction(1, "swap", CustomActionTypeContractInteraction, contract, signature, percentage),
+			true,
+		},
+		{
+			"fail - event log without contract",
+			NewCustomAction(1, "liquidity", CustomActionTypeEventLog, "", signature, percentage),
+			true,
+		},
+		{
+			"fail - event log from zero address",
+			NewCustomAction(1, "liquidity", CustomActionTypeEventLog, common.Address{}.Hex(), signature, percentage),
+			true,
+		},
+		{
+			"fail - event log without event signature",
+			NewCustomAction(1, "liquidity", CustomActionTypeEventLog, contract, "", percentage),
+			true,
+		},
+		{
+			"fail - event log with invalid event signature length",
+			NewCustomAction(1, "liquidity", CustomActionTypeEventLog, contract, "0x1234", percentage),
+			true,
+		},
+		{
+			"fail - zero percentage",
+			NewCustomAction(1, "swap", CustomActionTypeContractInteraction, contract, "", sdk.ZeroDec()),
+			true,
+		},
+		{
+			"fail - percentage greater than 1",
+			NewCustomAction(1, "swap", CustomActionTypeContractInteraction, contract, "", sdk.NewDecWithPrec(11, 1)),
+			true,
+		},
+		{
+			"pass - contract interaction",
+			NewCustomAction(1, "swap", CustomActionTypeContractInteraction, contract, "", percentage),
+			false,
+		},
+		{
+			"pass - erc20 conversion",
+			NewCustomAction(1, "convert", CustomActionTypeERC20Conversion, contract, "", percentage),
+			false,
+		},
+		{
+			"pass - event log from contract",
+			NewCustomAction(1, "liquidity", CustomActionTypeEventLog, contract, signature, percentage),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.customAction.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestCustomActionMatches(t *testing.T) {
+	from := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+	signature := crypto.Keccak256Hash([]byte("Mint(address,uint256,uint256)"))
+	percentage := sdk.NewDecWithPrec(25, 2)
+
+	interaction := NewCustomAction(1, "swap", CustomActionTypeContractInteraction, contract.Hex(), "", percentage)
+	eventLog := NewCustomAction(2, "liquidity", CustomActionTypeEventLog, contract.Hex(), signature.Hex(), percentage)
+	conversion := NewCustomAction(4, "convert", CustomActionTypeERC20Conversion, contract.Hex(), "", percentage)
+
+	testCases := []struct {
+		name         string
+		customAction CustomAction
+		to           common.Address
+		logs         []*ethtypes.Log
+		expMatch     bool
+	}{
+		{"interaction - call to other contract", interaction, other, nil, false},
+		{"interaction - call to contract", interaction, contract, nil, true},
+		{"interaction - internal call to contract", interaction, other, []*ethtypes.Log{{Address: contract}}, true},
+		{"event log - no logs", eventLog, contract, nil, false},
+		{"event log - other emitter", eventLog, other, []*ethtypes.Log{{Address: other, Topics: []common.Hash{signature}}}, false},
+		{"event log - other signature", eventLog, contract, []*ethtypes.Log{{Address: contract, Topics: []common.Hash{{}}}}, false},
+		{"event log - match", eventLog, other, []*ethtypes.Log{{Address: contract, Topics: []common.Hash{signature}}}, true},
+		{"event log - no contract", CustomAction{Type: CustomActionTypeEventLog, EventSignature: signature.Hex()}, other, []*ethtypes.Log{{Address: other, Topics: []common.Hash{signature}}}, false},
+		{"erc20 conversion - never matches evm txs", conversion, contract, []*ethtypes.Log{{Address: contract}}, false},
+	}
+
+	for _, tc := range testCases {
+		to := tc.to
+		msg := ethtypes.NewMessage(from, &to, 0, nil, 0, nil, nil, nil, nil, nil, false)
+		receipt := &ethtypes.Receipt{Logs: tc.logs}
+		require.Equal(t, tc.expMatch, tc.customAction.MatchesEVMTx(msg, receipt), tc.name)
+	}
+
+	require.True(t, conversion.MatchesERC20Conversion(contract))
+	require.False(t, conversion.MatchesERC20Conversion(other))
+	require.False(t, interaction.MatchesERC20Conversion(contract))
+}
@@ -6,6 +6,12 @@ import (
 
 // errors
 var (
-	ErrClaimsRecordNotFound = errorsmod.Register(ModuleName, 2, "claims record not found")
-	ErrInvalidAction        = errorsmod.Register(ModuleName, 3, "invalid claim action type")
+	ErrClaimsRecordNotFound   = errorsmod.Register(ModuleName, 2, "claims record not found")
+	ErrInvalidAction          = errorsmod.Register(ModuleName, 3, "invalid claim action type")
+	ErrCampaignNotFound       = errorsmod.Register(ModuleName, 4, "campaign not found")
+	ErrCustomActionNotFound   = errorsmod.Register(ModuleName, 5, "custom action not found")
+	ErrClaimsNotActive        = errorsmod.Register(ModuleName, 6, "claims are not active")
+	ErrInvalidMerkleProof     = errorsmod.Register(ModuleName, 7, "invalid merkle proof")
+	ErrMerkleLeafClaimed      = errorsmod.Register(ModuleName, 8, "merkle leaf already claimed")
+	ErrClaimsDelegateNotFound = errorsmod.Register(ModuleName, 9, "claims delegate not found")
 )
@@ -2,11 +2,27 @@ package types
 
 // claim module event types
 const (
-	EventTypeClaim              = "claim"
-	EventTypeMergeClaimsRecords = "merge_claims_records"
+	EventTypeClaim                = "claim"
+	EventTypeMergeClaimsRecords   = "merge_claims_records"
+	EventTypeCreateCampaign       = "create_campaign"
+	EventTypeEndCampaign          = "end_campaign"
+	EventTypeRegisterCustomAction = "register_custom_action"
+	EventTypeRemoveCustomAction   = "remove_custom_action"
+	EventTypeClaimWithProof       = "claim_with_proof"
+	EventTypeTransferClaimsRecord = "transfer_claims_record"
+	EventTypeSetClaimsDelegate    = "set_claims_delegate"
+	EventTypeAcceptClaimsDelegate = "accept_claims_delegate"
+	EventTypeRevokeClaimsDelegate = "revoke_claims_delegate"
 
 	AttributeKeyActionType             = "action"
 	AttributeKeyRecipient              = "recipient"
 	AttributeKeyClaimedCoins           = "claimed_coins"
 	AttributeKeyFundCommunityPoolCoins = "fund_community_pool_coins"
+	AttributeKeyCampaignID             = "campaign_id"
+	AttributeKeyFunder                 = "funder"
+	AttributeKeyCustomActionID         = "custom_action_id"
+	AttributeKeyCustomActionName       = "custom_action_name"
+	AttributeKeyAddress                = "address"
+	AttributeKeyGranter                = "granter"
+	AttributeKeyDelegate               = "delegate"
 )
@@ -4,14 +4,20 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 // DefaultGenesis returns the default claims module genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:        DefaultParams(),
-		ClaimsRecords: []ClaimsRecordAddress{},
+		Params:                DefaultParams(),
+		ClaimsRecords:         []ClaimsRecordAddress{},
+		Campaigns:             []Campaign{},
+		CampaignClaimsRecords: []CampaignClaimsRecord{},
+		CustomActions:         []CustomAction{},
+		MerkleClaims:          []MerkleClaim{},
+		ClaimsDelegates:       []ClaimsDelegate{},
 	}
 }
 
@@ -42,5 +48,86 @@ func (gs GenesisState) Validate() error {
 		seenClaims[claimsRecord.Address] = true
 	}
 
+	seenCampaigns := make(map[uint64]bool)
+	for _, campaign := range gs.Campaigns {
+		if seenCampaigns[campaign.ID] {
+			return fmt.Errorf("duplicated campaign %d", campaign.ID)
+		}
+		if err := campaign.Validate(); err != nil {
+			return err
+		}
+		seenCampaigns[campaign.ID] = true
+	}
+
+	seenCampaignClaims := make(map[uint64]map[string]bool)
+	for _, ccr := range gs.CampaignClaimsRecords {
+		if !seenCampaigns[ccr.CampaignID] {
+			return fmt.Errorf("campaign %d of claims record %s not found", ccr.CampaignID, ccr.ClaimsRecord.Address)
+		}
+		if seenCampaignClaims[ccr.CampaignID] == nil {
+			seenCampaignClaims[ccr.CampaignID] = make(map[string]bool)
+		}
+		if seenCampaignClaims[ccr.CampaignID][ccr.ClaimsRecord.Address] {
+			return fmt.Errorf("duplicated claims record entry %s for campaign %d", ccr.ClaimsRecord.Address, ccr.CampaignID)
+		}
+		if err := ccr.ClaimsRecord.Validate(); err != nil {
+			return err
+		}
+		seenCampaignClaims[ccr.CampaignID][ccr.ClaimsRecord.Address] = true
+	}
+
+	seenCustomActions := make(map[uint64]bool)
+	for _, ca := range gs.CustomActions {
+		if seenCustomActions[ca.ID] {
+			return fmt.Errorf("duplicated custom action %d", ca.ID)
+		}
+		if err := ca.Validate(); err != nil {
+			return err
+		}
+		seenCustomActions[ca.ID] = true
+	}
+
+	seenMerkleClaims := make(map[string]bool)
+	merkleClaimed := math.ZeroInt()
+	for _, mc := range gs.MerkleClaims {
+		if seenMerkleClaims[mc.Address] {
+			return fmt.Errorf("duplicated merkle claim %s", mc.Address)
+		}
+		if err := mc.Validate(); err != nil {
+			return err
+		}
+		merkleClaimed = merkleClaimed.Add(mc.Amount)
+		seenMerkleClaims[mc.Address] = true
+	}
+
+	seenGranters := make(map[string]bool)
+	for _, cd := range gs.ClaimsDelegates {
+		if seenGranters[cd.Granter] {
+			return fmt.Errorf("duplicated claims delegate of granter %s", cd.Granter)
+		}
+		if err := cd.Validate(); err != nil {
+			return err
+		}
+		seenGranters[cd.Granter] = true
+	}
+
+	seenPendingGranters := make(map[string]bool)
+	for _, cd := range gs.PendingClaimsDelegates {
+		if seenPendingGranters[cd.Granter] {
+			return fmt.Errorf("duplicated pending claims delegate of granter %s", cd.Granter)
+		}
+		if err := cd.Validate(); err != nil {
+			return err
+		}
+		seenPendingGranters[cd.Granter] = true
+	}
+
+	if merkleClaimed.GT(gs.Params.GetMerkleTotalAmount()) {
+		return fmt.Errorf(
+			"sum of merkle claims > merkle total amount (%s > %s)",
+			merkleClaimed, gs.Params.GetMerkleTotalAmount(),
+		)
+	}
+
 	return gs.Params.Validate()
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -34,6 +36,20 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// claims_records is a list of claim records with the corresponding airdrop recipient
 	ClaimsRecords []ClaimsRecordAddress `protobuf:"bytes,2,rep,name=claims_records,json=claimsRecords,proto3" json:"claims_records"`
+	// campaigns is the list of airdrop campaigns
+	Campaigns []Campaign `protobuf:"bytes,3,rep,name=campaigns,proto3" json:"campaigns"`
+	// campaign_claims_records is a list of claim records of the campaigns
+	CampaignClaimsRecords []CampaignClaimsRecord `protobuf:"bytes,4,rep,name=campaign_claims_records,json=campaignClaimsRecords,proto3" json:"campaign_claims_records"`
+	// custom_actions is the list of registered custom actions
+	CustomActions []CustomAction `protobuf:"bytes,5,rep,name=custom_actions,json=customActions,proto3" json:"custom_actions"`
+	// merkle_claims is the list of Merkle tree leaves that have been proven
+	MerkleClaims []MerkleClaim `protobuf:"bytes,6,rep,name=merkle_claims,json=merkleClaims,proto3" json:"merkle_claims"`
+	// claims_delegates is the list of delegates that complete the actions of the
+	// claims records of their granters
+	ClaimsDelegates []ClaimsDelegate `protobuf:"bytes,7,rep,name=claims_delegates,json=claimsDelegates,proto3" json:"claims_delegates"`
+	// pending_claims_delegates is the list of delegates requested by granters
+	// that haven't accepted yet
+	PendingClaimsDelegates []ClaimsDelegate `protobuf:"bytes,8,rep,name=pending_claims_delegates,json=pendingClaimsDelegates,proto3" json:"pending_claims_delegates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -83,6 +99,48 @@ func (m *GenesisState) GetClaimsRecords() []ClaimsRecordAddress {
 	return nil
 }
 
+func (m *GenesisState) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCampaignClaimsRecords() []CampaignClaimsRecord {
+	if m != nil {
+		return m.CampaignClaimsRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCustomActions() []CustomAction {
+	if m != nil {
+		return m.CustomActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleClaims() []MerkleClaim {
+	if m != nil {
+		return m.MerkleClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimsDelegates() []ClaimsDelegate {
+	if m != nil {
+		return m.ClaimsDelegates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingClaimsDelegates() []ClaimsDelegate {
+	if m != nil {
+		return m.PendingClaimsDelegates
+	}
+	return nil
+}
+
 // Params defines the claims module's parameters.
 type Params struct {
 	// enable_claims is the parameter to enable the claiming process
@@ -100,6 +158,24 @@ type Params struct {
 	AuthorizedChannels []string `protobuf:"bytes,6,rep,name=authorized_channels,json=authorizedChannels,proto3" json:"authorized_channels,omitempty"`
 	// evm_channels is the list of channel identifiers from EVM compatible chains
 	EVMChannels []string `protobuf:"bytes,7,rep,name=evm_channels,json=evmChannels,proto3" json:"evm_channels,omitempty"`
+	// merkle_root is the hex encoded root of the Merkle tree of the airdrop
+	// recipients whose claims records are created on demand. Empty if disabled.
+	MerkleRoot string `protobuf:"bytes,8,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// merkle_total_amount is the sum of the amounts of all the Merkle tree leaves
+	MerkleTotalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=merkle_total_amount,json=merkleTotalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"merkle_total_amount"`
+	// enable_vesting pays the claimed coins into a clawback vesting account
+	// funded by the claims module instead of transferring liquid coins
+	EnableVesting bool `protobuf:"varint,10,opt,name=enable_vesting,json=enableVesting,proto3" json:"enable_vesting,omitempty"`
+	// vesting_lockup_duration is the duration of the lockup of the claimed coins
+	VestingLockupDuration time.Duration `protobuf:"bytes,11,opt,name=vesting_lockup_duration,json=vestingLockupDuration,proto3,stdduration" json:"vesting_lockup_duration"`
+	// vesting_duration is the total duration of the vesting schedule of the
+	// claimed coins
+	VestingDuration time.Duration `protobuf:"bytes,12,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration"`
+	// vesting_periods is the number of equal periods of the vesting schedule
+	VestingPeriods uint64 `protobuf:"varint,13,opt,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods,omitempty"`
+	// campaign_creation_fee is the fee paid by the funder of a campaign to the
+	// community pool on creation, in addition to the escrowed coins
+	CampaignCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=campaign_creation_fee,json=campaignCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"campaign_creation_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,6 +260,48 @@ func (m *Params) GetEVMChannels() []string {
 	return nil
 }
 
+func (m *Params) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return ""
+}
+
+func (m *Params) GetEnableVesting() bool {
+	if m != nil {
+		return m.EnableVesting
+	}
+	return false
+}
+
+func (m *Params) GetVestingLockupDuration() time.Duration {
+	if m != nil {
+		return m.VestingLockupDuration
+	}
+	return 0
+}
+
+func (m *Params) GetVestingDuration() time.Duration {
+	if m != nil {
+		return m.VestingDuration
+	}
+	return 0
+}
+
+func (m *Params) GetVestingPeriods() uint64 {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return 0
+}
+
+func (m *Params) GetCampaignCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CampaignCreationFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.claims.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.claims.v1.Params")
@@ -192,38 +310,60 @@ func init() {
 func init() { proto.RegisterFile("evmos/claims/v1/genesis.proto", fileDescriptor_f2f8f1d6f18af278) }
 
 var fileDescriptor_f2f8f1d6f18af278 = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6e, 0xd3, 0x40,
-	0x14, 0xc6, 0xe3, 0xa6, 0x84, 0x76, 0x9c, 0x12, 0x18, 0x2a, 0x61, 0x22, 0x70, 0x42, 0x61, 0x11,
-	0x36, 0x36, 0x09, 0xe2, 0x00, 0x24, 0x41, 0xac, 0x50, 0xc1, 0xa5, 0x2c, 0xd8, 0x58, 0x13, 0x7b,
-	0xe2, 0x58, 0xf2, 0x78, 0xac, 0x99, 0xb1, 0x45, 0x39, 0x45, 0x97, 0xbd, 0x06, 0xb7, 0xe8, 0xb2,
-	0x4b, 0x56, 0x05, 0x25, 0x17, 0x41, 0xf3, 0x8f, 0xa2, 0x74, 0xc3, 0xc6, 0x1a, 0xbf, 0xef, 0xf7,
-	0x7d, 0xf3, 0xde, 0xd3, 0x80, 0xa7, 0xb8, 0x21, 0x94, 0x87, 0x49, 0x81, 0x72, 0xc2, 0xc3, 0x66,
-	0x1c, 0x66, 0xb8, 0xc4, 0x3c, 0xe7, 0x41, 0xc5, 0xa8, 0xa0, 0xb0, 0xa7, 0xe4, 0x40, 0xcb, 0x41,
-	0x33, 0xee, 0x3f, 0xd9, 0xe6, 0x8d, 0xa4, 0xf0, 0xfe, 0x61, 0x46, 0x33, 0xaa, 0x8e, 0xa1, 0x3c,
-	0x99, 0xaa, 0x9f, 0x51, 0x9a, 0x15, 0x38, 0x54, 0x7f, 0x8b, 0x7a, 0x19, 0xa6, 0x35, 0x43, 0x22,
-	0xa7, 0xa5, 0xd1, 0x07, 0xdb, 0xba, 0xc8, 0x09, 0xe6, 0x02, 0x91, 0x4a, 0x03, 0x47, 0x17, 0x0e,
-	0xe8, 0xbe, 0xd7, 0x7d, 0x9d, 0x08, 0x24, 0x30, 0x7c, 0x03, 0x3a, 0x15, 0x62, 0x88, 0x70, 0xcf,
-	0x19, 0x3a, 0x23, 0x77, 0xf2, 0x28, 0xd8, 0xea, 0x33, 0xf8, 0xa8, 0xe4, 0xe9, 0xee, 0xe5, 0xf5,
-	0xa0, 0x15, 0x19, 0x18, 0x7e, 0x02, 0xf7, 0x34, 0x11, 0x33, 0x9c, 0x50, 0x96, 0x72, 0x6f, 0x67,
-	0xd8, 0x1e, 0xb9, 0x93, 0x17, 0xb7, 0xec, 0x33, 0x75, 0x8a, 0x14, 0xf5, 0x36, 0x4d, 0x19, 0xe6,
-	0x36, 0xeb, 0x20, 0xf9, 0x47, 0xe2, 0x47, 0x3f, 0xda, 0xa0, 0xa3, 0xef, 0x82, 0xcf, 0xc1, 0x01,
-	0x2e, 0xd1, 0xa2, 0xc0, 0xb1, 0x46, 0x54, 0x6f, 0x7b, 0x51, 0x57, 0x17, 0x75, 0x22, 0x8c, 0x00,
-	0x44, 0x39, 0x4b, 0x19, 0xad, 0x62, 0x2e, 0x10, 0x13, 0xb1, 0x9c, 0xd5, 0xdb, 0x51, 0x53, 0xf4,
-	0x03, 0xbd, 0x88, 0xc0, 0x2e, 0x22, 0xf8, 0x6c, 0x17, 0x31, 0xdd, 0x93, 0x97, 0x9f, 0xff, 0x1a,
-	0x38, 0xd1, 0x7d, 0xe3, 0x3f, 0x91, 0x76, 0x09, 0xc0, 0x53, 0x70, 0x68, 0x37, 0x1a, 0xd7, 0xa5,
-	0xc8, 0x8b, 0x38, 0xc5, 0x09, 0x3a, 0xf3, 0xda, 0x2a, 0xf5, 0xf1, 0xad, 0xd4, 0xb9, 0x81, 0x75,
-	0xe8, 0x85, 0x0c, 0x85, 0x36, 0xe0, 0x54, 0xfa, 0xe7, 0xd2, 0x0e, 0x8f, 0xc1, 0x83, 0xbf, 0xb1,
-	0x74, 0x69, 0x32, 0x77, 0xff, 0x3f, 0xb3, 0x67, 0xdd, 0xc7, 0x4b, 0x1d, 0xf8, 0x0c, 0x74, 0xcd,
-	0xfa, 0x53, 0x5c, 0x52, 0xe2, 0xdd, 0x19, 0x3a, 0xa3, 0xfd, 0xc8, 0xd5, 0xb5, 0xb9, 0x2c, 0xc1,
-	0x10, 0x3c, 0x44, 0xb5, 0x58, 0x51, 0x96, 0x7f, 0xc7, 0x69, 0x9c, 0xac, 0x50, 0x59, 0xe2, 0x82,
-	0x7b, 0x9d, 0x61, 0x7b, 0xb4, 0x1f, 0xc1, 0x1b, 0x69, 0x66, 0x14, 0x38, 0x01, 0x5d, 0xdc, 0x90,
-	0x1b, 0xf2, 0xae, 0x24, 0xa7, 0xbd, 0xf5, 0xf5, 0xc0, 0x7d, 0xf7, 0xe5, 0x83, 0xc5, 0x22, 0x17,
-	0x37, 0xc4, 0xfe, 0x4c, 0x67, 0x97, 0x6b, 0xdf, 0xb9, 0x5a, 0xfb, 0xce, 0xef, 0xb5, 0xef, 0x9c,
-	0x6f, 0xfc, 0xd6, 0xd5, 0xc6, 0x6f, 0xfd, 0xdc, 0xf8, 0xad, 0xaf, 0x2f, 0xb3, 0x5c, 0xac, 0xea,
-	0x45, 0x90, 0x50, 0x12, 0xea, 0x87, 0xae, 0xbf, 0xcd, 0xf8, 0x55, 0xf8, 0xcd, 0x3e, 0x7a, 0x71,
-	0x56, 0x61, 0xbe, 0xe8, 0xa8, 0xd1, 0x5f, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x17, 0x29, 0xc9,
-	0xb8, 0x41, 0x03, 0x00, 0x00,
+	// 848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4d, 0x73, 0xdb, 0x44,
+	0x1c, 0xc6, 0xad, 0xc6, 0x71, 0xed, 0xf5, 0x5b, 0xba, 0x69, 0xc8, 0x36, 0xd3, 0x4a, 0xa6, 0x50,
+	0x30, 0x07, 0xa4, 0x3a, 0x0c, 0x47, 0x0e, 0xb1, 0x03, 0x1d, 0x18, 0x4a, 0x83, 0xfa, 0x72, 0x80,
+	0x19, 0x34, 0x6b, 0x69, 0xad, 0x68, 0x22, 0x69, 0x35, 0xda, 0x95, 0x86, 0x72, 0xe1, 0xc2, 0x07,
+	0xe8, 0x91, 0xcf, 0xc0, 0x89, 0x8f, 0xd1, 0x63, 0x8f, 0x0c, 0x87, 0x94, 0x71, 0xbe, 0x08, 0xb3,
+	0x2f, 0xb2, 0x5d, 0xbb, 0x99, 0x49, 0x2e, 0x89, 0xf4, 0xff, 0x3f, 0xcf, 0x4f, 0xfb, 0xf2, 0x78,
+	0x17, 0xdc, 0x23, 0x65, 0x42, 0x99, 0xe3, 0xc7, 0x38, 0x4a, 0x98, 0x53, 0x8e, 0x9c, 0x90, 0xa4,
+	0x84, 0x45, 0xcc, 0xce, 0x72, 0xca, 0x29, 0xec, 0xcb, 0xb6, 0xad, 0xda, 0x76, 0x39, 0x3a, 0x30,
+	0x7d, 0xca, 0x84, 0x61, 0x8a, 0x19, 0x71, 0xca, 0xd1, 0x94, 0x70, 0x3c, 0x72, 0x7c, 0x1a, 0xa5,
+	0xca, 0x70, 0x70, 0x77, 0x9d, 0xa7, 0xad, 0xaa, 0x7b, 0x3b, 0xa4, 0x21, 0x95, 0x8f, 0x8e, 0x78,
+	0xd2, 0x55, 0x33, 0xa4, 0x34, 0x8c, 0x89, 0x23, 0xdf, 0xa6, 0xc5, 0xcc, 0x09, 0x8a, 0x1c, 0xf3,
+	0x88, 0x56, 0x4c, 0x6b, 0xbd, 0xcf, 0xa3, 0x84, 0x30, 0x8e, 0x93, 0x4c, 0x09, 0xee, 0xff, 0xb1,
+	0x0d, 0x3a, 0x8f, 0xd4, 0xb8, 0x9f, 0x72, 0xcc, 0x09, 0xfc, 0x12, 0x34, 0x32, 0x9c, 0xe3, 0x84,
+	0x21, 0x63, 0x60, 0x0c, 0xdb, 0x87, 0xfb, 0xf6, 0xda, 0x3c, 0xec, 0x13, 0xd9, 0x1e, 0xd7, 0x5f,
+	0x9f, 0x5b, 0x35, 0x57, 0x8b, 0xe1, 0x8f, 0xa0, 0xa7, 0x14, 0x5e, 0x4e, 0x7c, 0x9a, 0x07, 0x0c,
+	0xdd, 0x18, 0x6c, 0x0d, 0xdb, 0x87, 0x1f, 0x6f, 0xd8, 0x27, 0xf2, 0xc9, 0x95, 0xaa, 0xa3, 0x20,
+	0xc8, 0x09, 0xab, 0x58, 0x5d, 0x7f, 0xa5, 0xc5, 0xe0, 0x57, 0xa0, 0xe5, 0xe3, 0x24, 0xc3, 0x51,
+	0x98, 0x32, 0xb4, 0x25, 0x69, 0x77, 0x36, 0x69, 0x5a, 0xa1, 0x11, 0x4b, 0x07, 0xf4, 0xc1, 0x7e,
+	0xf5, 0xe2, 0xad, 0x0d, 0xad, 0x2e, 0x61, 0x0f, 0x2e, 0x85, 0xad, 0x0e, 0x51, 0x83, 0xf7, 0xfc,
+	0xf7, 0xf4, 0x18, 0xfc, 0x0e, 0xf4, 0xfc, 0x82, 0x71, 0x9a, 0x78, 0xd8, 0x17, 0xcb, 0xce, 0xd0,
+	0xb6, 0x64, 0xdf, 0xdb, 0x64, 0x4b, 0xd9, 0x91, 0x54, 0x2d, 0xe6, 0xbb, 0x52, 0x63, 0xf0, 0x11,
+	0xe8, 0x26, 0x24, 0x3f, 0x8b, 0x89, 0x1e, 0x2e, 0x6a, 0x48, 0xd4, 0xdd, 0x0d, 0xd4, 0x63, 0xa9,
+	0x92, 0x03, 0xd1, 0xa4, 0x4e, 0xb2, 0x2c, 0x31, 0x78, 0x02, 0x76, 0xf4, 0x84, 0x03, 0x12, 0x93,
+	0x10, 0x73, 0xc2, 0xd0, 0x4d, 0xc9, 0xb2, 0x2e, 0xd9, 0x8d, 0x63, 0xad, 0xd3, 0xb8, 0xbe, 0xff,
+	0x4e, 0x95, 0x41, 0x0f, 0xa0, 0x8c, 0xa4, 0x41, 0x94, 0x86, 0xde, 0x06, 0xb9, 0x79, 0x1d, 0xf2,
+	0x07, 0x1a, 0xf3, 0x6e, 0x93, 0xdd, 0xff, 0xfb, 0x26, 0x68, 0xa8, 0x5c, 0xc1, 0x8f, 0x40, 0x97,
+	0xa4, 0x78, 0xba, 0x5c, 0x06, 0x91, 0xc3, 0xa6, 0xdb, 0x51, 0x45, 0x3d, 0x45, 0x17, 0x40, 0x1c,
+	0xe5, 0x41, 0x4e, 0x33, 0x8f, 0x71, 0x9c, 0x73, 0x4f, 0xe4, 0x1a, 0xdd, 0x90, 0x89, 0x3d, 0xb0,
+	0x55, 0xe8, 0xed, 0x2a, 0xf4, 0xf6, 0xb3, 0x2a, 0xf4, 0xe3, 0xa6, 0x18, 0xc5, 0xab, 0xb7, 0x96,
+	0xe1, 0xee, 0x68, 0xff, 0x53, 0x61, 0x17, 0x02, 0xf8, 0x1c, 0xdc, 0xae, 0x7e, 0x3d, 0x5e, 0x91,
+	0xf2, 0x28, 0xf6, 0x02, 0xe2, 0xe3, 0x97, 0x68, 0x4b, 0x52, 0xef, 0x6c, 0x50, 0x8f, 0xb5, 0x58,
+	0x41, 0xff, 0x14, 0x50, 0x58, 0x01, 0x9e, 0x0b, 0xff, 0xb1, 0xb0, 0xc3, 0x27, 0xe0, 0xd6, 0x02,
+	0x4b, 0x67, 0x9a, 0x59, 0xbf, 0x3a, 0xb3, 0x5f, 0xb9, 0x9f, 0xcc, 0x14, 0xf0, 0x43, 0xd0, 0x59,
+	0x6c, 0x42, 0x4a, 0x13, 0xb4, 0x3d, 0x30, 0x86, 0x2d, 0xb7, 0x5d, 0xed, 0x59, 0x4a, 0x13, 0xe8,
+	0x80, 0x5d, 0x5c, 0xf0, 0x53, 0x9a, 0x47, 0xbf, 0x91, 0xc0, 0xf3, 0x4f, 0x71, 0x9a, 0x92, 0x58,
+	0x05, 0xaa, 0xe5, 0xc2, 0x65, 0x6b, 0xa2, 0x3b, 0xf0, 0x10, 0x74, 0x48, 0x99, 0x2c, 0x95, 0x22,
+	0x2e, 0xad, 0x71, 0x7f, 0x7e, 0x6e, 0xb5, 0xbf, 0x7e, 0xf1, 0xb8, 0x92, 0xb9, 0x6d, 0x52, 0x26,
+	0x0b, 0x8f, 0x05, 0xda, 0x3a, 0xaf, 0x39, 0xa5, 0x1c, 0x35, 0xe5, 0x30, 0x80, 0x2a, 0xb9, 0x94,
+	0x72, 0xf8, 0x0b, 0xd8, 0xd5, 0x02, 0x4e, 0x39, 0x8e, 0x3d, 0x9c, 0xd0, 0x22, 0xe5, 0xa8, 0x25,
+	0x84, 0x63, 0x5b, 0x4c, 0xf0, 0xdf, 0x73, 0xeb, 0x93, 0x30, 0xe2, 0xa7, 0xc5, 0xd4, 0xf6, 0x69,
+	0xe2, 0xe8, 0x03, 0x52, 0xfd, 0xfb, 0x9c, 0x05, 0x67, 0x0e, 0x7f, 0x99, 0x11, 0x66, 0x7f, 0x9b,
+	0x72, 0xf7, 0x96, 0x42, 0x3d, 0x13, 0xa4, 0x23, 0x09, 0x82, 0x0f, 0x40, 0x4f, 0x27, 0xa5, 0x24,
+	0x8c, 0x47, 0x69, 0x88, 0x80, 0x8c, 0x8a, 0xce, 0xcf, 0x0b, 0x55, 0x84, 0x3f, 0x83, 0x7d, 0xdd,
+	0xf7, 0x62, 0xea, 0x9f, 0x15, 0x99, 0x57, 0xad, 0x28, 0x6a, 0x5f, 0x7d, 0x1b, 0xf6, 0x34, 0xe3,
+	0x7b, 0x89, 0xa8, 0x04, 0xf0, 0x07, 0xb0, 0x53, 0xc1, 0x17, 0xd4, 0xce, 0x35, 0x36, 0x57, 0x9b,
+	0x17, 0xbc, 0x4f, 0x41, 0x55, 0xf2, 0x32, 0x92, 0x47, 0x34, 0x60, 0xa8, 0x3b, 0x30, 0x86, 0x75,
+	0xb7, 0xa7, 0xcb, 0x27, 0xaa, 0x0a, 0x7f, 0x07, 0x7b, 0xcb, 0xe3, 0x2d, 0x27, 0x2a, 0x5f, 0x33,
+	0x42, 0x50, 0x4f, 0x9f, 0x94, 0x6a, 0x15, 0x6d, 0x71, 0xdb, 0xd8, 0xfa, 0xb6, 0xb1, 0x27, 0x34,
+	0x4a, 0xc7, 0x0f, 0xc5, 0xd7, 0xff, 0x7a, 0x6b, 0x0d, 0xaf, 0xb0, 0xf2, 0xc2, 0xc0, 0xdc, 0xdd,
+	0xc5, 0xe1, 0xa7, 0x3f, 0xf4, 0x0d, 0x21, 0xe3, 0xc9, 0xeb, 0xb9, 0x69, 0xbc, 0x99, 0x9b, 0xc6,
+	0x7f, 0x73, 0xd3, 0x78, 0x75, 0x61, 0xd6, 0xde, 0x5c, 0x98, 0xb5, 0x7f, 0x2e, 0xcc, 0xda, 0x4f,
+	0x9f, 0xad, 0x80, 0xd5, 0x9d, 0xa6, 0xfe, 0x96, 0xa3, 0x87, 0xce, 0xaf, 0xd5, 0xfd, 0x26, 0xf9,
+	0xd3, 0x86, 0x5c, 0x9c, 0x2f, 0xfe, 0x1f, 0x00, 0x34, 0x46, 0xc7, 0x8d, 0x4c, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -246,6 +386,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingClaimsDelegates) > 0 {
+		for iNdEx := len(m.PendingClaimsDelegates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingClaimsDelegates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClaimsDelegates) > 0 {
+		for iNdEx := len(m.ClaimsDelegates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimsDelegates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MerkleClaims) > 0 {
+		for iNdEx := len(m.MerkleClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CustomActions) > 0 {
+		for iNdEx := len(m.CustomActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CustomActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CampaignClaimsRecords) > 0 {
+		for iNdEx := len(m.CampaignClaimsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CampaignClaimsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ClaimsRecords) > 0 {
 		for iNdEx := len(m.ClaimsRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -293,6 +517,68 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CampaignCreationFee) > 0 {
+		for iNdEx := len(m.CampaignCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CampaignCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.VestingPeriods != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VestingPeriods))
+		i--
+		dAtA[i] = 0x68
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VestingLockupDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingLockupDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x5a
+	if m.EnableVesting {
+		i--
+		if m.EnableVesting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.MerkleTotalAmount.Size()
+		i -= size
+		if _, err := m.MerkleTotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.EVMChannels) > 0 {
 		for iNdEx := len(m.EVMChannels) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EVMChannels[iNdEx])
@@ -318,28 +604,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x22
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AirdropStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AirdropStartTime):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AirdropStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AirdropStartTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
 	if m.EnableClaims {
@@ -380,6 +666,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CampaignClaimsRecords) > 0 {
+		for _, e := range m.CampaignClaimsRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CustomActions) > 0 {
+		for _, e := range m.CustomActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleClaims) > 0 {
+		for _, e := range m.MerkleClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimsDelegates) > 0 {
+		for _, e := range m.ClaimsDelegates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingClaimsDelegates) > 0 {
+		for _, e := range m.PendingClaimsDelegates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -414,6 +736,28 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MerkleTotalAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EnableVesting {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingLockupDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.VestingPeriods != 0 {
+		n += 1 + sovGenesis(uint64(m.VestingPeriods))
+	}
+	if len(m.CampaignCreationFee) > 0 {
+		for _, e := range m.CampaignCreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -519,29 +863,233 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignClaimsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CampaignClaimsRecords = append(m.CampaignClaimsRecords, CampaignClaimsRecord{})
+			if err := m.CampaignClaimsRecords[len(m.CampaignClaimsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomActions = append(m.CustomActions, CustomAction{})
+			if err := m.CustomActions[len(m.CustomActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleClaims = append(m.MerkleClaims, MerkleClaim{})
+			if err := m.MerkleClaims[len(m.MerkleClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsDelegates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimsDelegates = append(m.ClaimsDelegates, ClaimsDelegate{})
+			if err := m.ClaimsDelegates[len(m.ClaimsDelegates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingClaimsDelegates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingClaimsDelegates = append(m.PendingClaimsDelegates, ClaimsDelegate{})
+			if err := m.PendingClaimsDelegates[len(m.PendingClaimsDelegates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -784,6 +1332,211 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.EVMChannels = append(m.EVMChannels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleTotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerkleTotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableVesting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableVesting = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingLockupDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingLockupDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			m.VestingPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CampaignCreationFee = append(m.CampaignCreationFee, types.Coin{})
+			if err := m.CampaignCreationFee[len(m.CampaignCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
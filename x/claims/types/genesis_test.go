@@ -2,8 +2,10 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/evmos/ethermint/tests"
 	"github.com/stretchr/testify/suite"
 )
@@ -22,6 +24,10 @@ func TestGenesisTestSuite(t *testing.T) {
 func (suite *GenesisTestSuite) TestValidateGenesis() {
 	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
 
+	merkleParams := DefaultParams()
+	merkleParams.MerkleRoot = common.BytesToHash([]byte{1}).Hex()
+	merkleParams.MerkleTotalAmount = sdk.NewInt(1000)
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -102,6 +108,172 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with campaign",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Campaigns: []Campaign{
+					NewCampaign(1, addr, "acoin", []Action{ActionVote}, nil, time.Now(), time.Hour, time.Hour, sdk.NewInt(1)),
+				},
+				CampaignClaimsRecords: []CampaignClaimsRecord{
+					{
+						CampaignID:   1,
+						ClaimsRecord: NewClaimsRecordAddress(addr, sdk.NewInt(1)),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated campaign",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Campaigns: []Campaign{
+					NewCampaign(1, addr, "acoin", []Action{ActionVote}, nil, time.Now(), time.Hour, time.Hour, sdk.NewInt(1)),
+					NewCampaign(1, addr, "acoin", []Action{ActionVote}, nil, time.Now(), time.Hour, time.Hour, sdk.NewInt(1)),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - campaign claims record without campaign",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				CampaignClaimsRecords: []CampaignClaimsRecord{
+					{
+						CampaignID:   1,
+						ClaimsRecord: NewClaimsRecordAddress(addr, sdk.NewInt(1)),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated campaign claims record",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Campaigns: []Campaign{
+					NewCampaign(1, addr, "acoin", []Action{ActionVote}, nil, time.Now(), time.Hour, time.Hour, sdk.NewInt(2)),
+				},
+				CampaignClaimsRecords: []CampaignClaimsRecord{
+					{
+						CampaignID:   1,
+						ClaimsRecord: NewClaimsRecordAddress(addr, sdk.NewInt(1)),
+					},
+					{
+						CampaignID:   1,
+						ClaimsRecord: NewClaimsRecordAddress(addr, sdk.NewInt(1)),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with custom actions",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				CustomActions: []CustomAction{
+					NewCustomAction(1, "swap", CustomActionTypeContractInteraction, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(25, 2)),
+					NewCustomAction(2, "convert", CustomActionTypeERC20Conversion, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(25, 2)),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated custom action",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				CustomActions: []CustomAction{
+					NewCustomAction(1, "swap", CustomActionTypeContractInteraction, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(25, 2)),
+					NewCustomAction(1, "convert", CustomActionTypeERC20Conversion, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(25, 2)),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid custom action",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				CustomActions: []CustomAction{
+					NewCustomAction(1, "swap", CustomActionTypeUnspecified, tests.GenerateAddress().Hex(), "", sdk.NewDecWithPrec(25, 2)),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with merkle claims",
+			genState: &GenesisState{
+				Params:       merkleParams,
+				MerkleClaims: []MerkleClaim{NewMerkleClaim(addr, sdk.NewInt(100))},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated merkle claim",
+			genState: &GenesisState{
+				Params: merkleParams,
+				MerkleClaims: []MerkleClaim{
+					NewMerkleClaim(addr, sdk.NewInt(100)),
+					NewMerkleClaim(addr, sdk.NewInt(100)),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - merkle claims exceed the total amount",
+			genState: &GenesisState{
+				Params:       merkleParams,
+				MerkleClaims: []MerkleClaim{NewMerkleClaim(addr, sdk.NewInt(1001))},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with claims delegates",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				ClaimsDelegates: []ClaimsDelegate{NewClaimsDelegate(addr, sdk.AccAddress(tests.GenerateAddress().Bytes()))},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated claims delegate granter",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ClaimsDelegates: []ClaimsDelegate{
+					NewClaimsDelegate(addr, sdk.AccAddress(tests.GenerateAddress().Bytes())),
+					NewClaimsDelegate(addr, sdk.AccAddress(tests.GenerateAddress().Bytes())),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with pending claims delegates",
+			genState: &GenesisState{
+				Params:                 DefaultParams(),
+				ClaimsDelegates:        []ClaimsDelegate{NewClaimsDelegate(addr, sdk.AccAddress(tests.GenerateAddress().Bytes()))},
+				PendingClaimsDelegates: []ClaimsDelegate{NewClaimsDelegate(addr, sdk.AccAddress(tests.GenerateAddress().Bytes()))},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated pending claims delegate granter",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				PendingClaimsDelegates: []ClaimsDelegate{
+					NewClaimsDelegate(addr, sdk.AccAddress(tests.GenerateAddress().Bytes())),
+					NewClaimsDelegate(addr, sdk.AccAddress(tests.GenerateAddress().Bytes())),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - granter is the delegate",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				ClaimsDelegates: []ClaimsDelegate{NewClaimsDelegate(addr, addr)},
+			},
+			expPass: false,
+		},
 		{
 			// duration of decay must be positive
 			name:     "empty genesis",
@@ -1,14 +1,18 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // BankKeeper defines the banking contract that must be fulfilled when
 // creating a x/claims keeper.
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderPool, recipientPool string, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
@@ -36,3 +40,14 @@ type StakingKeeper interface {
 	// BondDenom - Bondable coin denomination
 	BondDenom(sdk.Context) string
 }
+
+// VestingKeeper defines the expected vesting keeper used to pay the claimed
+// coins into clawback vesting accounts
+type VestingKeeper interface {
+	FundClawbackVestingAccount(
+		ctx sdk.Context,
+		funder, addr sdk.AccAddress,
+		startTime time.Time,
+		lockupPeriods, vestingPeriods sdkvesting.Periods,
+	) error
+}
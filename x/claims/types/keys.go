@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "claims"
@@ -14,9 +21,63 @@ const (
 // prefix bytes for the claims module's persistent store
 const (
 	prefixClaimsRecords = iota + 1
+	prefixCampaigns
+	prefixCampaignClaimsRecords
+	prefixCampaignCount
+	prefixCustomActions
+	prefixCustomActionCount
+	prefixMerkleClaims
+	prefixAddressCampaigns
+	prefixCampaignEndQueue
+	prefixEndedCampaigns
+	prefixClaimsDelegates
+	prefixClaimsDelegateGranters
+	prefixPendingClaimsDelegates
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixClaimsRecords = []byte{prefixClaimsRecords}
+	KeyPrefixClaimsRecords          = []byte{prefixClaimsRecords}
+	KeyPrefixCampaigns              = []byte{prefixCampaigns}
+	KeyPrefixCampaignClaimsRecords  = []byte{prefixCampaignClaimsRecords}
+	KeyCampaignCount                = []byte{prefixCampaignCount}
+	KeyPrefixCustomActions          = []byte{prefixCustomActions}
+	KeyCustomActionCount            = []byte{prefixCustomActionCount}
+	KeyPrefixMerkleClaims           = []byte{prefixMerkleClaims}
+	KeyPrefixAddressCampaigns       = []byte{prefixAddressCampaigns}
+	KeyPrefixCampaignEndQueue       = []byte{prefixCampaignEndQueue}
+	KeyPrefixEndedCampaigns         = []byte{prefixEndedCampaigns}
+	KeyPrefixClaimsDelegates        = []byte{prefixClaimsDelegates}
+	KeyPrefixClaimsDelegateGranters = []byte{prefixClaimsDelegateGranters}
+	KeyPrefixPendingClaimsDelegates = []byte{prefixPendingClaimsDelegates}
 )
+
+// GetKeyPrefixCampaignClaimsRecords returns the KVStore key prefix for the
+// claims records of a campaign
+func GetKeyPrefixCampaignClaimsRecords(campaignID uint64) []byte {
+	return append(KeyPrefixCampaignClaimsRecords, sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// GetKeyPrefixAddressCampaigns returns the KVStore key prefix for the index of
+// the campaigns that an address has a claims record for
+func GetKeyPrefixAddressCampaigns(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixAddressCampaigns, address.MustLengthPrefix(addr)...)
+}
+
+// GetKeyPrefixCampaignEndQueue returns the KVStore key prefix for the
+// campaigns that end at the given time
+func GetKeyPrefixCampaignEndQueue(endTime time.Time) []byte {
+	return append(KeyPrefixCampaignEndQueue, sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetCampaignEndQueueKey returns the KVStore key of a campaign in the end
+// queue
+func GetCampaignEndQueueKey(endTime time.Time, campaignID uint64) []byte {
+	return append(GetKeyPrefixCampaignEndQueue(endTime), sdk.Uint64ToBigEndian(campaignID)...)
+}
+
+// GetKeyPrefixClaimsDelegateGranters returns the KVStore key prefix for the
+// granters of a claims delegate
+func GetKeyPrefixClaimsDelegateGranters(delegate sdk.AccAddress) []byte {
+	return append(KeyPrefixClaimsDelegateGranters, address.MustLengthPrefix(delegate)...)
+}
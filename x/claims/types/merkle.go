@@ -0,0 +1,77 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// NewMerkleClaim creates a new merkle claim instance
+func NewMerkleClaim(addr sdk.AccAddress, amount math.Int) MerkleClaim {
+	return MerkleClaim{
+		Address: addr.String(),
+		Amount:  amount,
+	}
+}
+
+// Validate performs a stateless validation of the fields
+func (mc MerkleClaim) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(mc.Address); err != nil {
+		return err
+	}
+	if mc.Amount.IsNil() || !mc.Amount.IsPositive() {
+		return fmt.Errorf("merkle claim amount must be positive: %s", mc.Amount)
+	}
+	return nil
+}
+
+// MerkleLeaf returns the hash of a Merkle tree leaf, defined as the keccak256
+// hash of the 20 bytes of the address followed by the amount as a 32 bytes
+// big endian unsigned integer (i.e Solidity's abi.encodePacked(address, uint256))
+func MerkleLeaf(addr sdk.AccAddress, amount math.Int) common.Hash {
+	return crypto.Keccak256Hash(
+		common.BytesToAddress(addr).Bytes(),
+		common.LeftPadBytes(amount.BigInt().Bytes(), 32),
+	)
+}
+
+// MerkleParent returns the hash of the parent node of two Merkle tree nodes.
+// The nodes are sorted before hashing so that proofs don't need to specify the
+// position of the siblings.
+func MerkleParent(a, b common.Hash) common.Hash {
+	if bytes.Compare(a.Bytes(), b.Bytes()) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a.Bytes(), b.Bytes())
+}
+
+// VerifyMerkleProof returns true if the proof of sibling hashes leads from the
+// leaf to the root
+func VerifyMerkleProof(root, leaf common.Hash, proof []common.Hash) bool {
+	computed := leaf
+	for _, sibling := range proof {
+		computed = MerkleParent(computed, sibling)
+	}
+	return computed == root
+}
+
+// ParseMerkleProof decodes a list of hex encoded hashes
+func ParseMerkleProof(proof []string) ([]common.Hash, error) {
+	hashes := make([]common.Hash, len(proof))
+	for i, node := range proof {
+		bz, err := hexutil.Decode(node)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof node %s: %w", node, err)
+		}
+		if len(bz) != common.HashLength {
+			return nil, fmt.Errorf("invalid proof node length, expected %d bytes, got %d", common.HashLength, len(bz))
+		}
+		hashes[i] = common.BytesToHash(bz)
+	}
+	return hashes, nil
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerkleClaimValidate(t *testing.T) {
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name        string
+		merkleClaim MerkleClaim
+		expError    bool
+	}{
+		{"fail - invalid address", MerkleClaim{Address: "badaddress", Amount: sdk.OneInt()}, true},
+		{"fail - nil amount", MerkleClaim{Address: addr.String()}, true},
+		{"fail - zero amount", NewMerkleClaim(addr, sdk.ZeroInt()), true},
+		{"success", NewMerkleClaim(addr, sdk.OneInt()), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.merkleClaim.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestVerifyMerkleProof(t *testing.T) {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	}
+	leaves := []common.Hash{
+		MerkleLeaf(addrs[0], sdk.NewInt(100)),
+		MerkleLeaf(addrs[1], sdk.NewInt(200)),
+		MerkleLeaf(addrs[2], sdk.NewInt(300)),
+	}
+
+	// the odd leaf is paired with itself
+	left := MerkleParent(leaves[0], leaves[1])
+	right := MerkleParent(leaves[2], leaves[2])
+	root := MerkleParent(left, right)
+
+	testCases := []struct {
+		name   string
+		leaf   common.Hash
+		proof  []common.Hash
+		expect bool
+	}{
+		{"valid proof - first leaf", leaves[0], []common.Hash{leaves[1], right}, true},
+		{"valid proof - second leaf", leaves[1], []common.Hash{leaves[0], right}, true},
+		{"valid proof - odd leaf", leaves[2], []common.Hash{leaves[2], left}, true},
+		{"invalid proof - wrong amount", MerkleLeaf(addrs[0], sdk.NewInt(101)), []common.Hash{leaves[1], right}, false},
+		{"invalid proof - missing sibling", leaves[0], []common.Hash{right}, false},
+		{"invalid proof - empty", leaves[0], nil, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expect, VerifyMerkleProof(root, tc.leaf, tc.proof), tc.name)
+	}
+}
+
+func TestParseMerkleProof(t *testing.T) {
+	hash := common.BytesToHash([]byte{1})
+
+	proof, err := ParseMerkleProof([]string{hash.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, []common.Hash{hash}, proof)
+
+	_, err = ParseMerkleProof([]string{"0xzz"})
+	require.Error(t, err)
+
+	_, err = ParseMerkleProof([]string{"0x01"})
+	require.Error(t, err)
+}
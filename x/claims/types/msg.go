@@ -0,0 +1,329 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateCampaign{}
+	_ sdk.Msg = &MsgClaimWithProof{}
+	_ sdk.Msg = &MsgTransferClaimsRecord{}
+	_ sdk.Msg = &MsgSetClaimsDelegate{}
+	_ sdk.Msg = &MsgAcceptClaimsDelegate{}
+)
+
+const (
+	TypeMsgCreateCampaign       = "create_campaign"
+	TypeMsgClaimWithProof       = "claim_with_proof"
+	TypeMsgTransferClaimsRecord = "transfer_claims_record"
+	TypeMsgSetClaimsDelegate    = "set_claims_delegate"
+	TypeMsgAcceptClaimsDelegate = "accept_claims_delegate"
+)
+
+// NewMsgCreateCampaign creates new instance of MsgCreateCampaign
+func NewMsgCreateCampaign(
+	funder sdk.AccAddress,
+	denom string,
+	actions []Action,
+	customActionIDs []uint64,
+	startTime time.Time,
+	durationUntilDecay,
+	durationOfDecay time.Duration,
+	claimsRecords []ClaimsRecordAddress,
+) *MsgCreateCampaign {
+	return &MsgCreateCampaign{
+		Funder:             funder.String(),
+		Denom:              denom,
+		Actions:            actions,
+		StartTime:          startTime,
+		DurationUntilDecay: durationUntilDecay,
+		DurationOfDecay:    durationOfDecay,
+		ClaimsRecords:      claimsRecords,
+		CustomActionIDs:    customActionIDs,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgCreateCampaign) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgCreateCampaign) Type() string { return TypeMsgCreateCampaign }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateCampaign) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Funder); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address %s", msg.Funder)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, err.Error())
+	}
+
+	if len(msg.Actions) == 0 && len(msg.CustomActionIDs) == 0 {
+		return errorsmod.Wrap(ErrInvalidAction, "campaign actions cannot be empty")
+	}
+
+	if err := ValidateCampaignActions(msg.Actions); err != nil {
+		return errorsmod.Wrap(ErrInvalidAction, err.Error())
+	}
+
+	seenCustomActions := make(map[uint64]bool)
+	for _, id := range msg.CustomActionIDs {
+		if id == 0 || seenCustomActions[id] {
+			return errorsmod.Wrapf(ErrInvalidAction, "invalid or duplicated custom action %d", id)
+		}
+		seenCustomActions[id] = true
+	}
+
+	if err := validateDuration(msg.DurationUntilDecay); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if err := validateDuration(msg.DurationOfDecay); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.ClaimsRecords) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "claims records cannot be empty")
+	}
+
+	seenClaims := make(map[string]bool)
+	for _, claimsRecord := range msg.ClaimsRecords {
+		if seenClaims[claimsRecord.Address] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated claims record entry %s", claimsRecord.Address)
+		}
+		if err := claimsRecord.Validate(); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+		for _, completed := range claimsRecord.ActionsCompleted {
+			if completed {
+				return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "claims record %s cannot have completed actions", claimsRecord.Address)
+			}
+		}
+		if len(claimsRecord.CustomActionsCompleted) > 0 {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "claims record %s cannot have completed custom actions", claimsRecord.Address)
+		}
+		seenClaims[claimsRecord.Address] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateCampaign) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateCampaign) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.Funder)
+	return []sdk.AccAddress{funder}
+}
+
+// TotalClaimable returns the sum of the initial claimable amounts of the claims
+// records of the campaign
+func (msg MsgCreateCampaign) TotalClaimable() math.Int {
+	total := sdk.ZeroInt()
+	for _, claimsRecord := range msg.ClaimsRecords {
+		total = total.Add(claimsRecord.InitialClaimableAmount)
+	}
+
+	return total
+}
+
+// NewMsgClaimWithProof creates new instance of MsgClaimWithProof
+func NewMsgClaimWithProof(
+	sender sdk.AccAddress,
+	addr sdk.AccAddress,
+	amount math.Int,
+	proof []string,
+) *MsgClaimWithProof {
+	return &MsgClaimWithProof{
+		Sender:  sender.String(),
+		Address: addr.String(),
+		Amount:  amount,
+		Proof:   proof,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgClaimWithProof) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgClaimWithProof) Type() string { return TypeMsgClaimWithProof }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClaimWithProof) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address %s", msg.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(err, "invalid leaf address %s", msg.Address)
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "amount must be positive: %s", msg.Amount)
+	}
+
+	if msg.Amount.BigInt().BitLen() > 256 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "amount exceeds 256 bits: %s", msg.Amount)
+	}
+
+	if _, err := ParseMerkleProof(msg.Proof); err != nil {
+		return errorsmod.Wrap(ErrInvalidMerkleProof, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgClaimWithProof) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClaimWithProof) GetSigners() []sdk.AccAddress {
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgTransferClaimsRecord creates new instance of MsgTransferClaimsRecord
+func NewMsgTransferClaimsRecord(sender, recipient sdk.AccAddress) *MsgTransferClaimsRecord {
+	return &MsgTransferClaimsRecord{
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgTransferClaimsRecord) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgTransferClaimsRecord) Type() string { return TypeMsgTransferClaimsRecord }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransferClaimsRecord) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address %s", msg.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return errorsmod.Wrapf(err, "invalid recipient address %s", msg.Recipient)
+	}
+
+	if msg.Sender == msg.Recipient {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "sender and recipient cannot be the same")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgTransferClaimsRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTransferClaimsRecord) GetSigners() []sdk.AccAddress {
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// NewMsgSetClaimsDelegate creates new instance of MsgSetClaimsDelegate. A nil
+// delegate removes the delegate and the pending request of the granter.
+func NewMsgSetClaimsDelegate(granter, delegate sdk.AccAddress) *MsgSetClaimsDelegate {
+	msg := &MsgSetClaimsDelegate{
+		Granter: granter.String(),
+	}
+	if delegate != nil {
+		msg.Delegate = delegate.String()
+	}
+	return msg
+}
+
+// Route returns the name of the module
+func (msg MsgSetClaimsDelegate) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgSetClaimsDelegate) Type() string { return TypeMsgSetClaimsDelegate }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetClaimsDelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return errorsmod.Wrapf(err, "invalid granter address %s", msg.Granter)
+	}
+
+	if msg.Delegate == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return errorsmod.Wrapf(err, "invalid delegate address %s", msg.Delegate)
+	}
+
+	if msg.Granter == msg.Delegate {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "granter and delegate cannot be the same")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgSetClaimsDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetClaimsDelegate) GetSigners() []sdk.AccAddress {
+	granter := sdk.MustAccAddressFromBech32(msg.Granter)
+	return []sdk.AccAddress{granter}
+}
+
+// NewMsgAcceptClaimsDelegate creates new instance of MsgAcceptClaimsDelegate
+func NewMsgAcceptClaimsDelegate(delegate, granter sdk.AccAddress, revoke bool) *MsgAcceptClaimsDelegate {
+	return &MsgAcceptClaimsDelegate{
+		Delegate: delegate.String(),
+		Granter:  granter.String(),
+		Revoke:   revoke,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgAcceptClaimsDelegate) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgAcceptClaimsDelegate) Type() string { return TypeMsgAcceptClaimsDelegate }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAcceptClaimsDelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return errorsmod.Wrapf(err, "invalid delegate address %s", msg.Delegate)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return errorsmod.Wrapf(err, "invalid granter address %s", msg.Granter)
+	}
+
+	if msg.Granter == msg.Delegate {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "granter and delegate cannot be the same")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgAcceptClaimsDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAcceptClaimsDelegate) GetSigners() []sdk.AccAddress {
+	delegate := sdk.MustAccAddressFromBech32(msg.Delegate)
+	return []sdk.AccAddress{delegate}
+}
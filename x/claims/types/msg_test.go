@@ -0,0 +1,314 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateCampaignGetters() {
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := NewMsgCreateCampaign(
+		funder,
+		"acoin",
+		[]Action{ActionVote},
+		nil,
+		time.Time{},
+		time.Hour,
+		time.Hour,
+		[]ClaimsRecordAddress{},
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgCreateCampaign, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{funder}, msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateCampaign() {
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	records := []ClaimsRecordAddress{NewClaimsRecordAddress(recipient, sdk.NewInt(100))}
+	claimedRecord := NewClaimsRecordAddress(recipient, sdk.NewInt(100))
+	claimedRecord.ActionsCompleted[0] = true
+
+	testCases := []struct {
+		msg        *MsgCreateCampaign
+		expectPass bool
+	}{
+		{
+			&MsgCreateCampaign{Funder: "badaddress"},
+			false,
+		},
+		{
+			NewMsgCreateCampaign(funder, "", []Action{ActionVote}, nil, time.Time{}, time.Hour, time.Hour, records),
+			false,
+		},
+		{
+			NewMsgCreateCampaign(funder, "acoin", []Action{}, nil, time.Time{}, time.Hour, time.Hour, records),
+			false,
+		},
+		{
+			NewMsgCreateCampaign(funder, "acoin", []Action{ActionVote}, nil, time.Time{}, 0, time.Hour, records),
+			false,
+		},
+		{
+			NewMsgCreateCampaign(funder, "acoin", []Action{ActionVote}, nil, time.Time{}, time.Hour, time.Hour, nil),
+			false,
+		},
+		{
+			NewMsgCreateCampaign(funder, "acoin", []Action{ActionVote}, nil, time.Time{}, time.Hour, time.Hour, append(records, records...)),
+			false,
+		},
+		{
+			NewMsgCreateCampaign(funder, "acoin", []Action{ActionVote}, nil, time.Time{}, time.Hour, time.Hour, []ClaimsRecordAddress{claimedRecord}),
+			false,
+		},
+		{
+			NewMsgCreateCampaign(funder, "acoin", nil, []uint64{1, 1}, time.Time{}, time.Hour, time.Hour, records),
+			false,
+		},
+		{
+			NewMsgCreateCampaign(funder, "acoin", nil, []uint64{0}, time.Time{}, time.Hour, time.Hour, records),
+			false,
+		},
+		{
+			NewMsgCreateCampaign(funder, "acoin", []Action{ActionVote, ActionEVM}, nil, time.Time{}, time.Hour, time.Hour, records),
+			true,
+		},
+		{
+			NewMsgCreateCampaign(funder, "acoin", nil, []uint64{1, 2}, time.Time{}, time.Hour, time.Hour, records),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgClaimWithProofGetters() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := NewMsgClaimWithProof(sender, addr, sdk.NewInt(100), nil)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgClaimWithProof, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{sender}, msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgClaimWithProof() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	proof := []string{common.BytesToHash([]byte{1}).Hex()}
+
+	testCases := []struct {
+		msg        *MsgClaimWithProof
+		expectPass bool
+	}{
+		{
+			&MsgClaimWithProof{Sender: "badaddress", Address: addr.String(), Amount: sdk.NewInt(100)},
+			false,
+		},
+		{
+			&MsgClaimWithProof{Sender: sender.String(), Address: "badaddress", Amount: sdk.NewInt(100)},
+			false,
+		},
+		{
+			&MsgClaimWithProof{Sender: sender.String(), Address: addr.String()},
+			false,
+		},
+		{
+			NewMsgClaimWithProof(sender, addr, sdk.ZeroInt(), proof),
+			false,
+		},
+		{
+			NewMsgClaimWithProof(sender, addr, sdk.NewInt(100), []string{"0x01"}),
+			false,
+		},
+		{
+			NewMsgClaimWithProof(sender, addr, sdk.NewInt(100), proof),
+			true,
+		},
+		{
+			NewMsgClaimWithProof(addr, addr, sdk.NewInt(100), nil),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgTransferClaimsRecordGetters() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := NewMsgTransferClaimsRecord(sender, recipient)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgTransferClaimsRecord, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{sender}, msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgTransferClaimsRecord() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	recipient := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		msg        *MsgTransferClaimsRecord
+		expectPass bool
+	}{
+		{
+			&MsgTransferClaimsRecord{Sender: "badaddress", Recipient: recipient.String()},
+			false,
+		},
+		{
+			&MsgTransferClaimsRecord{Sender: sender.String(), Recipient: "badaddress"},
+			false,
+		},
+		{
+			NewMsgTransferClaimsRecord(sender, sender),
+			false,
+		},
+		{
+			NewMsgTransferClaimsRecord(sender, recipient),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetClaimsDelegateGetters() {
+	granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	delegate := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := NewMsgSetClaimsDelegate(granter, delegate)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgSetClaimsDelegate, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{granter}, msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgSetClaimsDelegate() {
+	granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	delegate := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		msg        *MsgSetClaimsDelegate
+		expectPass bool
+	}{
+		{
+			&MsgSetClaimsDelegate{Granter: "badaddress", Delegate: delegate.String()},
+			false,
+		},
+		{
+			&MsgSetClaimsDelegate{Granter: granter.String(), Delegate: "badaddress"},
+			false,
+		},
+		{
+			NewMsgSetClaimsDelegate(granter, granter),
+			false,
+		},
+		{
+			NewMsgSetClaimsDelegate(granter, delegate),
+			true,
+		},
+		{
+			NewMsgSetClaimsDelegate(granter, nil),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgAcceptClaimsDelegateGetters() {
+	granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	delegate := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := NewMsgAcceptClaimsDelegate(delegate, granter, false)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgAcceptClaimsDelegate, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{delegate}, msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgAcceptClaimsDelegate() {
+	granter := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	delegate := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		msg        *MsgAcceptClaimsDelegate
+		expectPass bool
+	}{
+		{
+			&MsgAcceptClaimsDelegate{Delegate: "badaddress", Granter: granter.String()},
+			false,
+		},
+		{
+			&MsgAcceptClaimsDelegate{Delegate: delegate.String(), Granter: "badaddress"},
+			false,
+		},
+		{
+			&MsgAcceptClaimsDelegate{Delegate: delegate.String()},
+			false,
+		},
+		{
+			NewMsgAcceptClaimsDelegate(delegate, delegate, false),
+			false,
+		},
+		{
+			NewMsgAcceptClaimsDelegate(delegate, granter, false),
+			true,
+		},
+		{
+			NewMsgAcceptClaimsDelegate(delegate, granter, true),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
@@ -5,8 +5,12 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
@@ -29,6 +33,8 @@ var (
 	DefaultEVMChannels = []string{
 		"channel-2", // Injective
 	}
+	// DefaultCampaignCreationFee is 10 EVMOS
+	DefaultCampaignCreationFee = sdk.Coins{{Denom: DefaultClaimsDenom, Amount: math.NewIntWithDecimal(10, 18)}}
 )
 
 // Parameter store key
@@ -40,6 +46,13 @@ var (
 	ParamStoreKeyClaimsDenom        = []byte("ClaimsDenom")
 	ParamStoreKeyAuthorizedChannels = []byte("AuthorizedChannels")
 	ParamStoreKeyEVMChannels        = []byte("EVMChannels")
+	ParamStoreKeyMerkleRoot         = []byte("MerkleRoot")
+	ParamStoreKeyMerkleTotalAmount  = []byte("MerkleTotalAmount")
+	ParamStoreKeyEnableVesting      = []byte("EnableVesting")
+	ParamStoreKeyVestingLockup      = []byte("VestingLockupDuration")
+	ParamStoreKeyVestingDuration    = []byte("VestingDuration")
+	ParamStoreKeyVestingPeriods     = []byte("VestingPeriods")
+	ParamStoreKeyCampaignFee        = []byte("CampaignCreationFee")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -59,6 +72,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyClaimsDenom, &p.ClaimsDenom, validateDenom),
 		paramtypes.NewParamSetPair(ParamStoreKeyAuthorizedChannels, &p.AuthorizedChannels, ValidateChannels),
 		paramtypes.NewParamSetPair(ParamStoreKeyEVMChannels, &p.EVMChannels, ValidateChannels),
+		paramtypes.NewParamSetPair(ParamStoreKeyMerkleRoot, &p.MerkleRoot, validateMerkleRoot),
+		paramtypes.NewParamSetPair(ParamStoreKeyMerkleTotalAmount, &p.MerkleTotalAmount, validateMerkleTotalAmount),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableVesting, &p.EnableVesting, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyVestingLockup, &p.VestingLockupDuration, validateVestingDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyVestingDuration, &p.VestingDuration, validateVestingDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyVestingPeriods, &p.VestingPeriods, validateVestingPeriods),
+		paramtypes.NewParamSetPair(ParamStoreKeyCampaignFee, &p.CampaignCreationFee, validateCampaignCreationFee),
 	}
 }
 
@@ -87,13 +107,17 @@ func NewParams(
 // for the claims module.
 func DefaultParams() Params {
 	return Params{
-		EnableClaims:       true,
-		ClaimsDenom:        DefaultClaimsDenom,
-		AirdropStartTime:   time.Time{},
-		DurationUntilDecay: DefaultDurationUntilDecay,
-		DurationOfDecay:    DefaultDurationOfDecay,
-		AuthorizedChannels: DefaultAuthorizedChannels,
-		EVMChannels:        DefaultEVMChannels,
+		EnableClaims:        true,
+		ClaimsDenom:         DefaultClaimsDenom,
+		AirdropStartTime:    time.Time{},
+		DurationUntilDecay:  DefaultDurationUntilDecay,
+		DurationOfDecay:     DefaultDurationOfDecay,
+		AuthorizedChannels:  DefaultAuthorizedChannels,
+		EVMChannels:         DefaultEVMChannels,
+		MerkleRoot:          "",
+		MerkleTotalAmount:   math.ZeroInt(),
+		EnableVesting:       false,
+		CampaignCreationFee: DefaultCampaignCreationFee,
 	}
 }
 
@@ -136,6 +160,71 @@ func validateDenom(i interface{}) error {
 	return sdk.ValidateDenom(denom)
 }
 
+func validateMerkleRoot(i interface{}) error {
+	root, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if root == "" {
+		return nil
+	}
+
+	bz, err := hexutil.Decode(root)
+	if err != nil {
+		return fmt.Errorf("invalid merkle root %s: %w", root, err)
+	}
+	if len(bz) != common.HashLength {
+		return fmt.Errorf("invalid merkle root length, expected %d bytes, got %d", common.HashLength, len(bz))
+	}
+
+	return nil
+}
+
+func validateMerkleTotalAmount(i interface{}) error {
+	amount, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !amount.IsNil() && amount.IsNegative() {
+		return fmt.Errorf("merkle total amount cannot be negative: %s", amount)
+	}
+
+	return nil
+}
+
+func validateVestingDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("vesting duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateVestingPeriods(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCampaignCreationFee(i interface{}) error {
+	fee, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return fee.Validate()
+}
+
 // ValidateChannels checks if channels ids are valid
 func ValidateChannels(i interface{}) error {
 	channels, ok := i.([]string)
@@ -167,7 +256,39 @@ func (p Params) Validate() error {
 	if err := ValidateChannels(p.AuthorizedChannels); err != nil {
 		return err
 	}
-	return ValidateChannels(p.EVMChannels)
+	if err := ValidateChannels(p.EVMChannels); err != nil {
+		return err
+	}
+	if err := validateMerkleRoot(p.MerkleRoot); err != nil {
+		return err
+	}
+	if err := validateMerkleTotalAmount(p.MerkleTotalAmount); err != nil {
+		return err
+	}
+	if p.IsMerkleEnabled() && !p.GetMerkleTotalAmount().IsPositive() {
+		return fmt.Errorf("merkle total amount must be positive if the merkle root is set: %s", p.MerkleTotalAmount)
+	}
+	if err := validateVestingDuration(p.VestingLockupDuration); err != nil {
+		return err
+	}
+	if err := validateVestingDuration(p.VestingDuration); err != nil {
+		return err
+	}
+	if err := validateCampaignCreationFee(p.CampaignCreationFee); err != nil {
+		return err
+	}
+	if p.EnableVesting {
+		if p.VestingPeriods == 0 {
+			return fmt.Errorf("vesting periods must be positive if vesting is enabled")
+		}
+		if uint64(p.VestingDuration/time.Second) < p.VestingPeriods {
+			return fmt.Errorf(
+				"vesting duration %s is too short for %d vesting periods of at least one second",
+				p.VestingDuration, p.VestingPeriods,
+			)
+		}
+	}
+	return nil
 }
 
 // DecayStartTime returns the time at which the Decay period starts
@@ -191,6 +312,55 @@ func (p Params) IsClaimsActive(blockTime time.Time) bool {
 	return true
 }
 
+// IsMerkleEnabled returns true if the claims records of the Merkle tree leaves
+// can be created with a proof
+func (p Params) IsMerkleEnabled() bool {
+	return p.MerkleRoot != ""
+}
+
+// GetMerkleTotalAmount returns the sum of the amounts of the Merkle tree
+// leaves. It returns zero if the parameter is not set.
+func (p Params) GetMerkleTotalAmount() math.Int {
+	if p.MerkleTotalAmount.IsNil() {
+		return math.ZeroInt()
+	}
+	return p.MerkleTotalAmount
+}
+
+// VestingSchedule returns the lockup and vesting periods of the claimed coins
+// when the vesting mode is enabled. The lockup is a single period and the
+// coins vest in equal periods, with the remainder added to the last one.
+func (p Params) VestingSchedule(coins sdk.Coins) (lockupPeriods, vestingPeriods sdkvesting.Periods) {
+	if lockup := int64(p.VestingLockupDuration / time.Second); lockup > 0 {
+		lockupPeriods = sdkvesting.Periods{
+			{Length: lockup, Amount: coins},
+		}
+	}
+
+	n := p.VestingPeriods
+	if n == 0 {
+		n = 1
+	}
+
+	length := int64(p.VestingDuration/time.Second) / int64(n)
+	periodCoins := sdk.Coins{}
+	for _, coin := range coins {
+		periodCoins = periodCoins.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(n))))
+	}
+
+	vested := sdk.Coins{}
+	for i := uint64(0); i < n; i++ {
+		amount := periodCoins
+		if i == n-1 {
+			amount = coins.Sub(vested...)
+		}
+		vested = vested.Add(amount...)
+		vestingPeriods = append(vestingPeriods, sdkvesting.Period{Length: length, Amount: amount})
+	}
+
+	return lockupPeriods, vestingPeriods
+}
+
 // IsAuthorizedChannel returns true if the channel provided is in the list of
 // authorized channels
 func (p Params) IsAuthorizedChannel(channel string) bool {
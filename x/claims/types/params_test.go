@@ -4,6 +4,9 @@ import (
 	"testing"
 	time "time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -77,6 +80,104 @@ func TestParamsValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"fail - invalid merkle root",
+			Params{
+				DurationOfDecay:    DefaultDurationOfDecay,
+				DurationUntilDecay: DefaultDurationUntilDecay,
+				ClaimsDenom:        DefaultClaimsDenom,
+				MerkleRoot:         "0x01",
+				MerkleTotalAmount:  sdk.NewInt(100),
+			},
+			true,
+		},
+		{
+			"fail - merkle root without total amount",
+			Params{
+				DurationOfDecay:    DefaultDurationOfDecay,
+				DurationUntilDecay: DefaultDurationUntilDecay,
+				ClaimsDenom:        DefaultClaimsDenom,
+				MerkleRoot:         common.BytesToHash([]byte{1}).Hex(),
+			},
+			true,
+		},
+		{
+			"fail - negative merkle total amount",
+			Params{
+				DurationOfDecay:    DefaultDurationOfDecay,
+				DurationUntilDecay: DefaultDurationUntilDecay,
+				ClaimsDenom:        DefaultClaimsDenom,
+				MerkleTotalAmount:  sdk.NewInt(-1),
+			},
+			true,
+		},
+		{
+			"success - merkle root",
+			Params{
+				DurationOfDecay:    DefaultDurationOfDecay,
+				DurationUntilDecay: DefaultDurationUntilDecay,
+				ClaimsDenom:        DefaultClaimsDenom,
+				MerkleRoot:         common.BytesToHash([]byte{1}).Hex(),
+				MerkleTotalAmount:  sdk.NewInt(100),
+			},
+			false,
+		},
+		{
+			"fail - negative vesting lockup duration",
+			Params{
+				DurationOfDecay:       DefaultDurationOfDecay,
+				DurationUntilDecay:    DefaultDurationUntilDecay,
+				ClaimsDenom:           DefaultClaimsDenom,
+				VestingLockupDuration: -time.Second,
+			},
+			true,
+		},
+		{
+			"fail - vesting enabled without periods",
+			Params{
+				DurationOfDecay:    DefaultDurationOfDecay,
+				DurationUntilDecay: DefaultDurationUntilDecay,
+				ClaimsDenom:        DefaultClaimsDenom,
+				EnableVesting:      true,
+				VestingDuration:    time.Hour,
+			},
+			true,
+		},
+		{
+			"fail - vesting periods shorter than a second",
+			Params{
+				DurationOfDecay:    DefaultDurationOfDecay,
+				DurationUntilDecay: DefaultDurationUntilDecay,
+				ClaimsDenom:        DefaultClaimsDenom,
+				EnableVesting:      true,
+				VestingDuration:    time.Second,
+				VestingPeriods:     2,
+			},
+			true,
+		},
+		{
+			"fail - invalid campaign creation fee",
+			Params{
+				DurationOfDecay:     DefaultDurationOfDecay,
+				DurationUntilDecay:  DefaultDurationUntilDecay,
+				ClaimsDenom:         DefaultClaimsDenom,
+				CampaignCreationFee: sdk.Coins{{Denom: DefaultClaimsDenom, Amount: sdk.NewInt(-1)}},
+			},
+			true,
+		},
+		{
+			"success - vesting enabled",
+			Params{
+				DurationOfDecay:       DefaultDurationOfDecay,
+				DurationUntilDecay:    DefaultDurationUntilDecay,
+				ClaimsDenom:           DefaultClaimsDenom,
+				EnableVesting:         true,
+				VestingLockupDuration: time.Hour,
+				VestingDuration:       4 * time.Hour,
+				VestingPeriods:        4,
+			},
+			false,
+		},
 		{
 			"success - constructor",
 			NewParams(true, "tevmos", time.Unix(0, 0), DefaultDurationOfDecay, DefaultDurationUntilDecay, DefaultAuthorizedChannels, DefaultEVMChannels),
@@ -210,3 +311,38 @@ func TestIsEVMChannel(t *testing.T) {
 	res = params.IsEVMChannel(DefaultEVMChannels[0])
 	require.True(t, res)
 }
+
+func TestParamsVestingSchedule(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(DefaultClaimsDenom, 1000))
+
+	testCases := []struct {
+		name       string
+		params     Params
+		expLockup  sdkvesting.Periods
+		expVesting sdkvesting.Periods
+	}{
+		{
+			"no lockup, single period",
+			Params{VestingDuration: time.Hour, VestingPeriods: 1},
+			nil,
+			sdkvesting.Periods{{Length: 3600, Amount: coins}},
+		},
+		{
+			"lockup and periods with remainder",
+			Params{VestingLockupDuration: time.Minute, VestingDuration: 3 * time.Hour, VestingPeriods: 3},
+			sdkvesting.Periods{{Length: 60, Amount: coins}},
+			sdkvesting.Periods{
+				{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(DefaultClaimsDenom, 333))},
+				{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(DefaultClaimsDenom, 333))},
+				{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(DefaultClaimsDenom, 334))},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		lockup, vesting := tc.params.VestingSchedule(coins)
+		require.Equal(t, tc.expLockup, lockup, tc.name)
+		require.Equal(t, tc.expVesting, vesting, tc.name)
+		require.Equal(t, coins, vesting.TotalAmount(), tc.name)
+	}
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// constants
+const (
+	ProposalTypeRegisterCustomAction string = "RegisterCustomAction"
+	ProposalTypeRemoveCustomAction   string = "RemoveCustomAction"
+)
+
+// Implements Proposal Interface
+var (
+	_ govv1beta1.Content = &RegisterCustomActionProposal{}
+	_ govv1beta1.Content = &RemoveCustomActionProposal{}
+)
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeRegisterCustomAction)
+	govv1beta1.RegisterProposalType(ProposalTypeRemoveCustomAction)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&RegisterCustomActionProposal{}, "claims/RegisterCustomActionProposal", nil)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&RemoveCustomActionProposal{}, "claims/RemoveCustomActionProposal", nil)
+}
+
+// NewRegisterCustomActionProposal returns new instance of
+// RegisterCustomActionProposal
+func NewRegisterCustomActionProposal(
+	title, description string,
+	name string,
+	actionType CustomActionType,
+	contract string,
+	eventSignature string,
+	claimablePercentage sdk.Dec,
+) govv1beta1.Content {
+	return &RegisterCustomActionProposal{
+		Title:               title,
+		Description:         description,
+		Name:                name,
+		Type:                actionType,
+		Contract:            contract,
+		EventSignature:      eventSignature,
+		ClaimablePercentage: claimablePercentage,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterCustomActionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterCustomActionProposal) ProposalType() string {
+	return ProposalTypeRegisterCustomAction
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *RegisterCustomActionProposal) ValidateBasic() error {
+	if err := ValidateCustomActionFields(p.Name, p.Type, p.Contract, p.EventSignature, p.ClaimablePercentage); err != nil {
+		return err
+	}
+
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// NewRemoveCustomActionProposal returns new instance of
+// RemoveCustomActionProposal
+func NewRemoveCustomActionProposal(
+	title, description string,
+	customActionID uint64,
+) govv1beta1.Content {
+	return &RemoveCustomActionProposal{
+		Title:          title,
+		Description:    description,
+		CustomActionID: customActionID,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RemoveCustomActionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RemoveCustomActionProposal) ProposalType() string {
+	return ProposalTypeRemoveCustomAction
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *RemoveCustomActionProposal) ValidateBasic() error {
+	if p.CustomActionID == 0 {
+		return errors.New("custom action identifier cannot be zero")
+	}
+
+	return govv1beta1.ValidateAbstract(p)
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type ProposalTestSuite struct {
+	suite.Suite
+}
+
+func TestProposalTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalTestSuite))
+}
+
+func (suite *ProposalTestSuite) TestKeysTypes() {
+	suite.Require().Equal("claims", (&RegisterCustomActionProposal{}).ProposalRoute())
+	suite.Require().Equal("RegisterCustomAction", (&RegisterCustomActionProposal{}).ProposalType())
+	suite.Require().Equal("claims", (&RemoveCustomActionProposal{}).ProposalRoute())
+	suite.Require().Equal("RemoveCustomAction", (&RemoveCustomActionProposal{}).ProposalType())
+}
+
+func (suite *ProposalTestSuite) TestRegisterCustomActionProposal() {
+	contract := tests.GenerateAddress().String()
+
+	testCases := []struct {
+		name       string
+		title      string
+		actionType CustomActionType
+		contract   string
+		percentage sdk.Dec
+		expectPass bool
+	}{
+		{"valid", "test", CustomActionTypeContractInteraction, contract, sdk.NewDecWithPrec(25, 2), true},
+		{"invalid - empty title", "", CustomActionTypeContractInteraction, contract, sdk.NewDecWithPrec(25, 2), false},
+		{"invalid - type", "test", CustomActionTypeUnspecified, contract, sdk.NewDecWithPrec(25, 2), false},
+		{"invalid - contract address", "test", CustomActionTypeERC20Conversion, "0x123", sdk.NewDecWithPrec(25, 2), false},
+		{"invalid - negative percentage", "test", CustomActionTypeContractInteraction, contract, sdk.NewDec(-1), false},
+	}
+
+	for _, tc := range testCases {
+		proposal := NewRegisterCustomActionProposal(tc.title, "test desc", "swap", tc.actionType, tc.contract, "", tc.percentage)
+		err := proposal.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestRemoveCustomActionProposal() {
+	suite.Require().NoError(NewRemoveCustomActionProposal("test", "test desc", 1).ValidateBasic())
+	suite.Require().Error(NewRemoveCustomActionProposal("test", "test desc", 0).ValidateBasic())
+}
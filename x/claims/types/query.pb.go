@@ -399,690 +399,3320 @@ func (m *QueryClaimsRecordResponse) GetClaims() []Claim {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryTotalUnclaimedRequest)(nil), "evmos.claims.v1.QueryTotalUnclaimedRequest")
-	proto.RegisterType((*QueryTotalUnclaimedResponse)(nil), "evmos.claims.v1.QueryTotalUnclaimedResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.claims.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.claims.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryClaimsRecordsRequest)(nil), "evmos.claims.v1.QueryClaimsRecordsRequest")
-	proto.RegisterType((*QueryClaimsRecordsResponse)(nil), "evmos.claims.v1.QueryClaimsRecordsResponse")
-	proto.RegisterType((*QueryClaimsRecordRequest)(nil), "evmos.claims.v1.QueryClaimsRecordRequest")
-	proto.RegisterType((*QueryClaimsRecordResponse)(nil), "evmos.claims.v1.QueryClaimsRecordResponse")
+// QueryCampaignsRequest is the request type for the Query/Campaigns RPC method.
+type QueryCampaignsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("evmos/claims/v1/query.proto", fileDescriptor_f3bf523ec58a3aba) }
-
-var fileDescriptor_f3bf523ec58a3aba = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xb3, 0xa5, 0x0d, 0x62, 0xcb, 0x87, 0xb4, 0x54, 0x6d, 0x9a, 0x16, 0xa7, 0x98, 0xaa,
-	0x4d, 0x5b, 0xf0, 0xd6, 0xa5, 0x3c, 0x40, 0x13, 0x09, 0x84, 0xc4, 0xa1, 0x58, 0x70, 0xe1, 0x12,
-	0x6d, 0x9c, 0x95, 0x6b, 0x91, 0x78, 0x5d, 0xef, 0x26, 0xa2, 0x42, 0x48, 0x88, 0x27, 0x00, 0x21,
-	0x24, 0x5e, 0x01, 0x5e, 0x81, 0x2b, 0x87, 0x1e, 0x2b, 0x71, 0x41, 0x1c, 0x0a, 0x6a, 0x79, 0x10,
-	0xe4, 0xfd, 0x08, 0x71, 0x62, 0x48, 0x2e, 0x89, 0xed, 0x99, 0xf9, 0xcf, 0x6f, 0xfe, 0x9e, 0x35,
-	0x5c, 0xa2, 0xbd, 0x0e, 0xe3, 0xd8, 0x6f, 0x93, 0xb0, 0xc3, 0x71, 0xcf, 0xc5, 0x87, 0x5d, 0x9a,
-	0x1c, 0x39, 0x71, 0xc2, 0x04, 0x43, 0xd7, 0x64, 0xd0, 0x51, 0x41, 0xa7, 0xe7, 0x96, 0x37, 0x7d,
-	0xc6, 0xd3, 0xf4, 0x26, 0xe1, 0x54, 0x65, 0xe2, 0x9e, 0xdb, 0xa4, 0x82, 0xb8, 0x38, 0x26, 0x41,
-	0x18, 0x11, 0x11, 0xb2, 0x48, 0x15, 0x97, 0xad, 0xc1, 0x5c, 0x93, 0xe5, 0xb3, 0xd0, 0xc4, 0x97,
-	0x87, 0x3b, 0xeb, 0x36, 0x2a, 0x7a, 0x63, 0x38, 0x1a, 0xd0, 0x88, 0xf2, 0xd0, 0x84, 0xe7, 0x02,
-	0x16, 0x30, 0x79, 0x89, 0xd3, 0x2b, 0x23, 0x19, 0x30, 0x16, 0xb4, 0x29, 0x26, 0x71, 0x88, 0x49,
-	0x14, 0x31, 0x21, 0x79, 0x74, 0x8d, 0xbd, 0x0c, 0xcb, 0x8f, 0x53, 0xe4, 0x27, 0x4c, 0x90, 0xf6,
-	0xd3, 0x48, 0x4a, 0xd3, 0x96, 0x47, 0x0f, 0xbb, 0x94, 0x0b, 0xfb, 0x35, 0x80, 0x4b, 0xb9, 0x61,
-	0x1e, 0xb3, 0x88, 0x53, 0x44, 0xe0, 0x4c, 0x0a, 0xcf, 0x4b, 0x60, 0xe5, 0x42, 0x75, 0x76, 0x67,
-	0xd1, 0x51, 0xe3, 0x39, 0xe9, 0x78, 0x8e, 0x1e, 0xcf, 0xa9, 0xb3, 0x30, 0xaa, 0x6d, 0x1f, 0x9f,
-	0x56, 0x0a, 0x9f, 0x7f, 0x56, 0xaa, 0x41, 0x28, 0x0e, 0xba, 0x4d, 0xc7, 0x67, 0x1d, 0xac, 0xbd,
-	0x50, 0x7f, 0x77, 0x78, 0xeb, 0x39, 0x16, 0x47, 0x31, 0xe5, 0xb2, 0x80, 0x7b, 0x4a, 0xd9, 0x9e,
-	0x83, 0x48, 0x12, 0xec, 0x93, 0x84, 0x74, 0xb8, 0x01, 0x7b, 0x04, 0xaf, 0x67, 0x9e, 0x6a, 0x9e,
-	0x7b, 0xb0, 0x18, 0xcb, 0x27, 0x25, 0xb0, 0x02, 0xaa, 0xb3, 0x3b, 0x0b, 0xce, 0xd0, 0xcb, 0x72,
-	0x54, 0x41, 0x6d, 0x3a, 0xc5, 0xf1, 0x74, 0xb2, 0xed, 0xc3, 0x45, 0xa9, 0x56, 0x97, 0x69, 0x1e,
-	0xf5, 0x59, 0xd2, 0x32, 0xad, 0xd0, 0x7d, 0x08, 0xff, 0xbe, 0x46, 0xad, 0xbb, 0x96, 0x19, 0x54,
-	0x6d, 0x87, 0x19, 0x77, 0x9f, 0x04, 0x54, 0xd7, 0x7a, 0x03, 0x95, 0xf6, 0x27, 0xa0, 0xad, 0x1e,
-	0xea, 0xa2, 0xd1, 0x6b, 0xb0, 0xa8, 0x28, 0xb5, 0x97, 0xab, 0x23, 0xe8, 0x83, 0x75, 0x7b, 0xad,
-	0x56, 0x42, 0x79, 0x7f, 0x0e, 0x95, 0x84, 0x1e, 0x64, 0x50, 0xa7, 0x24, 0xea, 0xfa, 0x58, 0x54,
-	0x05, 0x90, 0x61, 0xdd, 0x85, 0xa5, 0x11, 0x54, 0xe3, 0x47, 0x09, 0x5e, 0x24, 0xaa, 0xbb, 0x34,
-	0xe3, 0x92, 0x67, 0x6e, 0xed, 0x2f, 0x20, 0xc7, 0xc7, 0xfe, 0x80, 0x07, 0xb0, 0x14, 0x46, 0xa1,
-	0x08, 0x49, 0xbb, 0x21, 0x71, 0x49, 0xb3, 0x4d, 0x1b, 0xa4, 0xc3, 0xba, 0x91, 0x50, 0x42, 0x35,
-	0x27, 0x1d, 0xe6, 0xc7, 0x69, 0x65, 0x6d, 0x82, 0x1d, 0x79, 0x18, 0x09, 0x6f, 0x5e, 0xeb, 0xd5,
-	0x8d, 0xdc, 0x9e, 0x54, 0x43, 0xbb, 0x7d, 0x2b, 0xa7, 0xa4, 0x95, 0xf3, 0xf9, 0x56, 0x66, 0xcd,
-	0xdb, 0xf9, 0x3a, 0x0d, 0x67, 0x24, 0x3d, 0xfa, 0x00, 0xe0, 0xd5, 0xec, 0xc2, 0xa3, 0xad, 0x11,
-	0x89, 0x7f, 0x9f, 0x9a, 0xf2, 0xed, 0xc9, 0x92, 0x95, 0x2f, 0x76, 0xf5, 0xcd, 0xb7, 0xdf, 0xef,
-	0xa7, 0x6c, 0xb4, 0x82, 0x87, 0x4f, 0xb7, 0x48, 0x0b, 0x1a, 0xdd, 0x3e, 0x84, 0x80, 0x45, 0xb5,
-	0xbe, 0xe8, 0x56, 0x7e, 0x87, 0xcc, 0x19, 0x29, 0xaf, 0xfe, 0x3f, 0x49, 0xb7, 0xaf, 0xc8, 0xf6,
-	0x8b, 0x68, 0x61, 0xa4, 0xbd, 0x3a, 0x1c, 0xe8, 0x1d, 0x80, 0x57, 0x32, 0x2b, 0x8b, 0x36, 0xf3,
-	0x85, 0xf3, 0x4e, 0x4f, 0x79, 0x6b, 0xa2, 0x5c, 0xcd, 0xb2, 0x2e, 0x59, 0x6e, 0xa2, 0x0a, 0xce,
-	0xff, 0x0c, 0x36, 0x12, 0x4d, 0xf0, 0x11, 0xc0, 0xcb, 0x83, 0x12, 0x68, 0x63, 0x7c, 0x1b, 0x43,
-	0xb4, 0x39, 0x49, 0xaa, 0x06, 0x72, 0x25, 0xd0, 0x16, 0xda, 0x18, 0x03, 0x84, 0x5f, 0xea, 0x33,
-	0xf0, 0xaa, 0x56, 0x3f, 0x3e, 0xb3, 0xc0, 0xc9, 0x99, 0x05, 0x7e, 0x9d, 0x59, 0xe0, 0xed, 0xb9,
-	0x55, 0x38, 0x39, 0xb7, 0x0a, 0xdf, 0xcf, 0xad, 0xc2, 0xb3, 0x8d, 0x81, 0xb5, 0x56, 0x72, 0xea,
-	0xb7, 0xe7, 0x6e, 0xe3, 0x17, 0x46, 0x5a, 0x6e, 0x77, 0xb3, 0x28, 0x3f, 0xce, 0x77, 0xff, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x8a, 0x5d, 0x50, 0x72, 0x89, 0x06, 0x00, 0x00,
+func (m *QueryCampaignsRequest) Reset()         { *m = QueryCampaignsRequest{} }
+func (m *QueryCampaignsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignsRequest) ProtoMessage()    {}
+func (*QueryCampaignsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{8}
+}
+func (m *QueryCampaignsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCampaignsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignsRequest.Merge(m, src)
+}
+func (m *QueryCampaignsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryCampaignsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// TotalUnclaimed queries the total unclaimed tokens from the airdrop
-	TotalUnclaimed(ctx context.Context, in *QueryTotalUnclaimedRequest, opts ...grpc.CallOption) (*QueryTotalUnclaimedResponse, error)
-	// Params returns the claims module parameters
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ClaimsRecords returns all claims records
-	ClaimsRecords(ctx context.Context, in *QueryClaimsRecordsRequest, opts ...grpc.CallOption) (*QueryClaimsRecordsResponse, error)
-	// ClaimsRecord returns the claims record for a given address
-	ClaimsRecord(ctx context.Context, in *QueryClaimsRecordRequest, opts ...grpc.CallOption) (*QueryClaimsRecordResponse, error)
+func (m *QueryCampaignsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryCampaignsResponse is the response type for the Query/Campaigns RPC
+// method.
+type QueryCampaignsResponse struct {
+	// campaigns defines all airdrop campaigns
+	Campaigns []Campaign `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryCampaignsResponse) Reset()         { *m = QueryCampaignsResponse{} }
+func (m *QueryCampaignsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignsResponse) ProtoMessage()    {}
+func (*QueryCampaignsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{9}
 }
-
-func (c *queryClient) TotalUnclaimed(ctx context.Context, in *QueryTotalUnclaimedRequest, opts ...grpc.CallOption) (*QueryTotalUnclaimedResponse, error) {
-	out := new(QueryTotalUnclaimedResponse)
-	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/TotalUnclaimed", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryCampaignsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCampaignsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCampaignsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignsResponse.Merge(m, src)
+}
+func (m *QueryCampaignsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClaimsRecords(ctx context.Context, in *QueryClaimsRecordsRequest, opts ...grpc.CallOption) (*QueryClaimsRecordsResponse, error) {
-	out := new(QueryClaimsRecordsResponse)
-	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/ClaimsRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCampaignsResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignsResponse) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ClaimsRecord(ctx context.Context, in *QueryClaimsRecordRequest, opts ...grpc.CallOption) (*QueryClaimsRecordResponse, error) {
-	out := new(QueryClaimsRecordResponse)
-	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/ClaimsRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCampaignsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// TotalUnclaimed queries the total unclaimed tokens from the airdrop
-	TotalUnclaimed(context.Context, *QueryTotalUnclaimedRequest) (*QueryTotalUnclaimedResponse, error)
-	// Params returns the claims module parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ClaimsRecords returns all claims records
-	ClaimsRecords(context.Context, *QueryClaimsRecordsRequest) (*QueryClaimsRecordsResponse, error)
-	// ClaimsRecord returns the claims record for a given address
-	ClaimsRecord(context.Context, *QueryClaimsRecordRequest) (*QueryClaimsRecordResponse, error)
+// QueryCampaignRequest is the request type for the Query/Campaign RPC method.
+type QueryCampaignRequest struct {
+	// campaign_id is the identifier of the campaign
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryCampaignRequest) Reset()         { *m = QueryCampaignRequest{} }
+func (m *QueryCampaignRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignRequest) ProtoMessage()    {}
+func (*QueryCampaignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{10}
 }
-
-func (*UnimplementedQueryServer) TotalUnclaimed(ctx context.Context, req *QueryTotalUnclaimedRequest) (*QueryTotalUnclaimedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalUnclaimed not implemented")
+func (m *QueryCampaignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryCampaignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ClaimsRecords(ctx context.Context, req *QueryClaimsRecordsRequest) (*QueryClaimsRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimsRecords not implemented")
+func (m *QueryCampaignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ClaimsRecord(ctx context.Context, req *QueryClaimsRecordRequest) (*QueryClaimsRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimsRecord not implemented")
+func (m *QueryCampaignRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryCampaignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignRequest.DiscardUnknown(m)
 }
 
-func _Query_TotalUnclaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalUnclaimedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalUnclaimed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.claims.v1.Query/TotalUnclaimed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalUnclaimed(ctx, req.(*QueryTotalUnclaimedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryCampaignRequest proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.claims.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryCampaignRequest) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ClaimsRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimsRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimsRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.claims.v1.Query/ClaimsRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimsRecords(ctx, req.(*QueryClaimsRecordsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryCampaignResponse is the response type for the Query/Campaign RPC method.
+type QueryCampaignResponse struct {
+	// campaign for the given identifier
+	Campaign Campaign `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign"`
 }
 
-func _Query_ClaimsRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimsRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimsRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.claims.v1.Query/ClaimsRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimsRecord(ctx, req.(*QueryClaimsRecordRequest))
+func (m *QueryCampaignResponse) Reset()         { *m = QueryCampaignResponse{} }
+func (m *QueryCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignResponse) ProtoMessage()    {}
+func (*QueryCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{11}
+}
+func (m *QueryCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.claims.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TotalUnclaimed",
-			Handler:    _Query_TotalUnclaimed_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ClaimsRecords",
-			Handler:    _Query_ClaimsRecords_Handler,
-		},
-		{
-			MethodName: "ClaimsRecord",
-			Handler:    _Query_ClaimsRecord_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/claims/v1/query.proto",
+func (m *QueryCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignResponse.Merge(m, src)
+}
+func (m *QueryCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalUnclaimedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCampaignResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignResponse) GetCampaign() Campaign {
+	if m != nil {
+		return m.Campaign
 	}
-	return dAtA[:n], nil
+	return Campaign{}
 }
 
-func (m *QueryTotalUnclaimedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCampaignClaimsRecordsRequest is the request type for the
+// Query/CampaignClaimsRecords RPC method.
+type QueryCampaignClaimsRecordsRequest struct {
+	// campaign_id is the identifier of the campaign
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalUnclaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCampaignClaimsRecordsRequest) Reset()         { *m = QueryCampaignClaimsRecordsRequest{} }
+func (m *QueryCampaignClaimsRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignClaimsRecordsRequest) ProtoMessage()    {}
+func (*QueryCampaignClaimsRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{12}
 }
-
-func (m *QueryTotalUnclaimedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCampaignClaimsRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignClaimsRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignClaimsRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryTotalUnclaimedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCampaignClaimsRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignClaimsRecordsRequest.Merge(m, src)
+}
+func (m *QueryCampaignClaimsRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignClaimsRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignClaimsRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalUnclaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryCampaignClaimsRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignClaimsRecordsRequest) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCampaignClaimsRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCampaignClaimsRecordsResponse is the response type for the
+// Query/CampaignClaimsRecords RPC method.
+type QueryCampaignClaimsRecordsResponse struct {
+	// claims defines all claims records of the campaign
+	Claims []ClaimsRecordAddress `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCampaignClaimsRecordsResponse) Reset()         { *m = QueryCampaignClaimsRecordsResponse{} }
+func (m *QueryCampaignClaimsRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignClaimsRecordsResponse) ProtoMessage()    {}
+func (*QueryCampaignClaimsRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{13}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCampaignClaimsRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignClaimsRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignClaimsRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCampaignClaimsRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignClaimsRecordsResponse.Merge(m, src)
+}
+func (m *QueryCampaignClaimsRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignClaimsRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignClaimsRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryCampaignClaimsRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignClaimsRecordsResponse) GetClaims() []ClaimsRecordAddress {
+	if m != nil {
+		return m.Claims
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryClaimsRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCampaignClaimsRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClaimsRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCampaignClaimsRecordRequest is the request type for the
+// Query/CampaignClaimsRecord RPC method.
+type QueryCampaignClaimsRecordRequest struct {
+	// campaign_id is the identifier of the campaign
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	// address defines the user to query claims record for
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryClaimsRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCampaignClaimsRecordRequest) Reset()         { *m = QueryCampaignClaimsRecordRequest{} }
+func (m *QueryCampaignClaimsRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignClaimsRecordRequest) ProtoMessage()    {}
+func (*QueryCampaignClaimsRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{14}
+}
+func (m *QueryCampaignClaimsRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignClaimsRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignClaimsRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCampaignClaimsRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignClaimsRecordRequest.Merge(m, src)
+}
+func (m *QueryCampaignClaimsRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignClaimsRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignClaimsRecordRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClaimsRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCampaignClaimsRecordRequest proto.InternalMessageInfo
+
+func (m *QueryCampaignClaimsRecordRequest) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryClaimsRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCampaignClaimsRecordRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryClaimsRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryCampaignClaimsRecordResponse is the response type for the
+// Query/CampaignClaimsRecord RPC method.
+type QueryCampaignClaimsRecordResponse struct {
+	// initial_claimable_amount of the user
+	InitialClaimableAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=initial_claimable_amount,json=initialClaimableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_claimable_amount"`
+	// claims of the user for the actions of the campaign
+	Claims []Claim `protobuf:"bytes,2,rep,name=claims,proto3" json:"claims"`
+	// custom_claims of the user for the custom actions of the campaign
+	CustomClaims []CustomClaim `protobuf:"bytes,3,rep,name=custom_claims,json=customClaims,proto3" json:"custom_claims"`
+}
+
+func (m *QueryCampaignClaimsRecordResponse) Reset()         { *m = QueryCampaignClaimsRecordResponse{} }
+func (m *QueryCampaignClaimsRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCampaignClaimsRecordResponse) ProtoMessage()    {}
+func (*QueryCampaignClaimsRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{15}
+}
+func (m *QueryCampaignClaimsRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCampaignClaimsRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCampaignClaimsRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Claims) > 0 {
-		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryCampaignClaimsRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCampaignClaimsRecordResponse.Merge(m, src)
+}
+func (m *QueryCampaignClaimsRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCampaignClaimsRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCampaignClaimsRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCampaignClaimsRecordResponse proto.InternalMessageInfo
+
+func (m *QueryCampaignClaimsRecordResponse) GetClaims() []Claim {
+	if m != nil {
+		return m.Claims
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryClaimsRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCampaignClaimsRecordResponse) GetCustomClaims() []CustomClaim {
+	if m != nil {
+		return m.CustomClaims
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClaimsRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCustomActionsRequest is the request type for the Query/CustomActions
+// RPC method.
+type QueryCustomActionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryClaimsRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCustomActionsRequest) Reset()         { *m = QueryCustomActionsRequest{} }
+func (m *QueryCustomActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCustomActionsRequest) ProtoMessage()    {}
+func (*QueryCustomActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{16}
+}
+func (m *QueryCustomActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCustomActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCustomActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCustomActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCustomActionsRequest.Merge(m, src)
+}
+func (m *QueryCustomActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCustomActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCustomActionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClaimsRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCustomActionsRequest proto.InternalMessageInfo
+
+func (m *QueryCustomActionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClaimsRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCustomActionsResponse is the response type for the Query/CustomActions
+// RPC method.
+type QueryCustomActionsResponse struct {
+	// custom_actions defines all the registered custom actions
+	CustomActions []CustomAction `protobuf:"bytes,1,rep,name=custom_actions,json=customActions,proto3" json:"custom_actions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryClaimsRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Claims) > 0 {
-		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size := m.InitialClaimableAmount.Size()
-		i -= size
-		if _, err := m.InitialClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryCustomActionsResponse) Reset()         { *m = QueryCustomActionsResponse{} }
+func (m *QueryCustomActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCustomActionsResponse) ProtoMessage()    {}
+func (*QueryCustomActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{17}
+}
+func (m *QueryCustomActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCustomActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCustomActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCustomActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCustomActionsResponse.Merge(m, src)
+}
+func (m *QueryCustomActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCustomActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCustomActionsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryCustomActionsResponse proto.InternalMessageInfo
+
+func (m *QueryCustomActionsResponse) GetCustomActions() []CustomAction {
+	if m != nil {
+		return m.CustomActions
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryTotalUnclaimedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryCustomActionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryTotalUnclaimedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+// QueryCustomActionRequest is the request type for the Query/CustomAction RPC
+// method.
+type QueryCustomActionRequest struct {
+	// custom_action_id is the identifier of the custom action
+	CustomActionId uint64 `protobuf:"varint,1,opt,name=custom_action_id,json=customActionId,proto3" json:"custom_action_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCustomActionRequest) Reset()         { *m = QueryCustomActionRequest{} }
+func (m *QueryCustomActionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCustomActionRequest) ProtoMessage()    {}
+func (*QueryCustomActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{18}
+}
+func (m *QueryCustomActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCustomActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCustomActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryCustomActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCustomActionRequest.Merge(m, src)
+}
+func (m *QueryCustomActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCustomActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCustomActionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryCustomActionRequest proto.InternalMessageInfo
+
+func (m *QueryCustomActionRequest) GetCustomActionId() uint64 {
+	if m != nil {
+		return m.CustomActionId
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryClaimsRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryCustomActionResponse is the response type for the Query/CustomAction
+// RPC method.
+type QueryCustomActionResponse struct {
+	// custom_action for the given identifier
+	CustomAction CustomAction `protobuf:"bytes,1,opt,name=custom_action,json=customAction,proto3" json:"custom_action"`
 }
 
-func (m *QueryClaimsRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Claims) > 0 {
-		for _, e := range m.Claims {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCustomActionResponse) Reset()         { *m = QueryCustomActionResponse{} }
+func (m *QueryCustomActionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCustomActionResponse) ProtoMessage()    {}
+func (*QueryCustomActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3bf523ec58a3aba, []int{19}
+}
+func (m *QueryCustomActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCustomActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCustomActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryCustomActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCustomActionResponse.Merge(m, src)
+}
+func (m *QueryCustomActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCustomActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCustomActionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClaimsRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryCustomActionResponse proto.InternalMessageInfo
+
+func (m *QueryCustomActionResponse) GetCustomAction() CustomAction {
+	if m != nil {
+		return m.CustomAction
 	}
-	return n
+	return CustomAction{}
 }
 
-func (m *QueryClaimsRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InitialClaimableAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.Claims) > 0 {
-		for _, e := range m.Claims {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func init() {
+	proto.RegisterType((*QueryTotalUnclaimedRequest)(nil), "evmos.claims.v1.QueryTotalUnclaimedRequest")
+	proto.RegisterType((*QueryTotalUnclaimedResponse)(nil), "evmos.claims.v1.QueryTotalUnclaimedResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.claims.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.claims.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryClaimsRecordsRequest)(nil), "evmos.claims.v1.QueryClaimsRecordsRequest")
+	proto.RegisterType((*QueryClaimsRecordsResponse)(nil), "evmos.claims.v1.QueryClaimsRecordsResponse")
+	proto.RegisterType((*QueryClaimsRecordRequest)(nil), "evmos.claims.v1.QueryClaimsRecordRequest")
+	proto.RegisterType((*QueryClaimsRecordResponse)(nil), "evmos.claims.v1.QueryClaimsRecordResponse")
+	proto.RegisterType((*QueryCampaignsRequest)(nil), "evmos.claims.v1.QueryCampaignsRequest")
+	proto.RegisterType((*QueryCampaignsResponse)(nil), "evmos.claims.v1.QueryCampaignsResponse")
+	proto.RegisterType((*QueryCampaignRequest)(nil), "evmos.claims.v1.QueryCampaignRequest")
+	proto.RegisterType((*QueryCampaignResponse)(nil), "evmos.claims.v1.QueryCampaignResponse")
+	proto.RegisterType((*QueryCampaignClaimsRecordsRequest)(nil), "evmos.claims.v1.QueryCampaignClaimsRecordsRequest")
+	proto.RegisterType((*QueryCampaignClaimsRecordsResponse)(nil), "evmos.claims.v1.QueryCampaignClaimsRecordsResponse")
+	proto.RegisterType((*QueryCampaignClaimsRecordRequest)(nil), "evmos.claims.v1.QueryCampaignClaimsRecordRequest")
+	proto.RegisterType((*QueryCampaignClaimsRecordResponse)(nil), "evmos.claims.v1.QueryCampaignClaimsRecordResponse")
+	proto.RegisterType((*QueryCustomActionsRequest)(nil), "evmos.claims.v1.QueryCustomActionsRequest")
+	proto.RegisterType((*QueryCustomActionsResponse)(nil), "evmos.claims.v1.QueryCustomActionsResponse")
+	proto.RegisterType((*QueryCustomActionRequest)(nil), "evmos.claims.v1.QueryCustomActionRequest")
+	proto.RegisterType((*QueryCustomActionResponse)(nil), "evmos.claims.v1.QueryCustomActionResponse")
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func init() { proto.RegisterFile("evmos/claims/v1/query.proto", fileDescriptor_f3bf523ec58a3aba) }
+
+var fileDescriptor_f3bf523ec58a3aba = []byte{
+	// 1079 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x6e, 0x1b, 0x9a, 0x97, 0x1f, 0xa0, 0x21, 0x4d, 0x9d, 0x6d, 0x6a, 0xa7, 0x4b,
+	0x49, 0x1c, 0x07, 0x76, 0x63, 0x37, 0xa8, 0x02, 0x84, 0x50, 0x6c, 0xd4, 0x12, 0xc4, 0xa1, 0x58,
+	0xe5, 0x82, 0x84, 0xac, 0xf1, 0x7a, 0xe4, 0xae, 0xb0, 0x77, 0x5c, 0xef, 0xda, 0xa2, 0x8a, 0x22,
+	0x55, 0x5c, 0xe9, 0x01, 0x84, 0x90, 0x38, 0x70, 0x80, 0x23, 0xdc, 0x7a, 0xe6, 0xc4, 0x2d, 0xc7,
+	0x4a, 0x5c, 0x10, 0x87, 0x82, 0x12, 0xfe, 0x10, 0xb4, 0xf3, 0x63, 0xb3, 0xbb, 0x5e, 0x7b, 0xb7,
+	0x55, 0x0e, 0xf4, 0x92, 0x6c, 0x66, 0xde, 0x7b, 0xdf, 0xcf, 0x7b, 0x3b, 0x6f, 0xdf, 0x04, 0xae,
+	0xd0, 0x51, 0x8f, 0xb9, 0xa6, 0xd5, 0x25, 0x76, 0xcf, 0x35, 0x47, 0x15, 0xf3, 0xfe, 0x90, 0x0e,
+	0x1e, 0x18, 0xfd, 0x01, 0xf3, 0x18, 0x7e, 0x99, 0x6f, 0x1a, 0x62, 0xd3, 0x18, 0x55, 0xb4, 0xb2,
+	0xc5, 0x5c, 0xdf, 0xbc, 0x45, 0x5c, 0x2a, 0x2c, 0xcd, 0x51, 0xa5, 0x45, 0x3d, 0x52, 0x31, 0xfb,
+	0xa4, 0x63, 0x3b, 0xc4, 0xb3, 0x99, 0x23, 0x9c, 0xb5, 0x42, 0xd8, 0x56, 0x59, 0x59, 0xcc, 0x56,
+	0xfb, 0x6b, 0x71, 0x65, 0x29, 0x23, 0x76, 0xaf, 0xc6, 0x77, 0x3b, 0xd4, 0xa1, 0xae, 0xad, 0xb6,
+	0x97, 0x3b, 0xac, 0xc3, 0xf8, 0xa3, 0xe9, 0x3f, 0xa9, 0x90, 0x1d, 0xc6, 0x3a, 0x5d, 0x6a, 0x92,
+	0xbe, 0x6d, 0x12, 0xc7, 0x61, 0x1e, 0xe7, 0x91, 0x3e, 0xfa, 0x1a, 0x68, 0x9f, 0xf8, 0xc8, 0x77,
+	0x99, 0x47, 0xba, 0x9f, 0x3a, 0x3c, 0x34, 0x6d, 0x37, 0xe8, 0xfd, 0x21, 0x75, 0x3d, 0xfd, 0x21,
+	0x82, 0x2b, 0x89, 0xdb, 0x6e, 0x9f, 0x39, 0x2e, 0xc5, 0x04, 0x2e, 0xf8, 0xf0, 0x6e, 0x1e, 0xad,
+	0x9f, 0x2b, 0xcd, 0x57, 0x57, 0x0d, 0x91, 0x9e, 0xe1, 0xa7, 0x67, 0xc8, 0xf4, 0x8c, 0x3a, 0xb3,
+	0x9d, 0xda, 0xce, 0xd1, 0xd3, 0xe2, 0xcc, 0xaf, 0x7f, 0x17, 0x4b, 0x1d, 0xdb, 0xbb, 0x37, 0x6c,
+	0x19, 0x16, 0xeb, 0x99, 0xb2, 0x16, 0xe2, 0xd7, 0x9b, 0x6e, 0xfb, 0x0b, 0xd3, 0x7b, 0xd0, 0xa7,
+	0x2e, 0x77, 0x70, 0x1b, 0x22, 0xb2, 0xbe, 0x0c, 0x98, 0x13, 0xdc, 0x21, 0x03, 0xd2, 0x73, 0x15,
+	0xd8, 0xc7, 0xf0, 0x6a, 0x64, 0x55, 0xf2, 0xbc, 0x05, 0xb3, 0x7d, 0xbe, 0x92, 0x47, 0xeb, 0xa8,
+	0x34, 0x5f, 0xbd, 0x6c, 0xc4, 0x5e, 0x96, 0x21, 0x1c, 0x6a, 0xe7, 0x7d, 0x9c, 0x86, 0x34, 0xd6,
+	0x2d, 0x58, 0xe5, 0xd1, 0xea, 0xdc, 0xac, 0x41, 0x2d, 0x36, 0x68, 0x2b, 0x29, 0x7c, 0x0b, 0xe0,
+	0xf4, 0x35, 0xca, 0xb8, 0x1b, 0x91, 0x44, 0xc5, 0xe9, 0x50, 0xe9, 0xde, 0x21, 0x1d, 0x2a, 0x7d,
+	0x1b, 0x21, 0x4f, 0xfd, 0x17, 0x04, 0x5a, 0x92, 0x8a, 0x44, 0xaf, 0xc1, 0xac, 0xa0, 0x94, 0xb5,
+	0xbc, 0x3e, 0x86, 0x1e, 0xf6, 0xdb, 0x6b, 0xb7, 0x07, 0xd4, 0x0d, 0xf2, 0x10, 0x46, 0xf8, 0x76,
+	0x04, 0x35, 0xc7, 0x51, 0x37, 0x53, 0x51, 0x05, 0x40, 0x84, 0x75, 0x17, 0xf2, 0x63, 0xa8, 0xaa,
+	0x1e, 0x79, 0x78, 0x89, 0x08, 0x75, 0x5e, 0x8c, 0xb9, 0x86, 0xfa, 0x53, 0xff, 0x0d, 0x25, 0xd4,
+	0x31, 0x48, 0xf0, 0x1e, 0xe4, 0x6d, 0xc7, 0xf6, 0x6c, 0xd2, 0x6d, 0x72, 0x5c, 0xd2, 0xea, 0xd2,
+	0x26, 0xe9, 0xb1, 0xa1, 0xe3, 0x89, 0x40, 0x35, 0xc3, 0x4f, 0xe6, 0xaf, 0xa7, 0xc5, 0x8d, 0x0c,
+	0x67, 0x64, 0xdf, 0xf1, 0x1a, 0x2b, 0x32, 0x5e, 0x5d, 0x85, 0xdb, 0xe3, 0xd1, 0xf0, 0x6e, 0x50,
+	0xca, 0x1c, 0x2f, 0xe5, 0x4a, 0x72, 0x29, 0xa3, 0xc5, 0xd3, 0x9b, 0x70, 0x49, 0xc0, 0x93, 0x5e,
+	0x9f, 0xd8, 0x1d, 0xe7, 0xcc, 0x0f, 0xc0, 0x4f, 0x08, 0x56, 0xe2, 0x0a, 0xb2, 0x36, 0xef, 0xc1,
+	0x9c, 0xa5, 0x16, 0x83, 0x5e, 0x1a, 0x83, 0x96, 0x16, 0x92, 0xfb, 0xd4, 0xe3, 0xec, 0xde, 0xfb,
+	0x4d, 0x58, 0x8e, 0x10, 0xaa, 0x12, 0x14, 0x61, 0x5e, 0xa9, 0x35, 0xed, 0x36, 0xaf, 0xc1, 0xf9,
+	0x06, 0xa8, 0xa5, 0xfd, 0xb6, 0x7e, 0x37, 0x56, 0xbc, 0x20, 0xb3, 0x77, 0xe1, 0xa2, 0x32, 0x93,
+	0xa5, 0x4b, 0x4d, 0x2c, 0x70, 0xd0, 0x1f, 0x21, 0xb8, 0x16, 0x09, 0x9b, 0xd8, 0xa0, 0x69, 0x70,
+	0xf8, 0x56, 0x42, 0x79, 0x9e, 0xe7, 0x05, 0x3e, 0x46, 0xa0, 0x4f, 0xc3, 0xf9, 0x3f, 0x76, 0xf2,
+	0xe7, 0xb0, 0x3e, 0x11, 0x39, 0x73, 0x01, 0x43, 0x2d, 0x9f, 0x8b, 0xb6, 0xfc, 0xa3, 0xdc, 0x94,
+	0x37, 0xf4, 0xa2, 0xb4, 0x3e, 0xbe, 0x0d, 0x8b, 0xd6, 0xd0, 0xf5, 0x58, 0xaf, 0x29, 0x9d, 0xcf,
+	0x71, 0xe7, 0xb5, 0x71, 0x67, 0x6e, 0x15, 0x0e, 0xb1, 0x60, 0x9d, 0x2e, 0x85, 0x06, 0x09, 0x5f,
+	0xdc, 0xb3, 0xf8, 0xa4, 0x3d, 0xeb, 0xef, 0xc8, 0xe3, 0x60, 0x90, 0x44, 0x55, 0x64, 0xb1, 0x3f,
+	0x82, 0x25, 0x99, 0x0c, 0x11, 0x3b, 0xf2, 0x18, 0x5e, 0x9d, 0x90, 0x8d, 0xf0, 0x97, 0xe9, 0x2c,
+	0x5a, 0xa1, 0xb5, 0x33, 0x3c, 0x86, 0x1f, 0xa8, 0x81, 0x12, 0x0a, 0xaf, 0xea, 0x52, 0x82, 0x57,
+	0x22, 0xc0, 0xa7, 0x67, 0x70, 0x29, 0x4c, 0xb3, 0xdf, 0xd6, 0x69, 0x42, 0x79, 0x83, 0xbc, 0x3f,
+	0x84, 0xc5, 0x48, 0x18, 0x59, 0xe1, 0x4c, 0x69, 0x2f, 0x84, 0x85, 0xaa, 0x3f, 0x2e, 0xc0, 0x05,
+	0xae, 0x83, 0xbf, 0x47, 0xb0, 0x14, 0xbd, 0xfa, 0xe0, 0xed, 0xb1, 0x78, 0x93, 0xef, 0x4f, 0xda,
+	0x1b, 0xd9, 0x8c, 0x45, 0x06, 0x7a, 0xe9, 0xab, 0x3f, 0xfe, 0xfd, 0x2e, 0xa7, 0xe3, 0x75, 0x33,
+	0x7e, 0xcf, 0xf3, 0x7c, 0x87, 0xe6, 0x30, 0x80, 0xf0, 0x60, 0x56, 0x5c, 0x64, 0xf0, 0x6b, 0xc9,
+	0x0a, 0x91, 0xdb, 0x92, 0x76, 0x7d, 0xba, 0x91, 0x94, 0x2f, 0x72, 0xf9, 0x55, 0x7c, 0x79, 0x4c,
+	0x5e, 0x5c, 0x93, 0xf0, 0xb7, 0x08, 0x16, 0x23, 0x9f, 0x3c, 0x5c, 0x4e, 0x0e, 0x9c, 0xf4, 0x99,
+	0xd6, 0xb6, 0x33, 0xd9, 0x4a, 0x96, 0x4d, 0xce, 0x72, 0x0d, 0x17, 0xcd, 0xe4, 0x0b, 0x71, 0x73,
+	0x20, 0x09, 0x7e, 0x40, 0xb0, 0x10, 0x0e, 0x81, 0xb7, 0xd2, 0x65, 0x14, 0x51, 0x39, 0x8b, 0xa9,
+	0x04, 0xaa, 0x70, 0xa0, 0x6d, 0xbc, 0x95, 0x02, 0x64, 0x1e, 0xc8, 0x4f, 0xe3, 0x21, 0x7e, 0x88,
+	0x60, 0x2e, 0x18, 0xf5, 0x78, 0x63, 0x82, 0x58, 0xec, 0xb6, 0xa1, 0x6d, 0xa6, 0xda, 0x49, 0x22,
+	0x9d, 0x13, 0xad, 0x61, 0x6d, 0x9c, 0x28, 0x10, 0xfd, 0x1a, 0xc1, 0x45, 0xe5, 0x89, 0x5f, 0x9f,
+	0x1e, 0x59, 0x01, 0x6c, 0xa4, 0x99, 0x49, 0xfd, 0x1d, 0xae, 0x5f, 0xc6, 0xa5, 0xc9, 0xfa, 0xe6,
+	0x41, 0x68, 0xae, 0x1c, 0xe2, 0xdf, 0x11, 0x5c, 0x4a, 0x1c, 0x9d, 0xb8, 0x3a, 0x5d, 0x33, 0xf1,
+	0x3c, 0xdd, 0x78, 0x26, 0x1f, 0x09, 0xfd, 0x3e, 0x87, 0x7e, 0x1b, 0xdf, 0xcc, 0x0a, 0x1d, 0x3f,
+	0x6f, 0x47, 0x08, 0x96, 0x93, 0x24, 0x70, 0x25, 0x3b, 0x8e, 0xca, 0xa0, 0xfa, 0x2c, 0x2e, 0x32,
+	0x81, 0x7d, 0x9e, 0x40, 0x1d, 0xef, 0x3d, 0x67, 0x02, 0xa1, 0xf3, 0xc9, 0xdb, 0x39, 0xf2, 0xb9,
+	0x9f, 0xd4, 0x10, 0x09, 0xd3, 0x4c, 0xdb, 0xce, 0x64, 0x9b, 0xde, 0xce, 0x91, 0x51, 0x85, 0x7f,
+	0xf6, 0xdb, 0x39, 0x14, 0x62, 0x62, 0x3b, 0x8f, 0xcf, 0x11, 0xad, 0x9c, 0xc5, 0x54, 0x02, 0xbd,
+	0xc3, 0x81, 0x76, 0x71, 0x35, 0x05, 0xc8, 0x3c, 0x88, 0x8f, 0xa6, 0xc3, 0x5a, 0xfd, 0xe8, 0xb8,
+	0x80, 0x9e, 0x1c, 0x17, 0xd0, 0x3f, 0xc7, 0x05, 0xf4, 0xcd, 0x49, 0x61, 0xe6, 0xc9, 0x49, 0x61,
+	0xe6, 0xcf, 0x93, 0xc2, 0xcc, 0x67, 0x5b, 0xa1, 0xdb, 0x8b, 0x88, 0x2b, 0x7e, 0x8e, 0x2a, 0x3b,
+	0xe6, 0x97, 0x4a, 0x83, 0x5f, 0x62, 0x5a, 0xb3, 0xfc, 0xdf, 0xef, 0x1b, 0xff, 0x0d, 0x00, 0x47,
+	0xdf, 0x54, 0x44, 0x6b, 0x10, 0x00, 0x00,
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TotalUnclaimed queries the total unclaimed tokens from the airdrop
+	TotalUnclaimed(ctx context.Context, in *QueryTotalUnclaimedRequest, opts ...grpc.CallOption) (*QueryTotalUnclaimedResponse, error)
+	// Params returns the claims module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ClaimsRecords returns all claims records
+	ClaimsRecords(ctx context.Context, in *QueryClaimsRecordsRequest, opts ...grpc.CallOption) (*QueryClaimsRecordsResponse, error)
+	// ClaimsRecord returns the claims record for a given address
+	ClaimsRecord(ctx context.Context, in *QueryClaimsRecordRequest, opts ...grpc.CallOption) (*QueryClaimsRecordResponse, error)
+	// Campaigns returns all airdrop campaigns
+	Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error)
+	// Campaign returns the airdrop campaign for a given identifier
+	Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error)
+	// CampaignClaimsRecords returns all claims records of a campaign
+	CampaignClaimsRecords(ctx context.Context, in *QueryCampaignClaimsRecordsRequest, opts ...grpc.CallOption) (*QueryCampaignClaimsRecordsResponse, error)
+	// CampaignClaimsRecord returns the claims record of a campaign for a given
+	// address
+	CampaignClaimsRecord(ctx context.Context, in *QueryCampaignClaimsRecordRequest, opts ...grpc.CallOption) (*QueryCampaignClaimsRecordResponse, error)
+	// CustomActions retrieves all the registered custom actions
+	CustomActions(ctx context.Context, in *QueryCustomActionsRequest, opts ...grpc.CallOption) (*QueryCustomActionsResponse, error)
+	// CustomAction retrieves a registered custom action
+	CustomAction(ctx context.Context, in *QueryCustomActionRequest, opts ...grpc.CallOption) (*QueryCustomActionResponse, error)
 }
-func (m *QueryTotalUnclaimedRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TotalUnclaimed(ctx context.Context, in *QueryTotalUnclaimedRequest, opts ...grpc.CallOption) (*QueryTotalUnclaimedResponse, error) {
+	out := new(QueryTotalUnclaimedResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/TotalUnclaimed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimsRecords(ctx context.Context, in *QueryClaimsRecordsRequest, opts ...grpc.CallOption) (*QueryClaimsRecordsResponse, error) {
+	out := new(QueryClaimsRecordsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/ClaimsRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimsRecord(ctx context.Context, in *QueryClaimsRecordRequest, opts ...grpc.CallOption) (*QueryClaimsRecordResponse, error) {
+	out := new(QueryClaimsRecordResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/ClaimsRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error) {
+	out := new(QueryCampaignsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/Campaigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error) {
+	out := new(QueryCampaignResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/Campaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CampaignClaimsRecords(ctx context.Context, in *QueryCampaignClaimsRecordsRequest, opts ...grpc.CallOption) (*QueryCampaignClaimsRecordsResponse, error) {
+	out := new(QueryCampaignClaimsRecordsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/CampaignClaimsRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CampaignClaimsRecord(ctx context.Context, in *QueryCampaignClaimsRecordRequest, opts ...grpc.CallOption) (*QueryCampaignClaimsRecordResponse, error) {
+	out := new(QueryCampaignClaimsRecordResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/CampaignClaimsRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CustomActions(ctx context.Context, in *QueryCustomActionsRequest, opts ...grpc.CallOption) (*QueryCustomActionsResponse, error) {
+	out := new(QueryCustomActionsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/CustomActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CustomAction(ctx context.Context, in *QueryCustomActionRequest, opts ...grpc.CallOption) (*QueryCustomActionResponse, error) {
+	out := new(QueryCustomActionResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Query/CustomAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TotalUnclaimed queries the total unclaimed tokens from the airdrop
+	TotalUnclaimed(context.Context, *QueryTotalUnclaimedRequest) (*QueryTotalUnclaimedResponse, error)
+	// Params returns the claims module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ClaimsRecords returns all claims records
+	ClaimsRecords(context.Context, *QueryClaimsRecordsRequest) (*QueryClaimsRecordsResponse, error)
+	// ClaimsRecord returns the claims record for a given address
+	ClaimsRecord(context.Context, *QueryClaimsRecordRequest) (*QueryClaimsRecordResponse, error)
+	// Campaigns returns all airdrop campaigns
+	Campaigns(context.Context, *QueryCampaignsRequest) (*QueryCampaignsResponse, error)
+	// Campaign returns the airdrop campaign for a given identifier
+	Campaign(context.Context, *QueryCampaignRequest) (*QueryCampaignResponse, error)
+	// CampaignClaimsRecords returns all claims records of a campaign
+	CampaignClaimsRecords(context.Context, *QueryCampaignClaimsRecordsRequest) (*QueryCampaignClaimsRecordsResponse, error)
+	// CampaignClaimsRecord returns the claims record of a campaign for a given
+	// address
+	CampaignClaimsRecord(context.Context, *QueryCampaignClaimsRecordRequest) (*QueryCampaignClaimsRecordResponse, error)
+	// CustomActions retrieves all the registered custom actions
+	CustomActions(context.Context, *QueryCustomActionsRequest) (*QueryCustomActionsResponse, error)
+	// CustomAction retrieves a registered custom action
+	CustomAction(context.Context, *QueryCustomActionRequest) (*QueryCustomActionResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TotalUnclaimed(ctx context.Context, req *QueryTotalUnclaimedRequest) (*QueryTotalUnclaimedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalUnclaimed not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ClaimsRecords(ctx context.Context, req *QueryClaimsRecordsRequest) (*QueryClaimsRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimsRecords not implemented")
+}
+func (*UnimplementedQueryServer) ClaimsRecord(ctx context.Context, req *QueryClaimsRecordRequest) (*QueryClaimsRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimsRecord not implemented")
+}
+func (*UnimplementedQueryServer) Campaigns(ctx context.Context, req *QueryCampaignsRequest) (*QueryCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaigns not implemented")
+}
+func (*UnimplementedQueryServer) Campaign(ctx context.Context, req *QueryCampaignRequest) (*QueryCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaign not implemented")
+}
+func (*UnimplementedQueryServer) CampaignClaimsRecords(ctx context.Context, req *QueryCampaignClaimsRecordsRequest) (*QueryCampaignClaimsRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CampaignClaimsRecords not implemented")
+}
+func (*UnimplementedQueryServer) CampaignClaimsRecord(ctx context.Context, req *QueryCampaignClaimsRecordRequest) (*QueryCampaignClaimsRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CampaignClaimsRecord not implemented")
+}
+func (*UnimplementedQueryServer) CustomActions(ctx context.Context, req *QueryCustomActionsRequest) (*QueryCustomActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CustomActions not implemented")
+}
+func (*UnimplementedQueryServer) CustomAction(ctx context.Context, req *QueryCustomActionRequest) (*QueryCustomActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CustomAction not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TotalUnclaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalUnclaimedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalUnclaimed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/TotalUnclaimed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalUnclaimed(ctx, req.(*QueryTotalUnclaimedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimsRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimsRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimsRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/ClaimsRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimsRecords(ctx, req.(*QueryClaimsRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimsRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimsRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimsRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/ClaimsRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimsRecord(ctx, req.(*QueryClaimsRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Campaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/Campaigns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaigns(ctx, req.(*QueryCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Campaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/Campaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaign(ctx, req.(*QueryCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CampaignClaimsRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignClaimsRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CampaignClaimsRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/CampaignClaimsRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CampaignClaimsRecords(ctx, req.(*QueryCampaignClaimsRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CampaignClaimsRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignClaimsRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CampaignClaimsRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/CampaignClaimsRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CampaignClaimsRecord(ctx, req.(*QueryCampaignClaimsRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CustomActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCustomActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CustomActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/CustomActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CustomActions(ctx, req.(*QueryCustomActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CustomAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCustomActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CustomAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Query/CustomAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CustomAction(ctx, req.(*QueryCustomActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.claims.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TotalUnclaimed",
+			Handler:    _Query_TotalUnclaimed_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ClaimsRecords",
+			Handler:    _Query_ClaimsRecords_Handler,
+		},
+		{
+			MethodName: "ClaimsRecord",
+			Handler:    _Query_ClaimsRecord_Handler,
+		},
+		{
+			MethodName: "Campaigns",
+			Handler:    _Query_Campaigns_Handler,
+		},
+		{
+			MethodName: "Campaign",
+			Handler:    _Query_Campaign_Handler,
+		},
+		{
+			MethodName: "CampaignClaimsRecords",
+			Handler:    _Query_CampaignClaimsRecords_Handler,
+		},
+		{
+			MethodName: "CampaignClaimsRecord",
+			Handler:    _Query_CampaignClaimsRecord_Handler,
+		},
+		{
+			MethodName: "CustomActions",
+			Handler:    _Query_CustomActions_Handler,
+		},
+		{
+			MethodName: "CustomAction",
+			Handler:    _Query_CustomAction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/claims/v1/query.proto",
+}
+
+func (m *QueryTotalUnclaimedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalUnclaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalUnclaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalUnclaimedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalUnclaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalUnclaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimsRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimsRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimsRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimsRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimsRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimsRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimsRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimsRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimsRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimsRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimsRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimsRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.InitialClaimableAmount.Size()
+		i -= size
+		if _, err := m.InitialClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Campaign.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignClaimsRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignClaimsRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignClaimsRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignClaimsRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignClaimsRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignClaimsRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignClaimsRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignClaimsRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignClaimsRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CampaignId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCampaignClaimsRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCampaignClaimsRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCampaignClaimsRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CustomClaims) > 0 {
+		for iNdEx := len(m.CustomClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CustomClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.InitialClaimableAmount.Size()
+		i -= size
+		if _, err := m.InitialClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCustomActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCustomActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCustomActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCustomActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCustomActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCustomActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CustomActions) > 0 {
+		for iNdEx := len(m.CustomActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CustomActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCustomActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCustomActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCustomActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CustomActionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CustomActionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCustomActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCustomActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCustomActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CustomAction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTotalUnclaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalUnclaimedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimsRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimsRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimsRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimsRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialClaimableAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCampaignsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *QueryCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Campaign.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCampaignClaimsRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignClaimsRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignClaimsRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovQuery(uint64(m.CampaignId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCampaignClaimsRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialClaimableAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.CustomClaims) > 0 {
+		for _, e := range m.CustomClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCustomActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCustomActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CustomActions) > 0 {
+		for _, e := range m.CustomActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCustomActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CustomActionId != 0 {
+		n += 1 + sovQuery(uint64(m.CustomActionId))
+	}
+	return n
+}
+
+func (m *QueryCustomActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CustomAction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTotalUnclaimedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalUnclaimedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalUnclaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalUnclaimedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalUnclaimedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalUnclaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimsRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimsRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimsRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimsRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimsRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimsRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, ClaimsRecordAddress{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimsRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimsRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimsRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimsRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimsRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimsRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, Claim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Campaign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCampaignClaimsRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCampaignClaimsRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCampaignClaimsRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalUnclaimedRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalUnclaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1104,7 +3734,7 @@ func (m *QueryTotalUnclaimedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalUnclaimedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCampaignClaimsRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1127,15 +3757,15 @@ func (m *QueryTotalUnclaimedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalUnclaimedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCampaignClaimsRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalUnclaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCampaignClaimsRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1162,8 +3792,44 @@ func (m *QueryTotalUnclaimedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Claims = append(m.Claims, ClaimsRecordAddress{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1188,7 +3854,7 @@ func (m *QueryTotalUnclaimedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCampaignClaimsRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1211,12 +3877,63 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCampaignClaimsRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCampaignClaimsRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1238,7 +3955,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCampaignClaimsRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,15 +3978,49 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCampaignClaimsRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCampaignClaimsRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1296,7 +4047,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Claims = append(m.Claims, Claim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CustomClaims = append(m.CustomClaims, CustomClaim{})
+			if err := m.CustomClaims[len(m.CustomClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1321,7 +4107,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimsRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCustomActionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1344,10 +4130,10 @@ func (m *QueryClaimsRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimsRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCustomActionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimsRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCustomActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1407,7 +4193,7 @@ func (m *QueryClaimsRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimsRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCustomActionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1430,15 +4216,15 @@ func (m *QueryClaimsRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimsRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCustomActionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimsRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCustomActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomActions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1465,8 +4251,8 @@ func (m *QueryClaimsRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claims = append(m.Claims, ClaimsRecordAddress{})
-			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CustomActions = append(m.CustomActions, CustomAction{})
+			if err := m.CustomActions[len(m.CustomActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1527,7 +4313,7 @@ func (m *QueryClaimsRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimsRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCustomActionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1550,17 +4336,17 @@ func (m *QueryClaimsRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimsRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCustomActionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimsRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCustomActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomActionId", wireType)
 			}
-			var stringLen uint64
+			m.CustomActionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1570,24 +4356,11 @@ func (m *QueryClaimsRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CustomActionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1609,7 +4382,7 @@ func (m *QueryClaimsRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimsRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCustomActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1632,49 +4405,15 @@ func (m *QueryClaimsRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimsRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCustomActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimsRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCustomActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialClaimableAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitialClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomAction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1701,8 +4440,7 @@ func (m *QueryClaimsRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claims = append(m.Claims, Claim{})
-			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CustomAction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
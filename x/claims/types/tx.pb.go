@@ -0,0 +1,2906 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/claims/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgCreateCampaign defines a message that creates an airdrop campaign. The sum
+// of the initial claimable amounts of the claims records is escrowed from the
+// funder and any unclaimed remainder is returned to the funder once the
+// campaign ends.
+type MsgCreateCampaign struct {
+	// funder is the bech32 address of message sender that funds the campaign
+	Funder string `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder,omitempty"`
+	// denom is the denomination of the claimable coin
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// actions is the list of actions that have to be completed to claim the
+	// coins
+	Actions []Action `protobuf:"varint,3,rep,packed,name=actions,proto3,enum=evmos.claims.v1.Action" json:"actions,omitempty"`
+	// start_time defines the timestamp of the campaign start. If zero, the
+	// campaign starts at the block time of its creation.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// duration_until_decay of claimable tokens begin
+	DurationUntilDecay time.Duration `protobuf:"bytes,5,opt,name=duration_until_decay,json=durationUntilDecay,proto3,stdduration" json:"duration_until_decay"`
+	// duration_of_decay for token claim decay period
+	DurationOfDecay time.Duration `protobuf:"bytes,6,opt,name=duration_of_decay,json=durationOfDecay,proto3,stdduration" json:"duration_of_decay"`
+	// claims_records is the list of recipients and their initial claimable
+	// amounts
+	ClaimsRecords []ClaimsRecordAddress `protobuf:"bytes,7,rep,name=claims_records,json=claimsRecords,proto3" json:"claims_records"`
+	// custom_action_ids is the list of identifiers of the registered custom
+	// actions that have to be completed to claim the coins
+	CustomActionIDs []uint64 `protobuf:"varint,8,rep,packed,name=custom_action_ids,json=customActionIds,proto3" json:"custom_action_ids,omitempty"`
+}
+
+func (m *MsgCreateCampaign) Reset()         { *m = MsgCreateCampaign{} }
+func (m *MsgCreateCampaign) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCampaign) ProtoMessage()    {}
+func (*MsgCreateCampaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da3f957232eaa283, []int{0}
+}
+func (m *MsgCreateCampaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCampaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCampaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCampaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCampaign.Merge(m, src)
+}
+func (m *MsgCreateCampaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCampaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCampaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCampaign proto.InternalMessageInfo
+
+func (m *MsgCreateCampaign) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *MsgCreateCampaign) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgCreateCampaign) GetActions() []Action {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *MsgCreateCampaign) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreateCampaign) GetDurationUntilDecay() time.Duration {
+	if m != nil {
+		return m.DurationUntilDecay
+	}
+	return 0
+}
+
+func (m *MsgCreateCampaign) GetDurationOfDecay() time.Duration {
+	if m != nil {
+		return m.DurationOfDecay
+	}
+	return 0
+}
+
+func (m *MsgCreateCampaign) GetClaimsRecords() []ClaimsRecordAddress {
+	if m != nil {
+		return m.ClaimsRecords
+	}
+	return nil
+}
+
+func (m *MsgCreateCampaign) GetCustomActionIDs() []uint64 {
+	if m != nil {
+		return m.CustomActionIDs
+	}
+	return nil
+}
+
+// MsgCreateCampaignResponse returns the identifier of the created campaign
+type MsgCreateCampaignResponse struct {
+	// campaign_id is the identifier of the campaign
+	CampaignID uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (m *MsgCreateCampaignResponse) Reset()         { *m = MsgCreateCampaignResponse{} }
+func (m *MsgCreateCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCampaignResponse) ProtoMessage()    {}
+func (*MsgCreateCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da3f957232eaa283, []int{1}
+}
+func (m *MsgCreateCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCampaignResponse.Merge(m, src)
+}
+func (m *MsgCreateCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCampaignResponse proto.InternalMessageInfo
+
+func (m *MsgCreateCampaignResponse) GetCampaignID() uint64 {
+	if m != nil {
+		return m.CampaignID
+	}
+	return 0
+}
+
+// MsgClaimWithProof defines a message that proves the inclusion of a leaf
+// (address, amount) in the Merkle tree of the airdrop and creates the claims
+// record of the address. The sender can be any account, so that recipients
+// without balance can have their record created.
+type MsgClaimWithProof struct {
+	// sender is the bech32 address of message sender
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// address is the bech32 address of the leaf
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the initial claimable amount of the leaf
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// proof is the list of hex encoded sibling hashes from the leaf to the root
+	Proof []string `protobuf:"bytes,4,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgClaimWithProof) Reset()         { *m = MsgClaimWithProof{} }
+func (m *MsgClaimWithProof) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimWithProof) ProtoMessage()    {}
+func (*MsgClaimWithProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da3f957232eaa283, []int{2}
+}
+func (m *MsgClaimWithProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimWithProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimWithProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimWithProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimWithProof.Merge(m, src)
+}
+func (m *MsgClaimWithProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimWithProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimWithProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimWithProof proto.InternalMessageInfo
+
+func (m *MsgClaimWithProof) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgClaimWithProof) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgClaimWithProof) GetProof() []string {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// MsgClaimWithProofResponse returns the claims record of the address
+type MsgClaimWithProofResponse struct {
+	// claims_record of the address
+	ClaimsRecord ClaimsRecord `protobuf:"bytes,1,opt,name=claims_record,json=claimsRecord,proto3" json:"claims_record"`
+}
+
+func (m *MsgClaimWithProofResponse) Reset()         { *m = MsgClaimWithProofResponse{} }
+func (m *MsgClaimWithProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimWithProofResponse) ProtoMessage()    {}
+func (*MsgClaimWithProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da3f957232eaa283, []int{3}
+}
+func (m *MsgClaimWithProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimWithProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimWithProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimWithProofResponse.Merge(m, src)
+}
+func (m *MsgClaimWithProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimWithProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimWithProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimWithProofResponse proto.InternalMessageInfo
+
+func (m *MsgClaimWithProofResponse) GetClaimsRecord() ClaimsRecord {
+	if m != nil {
+		return m.ClaimsRecord
+	}
+	return ClaimsRecord{}
+}
+
+// MsgTransferClaimsRecord defines a message that transfers the claims record of
+// the sender to the recipient. If the recipient has a claims record, both
+// records are merged and the actions completed by only one of them are claimed
+// for the other.
+type MsgTransferClaimsRecord struct {
+	// sender is the bech32 address of the holder of the claims record
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the bech32 address that receives the claims record
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgTransferClaimsRecord) Reset()         { *m = MsgTransferClaimsRecord{} }
+func (m *MsgTransferClaimsRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferClaimsRecord) ProtoMessage()    {}
+func (*MsgTransferClaimsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da3f957232eaa283, []int{4}
+}
+func (m *MsgTransferClaimsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferClaimsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferClaimsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferClaimsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferClaimsRecord.Merge(m, src)
+}
+func (m *MsgTransferClaimsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferClaimsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferClaimsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferClaimsRecord proto.InternalMessageInfo
+
+func (m *MsgTransferClaimsRecord) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgTransferClaimsRecord) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgTransferClaimsRecordResponse returns the claims record of the recipient
+type MsgTransferClaimsRecordResponse struct {
+	// claims_record of the recipient
+	ClaimsRecord ClaimsRecord `protobuf:"bytes,1,opt,name=claims_record,json=claimsRecord,proto3" json:"claims_record"`
+}
+
+func (m *MsgTransferClaimsRecordResponse) Reset()         { *m = MsgTransferClaimsRecordResponse{} }
+func (m *MsgTransferClaimsRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferClaimsRecordResponse) ProtoMessage()    {}
+func (*MsgTransferClaimsRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da3f957232eaa283, []int{5}
+}
+func (m *MsgTransferClaimsRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferClaimsRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferClaimsRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferClaimsRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferClaimsRecordResponse.Merge(m, src)
+}
+func (m *MsgTransferClaimsRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferClaimsRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferClaimsRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferClaimsRecordResponse proto.InternalMessageInfo
+
+func (m *MsgTransferClaimsRecordResponse) GetClaimsRecord() ClaimsRecord {
+	if m != nil {
+		return m.ClaimsRecord
+	}
+	return ClaimsRecord{}
+}
+
+// MsgSetClaimsDelegate defines a message that requests a delegate, e.g. a hot
+// wallet, to complete the actions of the claims records of the granter, e.g. a
+// cold wallet. The claimed coins are transferred to the granter. The delegate
+// is only set once it accepts the request with a MsgAcceptClaimsDelegate. An
+// empty delegate removes the delegate and the pending request of the granter.
+type MsgSetClaimsDelegate struct {
+	// granter is the bech32 address of the holder of the claims records
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// delegate is the bech32 address that completes the actions
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+}
+
+func (m *MsgSetClaimsDelegate) Reset()         { *m = MsgSetClaimsDelegate{} }
+func (m *MsgSetClaimsDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimsDelegate) ProtoMessage()    {}
+func (*MsgSetClaimsDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da3f957232eaa283, []int{6}
+}
+func (m *MsgSetClaimsDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClaimsDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimsDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetClaimsDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimsDelegate.Merge(m, src)
+}
+func (m *MsgSetClaimsDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimsDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimsDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetClaimsDelegate proto.InternalMessageInfo
+
+func (m *MsgSetClaimsDelegate) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgSetClaimsDelegate) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+// MsgSetClaimsDelegateResponse defines the MsgSetClaimsDelegate response type
+type MsgSetClaimsDelegateResponse struct {
+}
+
+func (m *MsgSetClaimsDelegateResponse) Reset()         { *m = MsgSetClaimsDelegateResponse{} }
+func (m *MsgSetClaimsDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetClaimsDelegateResponse) ProtoMessage()    {}
+func (*MsgSetClaimsDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da3f957232eaa283, []int{7}
+}
+func (m *MsgSetClaimsDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetClaimsDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetClaimsDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetClaimsDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetClaimsDelegateResponse.Merge(m, src)
+}
+func (m *MsgSetClaimsDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetClaimsDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetClaimsDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetClaimsDelegateResponse proto.InternalMessageInfo
+
+// MsgAcceptClaimsDelegate defines a message that the delegate signs to accept
+// the pending request of a granter. With revoke, the delegate rejects the
+// pending request or stops completing the actions of the granter instead.
+type MsgAcceptClaimsDelegate struct {
+	// delegate is the bech32 address that completes the actions
+	Delegate string `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	// granter is the bech32 address of the holder of the claims records
+	Granter string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter,omitempty"`
+	// revoke rejects the request or removes the delegate of the granter
+	Revoke bool `protobuf:"varint,3,opt,name=revoke,proto3" json:"revoke,omitempty"`
+}
+
+func (m *MsgAcceptClaimsDelegate) Reset()         { *m = MsgAcceptClaimsDelegate{} }
+func (m *MsgAcceptClaimsDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptClaimsDelegate) ProtoMessage()    {}
+func (*MsgAcceptClaimsDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da3f957232eaa283, []int{8}
+}
+func (m *MsgAcceptClaimsDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptClaimsDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptClaimsDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptClaimsDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptClaimsDelegate.Merge(m, src)
+}
+func (m *MsgAcceptClaimsDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptClaimsDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptClaimsDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptClaimsDelegate proto.InternalMessageInfo
+
+func (m *MsgAcceptClaimsDelegate) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *MsgAcceptClaimsDelegate) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *MsgAcceptClaimsDelegate) GetRevoke() bool {
+	if m != nil {
+		return m.Revoke
+	}
+	return false
+}
+
+// MsgAcceptClaimsDelegateResponse defines the MsgAcceptClaimsDelegate response
+// type
+type MsgAcceptClaimsDelegateResponse struct {
+}
+
+func (m *MsgAcceptClaimsDelegateResponse) Reset()         { *m = MsgAcceptClaimsDelegateResponse{} }
+func (m *MsgAcceptClaimsDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptClaimsDelegateResponse) ProtoMessage()    {}
+func (*MsgAcceptClaimsDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da3f957232eaa283, []int{9}
+}
+func (m *MsgAcceptClaimsDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptClaimsDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptClaimsDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptClaimsDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptClaimsDelegateResponse.Merge(m, src)
+}
+func (m *MsgAcceptClaimsDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptClaimsDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptClaimsDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptClaimsDelegateResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateCampaign)(nil), "evmos.claims.v1.MsgCreateCampaign")
+	proto.RegisterType((*MsgCreateCampaignResponse)(nil), "evmos.claims.v1.MsgCreateCampaignResponse")
+	proto.RegisterType((*MsgClaimWithProof)(nil), "evmos.claims.v1.MsgClaimWithProof")
+	proto.RegisterType((*MsgClaimWithProofResponse)(nil), "evmos.claims.v1.MsgClaimWithProofResponse")
+	proto.RegisterType((*MsgTransferClaimsRecord)(nil), "evmos.claims.v1.MsgTransferClaimsRecord")
+	proto.RegisterType((*MsgTransferClaimsRecordResponse)(nil), "evmos.claims.v1.MsgTransferClaimsRecordResponse")
+	proto.RegisterType((*MsgSetClaimsDelegate)(nil), "evmos.claims.v1.MsgSetClaimsDelegate")
+	proto.RegisterType((*MsgSetClaimsDelegateResponse)(nil), "evmos.claims.v1.MsgSetClaimsDelegateResponse")
+	proto.RegisterType((*MsgAcceptClaimsDelegate)(nil), "evmos.claims.v1.MsgAcceptClaimsDelegate")
+	proto.RegisterType((*MsgAcceptClaimsDelegateResponse)(nil), "evmos.claims.v1.MsgAcceptClaimsDelegateResponse")
+}
+
+func init() { proto.RegisterFile("evmos/claims/v1/tx.proto", fileDescriptor_da3f957232eaa283) }
+
+var fileDescriptor_da3f957232eaa283 = []byte{
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x26, 0x9b, 0xb6, 0x53, 0xb6, 0x55, 0x87, 0x68, 0xd7, 0xb5, 0x4a, 0x1c, 0xcc,
+	0xee, 0x92, 0x2d, 0xbb, 0x76, 0x1b, 0x6e, 0x5c, 0x50, 0x93, 0x08, 0x51, 0x69, 0xab, 0x82, 0xd9,
+	0x15, 0x12, 0x17, 0x6b, 0x6a, 0x4f, 0x5c, 0xab, 0xb5, 0xc7, 0xf2, 0x4c, 0xc2, 0xee, 0x95, 0x3b,
+	0x68, 0x25, 0x84, 0x84, 0x38, 0x71, 0x42, 0xe2, 0x9b, 0xec, 0x71, 0x25, 0x2e, 0x88, 0x43, 0x41,
+	0x29, 0x07, 0xbe, 0x03, 0x17, 0x34, 0xe3, 0x19, 0x93, 0xc4, 0x2e, 0xcd, 0x85, 0x4b, 0xeb, 0x37,
+	0xef, 0xff, 0xfe, 0xfe, 0xcd, 0x9b, 0x37, 0x0e, 0xd0, 0xf1, 0x24, 0x26, 0xd4, 0xf1, 0x2f, 0x50,
+	0x14, 0x53, 0x67, 0x72, 0xe0, 0xb0, 0xe7, 0x76, 0x9a, 0x11, 0x46, 0xe0, 0x96, 0xc8, 0xd8, 0x79,
+	0xc6, 0x9e, 0x1c, 0x18, 0xbb, 0x8b, 0x52, 0x99, 0x12, 0x72, 0xa3, 0x15, 0x92, 0x90, 0x88, 0x47,
+	0x87, 0x3f, 0xc9, 0xd5, 0xdd, 0x90, 0x90, 0xf0, 0x02, 0x3b, 0x28, 0x8d, 0x1c, 0x94, 0x24, 0x84,
+	0x21, 0x16, 0x91, 0x44, 0xd5, 0xb4, 0x65, 0x56, 0x44, 0xa7, 0xe3, 0x91, 0x13, 0x8c, 0x33, 0x21,
+	0x90, 0x79, 0x73, 0x31, 0xcf, 0xa2, 0x18, 0x53, 0x86, 0xe2, 0x34, 0x17, 0x58, 0x7f, 0xd7, 0xc1,
+	0xf6, 0x31, 0x0d, 0x07, 0x19, 0x46, 0x0c, 0x0f, 0x50, 0x9c, 0xa2, 0x28, 0x4c, 0xe0, 0x1d, 0xd0,
+	0x1c, 0x8d, 0x93, 0x00, 0x67, 0xba, 0xd6, 0xd1, 0xba, 0xeb, 0xae, 0x8c, 0x60, 0x0b, 0xdc, 0x0a,
+	0x70, 0x42, 0x62, 0x7d, 0x45, 0x2c, 0xe7, 0x01, 0x3c, 0x00, 0xab, 0xc8, 0x17, 0x54, 0x7a, 0xbd,
+	0x53, 0xef, 0x6e, 0xf6, 0xee, 0xda, 0x0b, 0x3b, 0xb7, 0x0f, 0x45, 0xde, 0x55, 0x3a, 0x38, 0x00,
+	0x80, 0x32, 0x94, 0x31, 0x8f, 0xf3, 0xe8, 0x8d, 0x8e, 0xd6, 0xdd, 0xe8, 0x19, 0x76, 0x0e, 0x6b,
+	0x2b, 0x58, 0xfb, 0xa9, 0x82, 0xed, 0xaf, 0xbd, 0xba, 0x34, 0x6b, 0x2f, 0x7f, 0x37, 0x35, 0x77,
+	0x5d, 0xd4, 0xf1, 0x0c, 0x7c, 0x06, 0x5a, 0x6a, 0xbb, 0xde, 0x38, 0x61, 0xd1, 0x85, 0x17, 0x60,
+	0x1f, 0xbd, 0xd0, 0x6f, 0x09, 0xbb, 0x9d, 0x92, 0xdd, 0x50, 0x8a, 0x73, 0xb7, 0xef, 0xb9, 0x1b,
+	0x54, 0x06, 0xcf, 0x78, 0xfd, 0x90, 0x97, 0xc3, 0x13, 0xb0, 0x5d, 0xd8, 0x92, 0x91, 0xf4, 0x6c,
+	0x2e, 0xef, 0xb9, 0xa5, 0xaa, 0x4f, 0x46, 0xb9, 0xe1, 0xa7, 0x60, 0x33, 0xef, 0x84, 0x97, 0x61,
+	0x9f, 0x64, 0x01, 0xd5, 0x57, 0x3b, 0xf5, 0xee, 0x46, 0xef, 0x5e, 0xa9, 0x4d, 0x03, 0xf1, 0xe4,
+	0x0a, 0xd5, 0x61, 0x10, 0x64, 0x98, 0xd2, 0x7e, 0x83, 0x1b, 0xbb, 0xb7, 0xfd, 0x99, 0x14, 0x85,
+	0x1f, 0x82, 0x6d, 0x7f, 0x4c, 0x19, 0x89, 0xbd, 0xbc, 0xa3, 0x5e, 0x14, 0x50, 0x7d, 0xad, 0x53,
+	0xef, 0x36, 0xfa, 0x6f, 0x4e, 0x2f, 0xcd, 0xad, 0x81, 0x48, 0xe6, 0x5d, 0x3f, 0x1a, 0x52, 0x77,
+	0xcb, 0x9f, 0x5d, 0x08, 0xe8, 0x07, 0x8d, 0xbf, 0x7e, 0x34, 0x6b, 0xd6, 0x13, 0xb0, 0x53, 0x3a,
+	0x7c, 0x17, 0xd3, 0x94, 0x24, 0x14, 0x43, 0x07, 0x6c, 0xf8, 0x72, 0xcd, 0x8b, 0x02, 0x31, 0x09,
+	0x8d, 0xfe, 0xe6, 0xf4, 0xd2, 0x04, 0x4a, 0x7a, 0x34, 0x74, 0x81, 0x92, 0x1c, 0x05, 0xd6, 0xcf,
+	0x5a, 0x3e, 0x4b, 0x9c, 0xf4, 0xf3, 0x88, 0x9d, 0x7d, 0x92, 0x11, 0x32, 0xe2, 0xb3, 0x44, 0xf1,
+	0xec, 0x2c, 0xe5, 0x11, 0xd4, 0xc1, 0x2a, 0xca, 0xb7, 0x28, 0xa7, 0x49, 0x85, 0xf0, 0x23, 0xd0,
+	0x44, 0x31, 0x19, 0x27, 0x4c, 0xaf, 0xf3, 0x44, 0xdf, 0xe6, 0x1d, 0xf8, 0xed, 0xd2, 0x7c, 0x10,
+	0x46, 0xec, 0x6c, 0x7c, 0x6a, 0xfb, 0x24, 0x76, 0x7c, 0x42, 0xc5, 0x55, 0x12, 0xff, 0x1e, 0xd3,
+	0xe0, 0xdc, 0x61, 0x2f, 0x52, 0x4c, 0xed, 0xa3, 0x84, 0xb9, 0xb2, 0x9a, 0x4f, 0x6b, 0xca, 0x11,
+	0xf4, 0x46, 0xa7, 0xce, 0xa7, 0x55, 0x04, 0x72, 0xe7, 0x18, 0xec, 0x94, 0x50, 0x8b, 0x9d, 0x7f,
+	0x0c, 0x6e, 0xcf, 0x1d, 0x98, 0x20, 0xdf, 0xe8, 0xbd, 0xf5, 0x9f, 0xe7, 0x25, 0x0f, 0xea, 0x8d,
+	0xd9, 0x83, 0xb2, 0x4e, 0xc0, 0xdd, 0x63, 0x1a, 0x3e, 0xcd, 0x50, 0x42, 0x47, 0x38, 0x9b, 0x95,
+	0x5f, 0xdb, 0x97, 0x5d, 0xb0, 0x9e, 0x61, 0x3f, 0x4a, 0x23, 0x9c, 0x30, 0xd9, 0x99, 0x7f, 0x17,
+	0xac, 0x73, 0x60, 0x5e, 0x63, 0xf8, 0x3f, 0xd0, 0x3f, 0x01, 0xad, 0x63, 0x1a, 0x7e, 0x86, 0x59,
+	0xae, 0x1c, 0xe2, 0x0b, 0x1c, 0x22, 0x86, 0xf9, 0xd1, 0x85, 0x19, 0x4a, 0x58, 0xc1, 0xae, 0x42,
+	0x68, 0x80, 0xb5, 0x40, 0xaa, 0x24, 0x7b, 0x11, 0x5b, 0x6d, 0xb0, 0x5b, 0xe5, 0xa6, 0xb8, 0xad,
+	0x50, 0xf4, 0xea, 0xd0, 0xf7, 0x71, 0xba, 0xf8, 0xc2, 0x59, 0x5b, 0x6d, 0xde, 0x76, 0x16, 0x66,
+	0x65, 0x1e, 0xe6, 0x0e, 0x68, 0x66, 0x78, 0x42, 0xce, 0xb1, 0x98, 0xa3, 0x35, 0x57, 0x46, 0xd6,
+	0xdb, 0xc0, 0xbc, 0xe6, 0x45, 0x8a, 0xa5, 0xf7, 0x5d, 0x13, 0xd4, 0x8f, 0x69, 0x08, 0xbf, 0xd6,
+	0xc0, 0xe6, 0xc2, 0xb7, 0xd1, 0x2a, 0xf5, 0xb1, 0x74, 0x85, 0x8c, 0xbd, 0x9b, 0x35, 0xc5, 0xb6,
+	0xdf, 0xfb, 0xea, 0x97, 0x3f, 0xbf, 0x5d, 0xb9, 0x6f, 0xbd, 0xe3, 0x94, 0x7f, 0x48, 0x1c, 0x5f,
+	0xd4, 0x78, 0xea, 0x92, 0xc1, 0x6f, 0x38, 0xcf, 0xfc, 0xfd, 0xaa, 0xe6, 0x99, 0xd3, 0x18, 0x7b,
+	0x37, 0x6b, 0x0a, 0x9e, 0x47, 0x82, 0xe7, 0x81, 0x75, 0xaf, 0x92, 0x87, 0x07, 0xde, 0x97, 0x11,
+	0x3b, 0xf3, 0xc4, 0x6d, 0x82, 0x3f, 0x69, 0xa0, 0x55, 0x39, 0xde, 0xdd, 0xaa, 0x57, 0x56, 0x29,
+	0x8d, 0xfd, 0x65, 0x95, 0x05, 0x62, 0x4f, 0x20, 0x3e, 0xb2, 0xf6, 0xaa, 0x10, 0x99, 0xac, 0xf4,
+	0xe6, 0x2e, 0x01, 0xfc, 0x41, 0x03, 0xdb, 0xe5, 0x49, 0xbe, 0x5f, 0xf5, 0xee, 0x92, 0xcc, 0x78,
+	0xbc, 0x94, 0xac, 0xe0, 0x73, 0x04, 0xdf, 0x43, 0xeb, 0xdd, 0x2a, 0x3e, 0x8a, 0x99, 0x42, 0x2b,
+	0x66, 0x98, 0x77, 0xb1, 0x72, 0xf0, 0x2b, 0xbb, 0x58, 0xa5, 0x34, 0xf6, 0x97, 0x55, 0x2e, 0xd7,
+	0x45, 0x24, 0x2a, 0x17, 0x41, 0xfb, 0x83, 0x57, 0xd3, 0xb6, 0xf6, 0x7a, 0xda, 0xd6, 0xfe, 0x98,
+	0xb6, 0xb5, 0x97, 0x57, 0xed, 0xda, 0xeb, 0xab, 0x76, 0xed, 0xd7, 0xab, 0x76, 0xed, 0x8b, 0x87,
+	0x33, 0x1f, 0xe7, 0xdc, 0x2f, 0xff, 0x3b, 0x39, 0xd8, 0x77, 0x9e, 0x2b, 0x6f, 0xf1, 0x8d, 0x3e,
+	0x6d, 0x8a, 0x5f, 0xcf, 0xf7, 0xff, 0x19, 0x00, 0xb0, 0x47, 0x37, 0x1d, 0x3a, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateCampaign creates an airdrop campaign funded by the sender
+	CreateCampaign(ctx context.Context, in *MsgCreateCampaign, opts ...grpc.CallOption) (*MsgCreateCampaignResponse, error)
+	// ClaimWithProof creates the claims record of a Merkle tree leaf
+	ClaimWithProof(ctx context.Context, in *MsgClaimWithProof, opts ...grpc.CallOption) (*MsgClaimWithProofResponse, error)
+	// TransferClaimsRecord transfers the claims record of the sender to the
+	// recipient
+	TransferClaimsRecord(ctx context.Context, in *MsgTransferClaimsRecord, opts ...grpc.CallOption) (*MsgTransferClaimsRecordResponse, error)
+	// SetClaimsDelegate requests the delegate that completes the actions of the
+	// claims records of the granter
+	SetClaimsDelegate(ctx context.Context, in *MsgSetClaimsDelegate, opts ...grpc.CallOption) (*MsgSetClaimsDelegateResponse, error)
+	// AcceptClaimsDelegate accepts or revokes the request of a granter to
+	// complete the actions of its claims records
+	AcceptClaimsDelegate(ctx context.Context, in *MsgAcceptClaimsDelegate, opts ...grpc.CallOption) (*MsgAcceptClaimsDelegateResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateCampaign(ctx context.Context, in *MsgCreateCampaign, opts ...grpc.CallOption) (*MsgCreateCampaignResponse, error) {
+	out := new(MsgCreateCampaignResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Msg/CreateCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimWithProof(ctx context.Context, in *MsgClaimWithProof, opts ...grpc.CallOption) (*MsgClaimWithProofResponse, error) {
+	out := new(MsgClaimWithProofResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Msg/ClaimWithProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferClaimsRecord(ctx context.Context, in *MsgTransferClaimsRecord, opts ...grpc.CallOption) (*MsgTransferClaimsRecordResponse, error) {
+	out := new(MsgTransferClaimsRecordResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Msg/TransferClaimsRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetClaimsDelegate(ctx context.Context, in *MsgSetClaimsDelegate, opts ...grpc.CallOption) (*MsgSetClaimsDelegateResponse, error) {
+	out := new(MsgSetClaimsDelegateResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Msg/SetClaimsDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptClaimsDelegate(ctx context.Context, in *MsgAcceptClaimsDelegate, opts ...grpc.CallOption) (*MsgAcceptClaimsDelegateResponse, error) {
+	out := new(MsgAcceptClaimsDelegateResponse)
+	err := c.cc.Invoke(ctx, "/evmos.claims.v1.Msg/AcceptClaimsDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateCampaign creates an airdrop campaign funded by the sender
+	CreateCampaign(context.Context, *MsgCreateCampaign) (*MsgCreateCampaignResponse, error)
+	// ClaimWithProof creates the claims record of a Merkle tree leaf
+	ClaimWithProof(context.Context, *MsgClaimWithProof) (*MsgClaimWithProofResponse, error)
+	// TransferClaimsRecord transfers the claims record of the sender to the
+	// recipient
+	TransferClaimsRecord(context.Context, *MsgTransferClaimsRecord) (*MsgTransferClaimsRecordResponse, error)
+	// SetClaimsDelegate requests the delegate that completes the actions of the
+	// claims records of the granter
+	SetClaimsDelegate(context.Context, *MsgSetClaimsDelegate) (*MsgSetClaimsDelegateResponse, error)
+	// AcceptClaimsDelegate accepts or revokes the request of a granter to
+	// complete the actions of its claims records
+	AcceptClaimsDelegate(context.Context, *MsgAcceptClaimsDelegate) (*MsgAcceptClaimsDelegateResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateCampaign(ctx context.Context, req *MsgCreateCampaign) (*MsgCreateCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCampaign not implemented")
+}
+func (*UnimplementedMsgServer) ClaimWithProof(ctx context.Context, req *MsgClaimWithProof) (*MsgClaimWithProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimWithProof not implemented")
+}
+func (*UnimplementedMsgServer) TransferClaimsRecord(ctx context.Context, req *MsgTransferClaimsRecord) (*MsgTransferClaimsRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferClaimsRecord not implemented")
+}
+func (*UnimplementedMsgServer) SetClaimsDelegate(ctx context.Context, req *MsgSetClaimsDelegate) (*MsgSetClaimsDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClaimsDelegate not implemented")
+}
+func (*UnimplementedMsgServer) AcceptClaimsDelegate(ctx context.Context, req *MsgAcceptClaimsDelegate) (*MsgAcceptClaimsDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptClaimsDelegate not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCampaign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Msg/CreateCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCampaign(ctx, req.(*MsgCreateCampaign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimWithProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Msg/ClaimWithProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimWithProof(ctx, req.(*MsgClaimWithProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferClaimsRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferClaimsRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferClaimsRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Msg/TransferClaimsRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferClaimsRecord(ctx, req.(*MsgTransferClaimsRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClaimsDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClaimsDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClaimsDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Msg/SetClaimsDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClaimsDelegate(ctx, req.(*MsgSetClaimsDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptClaimsDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptClaimsDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptClaimsDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.claims.v1.Msg/AcceptClaimsDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptClaimsDelegate(ctx, req.(*MsgAcceptClaimsDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.claims.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCampaign",
+			Handler:    _Msg_CreateCampaign_Handler,
+		},
+		{
+			MethodName: "ClaimWithProof",
+			Handler:    _Msg_ClaimWithProof_Handler,
+		},
+		{
+			MethodName: "TransferClaimsRecord",
+			Handler:    _Msg_TransferClaimsRecord_Handler,
+		},
+		{
+			MethodName: "SetClaimsDelegate",
+			Handler:    _Msg_SetClaimsDelegate_Handler,
+		},
+		{
+			MethodName: "AcceptClaimsDelegate",
+			Handler:    _Msg_AcceptClaimsDelegate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/claims/v1/tx.proto",
+}
+
+func (m *MsgCreateCampaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCampaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCampaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CustomActionIDs) > 0 {
+		dAtA2 := make([]byte, len(m.CustomActionIDs)*10)
+		var j1 int
+		for _, num := range m.CustomActionIDs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ClaimsRecords) > 0 {
+		for iNdEx := len(m.ClaimsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationOfDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DurationUntilDecay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Actions) > 0 {
+		dAtA7 := make([]byte, len(m.Actions)*10)
+		var j6 int
+		for _, num := range m.Actions {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTx(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimWithProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimWithProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimWithProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimWithProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimsRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferClaimsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferClaimsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferClaimsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferClaimsRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferClaimsRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferClaimsRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimsRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClaimsDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimsDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimsDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetClaimsDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetClaimsDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetClaimsDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptClaimsDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptClaimsDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptClaimsDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoke {
+		i--
+		if m.Revoke {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptClaimsDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptClaimsDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptClaimsDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		l = 0
+		for _, e := range m.Actions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationUntilDecay)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DurationOfDecay)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.ClaimsRecords) > 0 {
+		for _, e := range m.ClaimsRecords {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.CustomActionIDs) > 0 {
+		l = 0
+		for _, e := range m.CustomActionIDs {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCreateCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignID != 0 {
+		n += 1 + sovTx(uint64(m.CampaignID))
+	}
+	return n
+}
+
+func (m *MsgClaimWithProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimWithProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClaimsRecord.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferClaimsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferClaimsRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClaimsRecord.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetClaimsDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetClaimsDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptClaimsDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Revoke {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAcceptClaimsDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateCampaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCampaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Action
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Action(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Actions = append(m.Actions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Actions) == 0 {
+					m.Actions = make([]Action, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Action
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Action(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Actions = append(m.Actions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationUntilDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DurationUntilDecay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationOfDecay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DurationOfDecay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimsRecords = append(m.ClaimsRecords, ClaimsRecordAddress{})
+			if err := m.ClaimsRecords[len(m.ClaimsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CustomActionIDs = append(m.CustomActionIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CustomActionIDs) == 0 {
+					m.CustomActionIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CustomActionIDs = append(m.CustomActionIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomActionIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignID", wireType)
+			}
+			m.CampaignID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimWithProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimWithProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimWithProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimWithProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimWithProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimWithProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimsRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferClaimsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferClaimsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferClaimsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferClaimsRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferClaimsRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferClaimsRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimsRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimsRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetClaimsDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetClaimsDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetClaimsDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetClaimsDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetClaimsDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetClaimsDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptClaimsDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptClaimsDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptClaimsDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoke = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptClaimsDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptClaimsDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptClaimsDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,299 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/evmos/evmos/v10/x/epochs/types"
+)
+
+// flags for the epoch proposal commands
+const (
+	FlagStartTime     = "start-time"
+	FlagBlockInterval = "block-interval"
+	FlagCatchUpBlocks = "catch-up-blocks"
+)
+
+// NewAddEpochProposalCmd implements the command to submit an add-epoch
+// proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewAddEpochProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "add-epoch IDENTIFIER DURATION",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to add a new epoch",
+		Long:    "Submit a proposal to add a new epoch. The first epoch starts at the given start time, or at the block time of the proposal execution if unset or in the past. Epochs with a block interval end on height boundaries and ignore the duration.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal add-epoch month 720h --start-time=2023-02-01T00:00:00Z --from=<key_or_address>\n$ %s tx gov submit-proposal add-epoch blocks 0s --block-interval=1000 --from=<key_or_address>", version.AppName, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			var startTime time.Time
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+
+			if startTimeStr != "" {
+				startTime, err = time.Parse(time.RFC3339, startTimeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			blockInterval, err := cmd.Flags().GetInt64(FlagBlockInterval)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewAddEpochProposal(title, description, args[0], startTime, duration, blockInterval)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	cmd.Flags().String(FlagStartTime, "", "start time of the first epoch in RFC3339 format (defaults to the block time of the proposal execution)")
+	cmd.Flags().Int64(FlagBlockInterval, 0, "number of blocks of a block-height based epoch")
+	return cmd
+}
+
+// NewUpdateEpochDurationProposalCmd implements the command to submit an
+// update-epoch-duration proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewUpdateEpochDurationProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-epoch-duration IDENTIFIER DURATION",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to change the duration of an epoch",
+		Long:    "Submit a proposal to change the duration of an epoch. The current epoch keeps its duration and the new duration applies from the next epoch onwards.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-epoch-duration day 12h --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateEpochDurationProposal(title, description, args[0], duration)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewSetEpochPausedProposalCmd implements the command to submit a
+// set-epoch-paused proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewSetEpochPausedProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-epoch-paused IDENTIFIER PAUSED",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to pause or resume an epoch",
+		Long:    "Submit a proposal to pause (true) or resume (false) an epoch. Paused epochs neither start nor end. A resumed epoch restarts its current epoch at the block time of the proposal execution.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal set-epoch-paused week true --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewSetEpochPausedProposal(title, description, args[0], paused)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewSetEpochCatchUpPolicyProposalCmd implements the command to submit a
+// set-epoch-catch-up-policy proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewSetEpochCatchUpPolicyProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-epoch-catch-up-policy IDENTIFIER POLICY",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to change the catch-up policy of an epoch",
+		Long: `Submit a proposal to change how an epoch catches up with the block time after a chain halt. The policy is one of:
+  all:    end one missed epoch per block
+  skip:   skip to the current epoch with a single epoch end
+  spread: end the missed epochs over --catch-up-blocks blocks`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal set-epoch-catch-up-policy day spread --catch-up-blocks=100 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseCatchUpPolicy(args[1])
+			if err != nil {
+				return err
+			}
+
+			catchUpBlocks, err := cmd.Flags().GetUint64(FlagCatchUpBlocks)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewSetEpochCatchUpPolicyProposal(title, description, args[0], policy, catchUpBlocks)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagCatchUpBlocks, 0, "number of blocks over which the missed epochs are spread")
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// parseCatchUpPolicy parses a catch-up policy from its short (e.g. "skip") or
+// full (e.g. "CATCH_UP_POLICY_SKIP") name
+func parseCatchUpPolicy(policy string) (types.CatchUpPolicy, error) {
+	name := strings.ToUpper(strings.TrimSpace(policy))
+	if !strings.HasPrefix(name, "CATCH_UP_POLICY_") {
+		name = "CATCH_UP_POLICY_" + name
+	}
+
+	value, ok := types.CatchUpPolicy_value[name]
+	if !ok {
+		return types.CatchUpPolicyAll, fmt.Errorf("invalid catch-up policy: %s", policy)
+	}
+	return types.CatchUpPolicy(value), nil
+}
+
+//nolint:staticcheck // we use deprecated flags
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
+
+//nolint:staticcheck // we use deprecated flags
+func parseProposalFlags(cmd *cobra.Command) (title, description string, deposit sdk.Coins, err error) {
+	title, err = cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	description, err = cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return title, description, deposit, nil
+}
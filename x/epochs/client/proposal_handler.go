@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/evmos/evmos/v10/x/epochs/client/cli"
+)
+
+var (
+	AddEpochProposalHandler              = govclient.NewProposalHandler(cli.NewAddEpochProposalCmd)
+	UpdateEpochDurationProposalHandler   = govclient.NewProposalHandler(cli.NewUpdateEpochDurationProposalCmd)
+	SetEpochPausedProposalHandler        = govclient.NewProposalHandler(cli.NewSetEpochPausedProposalCmd)
+	SetEpochCatchUpPolicyProposalHandler = govclient.NewProposalHandler(cli.NewSetEpochCatchUpPolicyProposalCmd)
+)
@@ -17,11 +17,22 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 	logger := k.Logger(ctx)
 
 	k.IterateEpochInfo(ctx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
+		// Paused epochs neither start nor end until they are resumed
+		if epochInfo.Paused {
+			return false
+		}
+
 		// Has it not started, and is the block time > initial epoch start time
 		shouldInitialEpochStart := !epochInfo.EpochCountingStarted && !epochInfo.StartTime.After(ctx.BlockTime())
 
-		epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
-		shouldEpochEnd := ctx.BlockTime().After(epochEndTime) && !shouldInitialEpochStart && !epochInfo.StartTime.After(ctx.BlockTime())
+		var shouldEpochEnd bool
+		if epochInfo.IsBlockBased() {
+			// Block-height based epochs end on height boundaries
+			shouldEpochEnd = epochInfo.EpochCountingStarted && ctx.BlockHeight() >= epochInfo.EndHeight()
+		} else {
+			epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+			shouldEpochEnd = ctx.BlockTime().After(epochEndTime) && !shouldInitialEpochStart && !epochInfo.StartTime.After(ctx.BlockTime())
+		}
 
 		epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
 
@@ -30,35 +41,99 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 			epochInfo.StartInitialEpoch()
 
 			logger.Info("starting epoch", "identifier", epochInfo.Identifier)
+
+			k.startEpoch(ctx, epochInfo)
+		case shouldEpochEnd && epochInfo.IsBlockBased():
+			// Block-height based epochs can't miss epochs
+			k.endEpoch(ctx, &epochInfo, 0)
 		case shouldEpochEnd:
-			epochInfo.EndEpoch()
-
-			logger.Info("ending epoch", "identifier", epochInfo.Identifier)
-
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeEpochEnd,
-					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
-				),
-			)
-			k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
-		default:
-			// continue
-			return false
+			k.catchUpEpochs(ctx, epochInfo)
 		}
 
-		k.SetEpochInfo(ctx, epochInfo)
+		return false
+	})
+}
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeEpochStart,
-				sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
-				sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epochInfo.CurrentEpochStartTime.Unix(), 10)),
-			),
-		)
+// catchUpEpochs ends the current epoch and, depending on the catch-up policy,
+// the epochs that ended since the last block:
+//   - CatchUpPolicyAll ends a single epoch per block
+//   - CatchUpPolicySkip ends all of them with a single AfterEpochEnd hook call
+//   - CatchUpPolicySpread ends them one by one over CatchUpBlocks blocks
+func (k Keeper) catchUpEpochs(ctx sdk.Context, epochInfo types.EpochInfo) {
+	switch epochInfo.CatchUpPolicy {
+	case types.CatchUpPolicySkip:
+		missedEpochs := epochInfo.EndedEpochs(ctx.BlockTime()) - 1
+		k.endEpoch(ctx, &epochInfo, missedEpochs)
+	case types.CatchUpPolicySpread:
+		endedEpochs := epochInfo.EndedEpochs(ctx.BlockTime())
+		if endedEpochs > 1 && epochInfo.CatchUpEpochsPerBlock == 0 {
+			// fix the rate at the start of the catch-up so that it completes
+			// within CatchUpBlocks blocks
+			blocks := int64(epochInfo.CatchUpBlocks)
+			epochInfo.CatchUpEpochsPerBlock = (endedEpochs + blocks - 1) / blocks
+		}
 
-		k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+		k.endEpoch(ctx, &epochInfo, 0)
+		for i := int64(1); i < epochInfo.CatchUpEpochsPerBlock && epochInfo.EndedEpochs(ctx.BlockTime()) > 0; i++ {
+			k.endEpoch(ctx, &epochInfo, 0)
+		}
 
-		return false
-	})
+		if epochInfo.CatchUpEpochsPerBlock > 0 && epochInfo.EndedEpochs(ctx.BlockTime()) == 0 {
+			epochInfo.CatchUpEpochsPerBlock = 0
+			k.SetEpochInfo(ctx, epochInfo)
+		}
+	default:
+		k.endEpoch(ctx, &epochInfo, 0)
+	}
+}
+
+// endEpoch ends the current epoch together with the missed epochs that follow
+// it and starts the next one
+func (k Keeper) endEpoch(ctx sdk.Context, epochInfo *types.EpochInfo, missedEpochs int64) {
+	logger := k.Logger(ctx)
+
+	epochInfo.EndEpochs(missedEpochs)
+	if epochInfo.IsBlockBased() {
+		// the next block-height based epoch starts with the block that ends
+		// the current one
+		epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+	}
+
+	logger.Info("ending epoch", "identifier", epochInfo.Identifier, "missed-epochs", missedEpochs)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+			sdk.NewAttribute(types.AttributeEpochMissedEpochs, strconv.FormatInt(missedEpochs, 10)),
+		),
+	)
+	k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch, missedEpochs)
+
+	// Apply the duration change from the epoch that is starting
+	if epochInfo.NextDuration > 0 {
+		oldDuration := epochInfo.Duration
+		epochInfo.ApplyNextDuration()
+
+		logger.Info("changing epoch duration", "identifier", epochInfo.Identifier, "duration", epochInfo.Duration)
+
+		k.AfterEpochDurationChange(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch, oldDuration, epochInfo.Duration)
+	}
+
+	k.startEpoch(ctx, *epochInfo)
+}
+
+// startEpoch stores the epoch info of the epoch that is starting
+func (k Keeper) startEpoch(ctx sdk.Context, epochInfo types.EpochInfo) {
+	k.SetEpochInfo(ctx, epochInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochStart,
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+			sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epochInfo.CurrentEpochStartTime.Unix(), 10)),
+		),
+	)
+
+	k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/evmos/evmos/v10/x/epochs"
 	"github.com/evmos/evmos/v10/x/epochs/types"
 )
@@ -212,3 +214,93 @@ func (suite *KeeperTestSuite) TestEpochStartingOneMonthAfterInitGenesis() {
 	suite.Require().Equal(epochInfo.CurrentEpochStartTime.UTC().String(), now.Add(month).UTC().String())
 	suite.Require().Equal(epochInfo.EpochCountingStarted, true)
 }
+
+func (suite *KeeperTestSuite) TestEpochCatchUpPolicies() {
+	testCases := []struct {
+		name          string
+		policy        types.CatchUpPolicy
+		catchUpBlocks uint64
+		// expEpochs is the current epoch after each block
+		expEpochs []int64
+		// expEpochEnds is the number of epochs ended in the first block
+		expEpochEnds int
+	}{
+		{"all - one epoch per block", types.CatchUpPolicyAll, 0, []int64{2, 3, 4}, 1},
+		{"skip - single epoch end", types.CatchUpPolicySkip, 0, []int64{11, 11, 11}, 1},
+		{"spread - over 3 blocks", types.CatchUpPolicySpread, 3, []int64{5, 9, 11, 11}, 4},
+		{"spread - over more blocks than missed epochs", types.CatchUpPolicySpread, 20, []int64{2, 3, 4}, 1},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			now := suite.ctx.BlockTime()
+			_, err := suite.app.EpochsKeeper.AddEpochInfo(suite.ctx, "hourly", now, time.Hour, 0)
+			suite.Require().NoError(err)
+			_, err = suite.app.EpochsKeeper.SetEpochCatchUpPolicy(suite.ctx, "hourly", tc.policy, tc.catchUpBlocks)
+			suite.Require().NoError(err)
+			suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+
+			// the chain halts for 10 epochs
+			blockTime := now.Add(10*time.Hour + time.Second)
+			for i, expEpoch := range tc.expEpochs {
+				suite.ctx = suite.ctx.WithBlockHeight(int64(i + 2)).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+				suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+
+				epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "hourly")
+				suite.Require().True(found)
+				suite.Require().Equal(expEpoch, epochInfo.CurrentEpoch, "block %d", i)
+				suite.Require().Equal(now.Add(time.Duration(expEpoch-1)*time.Hour), epochInfo.CurrentEpochStartTime)
+
+				if i == 0 {
+					epochEnds := 0
+					for _, event := range suite.ctx.EventManager().Events() {
+						if event.Type == types.EventTypeEpochEnd {
+							epochEnds++
+						}
+					}
+					suite.Require().Equal(tc.expEpochEnds, epochEnds)
+				}
+				if expEpoch == 11 {
+					suite.Require().Zero(epochInfo.CatchUpEpochsPerBlock)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBlockBasedEpochBeginBlocker() {
+	suite.SetupTest()
+
+	now := suite.ctx.BlockTime()
+	epochInfo, err := suite.app.EpochsKeeper.AddEpochInfo(suite.ctx, "blocks", now, time.Hour, 10)
+	suite.Require().NoError(err)
+	suite.Require().Zero(epochInfo.Duration)
+
+	// the epoch starts at the start time
+	suite.ctx = suite.ctx.WithBlockHeight(5)
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "blocks")
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+	suite.Require().Equal(int64(15), epochInfo.EndHeight())
+
+	// block times don't end the epoch
+	suite.ctx = suite.ctx.WithBlockHeight(14).WithBlockTime(now.Add(time.Hour * 24 * 365))
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "blocks")
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+
+	// the epoch ends at the height boundary
+	suite.ctx = suite.ctx.WithBlockHeight(15)
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "blocks")
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch)
+	suite.Require().Equal(int64(15), epochInfo.CurrentEpochStartHeight)
+	suite.Require().Equal(suite.ctx.BlockTime(), epochInfo.CurrentEpochStartTime)
+	suite.Require().Equal(int64(25), epochInfo.EndHeight())
+
+	// the duration of block-height based epochs can't be changed
+	_, err = suite.app.EpochsKeeper.UpdateEpochDuration(suite.ctx, "blocks", time.Hour)
+	suite.Require().ErrorIs(err, types.ErrBlockBasedEpoch)
+}
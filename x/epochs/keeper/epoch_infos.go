@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -64,3 +67,136 @@ func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
 	})
 	return epochs
 }
+
+// AddEpochInfo adds a new epoch that starts at the given start time, or at the
+// current block time if the start time is unset or in the past. The epoch is
+// block-height based if the block interval is positive, in which case the
+// duration is ignored.
+func (k Keeper) AddEpochInfo(
+	ctx sdk.Context,
+	identifier string,
+	startTime time.Time,
+	duration time.Duration,
+	blockInterval int64,
+) (types.EpochInfo, error) {
+	if _, found := k.GetEpochInfo(ctx, identifier); found {
+		return types.EpochInfo{}, errorsmod.Wrapf(types.ErrEpochAlreadyExists, "identifier %s", identifier)
+	}
+
+	if startTime.IsZero() || startTime.Before(ctx.BlockTime()) {
+		startTime = ctx.BlockTime()
+	}
+
+	epochInfo := types.EpochInfo{
+		Identifier:              identifier,
+		StartTime:               startTime,
+		Duration:                duration,
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: ctx.BlockHeight(),
+		CurrentEpochStartTime:   time.Time{},
+		EpochCountingStarted:    false,
+		BlockInterval:           blockInterval,
+	}
+
+	if epochInfo.IsBlockBased() {
+		epochInfo.Duration = 0
+	}
+
+	if err := epochInfo.Validate(); err != nil {
+		return types.EpochInfo{}, err
+	}
+
+	k.SetEpochInfo(ctx, epochInfo)
+	return epochInfo, nil
+}
+
+// UpdateEpochDuration changes the duration of an epoch from the next epoch
+// onwards. The duration of an epoch whose counting hasn't started yet is
+// changed immediately.
+func (k Keeper) UpdateEpochDuration(
+	ctx sdk.Context,
+	identifier string,
+	duration time.Duration,
+) (types.EpochInfo, error) {
+	epochInfo, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return types.EpochInfo{}, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", identifier)
+	}
+
+	if epochInfo.IsBlockBased() {
+		return types.EpochInfo{}, errorsmod.Wrapf(types.ErrBlockBasedEpoch, "identifier %s", identifier)
+	}
+
+	switch {
+	case !epochInfo.EpochCountingStarted:
+		oldDuration := epochInfo.Duration
+		epochInfo.Duration = duration
+		epochInfo.NextDuration = 0
+		k.SetEpochInfo(ctx, epochInfo)
+
+		if oldDuration != duration {
+			k.AfterEpochDurationChange(ctx, identifier, epochInfo.CurrentEpoch, oldDuration, duration)
+		}
+		return epochInfo, nil
+	case duration == epochInfo.Duration:
+		// cancel any pending change
+		epochInfo.NextDuration = 0
+	default:
+		epochInfo.NextDuration = duration
+	}
+
+	k.SetEpochInfo(ctx, epochInfo)
+	return epochInfo, nil
+}
+
+// SetEpochPaused pauses or resumes an epoch. A resumed epoch restarts its
+// current epoch at the current block time, so that the time elapsed while
+// paused isn't counted.
+func (k Keeper) SetEpochPaused(
+	ctx sdk.Context,
+	identifier string,
+	paused bool,
+) (types.EpochInfo, error) {
+	epochInfo, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return types.EpochInfo{}, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", identifier)
+	}
+
+	if epochInfo.Paused == paused {
+		return epochInfo, nil
+	}
+
+	epochInfo.Paused = paused
+	if !paused && epochInfo.EpochCountingStarted {
+		epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+		epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
+	}
+
+	k.SetEpochInfo(ctx, epochInfo)
+	return epochInfo, nil
+}
+
+// SetEpochCatchUpPolicy changes the catch-up policy of an epoch. An ongoing
+// spread catch-up is restarted with the new policy.
+func (k Keeper) SetEpochCatchUpPolicy(
+	ctx sdk.Context,
+	identifier string,
+	policy types.CatchUpPolicy,
+	catchUpBlocks uint64,
+) (types.EpochInfo, error) {
+	epochInfo, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return types.EpochInfo{}, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", identifier)
+	}
+
+	epochInfo.CatchUpPolicy = policy
+	epochInfo.CatchUpBlocks = catchUpBlocks
+	epochInfo.CatchUpEpochsPerBlock = 0
+
+	if err := epochInfo.Validate(); err != nil {
+		return types.EpochInfo{}, err
+	}
+
+	k.SetEpochInfo(ctx, epochInfo)
+	return epochInfo, nil
+}
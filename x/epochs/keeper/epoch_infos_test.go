@@ -28,3 +28,132 @@ func (suite *KeeperTestSuite) TestEpochLifeCycle() {
 	suite.Require().Equal(allEpochs[1].Identifier, "monthly")
 	suite.Require().Equal(allEpochs[2].Identifier, types.WeekEpochID)
 }
+
+func (suite *KeeperTestSuite) TestAddEpochInfo() {
+	suite.SetupTest()
+
+	_, err := suite.app.EpochsKeeper.AddEpochInfo(suite.ctx, types.DayEpochID, time.Time{}, time.Hour, 0)
+	suite.Require().ErrorIs(err, types.ErrEpochAlreadyExists)
+
+	// start time in the past defaults to the block time
+	epochInfo, err := suite.app.EpochsKeeper.AddEpochInfo(suite.ctx, "monthly", suite.ctx.BlockTime().Add(-time.Hour), time.Hour*24*30, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.BlockTime(), epochInfo.StartTime)
+	suite.Require().False(epochInfo.EpochCountingStarted)
+
+	epochInfoSaved, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "monthly")
+	suite.Require().True(found)
+	suite.Require().Equal(epochInfo, epochInfoSaved)
+
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "monthly")
+	suite.Require().True(epochInfo.EpochCountingStarted)
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+}
+
+func (suite *KeeperTestSuite) TestUpdateEpochDuration() {
+	suite.SetupTest()
+
+	_, err := suite.app.EpochsKeeper.UpdateEpochDuration(suite.ctx, "monthly", time.Hour)
+	suite.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	now := suite.ctx.BlockTime()
+
+	// the duration of an epoch that hasn't started is changed immediately
+	_, err = suite.app.EpochsKeeper.AddEpochInfo(suite.ctx, "monthly", now.Add(time.Hour), time.Hour*24*30, 0)
+	suite.Require().NoError(err)
+	epochInfo, err := suite.app.EpochsKeeper.UpdateEpochDuration(suite.ctx, "monthly", time.Hour*24*31)
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Hour*24*31, epochInfo.Duration)
+	suite.Require().Zero(epochInfo.NextDuration)
+
+	// start the epoch
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Hour))
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	startTime := now.Add(time.Hour)
+
+	// the current epoch keeps its duration
+	epochInfo, err = suite.app.EpochsKeeper.UpdateEpochDuration(suite.ctx, "monthly", time.Hour*24)
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Hour*24*31, epochInfo.Duration)
+	suite.Require().Equal(time.Hour*24, epochInfo.NextDuration)
+
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Hour * 24 * 2))
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "monthly")
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+
+	// the new duration applies from the next epoch onwards
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Hour*24*31 + time.Second))
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "monthly")
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch)
+	suite.Require().Equal(startTime.Add(time.Hour*24*31), epochInfo.CurrentEpochStartTime)
+	suite.Require().Equal(time.Hour*24, epochInfo.Duration)
+	suite.Require().Zero(epochInfo.NextDuration)
+
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(time.Hour*24*32 + time.Second))
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "monthly")
+	suite.Require().Equal(int64(3), epochInfo.CurrentEpoch)
+}
+
+func (suite *KeeperTestSuite) TestSetEpochPaused() {
+	suite.SetupTest()
+
+	_, err := suite.app.EpochsKeeper.SetEpochPaused(suite.ctx, "monthly", true)
+	suite.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	now := suite.ctx.BlockTime()
+	_, err = suite.app.EpochsKeeper.AddEpochInfo(suite.ctx, "monthly", now, time.Hour*24*30, 0)
+	suite.Require().NoError(err)
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+
+	epochInfo, err := suite.app.EpochsKeeper.SetEpochPaused(suite.ctx, "monthly", true)
+	suite.Require().NoError(err)
+	suite.Require().True(epochInfo.Paused)
+
+	// paused epochs don't end
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Hour * 24 * 60)).WithBlockHeight(10)
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "monthly")
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+
+	// resumed epochs restart the current epoch
+	epochInfo, err = suite.app.EpochsKeeper.SetEpochPaused(suite.ctx, "monthly", false)
+	suite.Require().NoError(err)
+	suite.Require().False(epochInfo.Paused)
+	suite.Require().Equal(suite.ctx.BlockTime(), epochInfo.CurrentEpochStartTime)
+	suite.Require().Equal(int64(10), epochInfo.CurrentEpochStartHeight)
+
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Hour * 24 * 61))
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "monthly")
+	suite.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+
+	suite.ctx = suite.ctx.WithBlockTime(now.Add(time.Hour*24*90 + time.Second))
+	suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+	epochInfo, _ = suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "monthly")
+	suite.Require().Equal(int64(2), epochInfo.CurrentEpoch)
+}
+
+func (suite *KeeperTestSuite) TestSetEpochCatchUpPolicy() {
+	suite.SetupTest()
+
+	_, err := suite.app.EpochsKeeper.SetEpochCatchUpPolicy(suite.ctx, "monthly", types.CatchUpPolicySkip, 0)
+	suite.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	_, err = suite.app.EpochsKeeper.AddEpochInfo(suite.ctx, "monthly", suite.ctx.BlockTime(), time.Hour*24*30, 0)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.EpochsKeeper.SetEpochCatchUpPolicy(suite.ctx, "monthly", types.CatchUpPolicySpread, 0)
+	suite.Require().Error(err)
+
+	epochInfo, err := suite.app.EpochsKeeper.SetEpochCatchUpPolicy(suite.ctx, "monthly", types.CatchUpPolicySpread, 10)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.CatchUpPolicySpread, epochInfo.CatchUpPolicy)
+	suite.Require().Equal(uint64(10), epochInfo.CatchUpBlocks)
+
+	stored, _ := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, "monthly")
+	suite.Require().Equal(epochInfo, stored)
+}
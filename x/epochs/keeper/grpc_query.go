@@ -55,6 +55,10 @@ func (k Keeper) CurrentEpoch(
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	if err := types.ValidateEpochIdentifierString(req.Identifier); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
 	info, found := k.GetEpochInfo(ctx, req.Identifier)
@@ -64,5 +68,6 @@ func (k Keeper) CurrentEpoch(
 
 	return &types.QueryCurrentEpochResponse{
 		CurrentEpoch: info.CurrentEpoch,
+		EndHeight:    info.EndHeight(),
 	}, nil
 }
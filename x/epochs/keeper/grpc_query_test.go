@@ -174,6 +174,28 @@ func (suite *KeeperTestSuite) TestCurrentEpoch() {
 			},
 			true,
 		},
+		{
+			"blank identifier",
+			func() {
+				req = &types.QueryCurrentEpochRequest{Identifier: " "}
+			},
+			false,
+		},
+		{
+			"block-height based epoch - end height",
+			func() {
+				_, err := suite.app.EpochsKeeper.AddEpochInfo(suite.ctx, "blocks", suite.ctx.BlockTime(), 0, 100)
+				suite.Require().NoError(err)
+				suite.app.EpochsKeeper.BeginBlocker(suite.ctx)
+
+				req = &types.QueryCurrentEpochRequest{Identifier: "blocks"}
+				expRes = &types.QueryCurrentEpochResponse{
+					CurrentEpoch: 1,
+					EndHeight:    suite.ctx.BlockHeight() + 100,
+				}
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
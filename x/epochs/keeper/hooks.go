@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v10/x/epochs/types"
 )
@@ -15,10 +17,11 @@ func NewMultiEpochHooks(hooks ...types.EpochHooks) MultiEpochHooks {
 }
 
 // AfterEpochEnd is called when epoch is going to be ended, epochNumber is the
-// number of epoch that is ending
-func (mh MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+// number of epoch that is ending and missedEpochs the number of epochs that
+// were skipped by the catch-up policy of the epoch
+func (mh MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber, missedEpochs int64) {
 	for i := range mh {
-		mh[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+		mh[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber, missedEpochs)
 	}
 }
 
@@ -30,12 +33,36 @@ func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier stri
 	}
 }
 
+// AfterEpochDurationChange is called when the duration of an epoch is changed,
+// epochNumber is the number of the first epoch with the new duration
+func (mh MultiEpochHooks) AfterEpochDurationChange(
+	ctx sdk.Context,
+	epochIdentifier string,
+	epochNumber int64,
+	oldDuration, newDuration time.Duration,
+) {
+	for i := range mh {
+		mh[i].AfterEpochDurationChange(ctx, epochIdentifier, epochNumber, oldDuration, newDuration)
+	}
+}
+
 // AfterEpochEnd executes the indicated hook after epochs ends
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber, missedEpochs int64) {
+	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber, missedEpochs)
 }
 
 // BeforeEpochStart executes the indicated hook before the epochs
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
 	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
 }
+
+// AfterEpochDurationChange executes the indicated hook after the duration of
+// an epoch changes
+func (k Keeper) AfterEpochDurationChange(
+	ctx sdk.Context,
+	identifier string,
+	epochNumber int64,
+	oldDuration, newDuration time.Duration,
+) {
+	k.hooks.AfterEpochDurationChange(ctx, identifier, epochNumber, oldDuration, newDuration)
+}
@@ -0,0 +1,114 @@
+package epochs
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/evmos/evmos/v10/x/epochs/keeper"
+	"github.com/evmos/evmos/v10/x/epochs/types"
+)
+
+// NewEpochsProposalHandler creates a governance handler to manage new
+// proposal types.
+func NewEpochsProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		switch c := content.(type) {
+		case *types.AddEpochProposal:
+			return handleAddEpochProposal(ctx, k, c)
+		case *types.UpdateEpochDurationProposal:
+			return handleUpdateEpochDurationProposal(ctx, k, c)
+		case *types.SetEpochPausedProposal:
+			return handleSetEpochPausedProposal(ctx, k, c)
+		case *types.SetEpochCatchUpPolicyProposal:
+			return handleSetEpochCatchUpPolicyProposal(ctx, k, c)
+		default:
+			return errorsmod.Wrapf(
+				errortypes.ErrUnknownRequest,
+				"unrecognized %s proposal content type: %T", types.ModuleName, c,
+			)
+		}
+	}
+}
+
+func handleAddEpochProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.AddEpochProposal,
+) error {
+	epochInfo, err := k.AddEpochInfo(ctx, p.Identifier, p.StartTime, p.Duration, p.BlockInterval)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddEpoch,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+			sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epochInfo.StartTime.Unix(), 10)),
+			sdk.NewAttribute(types.AttributeEpochDuration, epochInfo.Duration.String()),
+			sdk.NewAttribute(types.AttributeEpochBlockInterval, strconv.FormatInt(epochInfo.BlockInterval, 10)),
+		),
+	)
+	return nil
+}
+
+func handleUpdateEpochDurationProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.UpdateEpochDurationProposal,
+) error {
+	epochInfo, err := k.UpdateEpochDuration(ctx, p.Identifier, p.Duration)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateEpochDuration,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+			sdk.NewAttribute(types.AttributeEpochDuration, p.Duration.String()),
+		),
+	)
+	return nil
+}
+
+func handleSetEpochPausedProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.SetEpochPausedProposal,
+) error {
+	epochInfo, err := k.SetEpochPaused(ctx, p.Identifier, p.Paused)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetEpochPaused,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+			sdk.NewAttribute(types.AttributeEpochPaused, strconv.FormatBool(epochInfo.Paused)),
+		),
+	)
+	return nil
+}
+
+func handleSetEpochCatchUpPolicyProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.SetEpochCatchUpPolicyProposal,
+) error {
+	epochInfo, err := k.SetEpochCatchUpPolicy(ctx, p.Identifier, p.CatchUpPolicy, p.CatchUpBlocks)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetEpochCatchUp,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+			sdk.NewAttribute(types.AttributeEpochCatchUpPolicy, epochInfo.CatchUpPolicy.String()),
+			sdk.NewAttribute(types.AttributeEpochCatchUpBlocks, strconv.FormatUint(epochInfo.CatchUpBlocks, 10)),
+		),
+	)
+	return nil
+}
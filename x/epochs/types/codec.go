@@ -3,6 +3,18 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// RegisterInterfaces register implementations
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govv1beta1.Content)(nil),
+		&AddEpochProposal{},
+		&UpdateEpochDurationProposal{},
+		&SetEpochPausedProposal{},
+		&SetEpochCatchUpPolicyProposal{},
+	)
+}
@@ -3,7 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 )
 
 // StartInitialEpoch sets the epoch info fields to their start values
@@ -15,16 +15,58 @@ func (ei *EpochInfo) StartInitialEpoch() {
 
 // EndEpoch increments the epoch counter and resets the epoch start time
 func (ei *EpochInfo) EndEpoch() {
-	ei.CurrentEpoch++
-	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(ei.Duration)
+	ei.EndEpochs(0)
+}
+
+// EndEpochs ends the current epoch together with the given number of missed
+// epochs that follow it
+func (ei *EpochInfo) EndEpochs(missedEpochs int64) {
+	ei.CurrentEpoch += 1 + missedEpochs
+	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(time.Duration(1+missedEpochs) * ei.Duration)
+}
+
+// IsBlockBased returns true if the epoch ends on height boundaries instead of
+// after its duration
+func (ei EpochInfo) IsBlockBased() bool {
+	return ei.BlockInterval > 0
+}
+
+// EndHeight returns the height at which the current epoch of a block-height
+// based epoch ends. It returns 0 for time based epochs.
+func (ei EpochInfo) EndHeight() int64 {
+	if !ei.IsBlockBased() {
+		return 0
+	}
+	return ei.CurrentEpochStartHeight + ei.BlockInterval
+}
+
+// EndedEpochs returns the number of epochs, starting with the current one,
+// that ended before the given block time
+func (ei EpochInfo) EndedEpochs(blockTime time.Time) int64 {
+	elapsed := blockTime.Sub(ei.CurrentEpochStartTime)
+	if elapsed <= 0 || ei.Duration <= 0 {
+		return 0
+	}
+	// an epoch ends on the first block after its end time
+	return int64((elapsed - 1) / ei.Duration)
+}
+
+// ApplyNextDuration sets the epoch duration to the duration change that is
+// pending
+func (ei *EpochInfo) ApplyNextDuration() {
+	ei.Duration = ei.NextDuration
+	ei.NextDuration = 0
 }
 
 // Validate performs a stateless validation of the epoch info fields
 func (ei EpochInfo) Validate() error {
-	if strings.TrimSpace(ei.Identifier) == "" {
-		return errors.New("epoch identifier cannot be blank")
+	if err := ValidateEpochIdentifierString(ei.Identifier); err != nil {
+		return err
+	}
+	if ei.BlockInterval < 0 {
+		return fmt.Errorf("epoch block interval cannot be negative: %d", ei.BlockInterval)
 	}
-	if ei.Duration == 0 {
+	if ei.Duration == 0 && !ei.IsBlockBased() {
 		return errors.New("epoch duration cannot be 0")
 	}
 	if ei.CurrentEpoch < 0 {
@@ -33,5 +75,26 @@ func (ei EpochInfo) Validate() error {
 	if ei.CurrentEpochStartHeight < 0 {
 		return fmt.Errorf("current epoch start height cannot be negative: %d", ei.CurrentEpochStartHeight)
 	}
+	if ei.NextDuration < 0 {
+		return fmt.Errorf("next epoch duration cannot be negative: %s", ei.NextDuration)
+	}
+	if err := ValidateCatchUpPolicy(ei.CatchUpPolicy, ei.CatchUpBlocks); err != nil {
+		return err
+	}
+	if ei.CatchUpEpochsPerBlock < 0 {
+		return fmt.Errorf("catch-up epochs per block cannot be negative: %d", ei.CatchUpEpochsPerBlock)
+	}
+	return nil
+}
+
+// ValidateCatchUpPolicy checks that the catch-up policy is defined and that
+// the missed epochs of a spread catch-up are spread over at least one block
+func ValidateCatchUpPolicy(policy CatchUpPolicy, catchUpBlocks uint64) error {
+	if _, ok := CatchUpPolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("invalid catch-up policy: %d", policy)
+	}
+	if policy == CatchUpPolicySpread && catchUpBlocks == 0 {
+		return errors.New("catch-up blocks cannot be 0 with a spread catch-up policy")
+	}
 	return nil
 }
@@ -46,6 +46,12 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				false,
+				CatchUpPolicyAll,
+				0,
+				0,
+				0,
 			},
 			false,
 		},
@@ -59,6 +65,12 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				false,
+				CatchUpPolicyAll,
+				0,
+				0,
+				0,
 			},
 			false,
 		},
@@ -72,6 +84,12 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				false,
+				CatchUpPolicyAll,
+				0,
+				0,
+				0,
 			},
 			false,
 		},
@@ -85,9 +103,129 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				-1,
+				0,
+				false,
+				CatchUpPolicyAll,
+				0,
+				0,
+				0,
 			},
 			false,
 		},
+		{
+			"invalid - unknown catch-up policy",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				false,
+				CatchUpPolicy(3),
+				0,
+				0,
+				0,
+			},
+			false,
+		},
+		{
+			"invalid - spread catch-up without blocks",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				false,
+				CatchUpPolicySpread,
+				0,
+				0,
+				0,
+			},
+			false,
+		},
+		{
+			"invalid - negative catch-up epochs per block",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				false,
+				CatchUpPolicySpread,
+				10,
+				-1,
+				0,
+			},
+			false,
+		},
+		{
+			"pass - spread catch-up",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				false,
+				CatchUpPolicySpread,
+				10,
+				0,
+				0,
+			},
+			true,
+		},
+		{
+			"invalid - negative block interval",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				false,
+				CatchUpPolicyAll,
+				0,
+				0,
+				-1,
+			},
+			false,
+		},
+		{
+			"pass - block-height based epoch without duration",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				false,
+				CatchUpPolicyAll,
+				0,
+				0,
+				100,
+			},
+			true,
+		},
 		{
 			"pass",
 			EpochInfo{
@@ -98,6 +236,12 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				false,
+				CatchUpPolicyAll,
+				0,
+				0,
+				0,
 			},
 			true,
 		},
@@ -113,3 +257,54 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 		}
 	}
 }
+
+func (suite *EpochInfoTestSuite) TestApplyNextDuration() {
+	ei := EpochInfo{Duration: time.Hour * 24, NextDuration: time.Hour * 12}
+
+	ei.ApplyNextDuration()
+	suite.Require().Equal(time.Hour*12, ei.Duration)
+	suite.Require().Zero(ei.NextDuration)
+}
+
+func (suite *EpochInfoTestSuite) TestEndedEpochs() {
+	startTime := time.Now()
+	duration := time.Hour
+	ei := EpochInfo{CurrentEpochStartTime: startTime, Duration: duration}
+
+	testCases := []struct {
+		name      string
+		blockTime time.Time
+		expEnded  int64
+	}{
+		{"before start", startTime.Add(-time.Second), 0},
+		{"within epoch", startTime.Add(time.Minute), 0},
+		{"exactly at the end", startTime.Add(duration), 0},
+		{"after the end", startTime.Add(duration + time.Nanosecond), 1},
+		{"exactly at the end of the third epoch", startTime.Add(3 * duration), 2},
+		{"after the end of the third epoch", startTime.Add(3*duration + time.Second), 3},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expEnded, ei.EndedEpochs(tc.blockTime), tc.name)
+	}
+}
+
+func (suite *EpochInfoTestSuite) TestEndEpochs() {
+	startTime := time.Now()
+	duration := time.Hour
+	ei := EpochInfo{CurrentEpoch: 1, CurrentEpochStartTime: startTime, Duration: duration}
+
+	ei.EndEpochs(2)
+	suite.Require().Equal(int64(4), ei.CurrentEpoch)
+	suite.Require().Equal(startTime.Add(3*duration), ei.CurrentEpochStartTime)
+}
+
+func (suite *EpochInfoTestSuite) TestEndHeight() {
+	ei := EpochInfo{CurrentEpochStartHeight: 10, Duration: time.Hour}
+	suite.Require().False(ei.IsBlockBased())
+	suite.Require().Zero(ei.EndHeight())
+
+	ei.BlockInterval = 100
+	suite.Require().True(ei.IsBlockBased())
+	suite.Require().Equal(int64(110), ei.EndHeight())
+}
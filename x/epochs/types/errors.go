@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrEpochNotFound      = errorsmod.Register(ModuleName, 2, "epoch not found")
+	ErrEpochAlreadyExists = errorsmod.Register(ModuleName, 3, "epoch already exists")
+	ErrBlockBasedEpoch    = errorsmod.Register(ModuleName, 4, "epoch is block-height based")
+)
@@ -2,9 +2,21 @@ package types
 
 // epochs events
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd            = "epoch_end"
+	EventTypeEpochStart          = "epoch_start"
+	EventTypeAddEpoch            = "add_epoch"
+	EventTypeUpdateEpochDuration = "update_epoch_duration"
+	EventTypeSetEpochPaused      = "set_epoch_paused"
+	EventTypeSetEpochCatchUp     = "set_epoch_catch_up_policy"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber        = "epoch_number"
+	AttributeEpochStartTime     = "start_time"
+	AttributeEpochIdentifier    = "identifier"
+	AttributeEpochDuration      = "duration"
+	AttributeEpochPaused        = "paused"
+	AttributeEpochBlockInterval = "block_interval"
+
+	AttributeEpochMissedEpochs  = "missed_epochs"
+	AttributeEpochCatchUpPolicy = "catch_up_policy"
+	AttributeEpochCatchUpBlocks = "catch_up_blocks"
 )
@@ -28,6 +28,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CatchUpPolicy defines how an epoch catches up with the block time when more
+// than one epoch ended since the last block, e.g. after a chain halt.
+type CatchUpPolicy int32
+
+const (
+	// CATCH_UP_POLICY_ALL ends one epoch per block until the epoch catches up.
+	CatchUpPolicyAll CatchUpPolicy = 0
+	// CATCH_UP_POLICY_SKIP skips to the current epoch with a single epoch end
+	// that carries the number of missed epochs.
+	CatchUpPolicySkip CatchUpPolicy = 1
+	// CATCH_UP_POLICY_SPREAD ends all the missed epochs over catch_up_blocks
+	// blocks.
+	CatchUpPolicySpread CatchUpPolicy = 2
+)
+
+var CatchUpPolicy_name = map[int32]string{
+	0: "CATCH_UP_POLICY_ALL",
+	1: "CATCH_UP_POLICY_SKIP",
+	2: "CATCH_UP_POLICY_SPREAD",
+}
+
+var CatchUpPolicy_value = map[string]int32{
+	"CATCH_UP_POLICY_ALL":    0,
+	"CATCH_UP_POLICY_SKIP":   1,
+	"CATCH_UP_POLICY_SPREAD": 2,
+}
+
+func (x CatchUpPolicy) String() string {
+	return proto.EnumName(CatchUpPolicy_name, int32(x))
+}
+
+func (CatchUpPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c74bc0b3e7fa01c2, []int{0}
+}
+
 // EpochInfo defines the message interface containing the relevant informations about
 // an epoch.
 type EpochInfo struct {
@@ -45,6 +80,25 @@ type EpochInfo struct {
 	EpochCountingStarted bool `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	// current_epoch_start_height of the epoch
 	CurrentEpochStartHeight int64 `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// next_duration is the duration set by governance that applies from the next
+	// epoch onwards. Zero if unchanged.
+	NextDuration time.Duration `protobuf:"bytes,8,opt,name=next_duration,json=nextDuration,proto3,stdduration" json:"next_duration,omitempty" yaml:"next_duration"`
+	// paused defines if the epoch has been paused by governance. Paused epochs
+	// don't start nor end.
+	Paused bool `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	// catch_up_policy defines how the epochs that ended while the chain was
+	// halted are processed
+	CatchUpPolicy CatchUpPolicy `protobuf:"varint,10,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=evmos.epochs.v1.CatchUpPolicy" json:"catch_up_policy,omitempty"`
+	// catch_up_blocks is the number of blocks over which the missed epochs are
+	// spread with CATCH_UP_POLICY_SPREAD
+	CatchUpBlocks uint64 `protobuf:"varint,11,opt,name=catch_up_blocks,json=catchUpBlocks,proto3" json:"catch_up_blocks,omitempty"`
+	// catch_up_epochs_per_block is the number of epochs ended per block during
+	// an ongoing CATCH_UP_POLICY_SPREAD catch-up. Zero if the epoch is not
+	// catching up.
+	CatchUpEpochsPerBlock int64 `protobuf:"varint,12,opt,name=catch_up_epochs_per_block,json=catchUpEpochsPerBlock,proto3" json:"catch_up_epochs_per_block,omitempty"`
+	// block_interval is the number of blocks of a block-height based epoch. The
+	// epoch ends on height boundaries instead of after its duration if positive.
+	BlockInterval int64 `protobuf:"varint,13,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -129,6 +183,48 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetNextDuration() time.Duration {
+	if m != nil {
+		return m.NextDuration
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *EpochInfo) GetCatchUpPolicy() CatchUpPolicy {
+	if m != nil {
+		return m.CatchUpPolicy
+	}
+	return CatchUpPolicyAll
+}
+
+func (m *EpochInfo) GetCatchUpBlocks() uint64 {
+	if m != nil {
+		return m.CatchUpBlocks
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetCatchUpEpochsPerBlock() int64 {
+	if m != nil {
+		return m.CatchUpEpochsPerBlock
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	// epochs is a slice of EpochInfo that defines the epochs in the genesis state
@@ -176,6 +272,7 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 }
 
 func init() {
+	proto.RegisterEnum("evmos.epochs.v1.CatchUpPolicy", CatchUpPolicy_name, CatchUpPolicy_value)
 	proto.RegisterType((*EpochInfo)(nil), "evmos.epochs.v1.EpochInfo")
 	proto.RegisterType((*GenesisState)(nil), "evmos.epochs.v1.GenesisState")
 }
@@ -183,36 +280,52 @@ func init() {
 func init() { proto.RegisterFile("evmos/epochs/v1/genesis.proto", fileDescriptor_c74bc0b3e7fa01c2) }
 
 var fileDescriptor_c74bc0b3e7fa01c2 = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xae, 0x69, 0x29, 0x57, 0x73, 0xe8, 0x84, 0x75, 0x40, 0xa8, 0x74, 0x4e, 0x14, 0x96, 0x22,
-	0x90, 0x4d, 0x81, 0x01, 0xc1, 0xd6, 0x03, 0x71, 0xac, 0x29, 0x03, 0x62, 0xa9, 0xd2, 0xd6, 0x75,
-	0x2c, 0x5d, 0xe2, 0x28, 0x79, 0xa9, 0xe8, 0xc6, 0xcc, 0x74, 0x23, 0x3f, 0xe9, 0xc6, 0x1b, 0x99,
-	0x0a, 0x6a, 0x37, 0xc6, 0xfb, 0x05, 0x28, 0x76, 0x52, 0xca, 0x15, 0xc4, 0x12, 0xc5, 0xef, 0xfb,
-	0xde, 0xf7, 0xf9, 0x7b, 0x7a, 0xc6, 0x47, 0x62, 0x1e, 0xeb, 0x9c, 0x8b, 0x54, 0x4f, 0xa2, 0x9c,
-	0xcf, 0xfb, 0x5c, 0x8a, 0x44, 0xe4, 0x2a, 0x67, 0x69, 0xa6, 0x41, 0x93, 0x03, 0x03, 0x33, 0x0b,
-	0xb3, 0x79, 0xbf, 0x7b, 0x28, 0xb5, 0xd4, 0x06, 0xe3, 0xe5, 0x9f, 0xa5, 0x75, 0xa9, 0xd4, 0x5a,
-	0x9e, 0x0a, 0x6e, 0x4e, 0xe3, 0x62, 0xc6, 0xa7, 0x45, 0x16, 0x82, 0xd2, 0x49, 0x85, 0xbb, 0x57,
-	0x71, 0x50, 0xb1, 0xc8, 0x21, 0x8c, 0x53, 0x4b, 0xf0, 0xbf, 0xb4, 0x70, 0xe7, 0x4d, 0x69, 0xf2,
-	0x2e, 0x99, 0x69, 0x42, 0x31, 0x56, 0x53, 0x91, 0x80, 0x9a, 0x29, 0x91, 0x39, 0xc8, 0x43, 0xbd,
-	0x4e, 0xb0, 0x55, 0x21, 0x1f, 0x30, 0xce, 0x21, 0xcc, 0x60, 0x54, 0xca, 0x38, 0xd7, 0x3c, 0xd4,
-	0xbb, 0xf9, 0xb4, 0xcb, 0xac, 0x07, 0xab, 0x3d, 0xd8, 0xfb, 0xda, 0x63, 0x70, 0x74, 0xbe, 0x74,
-	0x1b, 0x97, 0x4b, 0xf7, 0xf6, 0x22, 0x8c, 0x4f, 0x5f, 0xfa, 0xbf, 0x7b, 0xfd, 0xb3, 0xef, 0x2e,
-	0x0a, 0x3a, 0xa6, 0x50, 0xd2, 0x49, 0x84, 0xf7, 0xea, 0xab, 0x3b, 0x4d, 0xa3, 0x7b, 0x7f, 0x47,
-	0xf7, 0x75, 0x45, 0x18, 0xf4, 0x4b, 0xd9, 0x9f, 0x4b, 0x97, 0xd4, 0x2d, 0x8f, 0x75, 0xac, 0x40,
-	0xc4, 0x29, 0x2c, 0x2e, 0x97, 0xee, 0x81, 0x35, 0xab, 0x31, 0xff, 0x6b, 0x69, 0xb5, 0x51, 0x27,
-	0x0f, 0xf0, 0xad, 0x49, 0x91, 0x65, 0x22, 0x81, 0x91, 0x99, 0xae, 0xd3, 0xf2, 0x50, 0xaf, 0x19,
-	0xec, 0x57, 0x45, 0x33, 0x0c, 0xf2, 0x19, 0x61, 0xe7, 0x0f, 0xd6, 0x68, 0x2b, 0xf7, 0xf5, 0xff,
-	0xe6, 0x7e, 0x54, 0xe5, 0x76, 0xed, 0x55, 0xfe, 0xa5, 0x64, 0xa7, 0x70, 0x67, 0xdb, 0x79, 0xb8,
-	0x99, 0xc8, 0x73, 0x7c, 0xd7, 0xf2, 0x27, 0xba, 0x48, 0x40, 0x25, 0xd2, 0x36, 0x8a, 0xa9, 0xd3,
-	0xf6, 0x50, 0x6f, 0x2f, 0x38, 0x34, 0xe8, 0x71, 0x05, 0x0e, 0x2d, 0x46, 0x5e, 0xe1, 0xee, 0xdf,
-	0xdc, 0x22, 0xa1, 0x64, 0x04, 0xce, 0x0d, 0x13, 0xf5, 0xde, 0x8e, 0xe1, 0x89, 0x81, 0xfd, 0x13,
-	0xbc, 0xff, 0xd6, 0x6e, 0xe1, 0x10, 0x42, 0x10, 0xe4, 0x05, 0x6e, 0xdb, 0x05, 0x74, 0x90, 0xd7,
-	0x34, 0x91, 0xaf, 0x6c, 0x25, 0xdb, 0xac, 0xce, 0xa0, 0x55, 0x46, 0x0e, 0x2a, 0xfe, 0xe0, 0xf8,
-	0x7c, 0x45, 0xd1, 0xc5, 0x8a, 0xa2, 0x1f, 0x2b, 0x8a, 0xce, 0xd6, 0xb4, 0x71, 0xb1, 0xa6, 0x8d,
-	0x6f, 0x6b, 0xda, 0xf8, 0xf8, 0x50, 0x2a, 0x88, 0x8a, 0x31, 0x9b, 0xe8, 0x98, 0x57, 0x4f, 0xc0,
-	0x7c, 0xe7, 0xfd, 0x27, 0xfc, 0x53, 0xfd, 0x1c, 0x60, 0x91, 0x8a, 0x7c, 0xdc, 0x36, 0x93, 0x7d,
-	0xf6, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x4c, 0xf4, 0x04, 0x86, 0x2b, 0x03, 0x00, 0x00,
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xbf, 0x4f, 0xdb, 0x4a,
+	0x1c, 0xcf, 0x41, 0x5e, 0x1e, 0x39, 0x12, 0x08, 0x47, 0x00, 0x63, 0x09, 0xc7, 0xca, 0xd3, 0x7b,
+	0xca, 0xeb, 0x0f, 0xbb, 0x81, 0x0e, 0xa8, 0x4c, 0x49, 0xa0, 0x25, 0x2a, 0x52, 0xa3, 0x04, 0xa4,
+	0xb6, 0x8b, 0xe5, 0x38, 0x87, 0x63, 0x91, 0xf8, 0x2c, 0xfb, 0x1c, 0x11, 0x75, 0xe9, 0x58, 0x31,
+	0x31, 0x76, 0x61, 0xea, 0xd6, 0xbf, 0x84, 0x91, 0xb1, 0x53, 0x5a, 0xc1, 0xd6, 0xa9, 0xe2, 0x2f,
+	0xa8, 0x7c, 0x67, 0xa7, 0x71, 0x68, 0xc5, 0x12, 0xe5, 0xbe, 0x9f, 0x5f, 0xfe, 0x7e, 0xbf, 0x3e,
+	0xc3, 0x0d, 0x3c, 0xe8, 0x13, 0x4f, 0xc5, 0x0e, 0x31, 0xba, 0x9e, 0x3a, 0x28, 0xab, 0x26, 0xb6,
+	0xb1, 0x67, 0x79, 0x8a, 0xe3, 0x12, 0x4a, 0xd0, 0x22, 0x83, 0x15, 0x0e, 0x2b, 0x83, 0xb2, 0x98,
+	0x37, 0x89, 0x49, 0x18, 0xa6, 0x06, 0xff, 0x38, 0x4d, 0x94, 0x4c, 0x42, 0xcc, 0x1e, 0x56, 0xd9,
+	0xa9, 0xed, 0x1f, 0xab, 0x1d, 0xdf, 0xd5, 0xa9, 0x45, 0xec, 0x10, 0x2f, 0x4c, 0xe3, 0xd4, 0xea,
+	0x63, 0x8f, 0xea, 0x7d, 0x87, 0x13, 0x8a, 0x3f, 0x52, 0x30, 0xbd, 0x17, 0x84, 0xd4, 0xed, 0x63,
+	0x82, 0x24, 0x08, 0xad, 0x0e, 0xb6, 0xa9, 0x75, 0x6c, 0x61, 0x57, 0x00, 0x32, 0x28, 0xa5, 0x9b,
+	0x13, 0x15, 0xf4, 0x1a, 0x42, 0x8f, 0xea, 0x2e, 0xd5, 0x02, 0x1b, 0x61, 0x46, 0x06, 0xa5, 0xf9,
+	0x4d, 0x51, 0xe1, 0x19, 0x4a, 0x94, 0xa1, 0x1c, 0x46, 0x19, 0xd5, 0x8d, 0xcb, 0x51, 0x21, 0x71,
+	0x3b, 0x2a, 0x2c, 0x0d, 0xf5, 0x7e, 0xef, 0x59, 0xf1, 0x97, 0xb6, 0x78, 0xfe, 0xb5, 0x00, 0x9a,
+	0x69, 0x56, 0x08, 0xe8, 0xa8, 0x0b, 0xe7, 0xa2, 0x47, 0x17, 0x66, 0x99, 0xef, 0xfa, 0x1d, 0xdf,
+	0xdd, 0x90, 0x50, 0x2d, 0x07, 0xb6, 0xdf, 0x47, 0x05, 0x14, 0x49, 0x1e, 0x91, 0xbe, 0x45, 0x71,
+	0xdf, 0xa1, 0xc3, 0xdb, 0x51, 0x61, 0x91, 0x87, 0x45, 0x58, 0xf1, 0x63, 0x10, 0x35, 0x76, 0x47,
+	0xff, 0xc0, 0xac, 0xe1, 0xbb, 0x2e, 0xb6, 0xa9, 0xc6, 0xa6, 0x2b, 0x24, 0x65, 0x50, 0x9a, 0x6d,
+	0x66, 0xc2, 0x22, 0x1b, 0x06, 0x7a, 0x0f, 0xa0, 0x10, 0x63, 0x69, 0x13, 0x7d, 0xff, 0x75, 0x6f,
+	0xdf, 0x0f, 0xc3, 0xbe, 0x0b, 0xfc, 0x51, 0xfe, 0xe4, 0xc4, 0xa7, 0xb0, 0x32, 0x99, 0xdc, 0x1a,
+	0x4f, 0xe4, 0x29, 0x5c, 0xe5, 0x7c, 0x83, 0xf8, 0x36, 0xb5, 0x6c, 0x93, 0x0b, 0x71, 0x47, 0x48,
+	0xc9, 0xa0, 0x34, 0xd7, 0xcc, 0x33, 0xb4, 0x16, 0x82, 0x2d, 0x8e, 0xa1, 0x1d, 0x28, 0xfe, 0x2e,
+	0xad, 0x8b, 0x2d, 0xb3, 0x4b, 0x85, 0xbf, 0x59, 0xab, 0x6b, 0x77, 0x02, 0xf7, 0x19, 0x8c, 0xde,
+	0xc1, 0xac, 0x8d, 0x4f, 0xa9, 0x36, 0xde, 0xc4, 0xdc, 0x7d, 0x9b, 0xd8, 0x09, 0x37, 0xb1, 0x16,
+	0xd3, 0xc5, 0xd6, 0x91, 0xe7, 0x33, 0x88, 0x11, 0xf8, 0x4e, 0x32, 0x41, 0x2d, 0xb2, 0x42, 0xab,
+	0x30, 0xe5, 0xe8, 0xbe, 0x87, 0x3b, 0x42, 0x9a, 0xf5, 0x17, 0x9e, 0xd0, 0x73, 0xb8, 0x68, 0xe8,
+	0xd4, 0xe8, 0x6a, 0xbe, 0xa3, 0x39, 0xa4, 0x67, 0x19, 0x43, 0x01, 0xca, 0xa0, 0xb4, 0xb0, 0x29,
+	0x29, 0x53, 0x77, 0x44, 0xa9, 0x05, 0xbc, 0x23, 0xa7, 0xc1, 0x58, 0xcd, 0xac, 0x31, 0x79, 0x44,
+	0xff, 0x4d, 0xf8, 0xb4, 0x7b, 0xc4, 0x38, 0xf1, 0x84, 0x79, 0x19, 0x94, 0x92, 0x63, 0x5e, 0x95,
+	0x15, 0xd1, 0x36, 0x5c, 0x1f, 0xf3, 0xb8, 0xb5, 0xe6, 0x60, 0x97, 0x4b, 0x84, 0x0c, 0x1b, 0xe0,
+	0x4a, 0xa8, 0x60, 0x03, 0xf4, 0x1a, 0xd8, 0x65, 0x52, 0xf4, 0x2f, 0x5c, 0x60, 0x2c, 0xcd, 0xb2,
+	0x29, 0x76, 0x07, 0x7a, 0x4f, 0xc8, 0x32, 0x7a, 0x96, 0x55, 0xeb, 0x61, 0xb1, 0xb8, 0x0f, 0x33,
+	0x2f, 0xf8, 0x5d, 0x6f, 0x51, 0x9d, 0x62, 0xb4, 0x0d, 0x53, 0x3c, 0x47, 0x00, 0xf2, 0x2c, 0x7b,
+	0xb1, 0xa6, 0xfb, 0x1a, 0x5f, 0xd0, 0x6a, 0x32, 0x98, 0x77, 0x33, 0xe4, 0x3f, 0xf8, 0x0c, 0x60,
+	0x36, 0xd6, 0x33, 0x7a, 0x0c, 0x97, 0x6b, 0x95, 0xc3, 0xda, 0xbe, 0x76, 0xd4, 0xd0, 0x1a, 0xaf,
+	0x0e, 0xea, 0xb5, 0x37, 0x5a, 0xe5, 0xe0, 0x20, 0x97, 0x10, 0xf3, 0x67, 0x17, 0x72, 0x2e, 0xc6,
+	0xad, 0xf4, 0x7a, 0x48, 0x85, 0xf9, 0x69, 0x7a, 0xeb, 0x65, 0xbd, 0x91, 0x03, 0xe2, 0xca, 0xd9,
+	0x85, 0xbc, 0x14, 0xe3, 0xb7, 0x4e, 0x2c, 0x07, 0x6d, 0xc1, 0xd5, 0x3b, 0x82, 0x46, 0x73, 0xaf,
+	0xb2, 0x9b, 0x9b, 0x11, 0xd7, 0xce, 0x2e, 0xe4, 0xe5, 0xb8, 0xc4, 0x71, 0xb1, 0xde, 0x11, 0x93,
+	0x1f, 0x3e, 0x49, 0x89, 0x6a, 0xed, 0xf2, 0x5a, 0x02, 0x57, 0xd7, 0x12, 0xf8, 0x76, 0x2d, 0x81,
+	0xf3, 0x1b, 0x29, 0x71, 0x75, 0x23, 0x25, 0xbe, 0xdc, 0x48, 0x89, 0xb7, 0xff, 0x9b, 0x16, 0xed,
+	0xfa, 0x6d, 0xc5, 0x20, 0x7d, 0x35, 0xfc, 0x2a, 0xb2, 0xdf, 0x41, 0xf9, 0x89, 0x7a, 0x1a, 0x7d,
+	0x21, 0xe9, 0xd0, 0xc1, 0x5e, 0x3b, 0xc5, 0x5e, 0xc1, 0xad, 0x9f, 0x03, 0x00, 0x46, 0x03, 0x7b,
+	0x38, 0x3e, 0x05, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -235,49 +348,87 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.CurrentEpochStartHeight != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
+	if m.BlockInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockInterval))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x68
 	}
-	if m.EpochCountingStarted {
+	if m.CatchUpEpochsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CatchUpEpochsPerBlock))
 		i--
-		if m.EpochCountingStarted {
+		dAtA[i] = 0x60
+	}
+	if m.CatchUpBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CatchUpBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CatchUpPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CatchUpPolicy))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x48
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CurrentEpochStartTime):])
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.NextDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextDuration):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintGenesis(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x2a
-	if m.CurrentEpoch != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpoch))
+	dAtA[i] = 0x42
+	if m.CurrentEpochStartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x38
+	}
+	if m.EpochCountingStarted {
+		i--
+		if m.EpochCountingStarted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CurrentEpochStartTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x1a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	dAtA[i] = 0x2a
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x12
 	if len(m.Identifier) > 0 {
 		i -= len(m.Identifier)
@@ -362,6 +513,23 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.NextDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	if m.CatchUpPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.CatchUpPolicy))
+	}
+	if m.CatchUpBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.CatchUpBlocks))
+	}
+	if m.CatchUpEpochsPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.CatchUpEpochsPerBlock))
+	}
+	if m.BlockInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockInterval))
+	}
 	return n
 }
 
@@ -604,6 +772,135 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.NextDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpPolicy", wireType)
+			}
+			m.CatchUpPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpPolicy |= CatchUpPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpBlocks", wireType)
+			}
+			m.CatchUpBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpEpochsPerBlock", wireType)
+			}
+			m.CatchUpEpochsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpEpochsPerBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			m.BlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
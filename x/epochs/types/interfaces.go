@@ -1,11 +1,18 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // EpochHooks event hooks for epoch processing
 type EpochHooks interface {
-	// the first block whose timestamp is after the duration is counted as the end of the epoch
-	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	// the first block whose timestamp is after the duration is counted as the end of the epoch,
+	// missedEpochs is the number of epochs before epochNumber that ended without a hook call
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber, missedEpochs int64)
 	// new epoch is next block of epoch end block
 	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	// the duration of the epoch is changed by governance, effective from the epoch epochNumber
+	AfterEpochDurationChange(ctx sdk.Context, epochIdentifier string, epochNumber int64, oldDuration, newDuration time.Duration)
 }
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// constants
+const (
+	ProposalTypeAddEpoch            string = "AddEpoch"
+	ProposalTypeUpdateEpochDuration string = "UpdateEpochDuration"
+	ProposalTypeSetEpochPaused      string = "SetEpochPaused"
+	ProposalTypeSetEpochCatchUp     string = "SetEpochCatchUpPolicy"
+)
+
+// Implements Proposal Interface
+var (
+	_ govv1beta1.Content = &AddEpochProposal{}
+	_ govv1beta1.Content = &UpdateEpochDurationProposal{}
+	_ govv1beta1.Content = &SetEpochPausedProposal{}
+	_ govv1beta1.Content = &SetEpochCatchUpPolicyProposal{}
+)
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeAddEpoch)
+	govv1beta1.RegisterProposalType(ProposalTypeUpdateEpochDuration)
+	govv1beta1.RegisterProposalType(ProposalTypeSetEpochPaused)
+	govv1beta1.RegisterProposalType(ProposalTypeSetEpochCatchUp)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&AddEpochProposal{}, "epochs/AddEpochProposal", nil)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&UpdateEpochDurationProposal{}, "epochs/UpdateEpochDurationProposal", nil)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&SetEpochPausedProposal{}, "epochs/SetEpochPausedProposal", nil)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&SetEpochCatchUpPolicyProposal{}, "epochs/SetEpochCatchUpPolicyProposal", nil)
+}
+
+// NewAddEpochProposal returns new instance of AddEpochProposal
+func NewAddEpochProposal(
+	title, description string,
+	identifier string,
+	startTime time.Time,
+	duration time.Duration,
+	blockInterval int64,
+) govv1beta1.Content {
+	return &AddEpochProposal{
+		Title:         title,
+		Description:   description,
+		Identifier:    identifier,
+		StartTime:     startTime,
+		Duration:      duration,
+		BlockInterval: blockInterval,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*AddEpochProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*AddEpochProposal) ProposalType() string {
+	return ProposalTypeAddEpoch
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *AddEpochProposal) ValidateBasic() error {
+	if err := ValidateEpochIdentifierString(p.Identifier); err != nil {
+		return err
+	}
+
+	switch {
+	case p.BlockInterval < 0:
+		return fmt.Errorf("epoch block interval cannot be negative: %d", p.BlockInterval)
+	case p.BlockInterval == 0:
+		if err := validateEpochDuration(p.Duration); err != nil {
+			return err
+		}
+	}
+
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// NewUpdateEpochDurationProposal returns new instance of
+// UpdateEpochDurationProposal
+func NewUpdateEpochDurationProposal(
+	title, description string,
+	identifier string,
+	duration time.Duration,
+) govv1beta1.Content {
+	return &UpdateEpochDurationProposal{
+		Title:       title,
+		Description: description,
+		Identifier:  identifier,
+		Duration:    duration,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateEpochDurationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateEpochDurationProposal) ProposalType() string {
+	return ProposalTypeUpdateEpochDuration
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *UpdateEpochDurationProposal) ValidateBasic() error {
+	if err := ValidateEpochIdentifierString(p.Identifier); err != nil {
+		return err
+	}
+	if err := validateEpochDuration(p.Duration); err != nil {
+		return err
+	}
+
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// NewSetEpochPausedProposal returns new instance of SetEpochPausedProposal
+func NewSetEpochPausedProposal(
+	title, description string,
+	identifier string,
+	paused bool,
+) govv1beta1.Content {
+	return &SetEpochPausedProposal{
+		Title:       title,
+		Description: description,
+		Identifier:  identifier,
+		Paused:      paused,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*SetEpochPausedProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*SetEpochPausedProposal) ProposalType() string {
+	return ProposalTypeSetEpochPaused
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *SetEpochPausedProposal) ValidateBasic() error {
+	if err := ValidateEpochIdentifierString(p.Identifier); err != nil {
+		return err
+	}
+
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// NewSetEpochCatchUpPolicyProposal returns new instance of
+// SetEpochCatchUpPolicyProposal
+func NewSetEpochCatchUpPolicyProposal(
+	title, description string,
+	identifier string,
+	policy CatchUpPolicy,
+	catchUpBlocks uint64,
+) govv1beta1.Content {
+	return &SetEpochCatchUpPolicyProposal{
+		Title:         title,
+		Description:   description,
+		Identifier:    identifier,
+		CatchUpPolicy: policy,
+		CatchUpBlocks: catchUpBlocks,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*SetEpochCatchUpPolicyProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*SetEpochCatchUpPolicyProposal) ProposalType() string {
+	return ProposalTypeSetEpochCatchUp
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *SetEpochCatchUpPolicyProposal) ValidateBasic() error {
+	if err := ValidateEpochIdentifierString(p.Identifier); err != nil {
+		return err
+	}
+	if err := ValidateCatchUpPolicy(p.CatchUpPolicy, p.CatchUpBlocks); err != nil {
+		return err
+	}
+
+	return govv1beta1.ValidateAbstract(p)
+}
+
+func validateEpochDuration(duration time.Duration) error {
+	if duration <= 0 {
+		return errors.New("epoch duration must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ProposalTestSuite struct {
+	suite.Suite
+}
+
+func TestProposalTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalTestSuite))
+}
+
+func (suite *ProposalTestSuite) TestKeysTypes() {
+	suite.Require().Equal("epochs", (&AddEpochProposal{}).ProposalRoute())
+	suite.Require().Equal("AddEpoch", (&AddEpochProposal{}).ProposalType())
+	suite.Require().Equal("epochs", (&UpdateEpochDurationProposal{}).ProposalRoute())
+	suite.Require().Equal("UpdateEpochDuration", (&UpdateEpochDurationProposal{}).ProposalType())
+	suite.Require().Equal("epochs", (&SetEpochPausedProposal{}).ProposalRoute())
+	suite.Require().Equal("SetEpochPaused", (&SetEpochPausedProposal{}).ProposalType())
+	suite.Require().Equal("epochs", (&SetEpochCatchUpPolicyProposal{}).ProposalRoute())
+	suite.Require().Equal("SetEpochCatchUpPolicy", (&SetEpochCatchUpPolicyProposal{}).ProposalType())
+}
+
+func (suite *ProposalTestSuite) TestAddEpochProposal() {
+	testCases := []struct {
+		name          string
+		title         string
+		identifier    string
+		duration      time.Duration
+		blockInterval int64
+		expectPass    bool
+	}{
+		{"valid", "test", "month", time.Hour * 24 * 30, 0, true},
+		{"valid - block-height based", "test", "blocks", 0, 1000, true},
+		{"invalid - empty title", "", "month", time.Hour * 24 * 30, 0, false},
+		{"invalid - blank identifier", "test", " ", time.Hour * 24 * 30, 0, false},
+		{"invalid - zero duration", "test", "month", 0, 0, false},
+		{"invalid - negative block interval", "test", "blocks", time.Hour, -1, false},
+	}
+
+	for _, tc := range testCases {
+		proposal := NewAddEpochProposal(tc.title, "test desc", tc.identifier, time.Time{}, tc.duration, tc.blockInterval)
+		err := proposal.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestUpdateEpochDurationProposal() {
+	testCases := []struct {
+		name       string
+		identifier string
+		duration   time.Duration
+		expectPass bool
+	}{
+		{"valid", DayEpochID, time.Hour * 12, true},
+		{"invalid - blank identifier", "", time.Hour * 12, false},
+		{"invalid - negative duration", DayEpochID, -time.Hour, false},
+	}
+
+	for _, tc := range testCases {
+		proposal := NewUpdateEpochDurationProposal("test", "test desc", tc.identifier, tc.duration)
+		err := proposal.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestSetEpochPausedProposal() {
+	testCases := []struct {
+		name       string
+		identifier string
+		expectPass bool
+	}{
+		{"valid", WeekEpochID, true},
+		{"invalid - blank identifier", "", false},
+	}
+
+	for _, tc := range testCases {
+		proposal := NewSetEpochPausedProposal("test", "test desc", tc.identifier, true)
+		err := proposal.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestSetEpochCatchUpPolicyProposal() {
+	testCases := []struct {
+		name          string
+		identifier    string
+		policy        CatchUpPolicy
+		catchUpBlocks uint64
+		expectPass    bool
+	}{
+		{"valid - all", WeekEpochID, CatchUpPolicyAll, 0, true},
+		{"valid - skip", WeekEpochID, CatchUpPolicySkip, 0, true},
+		{"valid - spread", WeekEpochID, CatchUpPolicySpread, 10, true},
+		{"invalid - blank identifier", "", CatchUpPolicySkip, 0, false},
+		{"invalid - unknown policy", WeekEpochID, CatchUpPolicy(3), 0, false},
+		{"invalid - spread without blocks", WeekEpochID, CatchUpPolicySpread, 0, false},
+	}
+
+	for _, tc := range testCases {
+		proposal := NewSetEpochCatchUpPolicyProposal("test", "test desc", tc.identifier, tc.policy, tc.catchUpBlocks)
+		err := proposal.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -185,6 +185,9 @@ func (m *QueryCurrentEpochRequest) GetIdentifier() string {
 type QueryCurrentEpochResponse struct {
 	// current_epoch is the number of the current epoch
 	CurrentEpoch int64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// end_height is the expected height at which the current epoch ends. Zero
+	// for time based epochs.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
 func (m *QueryCurrentEpochResponse) Reset()         { *m = QueryCurrentEpochResponse{} }
@@ -227,6 +230,13 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() int64 {
 	return 0
 }
 
+func (m *QueryCurrentEpochResponse) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "evmos.epochs.v1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "evmos.epochs.v1.QueryEpochsInfoResponse")
@@ -237,36 +247,37 @@ func init() {
 func init() { proto.RegisterFile("evmos/epochs/v1/query.proto", fileDescriptor_d13f5778acd937ff) }
 
 var fileDescriptor_d13f5778acd937ff = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6b, 0x14, 0x31,
-	0x14, 0xc6, 0x37, 0x5b, 0x2d, 0x98, 0x56, 0x84, 0x20, 0x76, 0x3b, 0x6a, 0xba, 0xac, 0xd0, 0x6e,
-	0x7b, 0x48, 0x9c, 0xf5, 0x22, 0x9e, 0xa4, 0x45, 0xc5, 0x9b, 0xce, 0xd1, 0x8b, 0xce, 0x8e, 0xaf,
-	0x69, 0xc0, 0x26, 0xd3, 0x49, 0x66, 0xb0, 0x37, 0xf1, 0x2e, 0x08, 0xde, 0xc4, 0x3f, 0xa8, 0xc7,
-	0x82, 0x17, 0x4f, 0x22, 0xbb, 0xfe, 0x21, 0x32, 0x49, 0x56, 0x67, 0x77, 0x07, 0xd6, 0xcb, 0x10,
-	0xf2, 0xde, 0xf7, 0xbd, 0xdf, 0xfb, 0x26, 0xf8, 0x36, 0x54, 0xa7, 0xda, 0x70, 0xc8, 0x75, 0x76,
-	0x62, 0x78, 0x15, 0xf3, 0xb3, 0x12, 0x8a, 0x73, 0x96, 0x17, 0xda, 0x6a, 0x72, 0xc3, 0x15, 0x99,
-	0x2f, 0xb2, 0x2a, 0x8e, 0x0e, 0x32, 0x6d, 0xea, 0xf6, 0x71, 0x6a, 0xc0, 0x77, 0xf2, 0x2a, 0x1e,
-	0x83, 0x4d, 0x63, 0x9e, 0xa7, 0x42, 0xaa, 0xd4, 0x4a, 0xad, 0xbc, 0x38, 0xba, 0xbb, 0xe8, 0x2c,
-	0x40, 0x81, 0x91, 0x26, 0x94, 0x6f, 0x0a, 0x2d, 0xb4, 0x3b, 0xf2, 0xfa, 0x14, 0x6e, 0xef, 0x08,
-	0xad, 0xc5, 0x3b, 0xe0, 0x69, 0x2e, 0x79, 0xaa, 0x94, 0xb6, 0xce, 0x31, 0x68, 0x06, 0x6f, 0xf0,
-	0xad, 0x97, 0xf5, 0xd0, 0x27, 0xce, 0xf3, 0xb9, 0x3a, 0xd6, 0x09, 0x9c, 0x95, 0x60, 0x2c, 0x79,
-	0x8a, 0xf1, 0x3f, 0x80, 0x1e, 0xea, 0xa3, 0xe1, 0xc6, 0x68, 0x97, 0x79, 0x5a, 0x56, 0xd3, 0x32,
-	0xbf, 0x57, 0xa0, 0x65, 0x2f, 0x52, 0x01, 0x41, 0x9b, 0x34, 0x94, 0x83, 0x6f, 0x08, 0x6f, 0x2d,
-	0x8d, 0x30, 0xb9, 0x56, 0x06, 0xc8, 0x43, 0xbc, 0xee, 0x97, 0xe9, 0xa1, 0xfe, 0xda, 0x70, 0x63,
-	0x14, 0xb1, 0x85, 0x78, 0x98, 0x13, 0xd5, 0x9a, 0xc3, 0x2b, 0x17, 0x3f, 0x77, 0x3a, 0x49, 0xe8,
-	0x27, 0xcf, 0xe6, 0xe8, 0xba, 0x8e, 0x6e, 0x6f, 0x25, 0x9d, 0x1f, 0x3b, 0x87, 0xf7, 0x08, 0xf7,
-	0x1c, 0xdd, 0x51, 0x59, 0x14, 0xa0, 0xac, 0x9b, 0x37, 0x8b, 0x80, 0x62, 0x2c, 0xdf, 0x82, 0xb2,
-	0xf2, 0x58, 0x42, 0xe1, 0x22, 0xb8, 0x96, 0x34, 0x6e, 0x06, 0x8f, 0xf1, 0x76, 0x8b, 0x36, 0xec,
-	0x76, 0x0f, 0x5f, 0xcf, 0xfc, 0xfd, 0x6b, 0xc7, 0xec, 0xf4, 0x6b, 0xc9, 0x66, 0xd6, 0x68, 0x1e,
-	0x7d, 0xed, 0xe2, 0xab, 0xce, 0x82, 0x7c, 0x40, 0x18, 0xff, 0x5d, 0xd6, 0x90, 0xbd, 0xa5, 0x24,
-	0xda, 0x7f, 0x53, 0x34, 0x5c, 0xdd, 0xe8, 0x81, 0x06, 0x3b, 0x1f, 0xbf, 0xff, 0xfe, 0xd2, 0xdd,
-	0x26, 0x5b, 0x7c, 0xf1, 0x19, 0x85, 0x4c, 0x3f, 0x21, 0xbc, 0xd9, 0x5c, 0x85, 0xec, 0xb7, 0x7b,
-	0xb7, 0x44, 0x15, 0x1d, 0xfc, 0x4f, 0x6b, 0x00, 0xd9, 0x75, 0x20, 0x7d, 0x42, 0x97, 0x40, 0xe6,
-	0x02, 0x3b, 0x3c, 0xba, 0x98, 0x50, 0x74, 0x39, 0xa1, 0xe8, 0xd7, 0x84, 0xa2, 0xcf, 0x53, 0xda,
-	0xb9, 0x9c, 0xd2, 0xce, 0x8f, 0x29, 0xed, 0xbc, 0xda, 0x17, 0xd2, 0x9e, 0x94, 0x63, 0x96, 0xe9,
-	0xd3, 0x99, 0x87, 0xfb, 0x56, 0xf1, 0x7d, 0xfe, 0x7e, 0xe6, 0x67, 0xcf, 0x73, 0x30, 0xe3, 0x75,
-	0xf7, 0xce, 0x1f, 0xfc, 0x09, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x64, 0xad, 0x04, 0x96, 0x03, 0x00,
-	0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0x89, 0x16, 0x3a, 0xad, 0x08, 0x83, 0xd8, 0x74, 0xb5, 0xdb, 0x10, 0xa1, 0x4d,
+	0x7b, 0x98, 0x71, 0xe3, 0x45, 0x3c, 0xb6, 0xf8, 0xeb, 0xa6, 0x7b, 0xf4, 0x12, 0x37, 0x9b, 0xd7,
+	0xc9, 0x80, 0x9d, 0xd9, 0xee, 0x4c, 0x16, 0x7b, 0x13, 0xef, 0x82, 0xe0, 0x4d, 0xfc, 0x83, 0x7a,
+	0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf8, 0x87, 0xc8, 0xbe, 0x99, 0x68, 0x7e, 0x41, 0xbc, 0x2c, 0xc3,
+	0x9b, 0xf7, 0xfd, 0xbe, 0xcf, 0xfb, 0xee, 0x2e, 0xb9, 0x07, 0xe5, 0xb9, 0x36, 0x1c, 0x72, 0x9d,
+	0x0d, 0x0d, 0x2f, 0x63, 0x7e, 0x31, 0x82, 0xe2, 0x92, 0xe5, 0x85, 0xb6, 0x9a, 0xde, 0xc6, 0x4b,
+	0xe6, 0x2e, 0x59, 0x19, 0x87, 0xc7, 0x99, 0x36, 0x55, 0x7b, 0x3f, 0x35, 0xe0, 0x3a, 0x79, 0x19,
+	0xf7, 0xc1, 0xa6, 0x31, 0xcf, 0x53, 0x21, 0x55, 0x6a, 0xa5, 0x56, 0x4e, 0x1c, 0xee, 0x2d, 0x3a,
+	0x0b, 0x50, 0x60, 0xa4, 0xf1, 0xd7, 0x77, 0x84, 0x16, 0x1a, 0x8f, 0xbc, 0x3a, 0xf9, 0xea, 0x7d,
+	0xa1, 0xb5, 0x78, 0x07, 0x3c, 0xcd, 0x25, 0x4f, 0x95, 0xd2, 0x16, 0x1d, 0xbd, 0xa6, 0xfd, 0x96,
+	0xdc, 0x7d, 0x5d, 0x0d, 0x7d, 0x8a, 0x9e, 0x2f, 0xd5, 0x99, 0x4e, 0xe0, 0x62, 0x04, 0xc6, 0xd2,
+	0x67, 0x84, 0xfc, 0x03, 0x68, 0x06, 0xad, 0xa0, 0xb3, 0xd5, 0x3d, 0x60, 0x8e, 0x96, 0x55, 0xb4,
+	0xcc, 0xed, 0xe5, 0x69, 0xd9, 0xab, 0x54, 0x80, 0xd7, 0x26, 0x33, 0xca, 0xf6, 0xb7, 0x80, 0xec,
+	0x2c, 0x8d, 0x30, 0xb9, 0x56, 0x06, 0xe8, 0x63, 0xb2, 0xe1, 0x96, 0x69, 0x06, 0xad, 0x46, 0x67,
+	0xab, 0x1b, 0xb2, 0x85, 0x78, 0x18, 0x8a, 0x2a, 0xcd, 0xc9, 0x8d, 0xab, 0x9f, 0xfb, 0xb5, 0xc4,
+	0xf7, 0xd3, 0xe7, 0x73, 0x74, 0x75, 0xa4, 0x3b, 0x5c, 0x4b, 0xe7, 0xc6, 0xce, 0xe1, 0x3d, 0x21,
+	0x4d, 0xa4, 0x3b, 0x1d, 0x15, 0x05, 0x28, 0x8b, 0xf3, 0xa6, 0x11, 0x44, 0x84, 0xc8, 0x01, 0x28,
+	0x2b, 0xcf, 0x24, 0x14, 0x18, 0xc1, 0x66, 0x32, 0x53, 0x69, 0xf7, 0xc8, 0xee, 0x0a, 0xad, 0xdf,
+	0xed, 0x01, 0xb9, 0x95, 0xb9, 0x7a, 0x0f, 0x99, 0x51, 0xdf, 0x48, 0xb6, 0xb3, 0x99, 0x66, 0xba,
+	0x47, 0x08, 0xa8, 0x41, 0x6f, 0x08, 0x52, 0x0c, 0x2d, 0xae, 0xd1, 0x48, 0x36, 0x41, 0x0d, 0x5e,
+	0x60, 0xa1, 0xfb, 0xb5, 0x4e, 0x6e, 0xe2, 0x04, 0xfa, 0x21, 0x20, 0xe4, 0x6f, 0x16, 0x86, 0x1e,
+	0x2e, 0x05, 0xb5, 0xfa, 0x2d, 0x86, 0x9d, 0xf5, 0x8d, 0x8e, 0xb7, 0xbd, 0xff, 0xf1, 0xfb, 0xef,
+	0x2f, 0xf5, 0x5d, 0xba, 0xc3, 0x17, 0xbf, 0x32, 0x1f, 0xf9, 0xa7, 0x80, 0x6c, 0xcf, 0x6e, 0x4a,
+	0x8f, 0x56, 0x7b, 0xaf, 0x48, 0x32, 0x3c, 0xfe, 0x9f, 0x56, 0x0f, 0x72, 0x80, 0x20, 0x2d, 0x1a,
+	0x2d, 0x81, 0xcc, 0xe5, 0x79, 0x72, 0x7a, 0x35, 0x8e, 0x82, 0xeb, 0x71, 0x14, 0xfc, 0x1a, 0x47,
+	0xc1, 0xe7, 0x49, 0x54, 0xbb, 0x9e, 0x44, 0xb5, 0x1f, 0x93, 0xa8, 0xf6, 0xe6, 0x48, 0x48, 0x3b,
+	0x1c, 0xf5, 0x59, 0xa6, 0xcf, 0xa7, 0x1e, 0xf8, 0x2c, 0xe3, 0x87, 0xfc, 0xfd, 0xd4, 0xcf, 0x5e,
+	0xe6, 0x60, 0xfa, 0x1b, 0xf8, 0x1b, 0x3c, 0xfa, 0x33, 0x00, 0x5b, 0xe6, 0x2d, 0xa9, 0xb5, 0x03,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -523,6 +534,11 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.CurrentEpoch != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
 		i--
@@ -596,6 +612,9 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	if m.CurrentEpoch != 0 {
 		n += 1 + sovQuery(uint64(m.CurrentEpoch))
 	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
 	return n
 }
 
@@ -941,6 +960,25 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
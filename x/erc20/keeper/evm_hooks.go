@@ -157,6 +157,8 @@ func (k Keeper) PostTxProcessing(
 			)
 			continue
 		}
+
+		k.claimsKeeper.AfterERC20Conversion(ctx, recipient, contractAddr)
 	}
 
 	return nil
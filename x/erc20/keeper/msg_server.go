@@ -49,14 +49,21 @@ func (k Keeper) ConvertCoin(
 	}
 
 	// Check ownership and execute conversion
+	var res *types.MsgConvertCoinResponse
 	switch {
 	case pair.IsNativeCoin():
-		return k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender) // case 1.1
+		res, err = k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender) // case 1.1
 	case pair.IsNativeERC20():
-		return k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender) // case 2.2
+		res, err = k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender) // case 2.2
 	default:
 		return nil, types.ErrUndefinedOwner
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	k.claimsKeeper.AfterERC20Conversion(ctx, sender, erc20)
+	return res, nil
 }
 
 // ConvertERC20 converts ERC20 tokens into native Cosmos coins for both
@@ -91,14 +98,21 @@ func (k Keeper) ConvertERC20(
 	}
 
 	// Check ownership and execute conversion
+	var res *types.MsgConvertERC20Response
 	switch {
 	case pair.IsNativeCoin():
-		return k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
+		res, err = k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
 	case pair.IsNativeERC20():
-		return k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
+		res, err = k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
 	default:
 		return nil, types.ErrUndefinedOwner
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	k.claimsKeeper.AfterERC20Conversion(ctx, sender.Bytes(), erc20)
+	return res, nil
 }
 
 // convertCoinNativeCoin handles the coin conversion for a native Cosmos coin
@@ -50,7 +50,9 @@ type EVMKeeper interface {
 	ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error)
 }
 
-// StakingKeeper defines the expected interface needed to retrieve the staking denom.
+// ClaimsKeeper defines the expected interface needed to retrieve the claims
+// params and to notify the claims module of token pair conversions.
 type ClaimsKeeper interface {
 	GetParams(ctx sdk.Context) claimstypes.Params
+	AfterERC20Conversion(ctx sdk.Context, sender sdk.AccAddress, erc20 common.Address)
 }
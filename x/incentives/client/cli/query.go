@@ -3,15 +3,23 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 
 	"github.com/evmos/evmos/v10/x/incentives/types"
 )
 
+// flags for the simulate incentive query
+const (
+	FlagTotalGas = "total-gas"
+)
+
 // GetQueryCmd returns the parent command for all incentives CLI query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,6 +35,9 @@ func GetQueryCmd() *cobra.Command {
 		GetIncentiveCmd(),
 		GetGasMetersCmd(),
 		GetGasMeterCmd(),
+		GetParticipantWeightsCmd(),
+		GetParticipantRewardsCmd(),
+		GetSimulateIncentiveCmd(),
 		GetAllocationMetersCmd(),
 		GetAllocationMeterCmd(),
 		GetParamsCmd(),
@@ -149,6 +160,50 @@ func GetGasMetersCmd() *cobra.Command {
 	return cmd
 }
 
+// GetParticipantWeightsCmd queries the raw and effective reward weights of the
+// participants of a given incentive
+func GetParticipantWeightsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participant-weights CONTRACT_ADDRESS",
+		Short: "Gets the raw and effective reward weights of the participants of a given incentive",
+		Long:  "Gets the raw and effective reward weights of the participants of a given incentive",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryParticipantWeightsRequest{
+				Contract:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ParticipantWeights(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "participant weights")
+	return cmd
+}
+
 // GetGasMeterCmd queries the list of incentives
 func GetGasMeterCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -190,6 +245,100 @@ func GetGasMeterCmd() *cobra.Command {
 	return cmd
 }
 
+// GetParticipantRewardsCmd queries the unclaimed incentive rewards of a
+// participant
+func GetParticipantRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards PARTICIPANT_ADDRESS",
+		Short: "Gets the unclaimed incentive rewards of a participant",
+		Long:  "Gets the unclaimed incentive rewards of a participant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid participant address: %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParticipantRewardsRequest{
+				Participant: args[0],
+			}
+
+			res, err := queryClient.ParticipantRewards(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetSimulateIncentiveCmd queries the projected rewards of a hypothetical
+// incentive
+func GetSimulateIncentiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-incentive CONTRACT_ADDRESS ALLOCATION EPOCHS",
+		Short:   "Simulates an incentive and gets its projected rewards and allocation meters",
+		Long:    "Simulates an incentive and gets its projected rewards per epoch, the reward per unit of gas and the allocation meters after its registration. If no expected total gas is provided, the gas of the current gas meters of the contract is used.",
+		Example: fmt.Sprintf("$ %s query incentives simulate-incentive <contract> 0.05aevmos 10 --total-gas=1000000", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			allocations, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			epochs, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			totalGas, err := cmd.Flags().GetUint64(FlagTotalGas)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySimulateIncentiveRequest{
+				Contract:    args[0],
+				Allocations: allocations,
+				Epochs:      uint32(epochs),
+				TotalGas:    totalGas,
+			}
+
+			res, err := queryClient.SimulateIncentive(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagTotalGas, 0, "expected gas spent on the contract during one epoch (0 to use the current gas meters)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetAllocationMetersCmd queries the list of allocation meters
 func GetAllocationMetersCmd() *cobra.Command {
 	cmd := &cobra.Command{
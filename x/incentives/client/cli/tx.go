@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -17,6 +18,135 @@ import (
 	"github.com/evmos/evmos/v10/x/incentives/types"
 )
 
+// flags for the register incentive proposal
+const (
+	FlagRewardCurve          = "reward-curve"
+	FlagMaxGasPerParticipant = "max-gas-per-participant"
+	FlagERC20                = "erc20"
+	FlagContracts            = "contracts"
+	FlagDeployer             = "deployer"
+)
+
+// NewTxCmd returns a root CLI command handler for certain modules/incentives
+// transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "incentives subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateSponsoredIncentiveCmd(),
+		NewClaimIncentiveRewardsCmd(),
+	)
+	return txCmd
+}
+
+// NewCreateSponsoredIncentiveCmd returns a CLI command handler for creating an
+// incentive funded by the sender's coins
+func NewCreateSponsoredIncentiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-sponsored-incentive CONTRACT_ADDRESS REWARDS EPOCHS",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Create a contract incentive funded by the sender",
+		Long:    "Create a contract incentive funded by the sender. The rewards are escrowed and distributed evenly over the given number of epochs. Any remainder is refunded to the sender once the incentive ends.",
+		Example: fmt.Sprintf("$ %s tx incentives create-sponsored-incentive <contract> 1000000000aevmos 10 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			rewards, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			epochs, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			rewardCurveStr, err := cmd.Flags().GetString(FlagRewardCurve)
+			if err != nil {
+				return err
+			}
+
+			rewardCurve, ok := types.RewardCurve_value[rewardCurveStr]
+			if !ok {
+				return fmt.Errorf("invalid reward curve: %s", rewardCurveStr)
+			}
+
+			maxGasPerParticipant, err := cmd.Flags().GetUint64(FlagMaxGasPerParticipant)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSponsoredIncentive(
+				clientCtx.GetFromAddress(),
+				common.HexToAddress(args[0]),
+				rewards,
+				uint32(epochs),
+				types.RewardCurve(rewardCurve),
+				maxGasPerParticipant,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRewardCurve, types.RewardCurveLinear.String(), "reward curve applied to the gas of each participant (REWARD_CURVE_LINEAR or REWARD_CURVE_SQRT)")
+	cmd.Flags().Uint64(FlagMaxGasPerParticipant, 0, "maximum gas per participant accounted for rewards during one epoch (0 for no cap)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimIncentiveRewardsCmd returns a CLI command handler for claiming the
+// accumulated incentive rewards of the sender
+func NewClaimIncentiveRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-rewards",
+		Args:    cobra.NoArgs,
+		Short:   "Claim the accumulated incentive rewards of the sender",
+		Long:    "Claim the accumulated incentive rewards of the sender. If the erc20 flag is set, the claimed coins with a registered token pair are converted to ERC20 tokens.",
+		Example: fmt.Sprintf("$ %s tx incentives claim-rewards --erc20 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			asERC20, err := cmd.Flags().GetBool(FlagERC20)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimIncentiveRewards(clientCtx.GetFromAddress(), asERC20)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagERC20, false, "convert the claimed coins to ERC20 tokens if a token pair is registered")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterIncentiveProposalCmd implements the command to submit a register
 //
 //	incentive proposal
@@ -69,10 +199,142 @@ func NewRegisterIncentiveProposalCmd() *cobra.Command {
 				return err
 			}
 
+			rewardCurveStr, err := cmd.Flags().GetString(FlagRewardCurve)
+			if err != nil {
+				return err
+			}
+
+			rewardCurve, ok := types.RewardCurve_value[rewardCurveStr]
+			if !ok {
+				return fmt.Errorf("invalid reward curve: %s", rewardCurveStr)
+			}
+
+			maxGasPerParticipant, err := cmd.Flags().GetUint64(FlagMaxGasPerParticipant)
+			if err != nil {
+				return err
+			}
+
 			contract := args[0]
 
 			from := clientCtx.GetFromAddress()
-			content := types.NewRegisterIncentiveProposal(title, description, contract, allocation, uint32(epochs))
+			content := types.NewRegisterIncentiveProposal(
+				title, description, contract, allocation, uint32(epochs),
+				types.RewardCurve(rewardCurve), maxGasPerParticipant,
+			)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().String(FlagRewardCurve, types.RewardCurveLinear.String(), "reward curve applied to the gas of each participant (REWARD_CURVE_LINEAR or REWARD_CURVE_SQRT)")
+	cmd.Flags().Uint64(FlagMaxGasPerParticipant, 0, "maximum gas per participant accounted for rewards during one epoch (0 for no cap)")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewRegisterIncentiveSetProposalCmd implements the command to submit a
+// register incentive set proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewRegisterIncentiveSetProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-incentive-set NAME ALLOCATION EPOCHS",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Submit a proposal to register an incentive for a set of contracts",
+		Long:    "Submit a proposal to register an incentive for a named set of contracts and/or all the contracts registered on x/revenue by a deployer.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-incentive-set <name> 0.05aevmos 10 --contracts=<contract>,<contract> --deployer=<deployer> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			allocation, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			epochs, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			contracts, err := cmd.Flags().GetStringSlice(FlagContracts)
+			if err != nil {
+				return err
+			}
+
+			for _, contract := range contracts {
+				if !common.IsHexAddress(contract) {
+					return fmt.Errorf("invalid contract address: %s", contract)
+				}
+			}
+
+			deployer, err := cmd.Flags().GetString(FlagDeployer)
+			if err != nil {
+				return err
+			}
+
+			rewardCurveStr, err := cmd.Flags().GetString(FlagRewardCurve)
+			if err != nil {
+				return err
+			}
+
+			rewardCurve, ok := types.RewardCurve_value[rewardCurveStr]
+			if !ok {
+				return fmt.Errorf("invalid reward curve: %s", rewardCurveStr)
+			}
+
+			maxGasPerParticipant, err := cmd.Flags().GetUint64(FlagMaxGasPerParticipant)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewRegisterIncentiveSetProposal(
+				title, description, args[0], contracts, deployer, allocation, uint32(epochs),
+				types.RewardCurve(rewardCurve), maxGasPerParticipant,
+			)
 
 			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -90,6 +352,10 @@ func NewRegisterIncentiveProposalCmd() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().StringSlice(FlagContracts, []string{}, "comma separated hex addresses of the member contracts of the set")
+	cmd.Flags().String(FlagDeployer, "", "bech32 address of a deployer whose contracts registered on x/revenue are members of the set")
+	cmd.Flags().String(FlagRewardCurve, types.RewardCurveLinear.String(), "reward curve applied to the gas of each participant (REWARD_CURVE_LINEAR or REWARD_CURVE_SQRT)")
+	cmd.Flags().Uint64(FlagMaxGasPerParticipant, 0, "maximum gas per participant accounted for rewards during one epoch (0 for no cap)")
 	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
 		panic(err)
 	}
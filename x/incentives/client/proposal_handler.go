@@ -7,6 +7,7 @@ import (
 )
 
 var (
-	RegisterIncentiveProposalHandler = govclient.NewProposalHandler(cli.NewRegisterIncentiveProposalCmd)
-	CancelIncentiveProposalHandler   = govclient.NewProposalHandler(cli.NewCancelIncentiveProposalCmd)
+	RegisterIncentiveProposalHandler    = govclient.NewProposalHandler(cli.NewRegisterIncentiveProposalCmd)
+	RegisterIncentiveSetProposalHandler = govclient.NewProposalHandler(cli.NewRegisterIncentiveSetProposalCmd)
+	CancelIncentiveProposalHandler      = govclient.NewProposalHandler(cli.NewCancelIncentiveProposalCmd)
 )
@@ -29,6 +29,7 @@ func InitGenesis(
 	for _, incentive := range data.Incentives {
 		// Set Incentives
 		k.SetIncentive(ctx, incentive)
+		k.SetIncentiveSetMembers(ctx, incentive)
 
 		// Build allocation meter map
 		for _, al := range incentive.Allocations {
@@ -55,13 +56,23 @@ func InitGenesis(
 	for _, gasMeter := range data.GasMeters {
 		k.SetGasMeter(ctx, gasMeter)
 	}
+
+	// Set unclaimed participant rewards
+	for _, pr := range data.ParticipantRewards {
+		k.SetParticipantRewards(ctx, pr)
+	}
+
+	if err := k.InstallSystemContract(ctx); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		Incentives: k.GetAllIncentives(ctx),
-		GasMeters:  k.GetIncentivesGasMeters(ctx),
+		Params:             k.GetParams(ctx),
+		Incentives:         k.GetAllIncentives(ctx),
+		GasMeters:          k.GetIncentivesGasMeters(ctx),
+		ParticipantRewards: k.GetAllParticipantRewards(ctx),
 	}
 }
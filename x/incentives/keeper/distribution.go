@@ -17,10 +17,12 @@ import (
 
 // DistributeRewards transfers the allocated rewards to the participants of a given
 // incentive.
-//   - allocates the amount to be distributed from the inflation pool
+//   - allocates the amount to be distributed from the inflation pool or the
+//     sponsor's escrow
 //   - distributes the rewards to all participants
 //   - deletes all gas meters
 //   - updates the remaining epochs of each incentive
+//   - refunds the remaining escrow of finalized sponsored incentives
 //   - sets the cumulative totalGas to zero
 func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 	logger := k.Logger(ctx)
@@ -31,16 +33,30 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 	}
 
 	k.IterateIncentives(ctx, func(incentive types.Incentive) (stop bool) {
-		_, _ = k.rewardParticipants(ctx, incentive, rewardAllocations)
+		rewards, _ := k.rewardParticipants(ctx, incentive, rewardAllocations)
 
 		incentive.Epochs--
 
+		// Deduct the distributed rewards from the sponsor's escrow
+		if incentive.IsSponsored() {
+			incentive.SponsoredRewards = incentive.SponsoredRewards.Sub(rewards...)
+		}
+
 		// Update Incentive and reset its total gas count. Remove incentive if it
 		// has no remaining epochs left.
 		if incentive.IsActive() {
 			k.SetIncentive(ctx, incentive)
 			k.SetIncentiveTotalGas(ctx, incentive, 0)
 		} else {
+			if err := k.refundSponsoredIncentive(ctx, incentive); err != nil {
+				logger.Error(
+					"failed to refund sponsored incentive",
+					"contract", incentive.Contract,
+					"sponsor", incentive.Sponsor,
+					"error", err.Error(),
+				)
+			}
+
 			k.DeleteIncentiveAndUpdateAllocationMeters(ctx, incentive)
 			logger.Info(
 				"incentive finalized",
@@ -76,9 +92,32 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 	return nil
 }
 
+// deductMissedEpochs deducts the epochs that were missed during a chain halt
+// from the remaining epochs of each incentive, so that incentives end at the
+// same time as without the halt. Each incentive keeps the epoch that is ending,
+// so that the escrow of a sponsored incentive is distributed over its
+// remaining epochs.
+func (k Keeper) deductMissedEpochs(ctx sdk.Context, missedEpochs int64) {
+	if missedEpochs <= 0 {
+		return
+	}
+
+	k.IterateIncentives(ctx, func(incentive types.Incentive) (stop bool) {
+		if int64(incentive.Epochs) > missedEpochs {
+			incentive.Epochs -= uint32(missedEpochs)
+		} else {
+			incentive.Epochs = 1
+		}
+
+		k.SetIncentive(ctx, incentive)
+		return false
+	})
+}
+
 // rewardAllocations returns a map of each incentive's reward allocation
 //   - Iterate over all the registered and active incentives
 //   - create an allocation (module account) from escrow balance to be distributed to the contract address
+//   - allocate an even split of the remaining sponsored rewards to sponsored incentives
 //   - check that escrow balance is sufficient
 func (k Keeper) rewardAllocations(
 	ctx sdk.Context,
@@ -89,13 +128,22 @@ func (k Keeper) rewardAllocations(
 
 	escrow := sdk.Coins{}
 
+	// escrowed rewards of sponsored incentives and unclaimed participant rewards
+	// are held by the module account but are not part of the inflation pool
+	reserved := k.GetSponsoredEscrow(ctx).Add(k.GetTotalUnclaimedRewards(ctx)...)
+
 	// iterate over the module account balance insert elements to the denom -> amount
 	// lookup map
 	k.bankKeeper.IterateAccountBalances(ctx, moduleAddr, func(coin sdk.Coin) bool {
-		denomBalances[coin.Denom] = coin.Amount
+		amount := coin.Amount.Sub(reserved.AmountOf(coin.Denom))
+		if !amount.IsPositive() {
+			return false
+		}
+
+		denomBalances[coin.Denom] = amount
 		// NOTE: all coins have different denomination so we can safely append instead
 		// of using Add
-		escrow = append(escrow, coin)
+		escrow = append(escrow, sdk.Coin{Denom: coin.Denom, Amount: amount})
 		return false
 	})
 
@@ -110,6 +158,12 @@ func (k Keeper) rewardAllocations(
 			coins := sdk.Coins{}
 			contract := common.HexToAddress(incentive.Contract)
 
+			// sponsored incentives are funded by their own escrow
+			if incentive.IsSponsored() {
+				rewardAllocations[contract] = incentive.SponsoredEpochRewards()
+				return false
+			}
+
 			// calculate allocation for the incentivized contract
 			for _, al := range incentive.Allocations {
 				// Check if a balance to allocate exists
@@ -153,9 +207,12 @@ func (k Keeper) rewardAllocations(
 // rewardParticipants reward participants of a given Incentive, delete their gas
 // meters and returns a count of all gas meters
 //   - Check if participants spent gas on interacting with incentive
+//   - Compute the total effective gas of the incentive according to its reward
+//     curve and participant cap
 //   - Iterate over the incentive participants' gas meters
-//   - Allocate rewards according to participants gasRatio and cap them at 100% of their gas spent on interaction with incentive
-//   - Send rewards to participants
+//   - Allocate rewards according to participants effective gas ratio and cap them at 100% of their gas spent on interaction with incentive
+//   - Send rewards to participants, or accumulate them to be claimed later if
+//     claimable rewards are enabled
 //   - Delete gas meter
 func (k Keeper) rewardParticipants(
 	ctx sdk.Context,
@@ -185,45 +242,82 @@ func (k Keeper) rewardParticipants(
 		return sdk.Coins{}, 0
 	}
 
-	totalGasDec := sdk.NewDecFromBigInt(new(big.Int).SetUint64(totalGas))
+	totalEffectiveGas, err := k.GetIncentiveTotalEffectiveGas(ctx, incentive)
+	if err != nil || !totalEffectiveGas.IsPositive() {
+		logger.Debug(
+			"failed to compute total effective gas of incentive",
+			"contract", incentive.Contract,
+			"error", err,
+		)
+		// Remove the gas meters so that they don't carry over to the next epoch
+		for _, gm := range k.GetIncentiveGasMeters(ctx, contract) {
+			k.DeleteGasMeter(ctx, gm)
+		}
+		return sdk.Coins{}, 0
+	}
+
 	mintDenom := k.evmKeeper.GetParams(ctx).EvmDenom
-	rewardScaler := k.GetParams(ctx).RewardScaler
+	params := k.GetParams(ctx)
+	rewardScaler := params.RewardScaler
 
 	// Iterate over the incentive's gas meters and distribute rewards
 	k.IterateIncentiveGasMeters(
 		ctx,
 		contract,
 		func(gm types.GasMeter) (stop bool) {
-			// Get participant's ratio of `gas spent / total gas spent`
+			// Get participant's ratio of `effective gas / total effective gas`
 			cumulativeGas := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gm.CumulativeGas))
-			gasRatio := cumulativeGas.Quo(totalGasDec)
+			effectiveGas, err := incentive.EffectiveGas(gm.CumulativeGas)
+			if err != nil {
+				logger.Debug(
+					"failed to compute effective gas of participant",
+					"address", gm.Participant,
+					"incentive", gm.Contract,
+					"error", err.Error(),
+				)
+				// Remove the gas meter so that it doesn't carry over to the next epoch
+				k.DeleteGasMeter(ctx, gm)
+				return false
+			}
+
+			gasRatio := effectiveGas.Quo(totalEffectiveGas)
 			coins := sdk.Coins{}
 
 			// Allocate rewards according to gasRatio
-			for _, allocation := range incentive.Allocations {
-				coinAllocated := contractAllocation.AmountOf(allocation.Denom)
-				reward := gasRatio.MulInt(coinAllocated)
+			for _, coinAllocated := range contractAllocation {
+				reward := gasRatio.MulInt(coinAllocated.Amount)
 				if !reward.IsPositive() {
 					continue
 				}
 
 				// Cap rewards in mint denom (i.e. aevmos) to receive only up to 100% of
 				// the participant's gas spent and prevent gaming
-				if mintDenom == allocation.Denom {
+				if mintDenom == coinAllocated.Denom {
 					rewardCap := cumulativeGas.Mul(rewardScaler)
 					reward = sdk.MinDec(reward, rewardCap)
 				}
 
 				// NOTE: ignore denom validation
-				coin := sdk.Coin{Denom: allocation.Denom, Amount: reward.TruncateInt()}
+				coin := sdk.Coin{Denom: coinAllocated.Denom, Amount: reward.TruncateInt()}
 				coins = coins.Add(coin)
 			}
 
-			rewards = rewards.Add(coins...)
+			participant := common.HexToAddress(gm.Participant)
+
+			// Accumulate rewards to be claimed by the participant
+			if params.EnableClaimableRewards {
+				if !coins.IsZero() {
+					k.AddParticipantRewards(ctx, participant, coins)
+				}
+
+				rewards = rewards.Add(coins...)
+				k.DeleteGasMeter(ctx, gm)
+				count++
+				return false
+			}
 
 			// Send rewards to participant
-			participant := common.HexToAddress(gm.Participant)
-			err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(
 				ctx,
 				types.ModuleName,
 				sdk.AccAddress(participant.Bytes()),
@@ -240,6 +334,8 @@ func (k Keeper) rewardParticipants(
 				return true // break iteration
 			}
 
+			rewards = rewards.Add(coins...)
+
 			// Remove gas meter once the rewards are distributed
 			k.DeleteGasMeter(ctx, gm)
 			count++
@@ -250,3 +346,39 @@ func (k Keeper) rewardParticipants(
 
 	return rewards, count
 }
+
+// GetIncentiveTotalEffectiveGas returns the sum of the effective gas of all the
+// participants of an incentive during the current epoch.
+func (k Keeper) GetIncentiveTotalEffectiveGas(
+	ctx sdk.Context,
+	incentive types.Incentive,
+) (total sdk.Dec, err error) {
+	// NOTE: without curve nor cap the effective gas equals the cumulative gas, so
+	// the incentive's total gas already tracks the sum over all participants
+	if incentive.RewardCurve == types.RewardCurveLinear && incentive.MaxGasPerParticipant == 0 {
+		return sdk.NewDecFromBigInt(new(big.Int).SetUint64(incentive.TotalGas)), nil
+	}
+
+	total = sdk.ZeroDec()
+
+	k.IterateIncentiveGasMeters(
+		ctx,
+		common.HexToAddress(incentive.Contract),
+		func(gm types.GasMeter) (stop bool) {
+			var effectiveGas sdk.Dec
+			effectiveGas, err = incentive.EffectiveGas(gm.CumulativeGas)
+			if err != nil {
+				return true
+			}
+
+			total = total.Add(effectiveGas)
+			return false
+		},
+	)
+
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return total, nil
+}
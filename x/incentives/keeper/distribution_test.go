@@ -77,6 +77,8 @@ func (suite *KeeperTestSuite) TestDistributeIncentives() {
 				contract,
 				tc.allocations,
 				tc.epochs,
+				types.RewardCurveLinear,
+				0,
 			)
 			suite.Require().NoError(err)
 
@@ -139,3 +141,173 @@ func (suite *KeeperTestSuite) TestDistributeIncentives() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDistributeIncentivesRewardCurve() {
+	const mintAmount int64 = 2000
+
+	testCases := []struct {
+		name        string
+		rewardCurve types.RewardCurve
+		maxGas      uint64
+		gasUsed     uint64
+		gasUsed2    uint64
+		expReward   int64
+		expReward2  int64
+	}{
+		{
+			"linear - rewards proportional to gas",
+			types.RewardCurveLinear,
+			0,
+			9000,
+			1000,
+			90,
+			10,
+		},
+		{
+			"sqrt - rewards proportional to square root of gas",
+			types.RewardCurveSqrt,
+			0,
+			9000,
+			1000,
+			75,
+			25,
+		},
+		{
+			"linear - gas capped per participant",
+			types.RewardCurveLinear,
+			1000,
+			9000,
+			1000,
+			50,
+			50,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			err := suite.app.BankKeeper.MintCoins(
+				suite.ctx,
+				types.ModuleName,
+				sdk.Coins{sdk.NewInt64Coin(denomCoin, mintAmount)},
+			)
+			suite.Require().NoError(err)
+
+			// allocate 5% of the module balance to the incentive
+			_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+				suite.ctx,
+				contract,
+				allocations,
+				epochs,
+				tc.rewardCurve,
+				tc.maxGas,
+			)
+			suite.Require().NoError(err)
+
+			regIn, found := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+			suite.Require().True(found)
+
+			suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, tc.gasUsed))
+			suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant2, tc.gasUsed2))
+			suite.app.IncentivesKeeper.SetIncentiveTotalGas(suite.ctx, regIn, tc.gasUsed+tc.gasUsed2)
+			suite.Commit()
+
+			err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+			suite.Require().NoError(err)
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant.Bytes()), denomCoin)
+			suite.Require().Equal(sdk.NewInt(tc.expReward), balance.Amount)
+
+			balance2 := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant2.Bytes()), denomCoin)
+			suite.Require().Equal(sdk.NewInt(tc.expReward2), balance2.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeIncentivesInvalidRewardCurve() {
+	suite.SetupTest()
+
+	err := suite.app.BankKeeper.MintCoins(
+		suite.ctx,
+		types.ModuleName,
+		sdk.Coins{sdk.NewInt64Coin(denomCoin, 2000)},
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		allocations,
+		epochs,
+		types.RewardCurveSqrt,
+		0,
+	)
+	suite.Require().NoError(err)
+
+	regIn, found := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.Require().True(found)
+	regIn.RewardCurve = types.RewardCurve(100)
+	suite.app.IncentivesKeeper.SetIncentive(suite.ctx, regIn)
+
+	suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 9000))
+	suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant2, 1000))
+	suite.app.IncentivesKeeper.SetIncentiveTotalGas(suite.ctx, regIn, 10000)
+	suite.Commit()
+
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	// no rewards are distributed and all gas meters are deleted
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant.Bytes()), denomCoin)
+	suite.Require().True(balance.IsZero())
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetIncentiveGasMeters(suite.ctx, contract))
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndMissedEpochs() {
+	testCases := []struct {
+		name         string
+		missedEpochs int64
+		expEpochs    uint32
+		expFinalized bool
+	}{
+		{"no missed epochs", 0, epochs - 1, false},
+		{"missed epochs are deducted", 3, epochs - 4, false},
+		{"missed epochs exceed the remaining epochs", int64(epochs) + 5, 0, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+			params.EnableIncentives = true
+			suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+			err := suite.app.BankKeeper.MintCoins(
+				suite.ctx,
+				types.ModuleName,
+				sdk.Coins{sdk.NewInt64Coin(denomMint, 1000)},
+			)
+			suite.Require().NoError(err)
+
+			_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+				suite.ctx,
+				contract,
+				mintAllocations,
+				epochs,
+				types.RewardCurveLinear,
+				0,
+			)
+			suite.Require().NoError(err)
+
+			suite.app.IncentivesKeeper.AfterEpochEnd(suite.ctx, params.IncentivesEpochIdentifier, 2, tc.missedEpochs)
+
+			incentive, found := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+			if tc.expFinalized {
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expEpochs, incentive.Epochs)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
@@ -9,8 +11,13 @@ import (
 // BeforeEpochStart performs a no-op
 func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
 
-// AfterEpochEnd distributes the contract incentives at the end of each epoch
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+// AfterEpochDurationChange performs a no-op
+func (k Keeper) AfterEpochDurationChange(_ sdk.Context, _ string, _ int64, _, _ time.Duration) {}
+
+// AfterEpochEnd distributes the contract incentives at the end of each epoch.
+// The epochs that were missed by the catch-up policy of the epoch are deducted
+// from the remaining epochs of the incentives before the distribution.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _, missedEpochs int64) {
 	params := k.GetParams(ctx)
 
 	// check if epochIdentifier signal equals the identifier in the params
@@ -23,6 +30,8 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64)
 		return
 	}
 
+	k.deductMissedEpochs(ctx, missedEpochs)
+
 	if err := k.DistributeRewards(ctx); err != nil {
 		panic(err)
 	}
@@ -48,6 +57,16 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 }
 
 // AfterEpochEnd implements EpochHooks
-func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
-	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber, missedEpochs int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber, missedEpochs)
+}
+
+// AfterEpochDurationChange implements EpochHooks
+func (h Hooks) AfterEpochDurationChange(
+	ctx sdk.Context,
+	epochIdentifier string,
+	epochNumber int64,
+	oldDuration, newDuration time.Duration,
+) {
+	h.k.AfterEpochDurationChange(ctx, epochIdentifier, epochNumber, oldDuration, newDuration)
 }
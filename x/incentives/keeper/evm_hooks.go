@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -11,6 +13,7 @@ import (
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
+	evmostypes "github.com/evmos/evmos/v10/types"
 	"github.com/evmos/evmos/v10/x/incentives/types"
 )
 
@@ -22,21 +25,47 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 	return h.k.PostTxProcessing(ctx, msg, receipt)
 }
 
+// gasShare is the portion of the gas used by a transaction that is attributed
+// to an incentive
+type gasShare struct {
+	incentive common.Address
+	gas       uint64
+}
+
 // PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
 // interaction with an incentivized contract, the participants's GasUsed is
-// added to its gasMeter.
+// added to its gasMeter. The gas spent on a member of an incentive set is
+// metered into the shared incentive of the set. If internal call metering is
+// enabled, the gas is split among all the contracts reached by the transaction.
+// Calls to the incentives system contract claim the rewards of the caller.
 func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	// claims through the system contract are processed like
+	// MsgClaimIncentiveRewards, regardless of the incentives param
+	if err := k.processSystemCalls(ctx, receipt); err != nil {
+		return err
+	}
+
 	// check if the Incentives are globally enabled
 	params := k.GetParams(ctx)
 	if !params.EnableIncentives {
 		return nil
 	}
 
-	contract := msg.To()
+	// the participant is the originating EOA of the transaction (tx.origin)
 	participant := msg.From()
 
-	// If theres no incentive registered for the contract, do nothing
-	if contract == nil || !k.IsIncentiveRegistered(ctx, *contract) {
+	var shares []gasShare
+	if params.EnableInternalCallMetering {
+		shares = k.internalCallGasShares(ctx, msg, receipt)
+	} else if msg.To() != nil {
+		// If theres no incentive registered for the contract nor an incentive set
+		// that contains it, do nothing
+		if contract, found := k.GetIncentiveOfContract(ctx, *msg.To()); found {
+			shares = []gasShare{{incentive: contract, gas: receipt.GasUsed}}
+		}
+	}
+
+	if len(shares) == 0 {
 		return nil
 	}
 
@@ -51,8 +80,10 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *eth
 		return nil
 	}
 
-	k.addGasToIncentive(ctx, *contract, receipt.GasUsed)
-	k.addGasToParticipant(ctx, *contract, participant, receipt.GasUsed)
+	for _, share := range shares {
+		k.addGasToIncentive(ctx, share.incentive, share.gas)
+		k.addGasToParticipant(ctx, share.incentive, participant, share.gas)
+	}
 
 	defer func() {
 		telemetry.IncrCounter(
@@ -71,6 +102,128 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *eth
 	return nil
 }
 
+// processSystemCalls executes the calls to the incentives system contract.
+// Each call emits a log with the caller and the calldata, which is executed as
+// a MsgClaimIncentiveRewards with the caller as participant. Returning an error
+// reverts the EVM transaction.
+func (k Keeper) processSystemCalls(ctx sdk.Context, receipt *ethtypes.Receipt) error {
+	for _, log := range receipt.Logs {
+		if log.Address != types.SystemContractAddress ||
+			len(log.Topics) != 2 ||
+			log.Topics[0] != evmostypes.SystemCallEventID {
+			continue
+		}
+
+		caller := common.BytesToAddress(log.Topics[1].Bytes())
+		if err := k.executeSystemCall(ctx, caller, log.Data); err != nil {
+			return errorsmod.Wrap(err, "failed to execute incentives system contract call")
+		}
+	}
+
+	return nil
+}
+
+// executeSystemCall decodes the calldata of an incentives system contract call
+// and executes the corresponding msg with the caller as participant
+func (k Keeper) executeSystemCall(ctx sdk.Context, caller common.Address, calldata []byte) error {
+	if len(calldata) < 4 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "calldata too short")
+	}
+
+	method, err := types.SystemContractABI.MethodById(calldata[:4])
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	values, err := method.Inputs.Unpack(calldata[4:])
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if method.Name != types.SystemContractMethodClaimRewards {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "unknown method %s", method.Name)
+	}
+
+	var args types.ClaimRewardsArgs
+	if err := method.Inputs.Copy(&args, values); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	msg := types.NewMsgClaimIncentiveRewards(sdk.AccAddress(caller.Bytes()), args.AsERC20)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	_, err = k.ClaimIncentiveRewards(sdk.WrapSDKContext(ctx), msg)
+	return err
+}
+
+// InstallSystemContract sets the runtime bytecode of the incentives system
+// contract at its address
+func (k Keeper) InstallSystemContract(ctx sdk.Context) error {
+	return evmostypes.InstallSystemContract(ctx, k.evmKeeper, types.SystemContractAddress)
+}
+
+// internalCallGasShares splits the gas used by a transaction evenly among all
+// the contracts it reached, i.e. the recipient of the transaction and every
+// contract that emitted a log, and returns the shares of the contracts that
+// resolve to an incentive. The remainder of the split is attributed to the
+// first contract reached.
+func (k Keeper) internalCallGasShares(
+	ctx sdk.Context,
+	msg core.Message,
+	receipt *ethtypes.Receipt,
+) []gasShare {
+	contracts := []common.Address{}
+	seen := make(map[common.Address]bool)
+
+	if msg.To() != nil {
+		contracts = append(contracts, *msg.To())
+		seen[*msg.To()] = true
+	}
+
+	for _, log := range receipt.Logs {
+		if seen[log.Address] {
+			continue
+		}
+		contracts = append(contracts, log.Address)
+		seen[log.Address] = true
+	}
+
+	if len(contracts) == 0 {
+		return nil
+	}
+
+	gasPerContract := receipt.GasUsed / uint64(len(contracts))
+	remainder := receipt.GasUsed % uint64(len(contracts))
+
+	shares := []gasShare{}
+	indexes := make(map[common.Address]int)
+
+	for i, contract := range contracts {
+		gas := gasPerContract
+		if i == 0 {
+			gas += remainder
+		}
+
+		incentive, found := k.GetIncentiveOfContract(ctx, contract)
+		if !found || gas == 0 {
+			continue
+		}
+
+		// contracts of the same incentive set accumulate their shares
+		if idx, ok := indexes[incentive]; ok {
+			shares[idx].gas += gas
+			continue
+		}
+
+		indexes[incentive] = len(shares)
+		shares = append(shares, gasShare{incentive: incentive, gas: gas})
+	}
+
+	return shares
+}
+
 // addGasToIncentive adds gasUsed to an incentive's cumulated totalGas
 func (k Keeper) addGasToIncentive(
 	ctx sdk.Context,
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -142,6 +143,8 @@ func (suite *KeeperTestSuite) TestEvmHooksStoreTxGasUsed() {
 				contractAddr,
 				mintAllocations,
 				epochs,
+				types.RewardCurveLinear,
+				0,
 			)
 			suite.Require().NoError(err)
 
@@ -177,3 +180,103 @@ func (suite *KeeperTestSuite) TestEvmHooksStoreTxGasUsed() {
 	}
 	suite.mintFeeCollector = false
 }
+
+func (suite *KeeperTestSuite) TestEvmHooksInternalCallMetering() {
+	aggregator := tests.GenerateAddress()
+	gasUsed := uint64(1000)
+
+	testCases := []struct {
+		name     string
+		malleate func() (to common.Address, logs []*ethtypes.Log)
+		enabled  bool
+		expGas   func() map[common.Address]uint64
+	}{
+		{
+			"internal call metering disabled",
+			func() (common.Address, []*ethtypes.Log) {
+				return aggregator, []*ethtypes.Log{{Address: contract}}
+			},
+			false,
+			func() map[common.Address]uint64 {
+				return map[common.Address]uint64{}
+			},
+		},
+		{
+			"direct call",
+			func() (common.Address, []*ethtypes.Log) {
+				return contract, []*ethtypes.Log{{Address: contract}}
+			},
+			true,
+			func() map[common.Address]uint64 {
+				return map[common.Address]uint64{contract: gasUsed}
+			},
+		},
+		{
+			"internal call through aggregator",
+			func() (common.Address, []*ethtypes.Log) {
+				return aggregator, []*ethtypes.Log{{Address: contract}, {Address: contract}}
+			},
+			true,
+			func() map[common.Address]uint64 {
+				return map[common.Address]uint64{contract: gasUsed / 2}
+			},
+		},
+		{
+			"internal calls to two incentivized contracts",
+			func() (common.Address, []*ethtypes.Log) {
+				_, err := suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, contract2, mintAllocations, epochs, types.RewardCurveLinear, 0)
+				suite.Require().NoError(err)
+				return aggregator, []*ethtypes.Log{{Address: contract}, {Address: contract2}}
+			},
+			true,
+			func() map[common.Address]uint64 {
+				return map[common.Address]uint64{contract: gasUsed / 3, contract2: gasUsed / 3}
+			},
+		},
+		{
+			"internal calls to members of an incentive set",
+			func() (common.Address, []*ethtypes.Log) {
+				_, err := suite.app.IncentivesKeeper.RegisterIncentiveSet(
+					suite.ctx, setName, []common.Address{contract2}, nil, mintAllocations, epochs, types.RewardCurveLinear, 0,
+				)
+				suite.Require().NoError(err)
+				return aggregator, []*ethtypes.Log{{Address: contract2}}
+			},
+			true,
+			func() map[common.Address]uint64 {
+				return map[common.Address]uint64{types.IncentiveSetAddress(setName): gasUsed / 2}
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+			params.EnableInternalCallMetering = tc.enabled
+			suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+			_, err := suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, contract, mintAllocations, epochs, types.RewardCurveLinear, 0)
+			suite.Require().NoError(err)
+
+			acc := authtypes.NewBaseAccount(sdk.AccAddress(participant.Bytes()), nil, 0, 0)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			to, logs := tc.malleate()
+			msg := ethtypes.NewMessage(participant, &to, 0, nil, 0, nil, nil, nil, nil, nil, false)
+			receipt := &ethtypes.Receipt{GasUsed: gasUsed, Logs: logs}
+
+			err = suite.app.IncentivesKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+			suite.Require().NoError(err)
+
+			expGas := tc.expGas()
+			for _, incentiveAddr := range []common.Address{contract, contract2, types.IncentiveSetAddress(setName)} {
+				gm, _ := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, incentiveAddr, participant)
+				suite.Require().Equal(expGas[incentiveAddr], gm, incentiveAddr.String())
+
+				incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, incentiveAddr)
+				suite.Require().Equal(expGas[incentiveAddr], incentive.TotalGas, incentiveAddr.String())
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"math/big"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -209,6 +210,228 @@ func (k Keeper) GasMeter(
 	return &types.QueryGasMeterResponse{GasMeter: gm}, nil
 }
 
+// ParticipantWeights returns the raw and effective reward weights of the
+// participants of an incentive
+func (k Keeper) ParticipantWeights(
+	c context.Context,
+	req *types.QueryParticipantWeightsRequest,
+) (*types.QueryParticipantWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Contract) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"contract address is empty",
+		)
+	}
+
+	// check if the contract is a hex address
+	if err := ethermint.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract address %s", req.Contract).Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contract := common.HexToAddress(req.Contract)
+
+	incentive, found := k.GetIncentive(ctx, contract)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"incentive with contract '%s'",
+			req.Contract,
+		)
+	}
+
+	totalEffectiveGas, err := k.GetIncentiveTotalEffectiveGas(ctx, incentive)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixGasMeter, contract.Bytes()...))
+
+	weights := []types.ParticipantWeight{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key, value []byte) error {
+			cumulativeGas := sdk.BigEndianToUint64(value)
+			effectiveGas, err := incentive.EffectiveGas(cumulativeGas)
+			if err != nil {
+				return err
+			}
+
+			weight := sdk.ZeroDec()
+			if totalEffectiveGas.IsPositive() {
+				weight = effectiveGas.Quo(totalEffectiveGas)
+			}
+
+			weights = append(weights, types.ParticipantWeight{
+				Participant:   common.BytesToAddress(key).Hex(),
+				CumulativeGas: cumulativeGas,
+				EffectiveGas:  effectiveGas,
+				Weight:        weight,
+			})
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParticipantWeightsResponse{
+		ParticipantWeights: weights,
+		TotalEffectiveGas:  totalEffectiveGas,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// ParticipantRewards returns the unclaimed incentive rewards of a participant
+func (k Keeper) ParticipantRewards(
+	c context.Context,
+	req *types.QueryParticipantRewardsRequest,
+) (*types.QueryParticipantRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Participant) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"participant address is empty",
+		)
+	}
+
+	// check if the participant is a hex address
+	if err := ethermint.ValidateAddress(req.Participant); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid participant address %s", req.Participant).Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pr, found := k.GetParticipantRewards(ctx, common.HexToAddress(req.Participant))
+	if !found {
+		return &types.QueryParticipantRewardsResponse{Rewards: sdk.Coins{}}, nil
+	}
+
+	return &types.QueryParticipantRewardsResponse{Rewards: pr.Rewards}, nil
+}
+
+// SimulateIncentive projects the rewards of a hypothetical incentive for a
+// contract and its effect on the allocation meters. The incentive is registered
+// on a cached context that is discarded afterwards. If the contract is already
+// incentivized, the simulated incentive replaces the existing one.
+func (k Keeper) SimulateIncentive(
+	c context.Context,
+	req *types.QuerySimulateIncentiveRequest,
+) (*types.QuerySimulateIncentiveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Contract) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"contract address is empty",
+		)
+	}
+
+	// check if the contract is a hex address
+	if err := ethermint.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be hex ('0x...')", req.Contract,
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	// NOTE: the cached context is never written, so the simulation doesn't
+	// modify the state
+	cacheCtx, _ := ctx.CacheContext()
+	contract := common.HexToAddress(req.Contract)
+
+	// use the gas spent on the contract during the current epoch if no expected
+	// gas is provided
+	totalGas := req.TotalGas
+	if totalGas == 0 {
+		k.IterateIncentiveGasMeters(cacheCtx, contract, func(gm types.GasMeter) (stop bool) {
+			totalGas += gm.CumulativeGas
+			return false
+		})
+	}
+
+	if incentive, found := k.GetIncentive(cacheCtx, contract); found {
+		k.DeleteIncentiveAndUpdateAllocationMeters(cacheCtx, incentive)
+	}
+
+	if _, err := k.RegisterIncentive(
+		cacheCtx,
+		contract,
+		req.Allocations,
+		req.Epochs,
+		types.RewardCurveLinear,
+		0,
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	allocations, _, err := k.rewardAllocations(cacheCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rewards := allocations[contract]
+	if rewards == nil {
+		rewards = sdk.Coins{}
+	}
+
+	totalRewards := sdk.Coins{}
+	rewardPerGas := sdk.DecCoins{}
+	for _, reward := range rewards {
+		totalRewards = totalRewards.Add(sdk.NewCoin(
+			reward.Denom,
+			reward.Amount.MulRaw(int64(req.Epochs)),
+		))
+
+		if totalGas > 0 {
+			rewardPerGas = rewardPerGas.Add(sdk.NewDecCoinFromDec(
+				reward.Denom,
+				sdk.NewDecFromInt(reward.Amount).Quo(sdk.NewDecFromBigInt(new(big.Int).SetUint64(totalGas))),
+			))
+		}
+	}
+
+	// the headroom of each denom is the largest allocation that a further
+	// incentive could get without exceeding the allocation limit nor 100%
+	allocationLimit := k.GetParams(cacheCtx).AllocationLimit
+	allocationMeters := sdk.DecCoins{}
+	allocationHeadroom := sdk.DecCoins{}
+	for _, al := range req.Allocations {
+		am, _ := k.GetAllocationMeter(cacheCtx, al.Denom)
+		allocationMeters = append(allocationMeters, am)
+
+		headroom := sdk.MinDec(allocationLimit, sdk.OneDec().Sub(am.Amount))
+		allocationHeadroom = append(allocationHeadroom, sdk.NewDecCoinFromDec(al.Denom, headroom))
+	}
+
+	return &types.QuerySimulateIncentiveResponse{
+		Rewards:            rewards,
+		TotalRewards:       totalRewards,
+		TotalGas:           totalGas,
+		RewardPerGas:       rewardPerGas,
+		AllocationMeters:   allocationMeters.Sort(),
+		AllocationHeadroom: allocationHeadroom.Sort(),
+	}, nil
+}
+
 // AllocationMeters return registered allocation meters
 func (k Keeper) AllocationMeters(
 	c context.Context,
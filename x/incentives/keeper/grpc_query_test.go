@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -328,6 +330,240 @@ func (suite *KeeperTestSuite) TestGasMeter() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestParticipantWeights() {
+	var (
+		req    *types.QueryParticipantWeightsRequest
+		expRes *types.QueryParticipantWeightsResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty contract address",
+			func() {
+				req = &types.QueryParticipantWeightsRequest{}
+			},
+			false,
+		},
+		{
+			"invalid contract address",
+			func() {
+				req = &types.QueryParticipantWeightsRequest{
+					Contract: "123",
+				}
+			},
+			false,
+		},
+		{
+			"incentive not registered",
+			func() {
+				req = &types.QueryParticipantWeightsRequest{
+					Contract: contract.Hex(),
+				}
+			},
+			false,
+		},
+		{
+			"2 participants with sqrt reward curve",
+			func() {
+				req = &types.QueryParticipantWeightsRequest{
+					Contract:   contract.Hex(),
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				in := types.NewIncentive(contract, allocations, epochs)
+				in.RewardCurve = types.RewardCurveSqrt
+				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, in)
+
+				suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 900))
+				suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant2, 100))
+				suite.Commit()
+
+				expRes = &types.QueryParticipantWeightsResponse{
+					ParticipantWeights: []types.ParticipantWeight{
+						{
+							Participant:   participant.Hex(),
+							CumulativeGas: 900,
+							EffectiveGas:  sdk.NewDec(30),
+							Weight:        sdk.NewDecWithPrec(75, 2),
+						},
+						{
+							Participant:   participant2.Hex(),
+							CumulativeGas: 100,
+							EffectiveGas:  sdk.NewDec(10),
+							Weight:        sdk.NewDecWithPrec(25, 2),
+						},
+					},
+					TotalEffectiveGas: sdk.NewDec(40),
+					Pagination:        &query.PageResponse{Total: 2},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.ParticipantWeights(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination, res.Pagination)
+				suite.Require().Equal(expRes.TotalEffectiveGas, res.TotalEffectiveGas)
+				suite.Require().ElementsMatch(expRes.ParticipantWeights, res.ParticipantWeights)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateIncentive() {
+	const mintAmount int64 = 1000
+
+	var (
+		req    *types.QuerySimulateIncentiveRequest
+		expRes *types.QuerySimulateIncentiveResponse
+	)
+
+	allocation := sdk.NewDecWithPrec(allocationRate, 2)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - empty contract",
+			func() {
+				req = &types.QuerySimulateIncentiveRequest{}
+			},
+			false,
+		},
+		{
+			"fail - invalid contract",
+			func() {
+				req = &types.QuerySimulateIncentiveRequest{Contract: "0x"}
+			},
+			false,
+		},
+		{
+			"fail - allocation above limit",
+			func() {
+				req = &types.QuerySimulateIncentiveRequest{
+					Contract:    contract.String(),
+					Allocations: sdk.DecCoins{sdk.NewDecCoinFromDec(denomMint, sdk.NewDecWithPrec(6, 2))},
+					Epochs:      epochs,
+				}
+			},
+			false,
+		},
+		{
+			"pass - with expected gas",
+			func() {
+				req = &types.QuerySimulateIncentiveRequest{
+					Contract:    contract.String(),
+					Allocations: mintAllocations,
+					Epochs:      epochs,
+					TotalGas:    500,
+				}
+				expRes = &types.QuerySimulateIncentiveResponse{
+					Rewards:            sdk.NewCoins(sdk.NewInt64Coin(denomMint, 50)),
+					TotalRewards:       sdk.NewCoins(sdk.NewInt64Coin(denomMint, 500)),
+					TotalGas:           500,
+					RewardPerGas:       sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomMint, sdk.NewDecWithPrec(1, 1))),
+					AllocationMeters:   mintAllocations,
+					AllocationHeadroom: mintAllocations,
+				}
+			},
+			true,
+		},
+		{
+			"pass - expected gas above max int64",
+			func() {
+				req = &types.QuerySimulateIncentiveRequest{
+					Contract:    contract.String(),
+					Allocations: mintAllocations,
+					Epochs:      epochs,
+					TotalGas:    math.MaxUint64,
+				}
+				totalGas := sdk.NewDecFromBigInt(new(big.Int).SetUint64(math.MaxUint64))
+				expRes = &types.QuerySimulateIncentiveResponse{
+					Rewards:            sdk.NewCoins(sdk.NewInt64Coin(denomMint, 50)),
+					TotalRewards:       sdk.NewCoins(sdk.NewInt64Coin(denomMint, 500)),
+					TotalGas:           math.MaxUint64,
+					RewardPerGas:       sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomMint, sdk.NewDec(50).Quo(totalGas))),
+					AllocationMeters:   mintAllocations,
+					AllocationHeadroom: mintAllocations,
+				}
+			},
+			true,
+		},
+		{
+			"pass - replaces existing incentive and uses current gas meters",
+			func() {
+				_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+					suite.ctx, contract, mintAllocations, epochs, types.RewardCurveLinear, 0,
+				)
+				suite.Require().NoError(err)
+				suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 100))
+				suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant2, 150))
+
+				halfAllocation := allocation.QuoInt64(2)
+				req = &types.QuerySimulateIncentiveRequest{
+					Contract:    contract.String(),
+					Allocations: sdk.DecCoins{sdk.NewDecCoinFromDec(denomMint, halfAllocation)},
+					Epochs:      2,
+				}
+				expRes = &types.QuerySimulateIncentiveResponse{
+					Rewards:            sdk.NewCoins(sdk.NewInt64Coin(denomMint, 25)),
+					TotalRewards:       sdk.NewCoins(sdk.NewInt64Coin(denomMint, 50)),
+					TotalGas:           250,
+					RewardPerGas:       sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomMint, sdk.NewDecWithPrec(1, 1))),
+					AllocationMeters:   sdk.DecCoins{sdk.NewDecCoinFromDec(denomMint, halfAllocation)},
+					AllocationHeadroom: mintAllocations,
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			err := suite.app.BankKeeper.MintCoins(
+				suite.ctx,
+				types.ModuleName,
+				sdk.Coins{sdk.NewInt64Coin(denomMint, mintAmount)},
+			)
+			suite.Require().NoError(err)
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			incentivesBefore := suite.app.IncentivesKeeper.GetAllIncentives(suite.ctx)
+			metersBefore := suite.app.IncentivesKeeper.GetAllAllocationMeters(suite.ctx)
+
+			res, err := suite.queryClient.SimulateIncentive(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			// the simulation doesn't modify the state
+			suite.Require().Equal(incentivesBefore, suite.app.IncentivesKeeper.GetAllIncentives(suite.ctx))
+			suite.Require().Equal(metersBefore, suite.app.IncentivesKeeper.GetAllAllocationMeters(suite.ctx))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestAllocationMeters() {
 	var (
 		req    *types.QueryAllocationMetersRequest
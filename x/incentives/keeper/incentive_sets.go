@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+// GetIncentiveOfContract returns the address of the incentive under which the
+// gas spent on a contract is metered. The contract is resolved in order of
+// precedence:
+//   - incentive registered for the contract itself
+//   - incentive set that contains the contract as a member
+//   - incentive set that contains the x/revenue deployer of the contract
+func (k Keeper) GetIncentiveOfContract(
+	ctx sdk.Context,
+	contract common.Address,
+) (common.Address, bool) {
+	if k.IsIncentiveRegistered(ctx, contract) {
+		return contract, true
+	}
+
+	if set, found := k.GetIncentiveSetOfMember(ctx, contract); found {
+		return set, true
+	}
+
+	revenue, found := k.revenueKeeper.GetRevenue(ctx, contract)
+	if !found {
+		return common.Address{}, false
+	}
+
+	return k.GetIncentiveSetOfDeployer(ctx, revenue.GetDeployerAddr())
+}
+
+// GetIncentiveSetOfMember returns the address of the incentive set that
+// contains the given contract as a member
+func (k Keeper) GetIncentiveSetOfMember(
+	ctx sdk.Context,
+	contract common.Address,
+) (common.Address, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveSetMember)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
+// GetIncentiveSetOfDeployer returns the address of the incentive set that
+// contains the contracts registered on x/revenue by the given deployer
+func (k Keeper) GetIncentiveSetOfDeployer(
+	ctx sdk.Context,
+	deployer sdk.AccAddress,
+) (common.Address, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveSetDeployer)
+	bz := store.Get(deployer.Bytes())
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+
+	return common.BytesToAddress(bz), true
+}
+
+// SetIncentiveSetMembers indexes the member contracts and the deployer of an
+// incentive set. It is a no-op for incentives that target a single contract.
+func (k Keeper) SetIncentiveSetMembers(ctx sdk.Context, incentive types.Incentive) {
+	if !incentive.IsSet() {
+		return
+	}
+
+	set := common.HexToAddress(incentive.Contract)
+
+	memberStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveSetMember)
+	for _, contract := range incentive.Contracts {
+		memberStore.Set(common.HexToAddress(contract).Bytes(), set.Bytes())
+	}
+
+	if incentive.Deployer != "" {
+		deployerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveSetDeployer)
+		deployerStore.Set(sdk.MustAccAddressFromBech32(incentive.Deployer).Bytes(), set.Bytes())
+	}
+}
+
+// DeleteIncentiveSetMembers removes the member contract and deployer indexes
+// of an incentive set
+func (k Keeper) DeleteIncentiveSetMembers(ctx sdk.Context, incentive types.Incentive) {
+	if !incentive.IsSet() {
+		return
+	}
+
+	memberStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveSetMember)
+	for _, contract := range incentive.Contracts {
+		memberStore.Delete(common.HexToAddress(contract).Bytes())
+	}
+
+	if incentive.Deployer != "" {
+		deployerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveSetDeployer)
+		deployerStore.Delete(sdk.MustAccAddressFromBech32(incentive.Deployer).Bytes())
+	}
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+	evm "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/incentives/types"
+	revenuetypes "github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+const setName = "pools"
+
+func (suite *KeeperTestSuite) TestRegisterIncentiveSet() {
+	deployer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func() []common.Address
+		deployer sdk.AccAddress
+		expPass  bool
+	}{
+		{
+			"fail - incentives are disabled globally",
+			func() []common.Address {
+				params := types.DefaultParams()
+				params.EnableIncentives = false
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+				return []common.Address{contract}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - incentive set already registered",
+			func() []common.Address {
+				_, err := suite.app.IncentivesKeeper.RegisterIncentiveSet(
+					suite.ctx, setName, []common.Address{contract2}, nil, mintAllocations, epochs, types.RewardCurveLinear, 0,
+				)
+				suite.Require().NoError(err)
+				return []common.Address{contract}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - contract doesn't exist",
+			func() []common.Address {
+				return []common.Address{tests.GenerateAddress()}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - contract already incentivized",
+			func() []common.Address {
+				_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+					suite.ctx, contract, mintAllocations, epochs, types.RewardCurveLinear, 0,
+				)
+				suite.Require().NoError(err)
+				return []common.Address{contract}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - contract already member of another set",
+			func() []common.Address {
+				_, err := suite.app.IncentivesKeeper.RegisterIncentiveSet(
+					suite.ctx, "other", []common.Address{contract}, nil, mintAllocations, epochs, types.RewardCurveLinear, 0,
+				)
+				suite.Require().NoError(err)
+				return []common.Address{contract}
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - deployer already part of another set",
+			func() []common.Address {
+				_, err := suite.app.IncentivesKeeper.RegisterIncentiveSet(
+					suite.ctx, "other", nil, deployer, mintAllocations, epochs, types.RewardCurveLinear, 0,
+				)
+				suite.Require().NoError(err)
+				return nil
+			},
+			deployer,
+			false,
+		},
+		{
+			"pass - contracts and deployer",
+			func() []common.Address {
+				return []common.Address{contract, contract2}
+			},
+			deployer,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			contracts := tc.malleate()
+
+			in, err := suite.app.IncentivesKeeper.RegisterIncentiveSet(
+				suite.ctx, setName, contracts, tc.deployer, mintAllocations, epochs, types.RewardCurveLinear, 0,
+			)
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				setAddr := types.IncentiveSetAddress(setName)
+				suite.Require().Equal(setAddr.String(), in.Contract)
+				suite.Require().True(in.IsSet())
+
+				incentive, found := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, setAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(*in, incentive)
+
+				for _, contract := range contracts {
+					set, found := suite.app.IncentivesKeeper.GetIncentiveSetOfMember(suite.ctx, contract)
+					suite.Require().True(found)
+					suite.Require().Equal(setAddr, set)
+				}
+
+				set, found := suite.app.IncentivesKeeper.GetIncentiveSetOfDeployer(suite.ctx, tc.deployer)
+				suite.Require().True(found)
+				suite.Require().Equal(setAddr, set)
+
+				allocationMeter, _ := suite.app.IncentivesKeeper.GetAllocationMeter(suite.ctx, denomMint)
+				suite.Require().Equal(mintAllocations[0], allocationMeter)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetIncentiveOfContract() {
+	suite.SetupTest()
+
+	deployer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	deployed := tests.GenerateAddress()
+	setAddr := types.IncentiveSetAddress(setName)
+
+	_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx, contract2, mintAllocations, epochs, types.RewardCurveLinear, 0,
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentiveSet(
+		suite.ctx, setName, []common.Address{contract}, deployer, mintAllocations, epochs, types.RewardCurveLinear, 0,
+	)
+	suite.Require().NoError(err)
+
+	// contract registered on x/revenue by the deployer of the set
+	suite.app.RevenueKeeper.SetRevenue(suite.ctx, revenuetypes.NewRevenue(deployed, deployer, nil))
+
+	testCases := []struct {
+		name     string
+		contract common.Address
+		expSet   common.Address
+		expFound bool
+	}{
+		{"incentivized contract", contract2, contract2, true},
+		{"member contract", contract, setAddr, true},
+		{"contract of set deployer", deployed, setAddr, true},
+		{"contract not incentivized", tests.GenerateAddress(), common.Address{}, false},
+	}
+	for _, tc := range testCases {
+		set, found := suite.app.IncentivesKeeper.GetIncentiveOfContract(suite.ctx, tc.contract)
+		suite.Require().Equal(tc.expFound, found, tc.name)
+		suite.Require().Equal(tc.expSet, set, tc.name)
+	}
+
+	// members are removed once the set is cancelled
+	err = suite.app.IncentivesKeeper.CancelIncentive(suite.ctx, setAddr)
+	suite.Require().NoError(err)
+
+	_, found := suite.app.IncentivesKeeper.GetIncentiveOfContract(suite.ctx, contract)
+	suite.Require().False(found)
+	_, found = suite.app.IncentivesKeeper.GetIncentiveOfContract(suite.ctx, deployed)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksIncentiveSet() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	suite.ensureHooksSet()
+
+	contractAddr, err := suite.DeployContract(denomCoin, "COIN", erc20Decimals)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentiveSet(
+		suite.ctx, setName, []common.Address{contractAddr}, nil, mintAllocations, epochs, types.RewardCurveLinear, 0,
+	)
+	suite.Require().NoError(err)
+
+	coins := sdk.NewCoins(sdk.NewCoin(evm.DefaultEVMDenom, sdk.NewInt(30000000)))
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sdk.AccAddress(suite.address.Bytes()), coins)
+	suite.Require().NoError(err)
+
+	acc := &ethermint.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(suite.address.Bytes()), nil, 0, 0),
+		CodeHash:    common.BytesToHash(crypto.Keccak256(nil)).String(),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	res := suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(1000))
+	expGasUsed := res.AsTransaction().Gas()
+
+	// gas is metered into the shared incentive of the set
+	setAddr := types.IncentiveSetAddress(setName)
+	incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, setAddr)
+	suite.Require().Equal(expGasUsed, incentive.TotalGas)
+
+	gm, found := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, setAddr, suite.address)
+	suite.Require().True(found)
+	suite.Require().Equal(expGasUsed, gm)
+
+	_, found = suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contractAddr, suite.address)
+	suite.Require().False(found)
+
+	suite.mintFeeCollector = false
+}
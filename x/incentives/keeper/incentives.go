@@ -70,13 +70,16 @@ func (k Keeper) SetIncentive(ctx sdk.Context, incentive types.Incentive) {
 	store.Set(key.Bytes(), bz)
 }
 
-// DeleteIncentiveAndUpdateAllocationMeters removes an incentive and updates the
-// percentage of incentives allocated to each denomination.
+// DeleteIncentiveAndUpdateAllocationMeters removes an incentive and its set
+// members and updates the percentage of incentives allocated to each
+// denomination.
 func (k Keeper) DeleteIncentiveAndUpdateAllocationMeters(ctx sdk.Context, incentive types.Incentive) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentive)
 	key := common.HexToAddress(incentive.Contract)
 	store.Delete(key.Bytes())
 
+	k.DeleteIncentiveSetMembers(ctx, incentive)
+
 	// Subtract allocations from allocation meters
 	for _, al := range incentive.Allocations {
 		// NOTE: existence of incentive is already checked
@@ -85,6 +85,8 @@ func (suite *KeeperTestSuite) TestDeleteIncentiveAndUpdateAllocationMeters() {
 		contract,
 		mintAllocations,
 		epochs,
+		types.RewardCurveLinear,
+		0,
 	)
 	suite.Require().NoError(err)
 
@@ -88,6 +88,8 @@ var _ = Describe("Distribution", Ordered, func() {
 			contractAddr,
 			mintAllocations,
 			epochs,
+			types.RewardCurveLinear,
+			0,
 		)
 		s.Require().NoError(err)
 
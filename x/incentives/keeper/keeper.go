@@ -25,8 +25,10 @@ type Keeper struct {
 	// Currently not used, but added to prevent breaking change s in case we want
 	// to allocate incentives to staking instead of transferring the deferred
 	// rewards to the user's wallet
-	stakeKeeper types.StakeKeeper
-	evmKeeper   types.EVMKeeper
+	stakeKeeper   types.StakeKeeper
+	evmKeeper     types.EVMKeeper
+	erc20Keeper   types.ERC20Keeper
+	revenueKeeper types.RevenueKeeper
 }
 
 // NewKeeper creates new instances of the incentives Keeper
@@ -39,6 +41,8 @@ func NewKeeper(
 	ik types.InflationKeeper,
 	sk types.StakeKeeper,
 	evmKeeper types.EVMKeeper,
+	erc20Keeper types.ERC20Keeper,
+	revenueKeeper types.RevenueKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -54,6 +58,8 @@ func NewKeeper(
 		inflationKeeper: ik,
 		stakeKeeper:     sk,
 		evmKeeper:       evmKeeper,
+		erc20Keeper:     erc20Keeper,
+		revenueKeeper:   revenueKeeper,
 	}
 }
 
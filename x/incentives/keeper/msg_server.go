@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// CreateSponsoredIncentive creates an incentive funded by the sponsor's coins
+func (k Keeper) CreateSponsoredIncentive(
+	goCtx context.Context,
+	msg *types.MsgCreateSponsoredIncentive,
+) (*types.MsgCreateSponsoredIncentiveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsor := sdk.MustAccAddressFromBech32(msg.Sponsor)
+	incentive, err := k.createSponsoredIncentive(
+		ctx,
+		sponsor,
+		common.HexToAddress(msg.Contract),
+		msg.Rewards,
+		msg.Epochs,
+		msg.RewardCurve,
+		msg.MaxGasPerParticipant,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCreateSponsoredIncentive,
+				sdk.NewAttribute(types.AttributeKeyContract, incentive.Contract),
+				sdk.NewAttribute(types.AttributeKeySponsor, msg.Sponsor),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Rewards.String()),
+				sdk.NewAttribute(
+					types.AttributeKeyEpochs,
+					strconv.FormatUint(uint64(incentive.Epochs), 10),
+				),
+			),
+		},
+	)
+
+	return &types.MsgCreateSponsoredIncentiveResponse{}, nil
+}
+
+// ClaimIncentiveRewards transfers the accumulated incentive rewards of a
+// participant and optionally converts them to ERC20 tokens
+func (k Keeper) ClaimIncentiveRewards(
+	goCtx context.Context,
+	msg *types.MsgClaimIncentiveRewards,
+) (*types.MsgClaimIncentiveRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	participant := sdk.MustAccAddressFromBech32(msg.Participant)
+	rewards, err := k.ClaimRewards(ctx, participant, msg.AsERC20)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaimIncentiveRewards,
+				sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+				sdk.NewAttribute(types.AttributeKeyAsERC20, strconv.FormatBool(msg.AsERC20)),
+			),
+		},
+	)
+
+	return &types.MsgClaimIncentiveRewardsResponse{Rewards: rewards}, nil
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+// setMinSponsoredEpochReward sets the minimum rewards in the EVM denom that a
+// sponsored incentive must distribute per epoch
+func (suite *KeeperTestSuite) setMinSponsoredEpochReward(min sdk.Int) {
+	params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+	params.MinSponsoredEpochReward = min
+	suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestCreateSponsoredIncentive() {
+	sponsor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	// 100 of the EVM denom are distributed per epoch
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000), sdk.NewInt64Coin(denomMint, 1000))
+
+	testCases := []struct {
+		name     string
+		malleate func() common.Address
+		expPass  bool
+	}{
+		{
+			"fail - incentives are disabled globally",
+			func() common.Address {
+				params := types.DefaultParams()
+				params.EnableIncentives = false
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+				return contract
+			},
+			false,
+		},
+		{
+			"fail - contract doesn't exist",
+			func() common.Address {
+				return tests.GenerateAddress()
+			},
+			false,
+		},
+		{
+			"fail - incentive already registered",
+			func() common.Address {
+				regIn := types.NewIncentive(contract, allocations, epochs)
+				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, regIn)
+				return contract
+			},
+			false,
+		},
+		{
+			"fail - epochs exceed the max",
+			func() common.Address {
+				params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+				params.MaxSponsoredEpochs = epochs - 1
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, rewards)
+				suite.Require().NoError(err)
+				return contract
+			},
+			false,
+		},
+		{
+			"fail - rewards per epoch below the min",
+			func() common.Address {
+				suite.setMinSponsoredEpochReward(sdk.NewInt(101))
+
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, rewards)
+				suite.Require().NoError(err)
+				return contract
+			},
+			false,
+		},
+		{
+			"fail - insufficient sponsor funds",
+			func() common.Address {
+				return contract
+			},
+			false,
+		},
+		{
+			"pass - rewards escrowed",
+			func() common.Address {
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, rewards)
+				suite.Require().NoError(err)
+				return contract
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.setMinSponsoredEpochReward(sdk.NewInt(100))
+
+			incentiveContract := tc.malleate()
+			msg := types.NewMsgCreateSponsoredIncentive(
+				sponsor,
+				incentiveContract,
+				rewards,
+				epochs,
+				types.RewardCurveSqrt,
+				0,
+			)
+
+			_, err := suite.app.IncentivesKeeper.CreateSponsoredIncentive(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				incentive, found := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, incentiveContract)
+				suite.Require().True(found)
+				suite.Require().True(incentive.IsSponsored())
+				suite.Require().Equal(sponsor.String(), incentive.Sponsor)
+				suite.Require().Equal(rewards, incentive.SponsoredRewards)
+				suite.Require().Equal(epochs, incentive.Epochs)
+				suite.Require().Equal(types.RewardCurveSqrt, incentive.RewardCurve)
+
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, sponsor).IsZero())
+				suite.Require().Equal(rewards, suite.app.IncentivesKeeper.GetSponsoredEscrow(suite.ctx))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeSponsoredIncentive() {
+	suite.SetupTest()
+	suite.setMinSponsoredEpochReward(sdk.ZeroInt())
+
+	sponsor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+	inflationRewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 500))
+
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, rewards)
+	suite.Require().NoError(err)
+
+	// inflation pool balance of the same denom must not be used by the sponsored incentive
+	err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, inflationRewards)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgCreateSponsoredIncentive(sponsor, contract, rewards, 2, types.RewardCurveLinear, 0)
+	_, err = suite.app.IncentivesKeeper.CreateSponsoredIncentive(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	// first epoch: half of the rewards are distributed to the only participant
+	incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 1000))
+	suite.app.IncentivesKeeper.SetIncentiveTotalGas(suite.ctx, incentive, 1000)
+
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant.Bytes()), denomCoin)
+	suite.Require().Equal(sdk.NewInt(500), balance.Amount)
+
+	incentive, found := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), incentive.Epochs)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 500)), incentive.SponsoredRewards)
+
+	// second epoch: no gas spent, so the remaining rewards are refunded
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	_, found = suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.Require().False(found)
+
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, sponsor, denomCoin)
+	suite.Require().Equal(sdk.NewInt(500), balance.Amount)
+
+	// the inflation pool is untouched
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denomCoin)
+	suite.Require().Equal(inflationRewards.AmountOf(denomCoin), balance.Amount)
+}
+
+func (suite *KeeperTestSuite) TestCancelSponsoredIncentive() {
+	suite.SetupTest()
+	suite.setMinSponsoredEpochReward(sdk.ZeroInt())
+
+	sponsor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sponsor, rewards)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgCreateSponsoredIncentive(sponsor, contract, rewards, epochs, types.RewardCurveLinear, 0)
+	_, err = suite.app.IncentivesKeeper.CreateSponsoredIncentive(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	err = suite.app.IncentivesKeeper.CancelIncentive(suite.ctx, contract)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(rewards, suite.app.BankKeeper.GetAllBalances(suite.ctx, sponsor))
+	suite.Require().True(suite.app.IncentivesKeeper.GetSponsoredEscrow(suite.ctx).IsZero())
+}
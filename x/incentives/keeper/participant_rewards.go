@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/evmos/evmos/v10/x/erc20/types"
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+// ClaimRewards transfers the accumulated rewards of a participant from the
+// incentives module account. If asERC20 is true, the claimed coins that have a
+// registered token pair are converted to their ERC20 representation.
+func (k Keeper) ClaimRewards(
+	ctx sdk.Context,
+	participant sdk.AccAddress,
+	asERC20 bool,
+) (sdk.Coins, error) {
+	participantAddr := common.BytesToAddress(participant.Bytes())
+
+	pr, found := k.GetParticipantRewards(ctx, participantAddr)
+	if !found || pr.Rewards.IsZero() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNoClaimableRewards,
+			"participant %s", participantAddr,
+		)
+	}
+
+	k.DeleteParticipantRewards(ctx, participantAddr)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, participant, pr.Rewards); err != nil {
+		return nil, err
+	}
+
+	if !asERC20 {
+		return pr.Rewards, nil
+	}
+
+	for _, coin := range pr.Rewards {
+		if !k.erc20Keeper.IsDenomRegistered(ctx, coin.Denom) {
+			continue
+		}
+
+		msg := erc20types.NewMsgConvertCoin(coin, participantAddr, participant)
+		if _, err := k.erc20Keeper.ConvertCoin(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to convert %s to ERC20", coin)
+		}
+	}
+
+	return pr.Rewards, nil
+}
+
+// AddParticipantRewards adds the given coins to the unclaimed rewards of a
+// participant
+func (k Keeper) AddParticipantRewards(
+	ctx sdk.Context,
+	participant common.Address,
+	rewards sdk.Coins,
+) {
+	pr, found := k.GetParticipantRewards(ctx, participant)
+	if !found {
+		pr = types.NewParticipantRewards(participant, sdk.Coins{})
+	}
+
+	pr.Rewards = pr.Rewards.Add(rewards...)
+	k.SetParticipantRewards(ctx, pr)
+}
+
+// GetTotalUnclaimedRewards returns the sum of the unclaimed rewards of all
+// participants. These coins are held by the incentives module account but are
+// not part of the inflation pool. The total is kept up to date every time the
+// rewards of a participant are set or deleted.
+func (k Keeper) GetTotalUnclaimedRewards(ctx sdk.Context) sdk.Coins {
+	total := sdk.Coins{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnclaimedRewardsTotal)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(fmt.Errorf("unable to unmarshal amount value %v", err))
+		}
+
+		total = total.Add(sdk.Coin{Denom: string(iterator.Key()), Amount: amount})
+	}
+
+	return total
+}
+
+// updateTotalUnclaimedRewards adds the added coins to and subtracts the
+// removed coins from the total unclaimed rewards
+func (k Keeper) updateTotalUnclaimedRewards(ctx sdk.Context, added, removed sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnclaimedRewardsTotal)
+
+	total := k.GetTotalUnclaimedRewards(ctx).Add(added...)
+	newTotal := total.Sub(removed...)
+
+	for _, coin := range total {
+		amount := newTotal.AmountOf(coin.Denom)
+
+		// Remove the denoms that are no longer owed
+		if amount.IsZero() {
+			store.Delete([]byte(coin.Denom))
+			continue
+		}
+
+		bz, err := amount.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("unable to marshal amount value %v", err))
+		}
+		store.Set([]byte(coin.Denom), bz)
+	}
+}
+
+// GetAllParticipantRewards returns the unclaimed rewards of all participants
+func (k Keeper) GetAllParticipantRewards(ctx sdk.Context) []types.ParticipantRewards {
+	prs := []types.ParticipantRewards{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixParticipantRewards)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pr types.ParticipantRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &pr)
+
+		prs = append(prs, pr)
+	}
+
+	return prs
+}
+
+// GetParticipantRewards returns the unclaimed rewards of a participant
+func (k Keeper) GetParticipantRewards(
+	ctx sdk.Context,
+	participant common.Address,
+) (types.ParticipantRewards, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantRewards)
+	bz := store.Get(participant.Bytes())
+	if len(bz) == 0 {
+		return types.ParticipantRewards{}, false
+	}
+
+	var pr types.ParticipantRewards
+	k.cdc.MustUnmarshal(bz, &pr)
+	return pr, true
+}
+
+// SetParticipantRewards stores the unclaimed rewards of a participant and
+// updates the total unclaimed rewards
+func (k Keeper) SetParticipantRewards(ctx sdk.Context, pr types.ParticipantRewards) {
+	key := common.HexToAddress(pr.Participant)
+	prev, _ := k.GetParticipantRewards(ctx, key)
+	k.updateTotalUnclaimedRewards(ctx, pr.Rewards, prev.Rewards)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantRewards)
+	bz := k.cdc.MustMarshal(&pr)
+	store.Set(key.Bytes(), bz)
+}
+
+// DeleteParticipantRewards removes the unclaimed rewards of a participant and
+// updates the total unclaimed rewards
+func (k Keeper) DeleteParticipantRewards(ctx sdk.Context, participant common.Address) {
+	prev, _ := k.GetParticipantRewards(ctx, participant)
+	k.updateTotalUnclaimedRewards(ctx, nil, prev.Rewards)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantRewards)
+	store.Delete(participant.Bytes())
+}
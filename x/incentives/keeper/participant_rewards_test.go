@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+func (suite *KeeperTestSuite) TestAccumulateParticipantRewards() {
+	suite.SetupTest()
+
+	params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+	params.EnableClaimableRewards = true
+	suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+	mintAmount := sdk.NewInt(1000)
+	coins := sdk.NewCoins(sdk.NewCoin(denomCoin, mintAmount))
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
+	suite.Require().NoError(err)
+
+	allocs := sdk.DecCoins{sdk.NewDecCoinFromDec(denomCoin, sdk.NewDecWithPrec(allocationRate, 2))}
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, contract, allocs, epochs, types.RewardCurveLinear, 0)
+	suite.Require().NoError(err)
+
+	incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 100))
+	suite.app.IncentivesKeeper.SetIncentiveTotalGas(suite.ctx, incentive, 100)
+
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	// rewards are accumulated instead of sent to the participant
+	expRewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 50))
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant.Bytes()), denomCoin)
+	suite.Require().True(balance.IsZero())
+
+	pr, found := suite.app.IncentivesKeeper.GetParticipantRewards(suite.ctx, participant)
+	suite.Require().True(found)
+	suite.Require().Equal(expRewards, pr.Rewards)
+	suite.Require().Equal(expRewards, suite.app.IncentivesKeeper.GetTotalUnclaimedRewards(suite.ctx))
+
+	// unclaimed rewards are excluded from the allocation of the next epoch
+	suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 100))
+	suite.app.IncentivesKeeper.SetIncentiveTotalGas(suite.ctx, incentive, 100)
+
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	pr, found = suite.app.IncentivesKeeper.GetParticipantRewards(suite.ctx, participant)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 97)), pr.Rewards)
+}
+
+func (suite *KeeperTestSuite) TestClaimIncentiveRewards() {
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 100))
+	sender := sdk.AccAddress(participant.Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		asERC20  bool
+		expPass  bool
+	}{
+		{
+			"fail - no rewards to claim",
+			func() {},
+			false,
+			false,
+		},
+		{
+			"pass - claim as coins",
+			func() {
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, rewards)
+				suite.Require().NoError(err)
+				suite.app.IncentivesKeeper.AddParticipantRewards(suite.ctx, participant, rewards)
+			},
+			false,
+			true,
+		},
+		{
+			"pass - claim as ERC20 without registered token pair",
+			func() {
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, rewards)
+				suite.Require().NoError(err)
+				suite.app.IncentivesKeeper.AddParticipantRewards(suite.ctx, participant, rewards)
+			},
+			true,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			msg := types.NewMsgClaimIncentiveRewards(sender, tc.asERC20)
+			res, err := suite.app.IncentivesKeeper.ClaimIncentiveRewards(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(rewards, res.Rewards)
+
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomCoin)
+				suite.Require().Equal(rewards.AmountOf(denomCoin), balance.Amount)
+
+				_, found := suite.app.IncentivesKeeper.GetParticipantRewards(suite.ctx, participant)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClaimIncentiveRewardsAsERC20() {
+	suite.SetupTest()
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 100))
+	sender := sdk.AccAddress(participant.Bytes())
+
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, rewards)
+	suite.Require().NoError(err)
+	suite.app.IncentivesKeeper.AddParticipantRewards(suite.ctx, participant, rewards)
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(suite.ctx, banktypes.Metadata{
+		Description: "description",
+		Base:        denomCoin,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denomCoin,
+				Exponent: 0,
+			},
+		},
+		Name:    denomCoin,
+		Symbol:  "COIN",
+		Display: denomCoin,
+	})
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	msg := types.NewMsgClaimIncentiveRewards(sender, true)
+	res, err := suite.app.IncentivesKeeper.ClaimIncentiveRewards(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, res.Rewards)
+
+	// the claimed coins are converted to their ERC20 representation
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomCoin)
+	suite.Require().True(balance.IsZero())
+
+	erc20Balance := suite.BalanceOf(pair.GetERC20Contract(), participant)
+	suite.Require().Equal(rewards.AmountOf(denomCoin).BigInt(), erc20Balance)
+}
+
+func (suite *KeeperTestSuite) TestTotalUnclaimedRewards() {
+	suite.SetupTest()
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 100), sdk.NewInt64Coin(denomMint, 50))
+	rewards2 := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 30))
+
+	suite.app.IncentivesKeeper.AddParticipantRewards(suite.ctx, participant, rewards)
+	suite.app.IncentivesKeeper.AddParticipantRewards(suite.ctx, participant2, rewards2)
+	suite.app.IncentivesKeeper.AddParticipantRewards(suite.ctx, participant2, rewards2)
+	suite.Require().Equal(
+		rewards.Add(rewards2...).Add(rewards2...),
+		suite.app.IncentivesKeeper.GetTotalUnclaimedRewards(suite.ctx),
+	)
+
+	// overwriting the rewards of a participant replaces its share of the total
+	suite.app.IncentivesKeeper.SetParticipantRewards(suite.ctx, types.NewParticipantRewards(participant2, rewards2))
+	suite.Require().Equal(rewards.Add(rewards2...), suite.app.IncentivesKeeper.GetTotalUnclaimedRewards(suite.ctx))
+
+	// denoms that are no longer owed are removed from the total
+	suite.app.IncentivesKeeper.DeleteParticipantRewards(suite.ctx, participant)
+	suite.Require().Equal(rewards2, suite.app.IncentivesKeeper.GetTotalUnclaimedRewards(suite.ctx))
+
+	suite.app.IncentivesKeeper.DeleteParticipantRewards(suite.ctx, participant2)
+	suite.Require().True(suite.app.IncentivesKeeper.GetTotalUnclaimedRewards(suite.ctx).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSystemContractClaimRewards() {
+	suite.SetupTest()
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 100))
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, rewards)
+	suite.Require().NoError(err)
+	suite.app.IncentivesKeeper.AddParticipantRewards(suite.ctx, suite.address, rewards)
+
+	data, err := types.SystemContractABI.Pack(types.SystemContractMethodClaimRewards, false)
+	suite.Require().NoError(err)
+	suite.sendTx(types.SystemContractAddress, suite.address, data)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denomCoin)
+	suite.Require().Equal(rewards.AmountOf(denomCoin), balance.Amount)
+
+	_, found := suite.app.IncentivesKeeper.GetParticipantRewards(suite.ctx, suite.address)
+	suite.Require().False(found)
+	suite.Require().True(suite.app.IncentivesKeeper.GetTotalUnclaimedRewards(suite.ctx).IsZero())
+}
@@ -15,6 +15,8 @@ func (k Keeper) RegisterIncentive(
 	contract common.Address,
 	allocations sdk.DecCoins,
 	epochs uint32,
+	rewardCurve types.RewardCurve,
+	maxGasPerParticipant uint64,
 ) (*types.Incentive, error) {
 	// Check if the Incentives are globally enabled
 	params := k.GetParams(ctx)
@@ -42,6 +44,131 @@ func (k Keeper) RegisterIncentive(
 		)
 	}
 
+	// Check if the contract is already a member of an incentive set
+	if set, found := k.GetIncentiveSetOfMember(ctx, contract); found {
+		return nil, errorsmod.Wrapf(
+			types.ErrInternalIncentive,
+			"contract %s is already a member of incentive set %s", contract, set,
+		)
+	}
+
+	allocationMeters, err := k.validateAllocations(ctx, allocations)
+	if err != nil {
+		return nil, err
+	}
+
+	// create incentive and set to store
+	incentive := types.NewIncentive(contract, allocations, epochs)
+	incentive.StartTime = ctx.BlockTime()
+	incentive.RewardCurve = rewardCurve
+	incentive.MaxGasPerParticipant = maxGasPerParticipant
+	k.SetIncentive(ctx, incentive)
+
+	// Update allocation meters
+	for _, am := range allocationMeters {
+		k.SetAllocationMeter(ctx, am)
+	}
+
+	return &incentive, nil
+}
+
+// RegisterIncentiveSet creates an incentive for a named set of contracts and/or
+// all the contracts registered on x/revenue by a deployer. The gas spent on
+// any member is metered into the shared incentive of the set.
+func (k Keeper) RegisterIncentiveSet(
+	ctx sdk.Context,
+	name string,
+	contracts []common.Address,
+	deployer sdk.AccAddress,
+	allocations sdk.DecCoins,
+	epochs uint32,
+	rewardCurve types.RewardCurve,
+	maxGasPerParticipant uint64,
+) (*types.Incentive, error) {
+	// Check if the Incentives are globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableIncentives {
+		return nil, errorsmod.Wrap(
+			types.ErrInternalIncentive,
+			"incentives are currently disabled by governance",
+		)
+	}
+
+	// Check if the incentive set is already registered
+	setAddr := types.IncentiveSetAddress(name)
+	if k.IsIncentiveRegistered(ctx, setAddr) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInternalIncentive,
+			"incentive set already registered: %s", name,
+		)
+	}
+
+	for _, contract := range contracts {
+		// Check if contract exists
+		acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+		if acc == nil || !acc.IsContract() {
+			return nil, errorsmod.Wrapf(
+				types.ErrInternalIncentive,
+				"contract doesn't exist: %s", contract,
+			)
+		}
+
+		// Check if the contract is already incentivized
+		if k.IsIncentiveRegistered(ctx, contract) {
+			return nil, errorsmod.Wrapf(
+				types.ErrInternalIncentive,
+				"incentive already registered: %s", contract,
+			)
+		}
+
+		if set, found := k.GetIncentiveSetOfMember(ctx, contract); found {
+			return nil, errorsmod.Wrapf(
+				types.ErrInternalIncentive,
+				"contract %s is already a member of incentive set %s", contract, set,
+			)
+		}
+	}
+
+	// Check if the deployer is already part of an incentive set
+	if !deployer.Empty() {
+		if set, found := k.GetIncentiveSetOfDeployer(ctx, deployer); found {
+			return nil, errorsmod.Wrapf(
+				types.ErrInternalIncentive,
+				"deployer %s is already part of incentive set %s", deployer, set,
+			)
+		}
+	}
+
+	allocationMeters, err := k.validateAllocations(ctx, allocations)
+	if err != nil {
+		return nil, err
+	}
+
+	// create incentive set and set to store
+	incentive := types.NewIncentiveSet(name, contracts, deployer, allocations, epochs)
+	incentive.StartTime = ctx.BlockTime()
+	incentive.RewardCurve = rewardCurve
+	incentive.MaxGasPerParticipant = maxGasPerParticipant
+	k.SetIncentive(ctx, incentive)
+	k.SetIncentiveSetMembers(ctx, incentive)
+
+	// Update allocation meters
+	for _, am := range allocationMeters {
+		k.SetAllocationMeter(ctx, am)
+	}
+
+	return &incentive, nil
+}
+
+// validateAllocations checks that the allocations of a new incentive can be
+// funded and respect the allocation limits, and returns the updated allocation
+// meters
+func (k Keeper) validateAllocations(
+	ctx sdk.Context,
+	allocations sdk.DecCoins,
+) ([]sdk.DecCoin, error) {
+	params := k.GetParams(ctx)
+
 	// Check if the balance is > 0 for coins other than the mint denomination
 	mintDenom := k.evmKeeper.GetParams(ctx).EvmDenom
 	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
@@ -84,17 +211,7 @@ func (k Keeper) RegisterIncentive(
 		allocationMeters = append(allocationMeters, newAllocationMeter)
 	}
 
-	// create incentive and set to store
-	incentive := types.NewIncentive(contract, allocations, epochs)
-	incentive.StartTime = ctx.BlockTime()
-	k.SetIncentive(ctx, incentive)
-
-	// Update allocation meters
-	for _, am := range allocationMeters {
-		k.SetAllocationMeter(ctx, am)
-	}
-
-	return &incentive, nil
+	return allocationMeters, nil
 }
 
 // RegisterIncentive deletes the incentive for a contract
@@ -119,6 +236,11 @@ func (k Keeper) CancelIncentive(
 		)
 	}
 
+	// Refund the remaining escrow to the sponsor
+	if err := k.refundSponsoredIncentive(ctx, incentive); err != nil {
+		return err
+	}
+
 	k.DeleteIncentiveAndUpdateAllocationMeters(ctx, incentive)
 
 	// Delete incentive's gas meters
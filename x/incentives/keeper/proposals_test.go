@@ -94,6 +94,8 @@ func (suite KeeperTestSuite) TestRegisterIncentive() {
 						sdk.NewDecCoinFromDec(denomCoin, sdk.NewDecWithPrec(100, 2)),
 					},
 					epochs,
+					types.RewardCurveLinear,
+					0,
 				)
 				suite.Require().NoError(err)
 				suite.Commit()
@@ -127,6 +129,8 @@ func (suite KeeperTestSuite) TestRegisterIncentive() {
 				contract,
 				allocations,
 				epochs,
+				types.RewardCurveLinear,
+				0,
 			)
 			suite.Commit()
 
@@ -182,6 +186,8 @@ func (suite KeeperTestSuite) TestCancelIncentive() {
 					contract,
 					mintAllocations,
 					epochs,
+					types.RewardCurveLinear,
+					0,
 				)
 				suite.Require().NoError(err)
 				suite.Commit()
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+// createSponsoredIncentive creates an incentive for a contract that is funded
+// by the sponsor. The rewards are escrowed on the incentives module account
+// until they are distributed or refunded.
+func (k Keeper) createSponsoredIncentive(
+	ctx sdk.Context,
+	sponsor sdk.AccAddress,
+	contract common.Address,
+	rewards sdk.Coins,
+	epochs uint32,
+	rewardCurve types.RewardCurve,
+	maxGasPerParticipant uint64,
+) (*types.Incentive, error) {
+	// Check if the Incentives are globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableIncentives {
+		return nil, errorsmod.Wrap(
+			types.ErrInternalIncentive,
+			"incentives are currently disabled by governance",
+		)
+	}
+
+	// Check if the number of epochs exceeds the maximum
+	if params.MaxSponsoredEpochs > 0 && epochs > params.MaxSponsoredEpochs {
+		return nil, errorsmod.Wrapf(
+			types.ErrSponsoredIncentive,
+			"epochs cannot exceed %d: %d", params.MaxSponsoredEpochs, epochs,
+		)
+	}
+
+	// Check if the rewards distributed per epoch reach the minimum, so that
+	// occupying the incentive of a contract has a cost
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	epochReward := rewards.AmountOf(evmDenom).QuoRaw(int64(epochs))
+	if epochReward.LT(params.MinSponsoredEpochReward) {
+		return nil, errorsmod.Wrapf(
+			types.ErrSponsoredIncentive,
+			"rewards per epoch must be at least %s%s: %s%s",
+			params.MinSponsoredEpochReward, evmDenom, epochReward, evmDenom,
+		)
+	}
+
+	// Check if contract exists
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return nil, errorsmod.Wrapf(
+			types.ErrSponsoredIncentive,
+			"contract doesn't exist: %s", contract,
+		)
+	}
+
+	// Check if the incentive is already registered
+	if k.IsIncentiveRegistered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrSponsoredIncentive,
+			"incentive already registered: %s", contract,
+		)
+	}
+
+	// Check if the contract is already a member of an incentive set
+	if set, found := k.GetIncentiveSetOfMember(ctx, contract); found {
+		return nil, errorsmod.Wrapf(
+			types.ErrSponsoredIncentive,
+			"contract %s is already a member of incentive set %s", contract, set,
+		)
+	}
+
+	// Escrow the sponsor's rewards
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sponsor, types.ModuleName, rewards); err != nil {
+		return nil, err
+	}
+
+	// create incentive and set to store
+	incentive := types.NewSponsoredIncentive(contract, sponsor, rewards, epochs)
+	incentive.StartTime = ctx.BlockTime()
+	incentive.RewardCurve = rewardCurve
+	incentive.MaxGasPerParticipant = maxGasPerParticipant
+	k.SetIncentive(ctx, incentive)
+
+	return &incentive, nil
+}
+
+// GetSponsoredEscrow returns the sum of the remaining escrowed rewards of all
+// sponsored incentives. These coins are held by the incentives module account
+// but are not part of the inflation pool.
+func (k Keeper) GetSponsoredEscrow(ctx sdk.Context) sdk.Coins {
+	escrow := sdk.Coins{}
+
+	k.IterateIncentives(ctx, func(incentive types.Incentive) (stop bool) {
+		if incentive.IsSponsored() {
+			escrow = escrow.Add(incentive.SponsoredRewards...)
+		}
+		return false
+	})
+
+	return escrow
+}
+
+// refundSponsoredIncentive transfers the remaining escrowed rewards of a
+// sponsored incentive back to its sponsor
+func (k Keeper) refundSponsoredIncentive(ctx sdk.Context, incentive types.Incentive) error {
+	if !incentive.IsSponsored() || incentive.SponsoredRewards.IsZero() {
+		return nil
+	}
+
+	sponsor := sdk.MustAccAddressFromBech32(incentive.Sponsor)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sponsor, incentive.SponsoredRewards); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundSponsoredIncentive,
+			sdk.NewAttribute(types.AttributeKeyContract, incentive.Contract),
+			sdk.NewAttribute(types.AttributeKeySponsor, incentive.Sponsor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, incentive.SponsoredRewards.String()),
+		),
+	)
+
+	return nil
+}
@@ -21,6 +21,8 @@ func NewIncentivesProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 		switch c := content.(type) {
 		case *types.RegisterIncentiveProposal:
 			return handleRegisterIncentiveProposal(ctx, k, c)
+		case *types.RegisterIncentiveSetProposal:
+			return handleRegisterIncentiveSetProposal(ctx, k, c)
 		case *types.CancelIncentiveProposal:
 			return handleCancelIncentiveProposal(ctx, k, c)
 		default:
@@ -33,7 +35,51 @@ func NewIncentivesProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 }
 
 func handleRegisterIncentiveProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterIncentiveProposal) error {
-	in, err := k.RegisterIncentive(ctx, common.HexToAddress(p.Contract), p.Allocations, p.Epochs)
+	in, err := k.RegisterIncentive(
+		ctx,
+		common.HexToAddress(p.Contract),
+		p.Allocations,
+		p.Epochs,
+		p.RewardCurve,
+		p.MaxGasPerParticipant,
+	)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterIncentive,
+			sdk.NewAttribute(types.AttributeKeyContract, in.Contract),
+			sdk.NewAttribute(
+				types.AttributeKeyEpochs,
+				strconv.FormatUint(uint64(in.Epochs), 10),
+			),
+		),
+	)
+	return nil
+}
+
+func handleRegisterIncentiveSetProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterIncentiveSetProposal) error {
+	contracts := make([]common.Address, len(p.Contracts))
+	for i, contract := range p.Contracts {
+		contracts[i] = common.HexToAddress(contract)
+	}
+
+	var deployer sdk.AccAddress
+	if p.Deployer != "" {
+		deployer = sdk.MustAccAddressFromBech32(p.Deployer)
+	}
+
+	in, err := k.RegisterIncentiveSet(
+		ctx,
+		p.Name,
+		contracts,
+		deployer,
+		p.Allocations,
+		p.Epochs,
+		p.RewardCurve,
+		p.MaxGasPerParticipant,
+	)
 	if err != nil {
 		return err
 	}
@@ -41,6 +87,7 @@ func handleRegisterIncentiveProposal(ctx sdk.Context, k *keeper.Keeper, p *types
 		sdk.NewEvent(
 			types.EventTypeRegisterIncentive,
 			sdk.NewAttribute(types.AttributeKeyContract, in.Contract),
+			sdk.NewAttribute(types.AttributeKeyName, in.Name),
 			sdk.NewAttribute(
 				types.AttributeKeyEpochs,
 				strconv.FormatUint(uint64(in.Epochs), 10),
@@ -6,5 +6,7 @@ import (
 
 // errors
 var (
-	ErrInternalIncentive = errorsmod.Register(ModuleName, 2, "internal incentives error")
+	ErrInternalIncentive  = errorsmod.Register(ModuleName, 2, "internal incentives error")
+	ErrSponsoredIncentive = errorsmod.Register(ModuleName, 3, "invalid sponsored incentive")
+	ErrNoClaimableRewards = errorsmod.Register(ModuleName, 4, "no claimable rewards")
 )
@@ -6,6 +6,14 @@ const (
 	EventTypeCancelIncentive      = "cancel_incentive"
 	EventTypeDistributeIncentives = "distribute_incentives"
 
-	AttributeKeyContract = "contract"
-	AttributeKeyEpochs   = "epochs"
+	EventTypeCreateSponsoredIncentive = "create_sponsored_incentive"
+	EventTypeRefundSponsoredIncentive = "refund_sponsored_incentive"
+	EventTypeClaimIncentiveRewards    = "claim_incentive_rewards"
+
+	AttributeKeyContract    = "contract"
+	AttributeKeyEpochs      = "epochs"
+	AttributeKeySponsor     = "sponsor"
+	AttributeKeyParticipant = "participant"
+	AttributeKeyAsERC20     = "as_erc20"
+	AttributeKeyName        = "name"
 )
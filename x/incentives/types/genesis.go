@@ -7,11 +7,13 @@ func NewGenesisState(
 	params Params,
 	incentives []Incentive,
 	gasMeters []GasMeter,
+	participantRewards []ParticipantRewards,
 ) GenesisState {
 	return GenesisState{
-		Params:     params,
-		Incentives: incentives,
-		GasMeters:  gasMeters,
+		Params:             params,
+		Incentives:         incentives,
+		GasMeters:          gasMeters,
+		ParticipantRewards: participantRewards,
 	}
 }
 
@@ -57,5 +59,19 @@ func (gs GenesisState) Validate() error {
 		seenGasMeters[gm.Contract+gm.Participant] = true
 	}
 
+	seenParticipantRewards := make(map[string]bool)
+	for _, pr := range gs.ParticipantRewards {
+		// only one rewards accumulator per participant
+		if seenParticipantRewards[pr.Participant] {
+			return fmt.Errorf("participant rewards duplicated on genesis '%s'", pr.Participant)
+		}
+
+		if err := pr.Validate(); err != nil {
+			return err
+		}
+
+		seenParticipantRewards[pr.Participant] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -32,6 +32,8 @@ type GenesisState struct {
 	Incentives []Incentive `protobuf:"bytes,2,rep,name=incentives,proto3" json:"incentives"`
 	// gas_meters is a slice of active Gasmeters
 	GasMeters []GasMeter `protobuf:"bytes,3,rep,name=gas_meters,json=gasMeters,proto3" json:"gas_meters"`
+	// participant_rewards is a slice of the unclaimed rewards of each participant
+	ParticipantRewards []ParticipantRewards `protobuf:"bytes,4,rep,name=participant_rewards,json=participantRewards,proto3" json:"participant_rewards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +90,13 @@ func (m *GenesisState) GetGasMeters() []GasMeter {
 	return nil
 }
 
+func (m *GenesisState) GetParticipantRewards() []ParticipantRewards {
+	if m != nil {
+		return m.ParticipantRewards
+	}
+	return nil
+}
+
 // Params defines the incentives module params
 type Params struct {
 	// enable_incentives is the parameter to enable incentives
@@ -98,6 +107,19 @@ type Params struct {
 	IncentivesEpochIdentifier string `protobuf:"bytes,3,opt,name=incentives_epoch_identifier,json=incentivesEpochIdentifier,proto3" json:"incentives_epoch_identifier,omitempty"`
 	// reward_scaler is the scaling factor for capping rewards
 	RewardScaler github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reward_scaler,json=rewardScaler,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_scaler"`
+	// enable_claimable_rewards defines if the rewards of each participant are
+	// accumulated to be claimed instead of transferred at the end of each epoch
+	EnableClaimableRewards bool `protobuf:"varint,5,opt,name=enable_claimable_rewards,json=enableClaimableRewards,proto3" json:"enable_claimable_rewards,omitempty"`
+	// enable_internal_call_metering defines if the gas of a transaction is also
+	// attributed to the incentivized contracts reached through internal calls,
+	// identified by the logs they emit
+	EnableInternalCallMetering bool `protobuf:"varint,6,opt,name=enable_internal_call_metering,json=enableInternalCallMetering,proto3" json:"enable_internal_call_metering,omitempty"`
+	// min_sponsored_epoch_reward is the minimum amount of the EVM denomination
+	// that a sponsored incentive must distribute per epoch. Zero means no minimum.
+	MinSponsoredEpochReward github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=min_sponsored_epoch_reward,json=minSponsoredEpochReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_sponsored_epoch_reward"`
+	// max_sponsored_epochs is the maximum number of epochs of a sponsored
+	// incentive. Zero means no maximum.
+	MaxSponsoredEpochs uint32 `protobuf:"varint,8,opt,name=max_sponsored_epochs,json=maxSponsoredEpochs,proto3" json:"max_sponsored_epochs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -147,6 +169,27 @@ func (m *Params) GetIncentivesEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetEnableClaimableRewards() bool {
+	if m != nil {
+		return m.EnableClaimableRewards
+	}
+	return false
+}
+
+func (m *Params) GetEnableInternalCallMetering() bool {
+	if m != nil {
+		return m.EnableInternalCallMetering
+	}
+	return false
+}
+
+func (m *Params) GetMaxSponsoredEpochs() uint32 {
+	if m != nil {
+		return m.MaxSponsoredEpochs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.incentives.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.incentives.v1.Params")
@@ -155,33 +198,42 @@ func init() {
 func init() { proto.RegisterFile("evmos/incentives/v1/genesis.proto", fileDescriptor_7bb1f7c7e8ad160b) }
 
 var fileDescriptor_7bb1f7c7e8ad160b = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0xee, 0xd2, 0x40,
-	0x10, 0x87, 0x5b, 0xf8, 0x87, 0xc8, 0x82, 0x11, 0xab, 0x87, 0x0a, 0xb1, 0x20, 0x31, 0x86, 0xc4,
-	0xb0, 0x15, 0x3c, 0x79, 0xf1, 0xd0, 0x60, 0x08, 0x89, 0x26, 0xa6, 0x9c, 0xf4, 0xd2, 0x2c, 0x65,
-	0x2c, 0x1b, 0xdb, 0x6e, 0xd3, 0x5d, 0xab, 0xbe, 0x85, 0x2f, 0xe1, 0xbb, 0x70, 0x24, 0xf1, 0x62,
-	0x3c, 0x10, 0x03, 0x2f, 0x62, 0x76, 0xb7, 0xda, 0x1e, 0x7a, 0xf2, 0xd2, 0xee, 0xb4, 0xdf, 0x7c,
-	0xbf, 0x69, 0x33, 0xe8, 0x11, 0x14, 0x09, 0xe3, 0x2e, 0x4d, 0x43, 0x48, 0x05, 0x2d, 0x80, 0xbb,
-	0xc5, 0xc2, 0x8d, 0x20, 0x05, 0x4e, 0x39, 0xce, 0x72, 0x26, 0x98, 0x75, 0x4f, 0x21, 0xb8, 0x42,
-	0x70, 0xb1, 0x18, 0x3e, 0x6e, 0xea, 0xab, 0x21, 0xaa, 0x75, 0x78, 0x3f, 0x62, 0x11, 0x53, 0x47,
-	0x57, 0x9e, 0xf4, 0xd3, 0xe9, 0x0f, 0x13, 0xf5, 0xd7, 0x3a, 0x62, 0x2b, 0x88, 0x00, 0xeb, 0x05,
-	0xea, 0x64, 0x24, 0x27, 0x09, 0xb7, 0xcd, 0x89, 0x39, 0xeb, 0x2d, 0x47, 0xb8, 0x21, 0x12, 0xbf,
-	0x55, 0x88, 0x77, 0x73, 0x3c, 0x8f, 0x0d, 0xbf, 0x6c, 0xb0, 0x56, 0x08, 0x55, 0x94, 0xdd, 0x9a,
-	0xb4, 0x67, 0xbd, 0xa5, 0xd3, 0xd8, 0xbe, 0xf9, 0x5b, 0x95, 0x86, 0x5a, 0x9f, 0xe5, 0x21, 0x14,
-	0x11, 0x1e, 0x24, 0x20, 0x20, 0xe7, 0x76, 0x5b, 0x59, 0x1e, 0x36, 0x5a, 0xd6, 0x84, 0xbf, 0x91,
-	0x54, 0x29, 0xe9, 0x46, 0x65, 0xcd, 0xa7, 0xdf, 0x5b, 0xa8, 0xa3, 0x47, 0xb4, 0x9e, 0xa2, 0xbb,
-	0x90, 0x92, 0x5d, 0x0c, 0x41, 0x6d, 0x36, 0xf9, 0x69, 0xb7, 0xfc, 0x81, 0x7e, 0xb1, 0xa9, 0xb2,
-	0xdf, 0xa1, 0x01, 0x89, 0x63, 0x16, 0x12, 0x41, 0x59, 0x1a, 0xc4, 0x34, 0xa1, 0xc2, 0x6e, 0x4d,
-	0xcc, 0x59, 0xd7, 0xc3, 0x32, 0xe2, 0xd7, 0x79, 0xfc, 0x24, 0xa2, 0xe2, 0xf0, 0x69, 0x87, 0x43,
-	0x96, 0xb8, 0x21, 0xe3, 0xf2, 0xbf, 0xeb, 0xdb, 0x9c, 0xef, 0x3f, 0xba, 0xe2, 0x6b, 0x06, 0x1c,
-	0xaf, 0x20, 0xf4, 0xef, 0x54, 0x9e, 0xd7, 0x52, 0x63, 0xbd, 0x44, 0xa3, 0x6a, 0x80, 0x00, 0x32,
-	0x16, 0x1e, 0x02, 0xba, 0x97, 0xf5, 0x07, 0x0a, 0xb9, 0xdd, 0x96, 0x29, 0xfe, 0x83, 0x0a, 0x79,
-	0x25, 0x89, 0xcd, 0x3f, 0xc0, 0xda, 0xa2, 0xdb, 0x39, 0x7c, 0x26, 0xf9, 0x3e, 0xe0, 0x21, 0x89,
-	0x21, 0xb7, 0x6f, 0xfe, 0x6b, 0xae, 0xbe, 0x96, 0x6c, 0x95, 0xc3, 0x5b, 0x1f, 0x2f, 0x8e, 0x79,
-	0xba, 0x38, 0xe6, 0xef, 0x8b, 0x63, 0x7e, 0xbb, 0x3a, 0xc6, 0xe9, 0xea, 0x18, 0x3f, 0xaf, 0x8e,
-	0xf1, 0x7e, 0x5e, 0xf3, 0xe9, 0xf5, 0xd2, 0xd7, 0x62, 0xf1, 0xcc, 0xfd, 0x52, 0x5f, 0x35, 0xa5,
-	0xde, 0x75, 0xd4, 0x36, 0x3d, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x6e, 0xce, 0xce, 0x10, 0xc3,
-	0x02, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xb6, 0x94, 0xcd, 0xdb, 0xc4, 0xf0, 0x26, 0x30, 0x9d, 0x96, 0x95, 0x09, 0x41,
+	0x25, 0xb4, 0x64, 0x1d, 0x17, 0xb8, 0x20, 0xd1, 0x0d, 0x55, 0x95, 0x98, 0x84, 0xd2, 0x13, 0x1c,
+	0x88, 0xdc, 0xd4, 0x64, 0xd6, 0x1c, 0x3b, 0xb2, 0x4d, 0x29, 0xdf, 0x82, 0xef, 0xc2, 0x27, 0xe0,
+	0xb6, 0xe3, 0x8e, 0x88, 0xc3, 0x84, 0xda, 0x2f, 0x82, 0x62, 0x27, 0x4b, 0x80, 0x72, 0x80, 0x4b,
+	0x62, 0xe7, 0xfd, 0xde, 0xff, 0xbd, 0xf7, 0x77, 0x0c, 0xee, 0x93, 0x69, 0x22, 0x94, 0x4f, 0x79,
+	0x44, 0xb8, 0xa6, 0x53, 0xa2, 0xfc, 0x69, 0xcf, 0x8f, 0x09, 0x27, 0x8a, 0x2a, 0x2f, 0x95, 0x42,
+	0x0b, 0xb8, 0x65, 0x10, 0xaf, 0x44, 0xbc, 0x69, 0xaf, 0xfd, 0x60, 0x59, 0x5e, 0x05, 0x31, 0xa9,
+	0xed, 0xed, 0x58, 0xc4, 0xc2, 0x2c, 0xfd, 0x6c, 0x65, 0xbf, 0xee, 0x7f, 0xa9, 0x83, 0xf5, 0x81,
+	0x2d, 0x31, 0xd2, 0x58, 0x13, 0xf8, 0x0c, 0xb4, 0x52, 0x2c, 0x71, 0xa2, 0x90, 0xd3, 0x71, 0xba,
+	0x6b, 0x47, 0x3b, 0xde, 0x92, 0x92, 0xde, 0x6b, 0x83, 0xf4, 0x9b, 0x17, 0x57, 0x7b, 0xb5, 0x20,
+	0x4f, 0x80, 0x27, 0x00, 0x94, 0x14, 0xaa, 0x77, 0x1a, 0xdd, 0xb5, 0x23, 0x77, 0x69, 0xfa, 0xb0,
+	0xd8, 0xe5, 0x0a, 0x95, 0x3c, 0xd8, 0x07, 0x20, 0xc6, 0x2a, 0x4c, 0x88, 0x26, 0x52, 0xa1, 0x86,
+	0x51, 0xd9, 0x5d, 0xaa, 0x32, 0xc0, 0xea, 0x34, 0xa3, 0x72, 0x91, 0xd5, 0x38, 0xdf, 0x2b, 0xf8,
+	0x0e, 0x6c, 0xa5, 0x58, 0x6a, 0x1a, 0xd1, 0x14, 0x73, 0x1d, 0x4a, 0xf2, 0x11, 0xcb, 0x89, 0x42,
+	0x4d, 0x23, 0xf6, 0xe8, 0x6f, 0x13, 0x15, 0x7c, 0x60, 0xf1, 0x5c, 0x16, 0xa6, 0x7f, 0x44, 0xf6,
+	0xbf, 0x36, 0x41, 0xcb, 0x5a, 0x00, 0x1f, 0x83, 0xdb, 0x84, 0xe3, 0x31, 0x23, 0x61, 0x65, 0xf6,
+	0xcc, 0xba, 0x95, 0x60, 0xd3, 0x06, 0x86, 0xe5, 0x6c, 0x6f, 0xc0, 0x26, 0x66, 0x4c, 0x44, 0x58,
+	0x53, 0xc1, 0x43, 0x46, 0x13, 0xaa, 0x51, 0xbd, 0xe3, 0x74, 0x57, 0xfb, 0x5e, 0x56, 0xeb, 0xfb,
+	0xd5, 0xde, 0xc3, 0x98, 0xea, 0xb3, 0x0f, 0x63, 0x2f, 0x12, 0x89, 0x1f, 0x09, 0x95, 0x9d, 0xab,
+	0x7d, 0x1d, 0xa8, 0xc9, 0xb9, 0xaf, 0x3f, 0xa5, 0x44, 0x79, 0x27, 0x24, 0x0a, 0x6e, 0x95, 0x3a,
+	0xaf, 0x32, 0x19, 0xf8, 0x1c, 0xec, 0x94, 0x0d, 0x84, 0x24, 0x15, 0xd1, 0x59, 0x48, 0x27, 0xd9,
+	0xfe, 0x3d, 0x25, 0x12, 0x35, 0xb2, 0x2a, 0xc1, 0xbd, 0x12, 0x79, 0x99, 0x11, 0xc3, 0x6b, 0x00,
+	0x8e, 0xc0, 0x86, 0xb5, 0x29, 0x54, 0x11, 0x66, 0x44, 0xa2, 0xe6, 0x7f, 0xf5, 0xb5, 0x6e, 0x45,
+	0x46, 0x46, 0x03, 0x3e, 0x05, 0x28, 0x37, 0x27, 0x62, 0x98, 0x26, 0x66, 0x55, 0x1c, 0xc6, 0x0d,
+	0xe3, 0xd1, 0x1d, 0x1b, 0x3f, 0x2e, 0xc2, 0xb9, 0xc3, 0xf0, 0x05, 0xd8, 0xbd, 0xb6, 0x55, 0x13,
+	0xc9, 0x31, 0x0b, 0x23, 0xcc, 0x98, 0xfd, 0x2d, 0x28, 0x8f, 0x51, 0xcb, 0xa4, 0xb7, 0x0b, 0x8b,
+	0x2d, 0x73, 0x8c, 0x19, 0x3b, 0xcd, 0x09, 0x78, 0x0e, 0xda, 0x09, 0xe5, 0xa1, 0x4a, 0x05, 0x57,
+	0x42, 0x92, 0x49, 0x6e, 0x8a, 0xad, 0x8f, 0x6e, 0xfe, 0xf3, 0x78, 0x43, 0xae, 0x83, 0xbb, 0x09,
+	0xe5, 0xa3, 0x42, 0xd0, 0x58, 0x68, 0x1b, 0x86, 0x87, 0x60, 0x3b, 0xc1, 0xb3, 0xdf, 0x8b, 0x29,
+	0xb4, 0xd2, 0x71, 0xba, 0x1b, 0x01, 0x4c, 0xf0, 0xec, 0xd7, 0x34, 0xd5, 0x1f, 0x5c, 0xcc, 0x5d,
+	0xe7, 0x72, 0xee, 0x3a, 0x3f, 0xe6, 0xae, 0xf3, 0x79, 0xe1, 0xd6, 0x2e, 0x17, 0x6e, 0xed, 0xdb,
+	0xc2, 0xad, 0xbd, 0x3d, 0xa8, 0x34, 0x63, 0xaf, 0xb6, 0x7d, 0x4e, 0x7b, 0x87, 0xfe, 0xac, 0x7a,
+	0xcd, 0x4d, 0x5f, 0xe3, 0x96, 0xb9, 0xc9, 0x4f, 0x7e, 0x0e, 0x00, 0xb9, 0x25, 0x3a, 0xf0, 0x3f,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -204,6 +256,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParticipantRewards) > 0 {
+		for iNdEx := len(m.ParticipantRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.GasMeters) > 0 {
 		for iNdEx := len(m.GasMeters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -265,6 +331,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSponsoredEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxSponsoredEpochs))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MinSponsoredEpochReward.Size()
+		i -= size
+		if _, err := m.MinSponsoredEpochReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.EnableInternalCallMetering {
+		i--
+		if m.EnableInternalCallMetering {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EnableClaimableRewards {
+		i--
+		if m.EnableClaimableRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.RewardScaler.Size()
 		i -= size
@@ -336,6 +437,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ParticipantRewards) > 0 {
+		for _, e := range m.ParticipantRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -356,6 +463,17 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.RewardScaler.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.EnableClaimableRewards {
+		n += 2
+	}
+	if m.EnableInternalCallMetering {
+		n += 2
+	}
+	l = m.MinSponsoredEpochReward.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxSponsoredEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxSponsoredEpochs))
+	}
 	return n
 }
 
@@ -495,6 +613,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantRewards = append(m.ParticipantRewards, ParticipantRewards{})
+			if err := m.ParticipantRewards[len(m.ParticipantRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -665,6 +817,99 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableClaimableRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableClaimableRewards = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableInternalCallMetering", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableInternalCallMetering = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSponsoredEpochReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSponsoredEpochReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSponsoredEpochs", wireType)
+			}
+			m.MaxSponsoredEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSponsoredEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -17,7 +17,7 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
-	newGen := NewGenesisState(DefaultParams(), []Incentive{}, []GasMeter{})
+	newGen := NewGenesisState(DefaultParams(), []Incentive{}, []GasMeter{}, []ParticipantRewards{})
 
 	testCases := []struct {
 		name     string
@@ -149,6 +149,49 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"valid genesis - with participant rewards",
+			&GenesisState{
+				Params: DefaultParams(),
+				ParticipantRewards: []ParticipantRewards{
+					{
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Rewards:     sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis - duplicated participant rewards",
+			&GenesisState{
+				Params: DefaultParams(),
+				ParticipantRewards: []ParticipantRewards{
+					{
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Rewards:     sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+					{
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Rewards:     sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid participant rewards",
+			&GenesisState{
+				Params: DefaultParams(),
+				ParticipantRewards: []ParticipantRewards{
+					{
+						Participant: "0xinvalidaddress",
+						Rewards:     sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+			},
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{},
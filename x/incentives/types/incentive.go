@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethermint "github.com/evmos/ethermint/types"
 )
 
@@ -22,12 +24,73 @@ func NewIncentive(
 	}
 }
 
+// NewSponsoredIncentive returns an instance of Incentive funded by a sponsor
+func NewSponsoredIncentive(
+	contract common.Address,
+	sponsor sdk.AccAddress,
+	rewards sdk.Coins,
+	epochs uint32,
+) Incentive {
+	return Incentive{
+		Contract:         contract.String(),
+		Allocations:      sdk.DecCoins{},
+		Epochs:           epochs,
+		TotalGas:         0,
+		Sponsor:          sponsor.String(),
+		SponsoredRewards: rewards,
+	}
+}
+
+// NewIncentiveSet returns an instance of Incentive that targets a named set of
+// contracts and/or the contracts registered on x/revenue by a deployer
+func NewIncentiveSet(
+	name string,
+	contracts []common.Address,
+	deployer sdk.AccAddress,
+	allocations sdk.DecCoins,
+	epochs uint32,
+) Incentive {
+	members := make([]string, len(contracts))
+	for i, contract := range contracts {
+		members[i] = contract.String()
+	}
+
+	incentive := Incentive{
+		Contract:    IncentiveSetAddress(name).String(),
+		Allocations: allocations,
+		Epochs:      epochs,
+		TotalGas:    0,
+		Name:        name,
+		Contracts:   members,
+	}
+
+	if !deployer.Empty() {
+		incentive.Deployer = deployer.String()
+	}
+
+	return incentive
+}
+
+// IncentiveSetAddress returns the address under which the incentive set with
+// the given name is registered and its gas is metered
+func IncentiveSetAddress(name string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(ModuleName + "/" + name))[12:])
+}
+
 // Validate performs a stateless validation of a Incentive
 func (i Incentive) Validate() error {
 	if err := ethermint.ValidateAddress(i.Contract); err != nil {
 		return err
 	}
 
+	if err := i.validateSet(); err != nil {
+		return err
+	}
+
+	if i.IsSponsored() {
+		return i.validateSponsored()
+	}
+
 	if i.Allocations.IsZero() {
 		return fmt.Errorf("allocations cannot be empty: %s", i.Allocations)
 	}
@@ -44,10 +107,105 @@ func (i Incentive) Validate() error {
 	if i.Epochs == 0 {
 		return fmt.Errorf("epoch cannot be 0")
 	}
-	return nil
+
+	return validateRewardCurve(i.RewardCurve)
+}
+
+// validateSponsored performs a stateless validation of the fields of a
+// sponsored Incentive
+func (i Incentive) validateSponsored() error {
+	if _, err := sdk.AccAddressFromBech32(i.Sponsor); err != nil {
+		return fmt.Errorf("invalid sponsor address %s: %w", i.Sponsor, err)
+	}
+
+	if !i.Allocations.Empty() {
+		return fmt.Errorf("sponsored incentive cannot have allocations: %s", i.Allocations)
+	}
+
+	if err := i.SponsoredRewards.Validate(); err != nil {
+		return err
+	}
+
+	if i.Epochs == 0 {
+		return fmt.Errorf("epoch cannot be 0")
+	}
+
+	return validateRewardCurve(i.RewardCurve)
+}
+
+// validateSet performs a stateless validation of the fields of an incentive set
+func (i Incentive) validateSet() error {
+	if !i.IsSet() {
+		if len(i.Contracts) > 0 || i.Deployer != "" {
+			return fmt.Errorf("incentive with members must have a name: %s", i.Contract)
+		}
+		return nil
+	}
+
+	if common.HexToAddress(i.Contract) != IncentiveSetAddress(i.Name) {
+		return fmt.Errorf("invalid address %s for incentive set %s", i.Contract, i.Name)
+	}
+
+	return validateSetMembers(i.Name, i.Contracts, i.Deployer)
+}
+
+// IsSet returns true if the Incentive targets a set of contracts instead of a
+// single contract
+func (i Incentive) IsSet() bool {
+	return i.Name != ""
+}
+
+// IsSponsored returns true if the Incentive is funded by a sponsor instead of
+// inflation
+func (i Incentive) IsSponsored() bool {
+	return i.Sponsor != ""
+}
+
+// SponsoredEpochRewards returns the escrowed coins of a sponsored Incentive to
+// be distributed during the current epoch, i.e. an even split of the remaining
+// rewards over the remaining epochs.
+func (i Incentive) SponsoredEpochRewards() sdk.Coins {
+	if !i.IsSponsored() || i.Epochs == 0 {
+		return sdk.Coins{}
+	}
+
+	rewards := sdk.Coins{}
+	for _, coin := range i.SponsoredRewards {
+		amount := coin.Amount.QuoRaw(int64(i.Epochs))
+		if amount.IsPositive() {
+			rewards = rewards.Add(sdk.Coin{Denom: coin.Denom, Amount: amount})
+		}
+	}
+
+	return rewards
 }
 
 // IsActive returns true if the Incentive has remaining Epochs
 func (i Incentive) IsActive() bool {
 	return i.Epochs > 0
 }
+
+// EffectiveGas returns the reward weight of a participant's cumulative gas
+// after capping it at the incentive's max gas per participant and applying the
+// incentive's reward curve.
+//
+// NOTE: the sqrt curve is not Sybil-resistant. Splitting the same gas across n
+// addresses yields a total weight of sqrt(n*gas) instead of sqrt(gas), so it
+// favors participants that spread their activity over many addresses. The
+// linear curve is the only split-insensitive curve.
+func (i Incentive) EffectiveGas(cumulativeGas uint64) (sdk.Dec, error) {
+	if i.MaxGasPerParticipant > 0 && cumulativeGas > i.MaxGasPerParticipant {
+		cumulativeGas = i.MaxGasPerParticipant
+	}
+
+	gas := sdk.NewDecFromBigInt(new(big.Int).SetUint64(cumulativeGas))
+
+	switch i.RewardCurve {
+	case RewardCurveLinear:
+		return gas, nil
+	case RewardCurveSqrt:
+		return gas.ApproxSqrt()
+	default:
+		return sdk.Dec{}, fmt.Errorf("invalid reward curve: %s", i.RewardCurve)
+	}
+}
@@ -97,6 +97,13 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -108,6 +115,13 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -119,6 +133,13 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -130,6 +151,13 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			true,
 		},
@@ -160,6 +188,13 @@ func (suite *IncentiveTestSuite) TestIsActive() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			true,
 		},
@@ -171,6 +206,13 @@ func (suite *IncentiveTestSuite) TestIsActive() {
 				0,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -184,3 +226,138 @@ func (suite *IncentiveTestSuite) TestIsActive() {
 		}
 	}
 }
+
+func (suite *IncentiveTestSuite) TestEffectiveGas() {
+	testCases := []struct {
+		name          string
+		rewardCurve   RewardCurve
+		maxGas        uint64
+		cumulativeGas uint64
+		expGas        sdk.Dec
+		expPass       bool
+	}{
+		{
+			"linear - no cap",
+			RewardCurveLinear,
+			0,
+			10000,
+			sdk.NewDec(10000),
+			true,
+		},
+		{
+			"linear - below cap",
+			RewardCurveLinear,
+			20000,
+			10000,
+			sdk.NewDec(10000),
+			true,
+		},
+		{
+			"linear - above cap",
+			RewardCurveLinear,
+			5000,
+			10000,
+			sdk.NewDec(5000),
+			true,
+		},
+		{
+			"sqrt - no cap",
+			RewardCurveSqrt,
+			0,
+			10000,
+			sdk.NewDec(100),
+			true,
+		},
+		{
+			"sqrt - above cap",
+			RewardCurveSqrt,
+			2500,
+			10000,
+			sdk.NewDec(50),
+			true,
+		},
+		{
+			"fail - invalid reward curve",
+			RewardCurve(5),
+			0,
+			10000,
+			sdk.Dec{},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		incentive := NewIncentive(tests.GenerateAddress(), sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))}, 10)
+		incentive.RewardCurve = tc.rewardCurve
+		incentive.MaxGasPerParticipant = tc.maxGas
+
+		gas, err := incentive.EffectiveGas(tc.cumulativeGas)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(tc.expGas, gas, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *IncentiveTestSuite) TestSponsoredIncentive() {
+	sponsor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("acoin", 1000), sdk.NewInt64Coin("aevmos", 5))
+
+	incentive := NewSponsoredIncentive(tests.GenerateAddress(), sponsor, rewards, 3)
+	suite.Require().True(incentive.IsSponsored())
+	suite.Require().NoError(incentive.Validate())
+
+	// remaining rewards are split evenly over the remaining epochs
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("acoin", 333), sdk.NewInt64Coin("aevmos", 1)),
+		incentive.SponsoredEpochRewards(),
+	)
+
+	incentive.Epochs = 1
+	suite.Require().Equal(rewards, incentive.SponsoredEpochRewards())
+
+	incentive.Allocations = sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))}
+	suite.Require().Error(incentive.Validate())
+
+	incentive = NewIncentive(tests.GenerateAddress(), sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))}, 10)
+	suite.Require().False(incentive.IsSponsored())
+	suite.Require().True(incentive.SponsoredEpochRewards().IsZero())
+}
+
+func (suite *IncentiveTestSuite) TestIncentiveSet() {
+	deployer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	contracts := []common.Address{tests.GenerateAddress(), tests.GenerateAddress()}
+	allocations := sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))}
+
+	incentive := NewIncentiveSet("pools", contracts, deployer, allocations, 10)
+	suite.Require().True(incentive.IsSet())
+	suite.Require().Equal(IncentiveSetAddress("pools").String(), incentive.Contract)
+	suite.Require().Equal(deployer.String(), incentive.Deployer)
+	suite.Require().NoError(incentive.Validate())
+
+	// deployer only
+	incentive = NewIncentiveSet("pools", nil, deployer, allocations, 10)
+	suite.Require().NoError(incentive.Validate())
+
+	// no members
+	incentive = NewIncentiveSet("pools", nil, nil, allocations, 10)
+	suite.Require().Error(incentive.Validate())
+
+	// duplicated member
+	incentive = NewIncentiveSet("pools", []common.Address{contracts[0], contracts[0]}, nil, allocations, 10)
+	suite.Require().Error(incentive.Validate())
+
+	// address doesn't match the name of the set
+	incentive = NewIncentiveSet("pools", contracts, nil, allocations, 10)
+	incentive.Contract = contracts[0].String()
+	suite.Require().Error(incentive.Validate())
+
+	// members without a name
+	incentive = NewIncentive(tests.GenerateAddress(), allocations, 10)
+	suite.Require().False(incentive.IsSet())
+	incentive.Contracts = []string{contracts[0].String()}
+	suite.Require().Error(incentive.Validate())
+
+	suite.Require().NotEqual(IncentiveSetAddress("pools"), IncentiveSetAddress("vaults"))
+}
@@ -29,6 +29,37 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RewardCurve defines the function applied to the cumulative gas of each
+// participant to compute its reward weight.
+type RewardCurve int32
+
+const (
+	// REWARD_CURVE_LINEAR weights participants by their cumulative gas.
+	RewardCurveLinear RewardCurve = 0
+	// REWARD_CURVE_SQRT weights participants by the square root of their
+	// cumulative gas. It is not Sybil-resistant: splitting the same gas across
+	// several addresses increases the total reward weight.
+	RewardCurveSqrt RewardCurve = 1
+)
+
+var RewardCurve_name = map[int32]string{
+	0: "REWARD_CURVE_LINEAR",
+	1: "REWARD_CURVE_SQRT",
+}
+
+var RewardCurve_value = map[string]int32{
+	"REWARD_CURVE_LINEAR": 0,
+	"REWARD_CURVE_SQRT":   1,
+}
+
+func (x RewardCurve) String() string {
+	return proto.EnumName(RewardCurve_name, int32(x))
+}
+
+func (RewardCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{0}
+}
+
 // Incentive defines an instance that organizes distribution conditions for a
 // given smart contract
 type Incentive struct {
@@ -42,6 +73,24 @@ type Incentive struct {
 	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// total_gas is the cumulative gas spent by all gas meters of the incentive during the epoch
 	TotalGas uint64 `protobuf:"varint,5,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	// reward_curve applied to the cumulative gas of each participant
+	RewardCurve RewardCurve `protobuf:"varint,6,opt,name=reward_curve,json=rewardCurve,proto3,enum=evmos.incentives.v1.RewardCurve" json:"reward_curve,omitempty"`
+	// max_gas_per_participant caps the cumulative gas of a participant that is
+	// accounted for rewards during one epoch. Zero means no cap.
+	MaxGasPerParticipant uint64 `protobuf:"varint,7,opt,name=max_gas_per_participant,json=maxGasPerParticipant,proto3" json:"max_gas_per_participant,omitempty"`
+	// sponsor is the bech32 address of the account that funds the incentive with
+	// its own coins. Empty for incentives funded by inflation.
+	Sponsor string `protobuf:"bytes,8,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// sponsored_rewards are the remaining escrowed coins of a sponsored incentive
+	SponsoredRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=sponsored_rewards,json=sponsoredRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"sponsored_rewards"`
+	// name of an incentive set. Empty for incentives that target a single
+	// contract. The contract of an incentive set is derived from its name.
+	Name string `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	// contracts are the hex addresses of the member contracts of an incentive set
+	Contracts []string `protobuf:"bytes,11,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// deployer is the bech32 address of a deployer whose contracts registered on
+	// x/revenue are members of an incentive set
+	Deployer string `protobuf:"bytes,12,opt,name=deployer,proto3" json:"deployer,omitempty"`
 }
 
 func (m *Incentive) Reset()         { *m = Incentive{} }
@@ -112,6 +161,55 @@ func (m *Incentive) GetTotalGas() uint64 {
 	return 0
 }
 
+func (m *Incentive) GetRewardCurve() RewardCurve {
+	if m != nil {
+		return m.RewardCurve
+	}
+	return RewardCurveLinear
+}
+
+func (m *Incentive) GetMaxGasPerParticipant() uint64 {
+	if m != nil {
+		return m.MaxGasPerParticipant
+	}
+	return 0
+}
+
+func (m *Incentive) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *Incentive) GetSponsoredRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SponsoredRewards
+	}
+	return nil
+}
+
+func (m *Incentive) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Incentive) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *Incentive) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
 // GasMeter tracks the cumulative gas spent per participant in one epoch
 type GasMeter struct {
 	// contract is the hex address of the incentivized smart contract
@@ -176,6 +274,62 @@ func (m *GasMeter) GetCumulativeGas() uint64 {
 	return 0
 }
 
+// ParticipantRewards tracks the accumulated rewards of a participant that
+// have not been claimed yet
+type ParticipantRewards struct {
+	// participant is the hex address of the rewarded user
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// rewards are the accumulated unclaimed rewards
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ParticipantRewards) Reset()         { *m = ParticipantRewards{} }
+func (m *ParticipantRewards) String() string { return proto.CompactTextString(m) }
+func (*ParticipantRewards) ProtoMessage()    {}
+func (*ParticipantRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{2}
+}
+func (m *ParticipantRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantRewards.Merge(m, src)
+}
+func (m *ParticipantRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantRewards proto.InternalMessageInfo
+
+func (m *ParticipantRewards) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *ParticipantRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 // RegisterIncentiveProposal is a gov Content type to register an incentive
 type RegisterIncentiveProposal struct {
 	// title of the proposal
@@ -188,13 +342,18 @@ type RegisterIncentiveProposal struct {
 	Allocations github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=allocations,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"allocations"`
 	// epochs is the number of remaining epochs for the incentive
 	Epochs uint32 `protobuf:"varint,5,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	// reward_curve applied to the cumulative gas of each participant
+	RewardCurve RewardCurve `protobuf:"varint,6,opt,name=reward_curve,json=rewardCurve,proto3,enum=evmos.incentives.v1.RewardCurve" json:"reward_curve,omitempty"`
+	// max_gas_per_participant caps the cumulative gas of a participant that is
+	// accounted for rewards during one epoch. Zero means no cap.
+	MaxGasPerParticipant uint64 `protobuf:"varint,7,opt,name=max_gas_per_participant,json=maxGasPerParticipant,proto3" json:"max_gas_per_participant,omitempty"`
 }
 
 func (m *RegisterIncentiveProposal) Reset()         { *m = RegisterIncentiveProposal{} }
 func (m *RegisterIncentiveProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterIncentiveProposal) ProtoMessage()    {}
 func (*RegisterIncentiveProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95b81e40854aec77, []int{2}
+	return fileDescriptor_95b81e40854aec77, []int{3}
 }
 func (m *RegisterIncentiveProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,6 +417,142 @@ func (m *RegisterIncentiveProposal) GetEpochs() uint32 {
 	return 0
 }
 
+func (m *RegisterIncentiveProposal) GetRewardCurve() RewardCurve {
+	if m != nil {
+		return m.RewardCurve
+	}
+	return RewardCurveLinear
+}
+
+func (m *RegisterIncentiveProposal) GetMaxGasPerParticipant() uint64 {
+	if m != nil {
+		return m.MaxGasPerParticipant
+	}
+	return 0
+}
+
+// RegisterIncentiveSetProposal is a gov Content type to register an incentive
+// that targets a named set of contracts and/or all the contracts registered on
+// x/revenue by a deployer
+type RegisterIncentiveSetProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// name of the incentive set
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// contracts are the hex addresses of the member contracts
+	Contracts []string `protobuf:"bytes,4,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// deployer is the bech32 address of a deployer whose contracts registered on
+	// x/revenue are members of the set
+	Deployer string `protobuf:"bytes,5,opt,name=deployer,proto3" json:"deployer,omitempty"`
+	// allocations defines the denoms and percentage of rewards to be allocated
+	Allocations github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=allocations,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"allocations"`
+	// epochs is the number of remaining epochs for the incentive
+	Epochs uint32 `protobuf:"varint,7,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	// reward_curve applied to the cumulative gas of each participant
+	RewardCurve RewardCurve `protobuf:"varint,8,opt,name=reward_curve,json=rewardCurve,proto3,enum=evmos.incentives.v1.RewardCurve" json:"reward_curve,omitempty"`
+	// max_gas_per_participant caps the cumulative gas of a participant that is
+	// accounted for rewards during one epoch. Zero means no cap.
+	MaxGasPerParticipant uint64 `protobuf:"varint,9,opt,name=max_gas_per_participant,json=maxGasPerParticipant,proto3" json:"max_gas_per_participant,omitempty"`
+}
+
+func (m *RegisterIncentiveSetProposal) Reset()         { *m = RegisterIncentiveSetProposal{} }
+func (m *RegisterIncentiveSetProposal) String() string { return proto.CompactTextString(m) }
+func (*RegisterIncentiveSetProposal) ProtoMessage()    {}
+func (*RegisterIncentiveSetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{4}
+}
+func (m *RegisterIncentiveSetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterIncentiveSetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterIncentiveSetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterIncentiveSetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterIncentiveSetProposal.Merge(m, src)
+}
+func (m *RegisterIncentiveSetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterIncentiveSetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterIncentiveSetProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterIncentiveSetProposal proto.InternalMessageInfo
+
+func (m *RegisterIncentiveSetProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RegisterIncentiveSetProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RegisterIncentiveSetProposal) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RegisterIncentiveSetProposal) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *RegisterIncentiveSetProposal) GetDeployer() string {
+	if m != nil {
+		return m.Deployer
+	}
+	return ""
+}
+
+func (m *RegisterIncentiveSetProposal) GetAllocations() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func (m *RegisterIncentiveSetProposal) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+func (m *RegisterIncentiveSetProposal) GetRewardCurve() RewardCurve {
+	if m != nil {
+		return m.RewardCurve
+	}
+	return RewardCurveLinear
+}
+
+func (m *RegisterIncentiveSetProposal) GetMaxGasPerParticipant() uint64 {
+	if m != nil {
+		return m.MaxGasPerParticipant
+	}
+	return 0
+}
+
 // CancelIncentiveProposal is a gov Content type to cancel an incentive
 type CancelIncentiveProposal struct {
 	// title of the proposal
@@ -272,7 +567,7 @@ func (m *CancelIncentiveProposal) Reset()         { *m = CancelIncentiveProposal
 func (m *CancelIncentiveProposal) String() string { return proto.CompactTextString(m) }
 func (*CancelIncentiveProposal) ProtoMessage()    {}
 func (*CancelIncentiveProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95b81e40854aec77, []int{3}
+	return fileDescriptor_95b81e40854aec77, []int{5}
 }
 func (m *CancelIncentiveProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,11 +617,78 @@ func (m *CancelIncentiveProposal) GetContract() string {
 	return ""
 }
 
+// ParticipantWeight defines the raw and effective reward weight of a
+// participant of an incentive during the current epoch. This is only used
+// during client queries.
+type ParticipantWeight struct {
+	// participant address that interacts with the incentive
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// cumulative_gas spent during the epoch
+	CumulativeGas uint64 `protobuf:"varint,2,opt,name=cumulative_gas,json=cumulativeGas,proto3" json:"cumulative_gas,omitempty"`
+	// effective_gas is the cumulative gas after applying the participant cap and
+	// the reward curve of the incentive
+	EffectiveGas github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=effective_gas,json=effectiveGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"effective_gas"`
+	// weight is the share of the participant's effective gas over the total
+	// effective gas of the incentive
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *ParticipantWeight) Reset()         { *m = ParticipantWeight{} }
+func (m *ParticipantWeight) String() string { return proto.CompactTextString(m) }
+func (*ParticipantWeight) ProtoMessage()    {}
+func (*ParticipantWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{6}
+}
+func (m *ParticipantWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantWeight.Merge(m, src)
+}
+func (m *ParticipantWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantWeight proto.InternalMessageInfo
+
+func (m *ParticipantWeight) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *ParticipantWeight) GetCumulativeGas() uint64 {
+	if m != nil {
+		return m.CumulativeGas
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("evmos.incentives.v1.RewardCurve", RewardCurve_name, RewardCurve_value)
 	proto.RegisterType((*Incentive)(nil), "evmos.incentives.v1.Incentive")
 	proto.RegisterType((*GasMeter)(nil), "evmos.incentives.v1.GasMeter")
+	proto.RegisterType((*ParticipantRewards)(nil), "evmos.incentives.v1.ParticipantRewards")
 	proto.RegisterType((*RegisterIncentiveProposal)(nil), "evmos.incentives.v1.RegisterIncentiveProposal")
+	proto.RegisterType((*RegisterIncentiveSetProposal)(nil), "evmos.incentives.v1.RegisterIncentiveSetProposal")
 	proto.RegisterType((*CancelIncentiveProposal)(nil), "evmos.incentives.v1.CancelIncentiveProposal")
+	proto.RegisterType((*ParticipantWeight)(nil), "evmos.incentives.v1.ParticipantWeight")
 }
 
 func init() {
@@ -334,38 +696,61 @@ func init() {
 }
 
 var fileDescriptor_95b81e40854aec77 = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xbf, 0x6f, 0xd4, 0x30,
-	0x18, 0x4d, 0xee, 0x47, 0x75, 0xe7, 0x53, 0x19, 0x42, 0x05, 0xe1, 0x40, 0x49, 0x74, 0x02, 0x29,
-	0x12, 0xaa, 0x4d, 0xda, 0x8d, 0xf1, 0x0e, 0xe9, 0xc4, 0x80, 0x84, 0x22, 0x26, 0x96, 0xca, 0x71,
-	0x4d, 0x6a, 0x91, 0xc4, 0x51, 0xfc, 0x5d, 0x04, 0x2b, 0x7f, 0x41, 0x27, 0x66, 0x66, 0xfe, 0x92,
-	0x8e, 0x1d, 0x99, 0x28, 0xba, 0x5b, 0xf8, 0x33, 0x90, 0x9d, 0xa4, 0xa4, 0x4b, 0xc7, 0x5b, 0x12,
-	0xbf, 0x67, 0x7f, 0x7a, 0xef, 0x7d, 0x9f, 0x8d, 0x9e, 0xf3, 0x3a, 0x97, 0x8a, 0x88, 0x82, 0xf1,
-	0x02, 0x44, 0xcd, 0x15, 0xa9, 0xa3, 0x1e, 0xc2, 0x65, 0x25, 0x41, 0x3a, 0x0f, 0xcd, 0x29, 0xdc,
-	0xe3, 0xeb, 0x68, 0xee, 0x31, 0xa9, 0x74, 0x6d, 0x42, 0x15, 0x27, 0x75, 0x94, 0x70, 0xa0, 0x11,
-	0x61, 0x52, 0x14, 0x4d, 0xd1, 0xfc, 0x28, 0x95, 0xa9, 0x34, 0x4b, 0xa2, 0x57, 0x2d, 0xeb, 0xa7,
-	0x52, 0xa6, 0x19, 0x27, 0x06, 0x25, 0x9b, 0x4f, 0x04, 0x44, 0xce, 0x15, 0xd0, 0xbc, 0x6c, 0x0e,
-	0x2c, 0xbe, 0x0f, 0xd0, 0xf4, 0x6d, 0x27, 0xe4, 0xcc, 0xd1, 0x84, 0xc9, 0x02, 0x2a, 0xca, 0xc0,
-	0xb5, 0x03, 0x3b, 0x9c, 0xc6, 0xb7, 0xd8, 0x51, 0x68, 0x46, 0xb3, 0x4c, 0x32, 0x0a, 0x42, 0x16,
-	0xca, 0x1d, 0x04, 0xc3, 0x70, 0x76, 0xf2, 0x0c, 0x37, 0xb6, 0xb0, 0xb6, 0x85, 0x5b, 0x5b, 0xf8,
-	0x0d, 0x67, 0x2b, 0x29, 0x8a, 0xe5, 0xe9, 0xd5, 0x6f, 0xdf, 0xfa, 0x79, 0xe3, 0xbf, 0x4c, 0x05,
-	0x5c, 0x6c, 0x12, 0xcc, 0x64, 0x4e, 0xda, 0x18, 0xcd, 0xef, 0x58, 0x9d, 0x7f, 0x26, 0xf0, 0xb5,
-	0xe4, 0xaa, 0xab, 0x51, 0x71, 0x5f, 0xc5, 0x79, 0x84, 0x0e, 0x78, 0x29, 0xd9, 0x85, 0x72, 0x87,
-	0x81, 0x1d, 0x1e, 0xc6, 0x2d, 0x72, 0x56, 0x08, 0x29, 0xa0, 0x15, 0x9c, 0xe9, 0x3c, 0xee, 0x28,
-	0xb0, 0xc3, 0xd9, 0xc9, 0x1c, 0x37, 0x61, 0x71, 0x17, 0x16, 0x7f, 0xe8, 0xc2, 0x2e, 0x27, 0xda,
-	0xc9, 0xe5, 0x8d, 0x6f, 0xc7, 0x53, 0x53, 0xa7, 0x77, 0x9c, 0xa7, 0x68, 0x0a, 0x12, 0x68, 0x76,
-	0x96, 0x52, 0xe5, 0x8e, 0x03, 0x3b, 0x1c, 0xc5, 0x13, 0x43, 0xac, 0xa9, 0x5a, 0x48, 0x34, 0x59,
-	0x53, 0xf5, 0x8e, 0x03, 0xaf, 0xee, 0x6d, 0x4b, 0x80, 0x66, 0x25, 0xad, 0x40, 0x30, 0x51, 0xd2,
-	0x02, 0xdc, 0x81, 0xd9, 0xee, 0x53, 0xce, 0x0b, 0xf4, 0x80, 0x6d, 0xf2, 0x4d, 0x46, 0x75, 0x8b,
-	0x8d, 0xd6, 0xd0, 0x68, 0x1d, 0xfe, 0x67, 0xb5, 0xe0, 0xb7, 0x01, 0x7a, 0x12, 0xf3, 0x54, 0x28,
-	0xe0, 0xd5, 0xed, 0x44, 0xde, 0x57, 0xb2, 0x94, 0x8a, 0x66, 0xce, 0x11, 0x1a, 0x83, 0x80, 0x8c,
-	0xb7, 0xfa, 0x0d, 0xd0, 0xe2, 0xe7, 0x5c, 0xb1, 0x4a, 0x94, 0xba, 0x5d, 0x9d, 0x78, 0x8f, 0xba,
-	0x63, 0x7d, 0x78, 0xff, 0x44, 0x47, 0x7b, 0x9e, 0xe8, 0xb8, 0x3f, 0xd1, 0xd7, 0xa3, 0xbf, 0x3f,
-	0x7c, 0x6b, 0xa1, 0xd0, 0xe3, 0x15, 0x2d, 0x18, 0xcf, 0xf6, 0xd2, 0x81, 0x46, 0x74, 0xb9, 0xbe,
-	0xda, 0x7a, 0xf6, 0xf5, 0xd6, 0xb3, 0xff, 0x6c, 0x3d, 0xfb, 0x72, 0xe7, 0x59, 0xd7, 0x3b, 0xcf,
-	0xfa, 0xb5, 0xf3, 0xac, 0x8f, 0xc7, 0xbd, 0x98, 0xcd, 0xd3, 0x6d, 0xbe, 0x75, 0xf4, 0x8a, 0x7c,
-	0xe9, 0x3f, 0x63, 0x93, 0x38, 0x39, 0x30, 0x37, 0xef, 0xf4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x1f, 0xe9, 0x28, 0xf9, 0xe7, 0x03, 0x00, 0x00,
+	// 856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0x13, 0xe7, 0x8f, 0x27, 0xbb, 0x65, 0x33, 0xbb, 0x50, 0x37, 0xac, 0x1c, 0x2b, 0x02,
+	0x64, 0x15, 0xd5, 0x6e, 0xb6, 0xe2, 0xc2, 0xad, 0x49, 0x97, 0xa8, 0x52, 0x41, 0x8b, 0x53, 0xa8,
+	0xc4, 0xc5, 0x9a, 0x38, 0xb3, 0x5e, 0x0b, 0xdb, 0x63, 0x66, 0x26, 0x69, 0xfa, 0x0d, 0x50, 0x4f,
+	0xfd, 0x02, 0x2b, 0x21, 0x71, 0xe3, 0xca, 0x97, 0xe8, 0xb1, 0x47, 0xc4, 0xa1, 0x45, 0xbb, 0x17,
+	0x3e, 0x00, 0x17, 0x2e, 0x08, 0x79, 0x6c, 0x27, 0xce, 0xee, 0x12, 0x56, 0xb0, 0xda, 0x5e, 0x92,
+	0x79, 0xcf, 0x6f, 0xde, 0xfb, 0xbd, 0xf7, 0x9b, 0xdf, 0x68, 0xc0, 0x07, 0x78, 0x16, 0x12, 0x66,
+	0xf9, 0x91, 0x8b, 0x23, 0xee, 0xcf, 0x30, 0xb3, 0x66, 0xbd, 0x82, 0x65, 0xc6, 0x94, 0x70, 0x02,
+	0xb7, 0x45, 0x94, 0x59, 0xf0, 0xcf, 0x7a, 0x6d, 0xcd, 0x25, 0x2c, 0xd9, 0x3b, 0x46, 0x0c, 0x5b,
+	0xb3, 0xde, 0x18, 0x73, 0xd4, 0xb3, 0x5c, 0xe2, 0x47, 0xe9, 0xa6, 0xf6, 0x8e, 0x47, 0x3c, 0x22,
+	0x96, 0x56, 0xb2, 0xca, 0xbc, 0x1d, 0x8f, 0x10, 0x2f, 0xc0, 0x96, 0xb0, 0xc6, 0xd3, 0x43, 0x8b,
+	0xfb, 0x21, 0x66, 0x1c, 0x85, 0x71, 0x1a, 0xd0, 0xfd, 0x43, 0x06, 0xca, 0xc3, 0xbc, 0x10, 0x6c,
+	0x83, 0x86, 0x4b, 0x22, 0x4e, 0x91, 0xcb, 0x55, 0x49, 0x97, 0x0c, 0xc5, 0x5e, 0xd8, 0x90, 0x81,
+	0x26, 0x0a, 0x02, 0xe2, 0x22, 0xee, 0x93, 0x88, 0xa9, 0x65, 0xbd, 0x62, 0x34, 0xf7, 0x76, 0xcd,
+	0x14, 0x96, 0x99, 0xc0, 0x32, 0x33, 0x58, 0xe6, 0x03, 0xec, 0x0e, 0x88, 0x1f, 0xf5, 0xef, 0xbd,
+	0x7c, 0xdd, 0x29, 0xfd, 0xf4, 0xa6, 0xf3, 0xb1, 0xe7, 0xf3, 0xa3, 0xe9, 0xd8, 0x74, 0x49, 0x68,
+	0x65, 0x6d, 0xa4, 0x7f, 0x77, 0xd8, 0xe4, 0x5b, 0x8b, 0x3f, 0x8b, 0x31, 0xcb, 0xf7, 0x30, 0xbb,
+	0x58, 0x05, 0xbe, 0x07, 0x6a, 0x38, 0x26, 0xee, 0x11, 0x53, 0x2b, 0xba, 0x64, 0x6c, 0xda, 0x99,
+	0x05, 0x07, 0x00, 0x30, 0x8e, 0x28, 0x77, 0x92, 0x7e, 0x54, 0x59, 0x97, 0x8c, 0xe6, 0x5e, 0xdb,
+	0x4c, 0x9b, 0x35, 0xf3, 0x66, 0xcd, 0xc7, 0x79, 0xb3, 0xfd, 0x46, 0x82, 0xe4, 0xc5, 0x9b, 0x8e,
+	0x64, 0x2b, 0x62, 0x5f, 0xf2, 0x05, 0xbe, 0x0f, 0x14, 0x4e, 0x38, 0x0a, 0x1c, 0x0f, 0x31, 0xb5,
+	0xaa, 0x4b, 0x86, 0x6c, 0x37, 0x84, 0x63, 0x88, 0x92, 0x0a, 0x1b, 0x14, 0x3f, 0x45, 0x74, 0xe2,
+	0xb8, 0x53, 0x3a, 0xc3, 0x6a, 0x4d, 0x97, 0x8c, 0x1b, 0x7b, 0xba, 0x79, 0x01, 0x37, 0xa6, 0x2d,
+	0x02, 0x07, 0x49, 0x9c, 0xdd, 0xa4, 0x4b, 0x03, 0x7e, 0x02, 0x6e, 0x86, 0x68, 0x9e, 0xe4, 0x77,
+	0x62, 0x4c, 0x9d, 0x18, 0x51, 0xee, 0xbb, 0x7e, 0x8c, 0x22, 0xae, 0xd6, 0x45, 0xbd, 0x9d, 0x10,
+	0xcd, 0x87, 0x88, 0x1d, 0x60, 0x7a, 0xb0, 0xfc, 0x06, 0x55, 0x50, 0x67, 0x31, 0x89, 0x18, 0xa1,
+	0x6a, 0x43, 0xb0, 0x90, 0x9b, 0x70, 0x0e, 0x5a, 0xd9, 0x12, 0x4f, 0x9c, 0xb4, 0x12, 0x53, 0x15,
+	0x41, 0xc5, 0xad, 0x0b, 0xa9, 0x10, 0x3c, 0xdc, 0xcd, 0x78, 0x30, 0x2e, 0xc1, 0x43, 0x4a, 0xc2,
+	0xd6, 0xa2, 0x4a, 0xda, 0x1b, 0x83, 0x10, 0xc8, 0x11, 0x0a, 0xb1, 0x0a, 0x04, 0x20, 0xb1, 0x86,
+	0xbb, 0x40, 0xc9, 0x8f, 0x07, 0x53, 0x9b, 0x7a, 0xc5, 0x50, 0xec, 0xa5, 0x23, 0x39, 0x4c, 0x13,
+	0x1c, 0x07, 0xe4, 0x19, 0xa6, 0xea, 0x46, 0x7a, 0x98, 0x72, 0xbb, 0x4b, 0x40, 0x63, 0x88, 0xd8,
+	0xe7, 0x98, 0x63, 0xba, 0xf6, 0xd0, 0xe9, 0xa0, 0x59, 0x1c, 0x5a, 0x59, 0x7c, 0x2e, 0xba, 0xe0,
+	0x87, 0xe0, 0x86, 0x3b, 0x0d, 0xa7, 0x01, 0x4a, 0xd8, 0x10, 0x4c, 0x56, 0xc4, 0x64, 0x37, 0x97,
+	0xde, 0x21, 0x62, 0xdd, 0x63, 0x09, 0xc0, 0xc2, 0x88, 0xf3, 0xae, 0xce, 0xe4, 0x97, 0xce, 0xe7,
+	0xc7, 0xa0, 0x9e, 0xcf, 0xb9, 0x7c, 0xf5, 0x73, 0xce, 0x73, 0x77, 0xff, 0x2a, 0x83, 0x5b, 0x36,
+	0xf6, 0x7c, 0xc6, 0x31, 0x5d, 0xe8, 0xf1, 0x80, 0x92, 0x98, 0x30, 0x14, 0xc0, 0x1d, 0x50, 0xe5,
+	0x3e, 0x0f, 0x70, 0x06, 0x30, 0x35, 0x12, 0xf0, 0x13, 0xcc, 0x5c, 0xea, 0xc7, 0x89, 0x58, 0xf2,
+	0xe1, 0x14, 0x5c, 0x2b, 0xa3, 0xad, 0xac, 0xd7, 0xb3, 0x7c, 0xcd, 0x7a, 0xae, 0x9e, 0xd1, 0xf3,
+	0x5b, 0x53, 0xdb, 0xa7, 0xf2, 0xef, 0x3f, 0x74, 0x4a, 0xdd, 0x9f, 0x2b, 0x60, 0xf7, 0x1c, 0x01,
+	0x23, 0xcc, 0xff, 0x37, 0x07, 0xb9, 0x70, 0x2a, 0xff, 0x24, 0x1c, 0x79, 0x9d, 0x70, 0xaa, 0xab,
+	0xc2, 0x39, 0xcb, 0x5a, 0xed, 0x9a, 0x59, 0xab, 0xaf, 0x65, 0xad, 0x71, 0xc5, 0xac, 0x29, 0xff,
+	0xca, 0x1a, 0x03, 0x37, 0x07, 0x28, 0x72, 0x71, 0x70, 0x2d, 0x9a, 0xc9, 0x8a, 0xfe, 0x29, 0x81,
+	0x56, 0x01, 0xca, 0x13, 0xec, 0x7b, 0x47, 0xfc, 0x12, 0x57, 0xc9, 0xf9, 0xab, 0xaa, 0x7c, 0xc1,
+	0x55, 0x05, 0x47, 0x60, 0x13, 0x1f, 0x1e, 0x62, 0x77, 0xe5, 0x42, 0x53, 0xfa, 0x66, 0x42, 0xe3,
+	0xaf, 0xaf, 0x3b, 0x1f, 0x5d, 0x8e, 0x46, 0x7b, 0x63, 0x91, 0x24, 0x49, 0xfa, 0x19, 0xa8, 0x3d,
+	0x15, 0x38, 0x55, 0xf9, 0x3f, 0x65, 0xcb, 0x76, 0xdf, 0x26, 0xa0, 0x59, 0x60, 0x12, 0x9a, 0x60,
+	0xdb, 0xde, 0x7f, 0x72, 0xdf, 0x7e, 0xe0, 0x0c, 0xbe, 0xb2, 0xbf, 0xde, 0x77, 0x1e, 0x3d, 0xfc,
+	0x62, 0xff, 0xbe, 0xbd, 0x55, 0x6a, 0xbf, 0xfb, 0xfc, 0x58, 0x6f, 0x15, 0x22, 0x1f, 0xf9, 0x11,
+	0x46, 0x14, 0xde, 0x06, 0xad, 0x95, 0xf8, 0xd1, 0x97, 0xf6, 0xe3, 0x2d, 0xa9, 0xbd, 0xfd, 0xfc,
+	0x58, 0x7f, 0xa7, 0x10, 0x3d, 0xfa, 0x8e, 0xf2, 0xb6, 0xfc, 0xfd, 0x8f, 0x5a, 0xa9, 0x3f, 0x7c,
+	0x79, 0xa2, 0x49, 0xaf, 0x4e, 0x34, 0xe9, 0xb7, 0x13, 0x4d, 0x7a, 0x71, 0xaa, 0x95, 0x5e, 0x9d,
+	0x6a, 0xa5, 0x5f, 0x4e, 0xb5, 0xd2, 0x37, 0x77, 0x0a, 0xd0, 0xd3, 0x77, 0x55, 0xfa, 0x3b, 0xeb,
+	0xdd, 0xb5, 0xe6, 0xc5, 0x37, 0x96, 0xe8, 0x62, 0x5c, 0x13, 0xcf, 0x82, 0x7b, 0x7f, 0x0f, 0x00,
+	0xb8, 0x86, 0x70, 0xd2, 0x84, 0x09, 0x00, 0x00,
 }
 
 func (m *Incentive) Marshal() (dAtA []byte, err error) {
@@ -388,6 +773,60 @@ func (m *Incentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Deployer) > 0 {
+		i -= len(m.Deployer)
+		copy(dAtA[i:], m.Deployer)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Deployer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SponsoredRewards) > 0 {
+		for iNdEx := len(m.SponsoredRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsoredRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxGasPerParticipant != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.MaxGasPerParticipant))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RewardCurve != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.RewardCurve))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.TotalGas != 0 {
 		i = encodeVarintIncentives(dAtA, i, uint64(m.TotalGas))
 		i--
@@ -472,7 +911,7 @@ func (m *GasMeter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterIncentiveProposal) Marshal() (dAtA []byte, err error) {
+func (m *ParticipantRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -482,25 +921,20 @@ func (m *RegisterIncentiveProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParticipantRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParticipantRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Epochs != 0 {
-		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Allocations) > 0 {
-		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -508,25 +942,173 @@ func (m *RegisterIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, erro
 				i = encodeVarintIncentives(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Description)))
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Participant)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterIncentiveProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasPerParticipant != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.MaxGasPerParticipant))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RewardCurve != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.RewardCurve))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epochs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterIncentiveSetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterIncentiveSetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterIncentiveSetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasPerParticipant != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.MaxGasPerParticipant))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RewardCurve != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.RewardCurve))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Epochs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Deployer) > 0 {
+		i -= len(m.Deployer)
+		copy(dAtA[i:], m.Deployer)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Deployer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
 		copy(dAtA[i:], m.Title)
 		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Title)))
 		i--
@@ -579,6 +1161,61 @@ func (m *CancelIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ParticipantWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EffectiveGas.Size()
+		i -= size
+		if _, err := m.EffectiveGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CumulativeGas != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.CumulativeGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIncentives(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIncentives(v)
 	base := offset
@@ -614,6 +1251,36 @@ func (m *Incentive) Size() (n int) {
 	if m.TotalGas != 0 {
 		n += 1 + sovIncentives(uint64(m.TotalGas))
 	}
+	if m.RewardCurve != 0 {
+		n += 1 + sovIncentives(uint64(m.RewardCurve))
+	}
+	if m.MaxGasPerParticipant != 0 {
+		n += 1 + sovIncentives(uint64(m.MaxGasPerParticipant))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.SponsoredRewards) > 0 {
+		for _, e := range m.SponsoredRewards {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	l = len(m.Deployer)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
 	return n
 }
 
@@ -637,6 +1304,25 @@ func (m *GasMeter) Size() (n int) {
 	return n
 }
 
+func (m *ParticipantRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RegisterIncentiveProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -664,6 +1350,58 @@ func (m *RegisterIncentiveProposal) Size() (n int) {
 	if m.Epochs != 0 {
 		n += 1 + sovIncentives(uint64(m.Epochs))
 	}
+	if m.RewardCurve != 0 {
+		n += 1 + sovIncentives(uint64(m.RewardCurve))
+	}
+	if m.MaxGasPerParticipant != 0 {
+		n += 1 + sovIncentives(uint64(m.MaxGasPerParticipant))
+	}
+	return n
+}
+
+func (m *RegisterIncentiveSetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	l = len(m.Deployer)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovIncentives(uint64(m.Epochs))
+	}
+	if m.RewardCurve != 0 {
+		n += 1 + sovIncentives(uint64(m.RewardCurve))
+	}
+	if m.MaxGasPerParticipant != 0 {
+		n += 1 + sovIncentives(uint64(m.MaxGasPerParticipant))
+	}
 	return n
 }
 
@@ -688,6 +1426,26 @@ func (m *CancelIncentiveProposal) Size() (n int) {
 	return n
 }
 
+func (m *ParticipantWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if m.CumulativeGas != 0 {
+		n += 1 + sovIncentives(uint64(m.CumulativeGas))
+	}
+	l = m.EffectiveGas.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	return n
+}
+
 func sovIncentives(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -753,13 +1511,918 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, types.DecCoin{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+			}
+			m.TotalGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCurve", wireType)
+			}
+			m.RewardCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardCurve |= RewardCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerParticipant", wireType)
+			}
+			m.MaxGasPerParticipant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerParticipant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsoredRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsoredRewards = append(m.SponsoredRewards, types.Coin{})
+			if err := m.SponsoredRewards[len(m.SponsoredRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deployer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasMeter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasMeter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasMeter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGas", wireType)
+			}
+			m.CumulativeGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CumulativeGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterIncentiveProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterIncentiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, types.DecCoin{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCurve", wireType)
+			}
+			m.RewardCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardCurve |= RewardCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerParticipant", wireType)
+			}
+			m.MaxGasPerParticipant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerParticipant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterIncentiveSetProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterIncentiveSetProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterIncentiveSetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -769,31 +2432,29 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIncentives
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIncentives
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allocations = append(m.Allocations, types.DecCoin{})
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
-			m.Epochs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -803,16 +2464,29 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epochs |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -822,30 +2496,29 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIncentives
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIncentives
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deployer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
-			m.TotalGas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -855,66 +2528,31 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalGas |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIncentives(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIncentives
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GasMeter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIncentives
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Allocations = append(m.Allocations, types.DecCoin{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GasMeter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasMeter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
 			}
-			var stringLen uint64
+			m.Epochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -924,29 +2562,16 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epochs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCurve", wireType)
 			}
-			var stringLen uint64
+			m.RewardCurve = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -956,29 +2581,16 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RewardCurve |= RewardCurve(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerParticipant", wireType)
 			}
-			m.CumulativeGas = 0
+			m.MaxGasPerParticipant = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -988,7 +2600,7 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CumulativeGas |= uint64(b&0x7F) << shift
+				m.MaxGasPerParticipant |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1014,7 +2626,7 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
+func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1037,10 +2649,10 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterIncentiveProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelIncentiveProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterIncentiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelIncentiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1139,59 +2751,6 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIncentives
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Allocations = append(m.Allocations, types.DecCoin{})
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
-			}
-			m.Epochs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIncentives
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epochs |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentives(dAtA[iNdEx:])
@@ -1213,7 +2772,7 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
+func (m *ParticipantWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1236,15 +2795,15 @@ func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelIncentiveProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipantWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelIncentiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipantWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1272,11 +2831,30 @@ func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGas", wireType)
+			}
+			m.CumulativeGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CumulativeGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveGas", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1304,11 +2882,13 @@ func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.EffectiveGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1336,7 +2916,9 @@ func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -14,7 +15,9 @@ import (
 	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
+	erc20types "github.com/evmos/evmos/v10/x/erc20/types"
 	inflationtypes "github.com/evmos/evmos/v10/x/inflation/types"
+	revenuetypes "github.com/evmos/evmos/v10/x/revenue/types"
 )
 
 // AccountKeeper defines the expected interface needed to retrieve account info.
@@ -56,6 +59,20 @@ type InflationKeeper interface {
 type EVMKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
 	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error
+	SetCode(ctx sdk.Context, codeHash, code []byte)
+}
+
+// ERC20Keeper defines the expected ERC20 keeper interface used on incentives
+type ERC20Keeper interface {
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+	ConvertCoin(goCtx context.Context, msg *erc20types.MsgConvertCoin) (*erc20types.MsgConvertCoinResponse, error)
+}
+
+// RevenueKeeper defines the expected revenue keeper interface used on incentives
+type RevenueKeeper interface {
+	GetRevenue(ctx sdk.Context, contract common.Address) (revenuetypes.Revenue, bool)
 }
 
 // Stakekeeper defines the expected staking keeper interface used on incentives
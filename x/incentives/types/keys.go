@@ -29,6 +29,10 @@ const (
 	prefixIncentive = iota + 1
 	prefixGasMeter
 	prefixAllocationMeter
+	prefixParticipantRewards
+	prefixIncentiveSetMember
+	prefixIncentiveSetDeployer
+	prefixUnclaimedRewardsTotal
 )
 
 // KVStore key prefixes
@@ -36,6 +40,11 @@ var (
 	KeyPrefixIncentive       = []byte{prefixIncentive}
 	KeyPrefixGasMeter        = []byte{prefixGasMeter}
 	KeyPrefixAllocationMeter = []byte{prefixAllocationMeter}
+
+	KeyPrefixParticipantRewards    = []byte{prefixParticipantRewards}
+	KeyPrefixIncentiveSetMember    = []byte{prefixIncentiveSetMember}
+	KeyPrefixIncentiveSetDeployer  = []byte{prefixIncentiveSetDeployer}
+	KeyPrefixUnclaimedRewardsTotal = []byte{prefixUnclaimedRewardsTotal}
 )
 
 // SplitGasMeterKey is a helper to split up KV-store keys in a
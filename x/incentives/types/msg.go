@@ -0,0 +1,118 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateSponsoredIncentive{}
+	_ sdk.Msg = &MsgClaimIncentiveRewards{}
+)
+
+const (
+	TypeMsgCreateSponsoredIncentive = "create_sponsored_incentive"
+	TypeMsgClaimIncentiveRewards    = "claim_incentive_rewards"
+)
+
+// NewMsgCreateSponsoredIncentive creates new instance of
+// MsgCreateSponsoredIncentive
+func NewMsgCreateSponsoredIncentive(
+	sponsor sdk.AccAddress,
+	contract common.Address,
+	rewards sdk.Coins,
+	epochs uint32,
+	rewardCurve RewardCurve,
+	maxGasPerParticipant uint64,
+) *MsgCreateSponsoredIncentive {
+	return &MsgCreateSponsoredIncentive{
+		Sponsor:              sponsor.String(),
+		Contract:             contract.String(),
+		Rewards:              rewards,
+		Epochs:               epochs,
+		RewardCurve:          rewardCurve,
+		MaxGasPerParticipant: maxGasPerParticipant,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgCreateSponsoredIncentive) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgCreateSponsoredIncentive) Type() string { return TypeMsgCreateSponsoredIncentive }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateSponsoredIncentive) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sponsor); err != nil {
+		return errorsmod.Wrapf(err, "invalid sponsor address %s", msg.Sponsor)
+	}
+
+	if err := ethermint.ValidateNonZeroAddress(msg.Contract); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.Contract)
+	}
+
+	if !msg.Rewards.IsValid() || msg.Rewards.Empty() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid rewards %s", msg.Rewards)
+	}
+
+	if err := validateEpochs(msg.Epochs); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if err := validateRewardCurve(msg.RewardCurve); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCreateSponsoredIncentive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateSponsoredIncentive) GetSigners() []sdk.AccAddress {
+	sponsor := sdk.MustAccAddressFromBech32(msg.Sponsor)
+	return []sdk.AccAddress{sponsor}
+}
+
+// NewMsgClaimIncentiveRewards creates new instance of MsgClaimIncentiveRewards
+func NewMsgClaimIncentiveRewards(
+	participant sdk.AccAddress,
+	asERC20 bool,
+) *MsgClaimIncentiveRewards {
+	return &MsgClaimIncentiveRewards{
+		Participant: participant.String(),
+		AsERC20:     asERC20,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgClaimIncentiveRewards) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgClaimIncentiveRewards) Type() string { return TypeMsgClaimIncentiveRewards }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClaimIncentiveRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Participant); err != nil {
+		return errorsmod.Wrapf(err, "invalid participant address %s", msg.Participant)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgClaimIncentiveRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClaimIncentiveRewards) GetSigners() []sdk.AccAddress {
+	participant := sdk.MustAccAddressFromBech32(msg.Participant)
+	return []sdk.AccAddress{participant}
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateSponsoredIncentiveGetters() {
+	sponsor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := NewMsgCreateSponsoredIncentive(
+		sponsor,
+		tests.GenerateAddress(),
+		sdk.NewCoins(sdk.NewInt64Coin("acoin", 100)),
+		10,
+		RewardCurveLinear,
+		0,
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgCreateSponsoredIncentive, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{sponsor}, msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgCreateSponsoredIncentive() {
+	sponsor := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	contract := tests.GenerateAddress().String()
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("acoin", 100))
+
+	testCases := []struct {
+		msg        MsgCreateSponsoredIncentive
+		expectPass bool
+	}{
+		{
+			MsgCreateSponsoredIncentive{"invalid", contract, rewards, 10, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			MsgCreateSponsoredIncentive{sponsor, "0x", rewards, 10, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			MsgCreateSponsoredIncentive{sponsor, contract, sdk.Coins{}, 10, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			MsgCreateSponsoredIncentive{sponsor, contract, sdk.Coins{{Denom: "acoin", Amount: sdk.NewInt(-1)}}, 10, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			MsgCreateSponsoredIncentive{sponsor, contract, rewards, 0, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			MsgCreateSponsoredIncentive{sponsor, contract, rewards, 10, RewardCurve(5), 0},
+			false,
+		},
+		{
+			MsgCreateSponsoredIncentive{sponsor, contract, rewards, 10, RewardCurveSqrt, 1000},
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgClaimIncentiveRewardsGetters() {
+	participant := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := NewMsgClaimIncentiveRewards(participant, true)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgClaimIncentiveRewards, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{participant}, msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgClaimIncentiveRewards() {
+	participant := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	testCases := []struct {
+		msg        MsgClaimIncentiveRewards
+		expectPass bool
+	}{
+		{
+			MsgClaimIncentiveRewards{"invalid", false},
+			false,
+		},
+		{
+			MsgClaimIncentiveRewards{participant, false},
+			true,
+		},
+		{
+			MsgClaimIncentiveRewards{participant, true},
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ethermint "github.com/evmos/ethermint/types"
 
 	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
 )
@@ -17,6 +18,20 @@ var (
 	ParamStoreKeyAllocationLimit  = []byte("AllocationLimit")
 	ParamStoreKeyEpochIdentifier  = []byte("EpochIdentifier")
 	ParamStoreKeyRewardScaler     = []byte("RewardScaler")
+
+	ParamStoreKeyEnableClaimableRewards     = []byte("EnableClaimableRewards")
+	ParamStoreKeyEnableInternalCallMetering = []byte("EnableInternalCallMetering")
+	ParamStoreKeyMinSponsoredEpochReward    = []byte("MinSponsoredEpochReward")
+	ParamStoreKeyMaxSponsoredEpochs         = []byte("MaxSponsoredEpochs")
+)
+
+var (
+	// DefaultMinSponsoredEpochReward requires sponsors to distribute at least 100
+	// EVMOS per epoch, which makes occupying the incentive of a contract costly
+	DefaultMinSponsoredEpochReward = sdk.NewInt(100).Mul(ethermint.PowerReduction)
+	// DefaultMaxSponsoredEpochs limits sponsored incentives to one year of weekly
+	// epochs
+	DefaultMaxSponsoredEpochs = uint32(52)
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -31,21 +46,33 @@ func NewParams(
 	allocationLimit sdk.Dec,
 	epochIdentifier string,
 	rewardScaler sdk.Dec,
+	enableClaimableRewards bool,
+	enableInternalCallMetering bool,
+	minSponsoredEpochReward sdk.Int,
+	maxSponsoredEpochs uint32,
 ) Params {
 	return Params{
-		EnableIncentives:          enableIncentives,
-		AllocationLimit:           allocationLimit,
-		IncentivesEpochIdentifier: epochIdentifier,
-		RewardScaler:              rewardScaler,
+		EnableIncentives:           enableIncentives,
+		AllocationLimit:            allocationLimit,
+		IncentivesEpochIdentifier:  epochIdentifier,
+		RewardScaler:               rewardScaler,
+		EnableClaimableRewards:     enableClaimableRewards,
+		EnableInternalCallMetering: enableInternalCallMetering,
+		MinSponsoredEpochReward:    minSponsoredEpochReward,
+		MaxSponsoredEpochs:         maxSponsoredEpochs,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableIncentives:          true,
-		AllocationLimit:           sdk.NewDecWithPrec(5, 2),
-		IncentivesEpochIdentifier: epochstypes.WeekEpochID,
-		RewardScaler:              sdk.NewDecWithPrec(12, 1),
+		EnableIncentives:           true,
+		AllocationLimit:            sdk.NewDecWithPrec(5, 2),
+		IncentivesEpochIdentifier:  epochstypes.WeekEpochID,
+		RewardScaler:               sdk.NewDecWithPrec(12, 1),
+		EnableClaimableRewards:     false,
+		EnableInternalCallMetering: false,
+		MinSponsoredEpochReward:    DefaultMinSponsoredEpochReward,
+		MaxSponsoredEpochs:         DefaultMaxSponsoredEpochs,
 	}
 }
 
@@ -56,6 +83,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyAllocationLimit, &p.AllocationLimit, validatePercentage),
 		paramtypes.NewParamSetPair(ParamStoreKeyEpochIdentifier, &p.IncentivesEpochIdentifier, epochstypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(ParamStoreKeyRewardScaler, &p.RewardScaler, validateUncappedPercentage),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableClaimableRewards, &p.EnableClaimableRewards, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableInternalCallMetering, &p.EnableInternalCallMetering, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinSponsoredEpochReward, &p.MinSponsoredEpochReward, validateMinSponsoredEpochReward),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxSponsoredEpochs, &p.MaxSponsoredEpochs, validateUint32),
 	}
 }
 
@@ -68,6 +99,30 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateUint32(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMinSponsoredEpochReward(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return errors.New("min sponsored epoch reward cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min sponsored epoch reward cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
 func validatePercentage(i interface{}) error {
 	dec, ok := i.(sdk.Dec)
 	if !ok {
@@ -114,5 +169,21 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBool(p.EnableClaimableRewards); err != nil {
+		return err
+	}
+
+	if err := validateBool(p.EnableInternalCallMetering); err != nil {
+		return err
+	}
+
+	if err := validateMinSponsoredEpochReward(p.MinSponsoredEpochReward); err != nil {
+		return err
+	}
+
+	if err := validateUint32(p.MaxSponsoredEpochs); err != nil {
+		return err
+	}
+
 	return epochstypes.ValidateEpochIdentifierString(p.IncentivesEpochIdentifier)
 }
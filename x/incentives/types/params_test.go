@@ -43,6 +43,10 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				sdk.NewDecWithPrec(5, 2),
 				epochstypes.WeekEpochID,
 				sdk.NewDecWithPrec(15, 1),
+				false,
+				false,
+				DefaultMinSponsoredEpochReward,
+				DefaultMaxSponsoredEpochs,
 			),
 			false,
 		},
@@ -54,6 +58,10 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				sdk.NewDecWithPrec(100, 2),
 				epochstypes.WeekEpochID,
 				sdk.NewDecWithPrec(15, 1),
+				false,
+				false,
+				DefaultMinSponsoredEpochReward,
+				DefaultMaxSponsoredEpochs,
 			),
 			false,
 		},
@@ -65,9 +73,73 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				sdk.NewDecWithPrec(100, 2),
 				epochstypes.WeekEpochID,
 				sdk.NewDecWithPrec(10, 0),
+				false,
+				false,
+				DefaultMinSponsoredEpochReward,
+				DefaultMaxSponsoredEpochs,
 			),
 			false,
 		},
+		{
+			"valid - claimable rewards enabled",
+			NewParams(
+				true,
+				govtypes.DefaultPeriod,
+				sdk.NewDecWithPrec(5, 2),
+				epochstypes.WeekEpochID,
+				sdk.NewDecWithPrec(15, 1),
+				true,
+				false,
+				DefaultMinSponsoredEpochReward,
+				DefaultMaxSponsoredEpochs,
+			),
+			false,
+		},
+		{
+			"valid - internal call metering enabled",
+			NewParams(
+				true,
+				govtypes.DefaultPeriod,
+				sdk.NewDecWithPrec(5, 2),
+				epochstypes.WeekEpochID,
+				sdk.NewDecWithPrec(15, 1),
+				false,
+				true,
+				DefaultMinSponsoredEpochReward,
+				DefaultMaxSponsoredEpochs,
+			),
+			false,
+		},
+		{
+			"valid - no sponsored incentive limits",
+			NewParams(
+				true,
+				govtypes.DefaultPeriod,
+				sdk.NewDecWithPrec(5, 2),
+				epochstypes.WeekEpochID,
+				sdk.NewDecWithPrec(15, 1),
+				false,
+				false,
+				sdk.ZeroInt(),
+				0,
+			),
+			false,
+		},
+		{
+			"invalid - negative min sponsored epoch reward",
+			NewParams(
+				true,
+				govtypes.DefaultPeriod,
+				sdk.NewDecWithPrec(5, 2),
+				epochstypes.WeekEpochID,
+				sdk.NewDecWithPrec(15, 1),
+				false,
+				false,
+				sdk.NewInt(-1),
+				DefaultMaxSponsoredEpochs,
+			),
+			true,
+		},
 		{
 			"invalid - empty Params",
 			Params{},
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewParticipantRewards returns an instance of ParticipantRewards
+func NewParticipantRewards(
+	participant common.Address,
+	rewards sdk.Coins,
+) ParticipantRewards {
+	return ParticipantRewards{
+		Participant: participant.String(),
+		Rewards:     rewards,
+	}
+}
+
+// Validate performs a stateless validation of a ParticipantRewards
+func (pr ParticipantRewards) Validate() error {
+	if err := ethermint.ValidateAddress(pr.Participant); err != nil {
+		return err
+	}
+
+	return pr.Rewards.Validate()
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	fmt "fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -11,20 +12,28 @@ import (
 
 // constants
 const (
-	ProposalTypeRegisterIncentive string = "RegisterIncentive"
-	ProposalTypeCancelIncentive   string = "CancelIncentive"
+	ProposalTypeRegisterIncentive    string = "RegisterIncentive"
+	ProposalTypeRegisterIncentiveSet string = "RegisterIncentiveSet"
+	ProposalTypeCancelIncentive      string = "CancelIncentive"
 )
 
+// MaxIncentiveSetNameLength is the maximum length of the name of an incentive
+// set
+const MaxIncentiveSetNameLength = 64
+
 // Implements Proposal Interface
 var (
 	_ govv1beta1.Content = &RegisterIncentiveProposal{}
+	_ govv1beta1.Content = &RegisterIncentiveSetProposal{}
 	_ govv1beta1.Content = &CancelIncentiveProposal{}
 )
 
 func init() {
 	govv1beta1.RegisterProposalType(ProposalTypeRegisterIncentive)
+	govv1beta1.RegisterProposalType(ProposalTypeRegisterIncentiveSet)
 	govv1beta1.RegisterProposalType(ProposalTypeCancelIncentive)
 	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&RegisterIncentiveProposal{}, "incentives/RegisterIncentiveProposal", nil)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&RegisterIncentiveSetProposal{}, "incentives/RegisterIncentiveSetProposal", nil)
 	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&CancelIncentiveProposal{}, "incentives/CancelIncentiveProposal", nil)
 }
 
@@ -33,13 +42,17 @@ func NewRegisterIncentiveProposal(
 	title, description, contract string,
 	allocations sdk.DecCoins,
 	epochs uint32,
+	rewardCurve RewardCurve,
+	maxGasPerParticipant uint64,
 ) govv1beta1.Content {
 	return &RegisterIncentiveProposal{
-		Title:       title,
-		Description: description,
-		Contract:    contract,
-		Allocations: allocations,
-		Epochs:      epochs,
+		Title:                title,
+		Description:          description,
+		Contract:             contract,
+		Allocations:          allocations,
+		Epochs:               epochs,
+		RewardCurve:          rewardCurve,
+		MaxGasPerParticipant: maxGasPerParticipant,
 	}
 }
 
@@ -65,9 +78,106 @@ func (rip *RegisterIncentiveProposal) ValidateBasic() error {
 		return err
 	}
 
+	if err := validateRewardCurve(rip.RewardCurve); err != nil {
+		return err
+	}
+
 	return govv1beta1.ValidateAbstract(rip)
 }
 
+// NewRegisterIncentiveSetProposal returns new instance of
+// RegisterIncentiveSetProposal
+func NewRegisterIncentiveSetProposal(
+	title, description, name string,
+	contracts []string,
+	deployer string,
+	allocations sdk.DecCoins,
+	epochs uint32,
+	rewardCurve RewardCurve,
+	maxGasPerParticipant uint64,
+) govv1beta1.Content {
+	return &RegisterIncentiveSetProposal{
+		Title:                title,
+		Description:          description,
+		Name:                 name,
+		Contracts:            contracts,
+		Deployer:             deployer,
+		Allocations:          allocations,
+		Epochs:               epochs,
+		RewardCurve:          rewardCurve,
+		MaxGasPerParticipant: maxGasPerParticipant,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterIncentiveSetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterIncentiveSetProposal) ProposalType() string {
+	return ProposalTypeRegisterIncentiveSet
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (risp *RegisterIncentiveSetProposal) ValidateBasic() error {
+	if err := validateSetMembers(risp.Name, risp.Contracts, risp.Deployer); err != nil {
+		return err
+	}
+
+	if err := validateAllocations(risp.Allocations); err != nil {
+		return err
+	}
+
+	if err := validateEpochs(risp.Epochs); err != nil {
+		return err
+	}
+
+	if err := validateRewardCurve(risp.RewardCurve); err != nil {
+		return err
+	}
+
+	return govv1beta1.ValidateAbstract(risp)
+}
+
+// validateSetMembers checks that an incentive set has
+// - a non-blank name within the length limit
+// - at least one member contract or a deployer
+// - valid and unique member contract addresses
+// - a valid deployer address, if any
+func validateSetMembers(name string, contracts []string, deployer string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("incentive set name cannot be blank")
+	}
+
+	if len(name) > MaxIncentiveSetNameLength {
+		return fmt.Errorf("incentive set name is longer than %d characters: %s", MaxIncentiveSetNameLength, name)
+	}
+
+	if len(contracts) == 0 && deployer == "" {
+		return fmt.Errorf("incentive set %s must define member contracts or a deployer", name)
+	}
+
+	seen := make(map[string]bool)
+	for _, contract := range contracts {
+		if err := ethermint.ValidateNonZeroAddress(contract); err != nil {
+			return err
+		}
+
+		key := strings.ToLower(contract)
+		if seen[key] {
+			return fmt.Errorf("duplicated contract %s in incentive set %s", contract, name)
+		}
+		seen[key] = true
+	}
+
+	if deployer != "" {
+		if _, err := sdk.AccAddressFromBech32(deployer); err != nil {
+			return fmt.Errorf("invalid deployer address %s: %w", deployer, err)
+		}
+	}
+
+	return nil
+}
+
 // validateAllocations checks if each allocation has
 // - a valid denom
 // - a valid amount representing the percentage of allocation
@@ -99,6 +209,13 @@ func validateEpochs(epochs uint32) error {
 	return nil
 }
 
+func validateRewardCurve(curve RewardCurve) error {
+	if _, ok := RewardCurve_name[int32(curve)]; !ok {
+		return fmt.Errorf("invalid reward curve: %d", curve)
+	}
+	return nil
+}
+
 // NewCancelIncentiveProposal returns new instance of RegisterIncentiveProposal
 func NewCancelIncentiveProposal(
 	title, description, contract string,
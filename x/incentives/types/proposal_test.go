@@ -21,6 +21,8 @@ func TestProposalTestSuite(t *testing.T) {
 func (suite *ProposalTestSuite) TestKeysTypes() {
 	suite.Require().Equal("incentives", (&RegisterIncentiveProposal{}).ProposalRoute())
 	suite.Require().Equal("RegisterIncentive", (&RegisterIncentiveProposal{}).ProposalType())
+	suite.Require().Equal("incentives", (&RegisterIncentiveSetProposal{}).ProposalRoute())
+	suite.Require().Equal("RegisterIncentiveSet", (&RegisterIncentiveSetProposal{}).ProposalType())
 	suite.Require().Equal("incentives", (&CancelIncentiveProposal{}).ProposalRoute())
 	suite.Require().Equal("CancelIncentive", (&CancelIncentiveProposal{}).ProposalType())
 }
@@ -43,6 +45,13 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			true,
 		},
@@ -56,6 +65,13 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -69,6 +85,13 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -82,6 +105,13 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -95,6 +125,13 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -108,6 +145,13 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -121,6 +165,13 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -134,6 +185,13 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -147,6 +205,53 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
+			},
+			false,
+		},
+		{
+			"Register incentive - valid sqrt reward curve with participant cap",
+			"test",
+			"test desc",
+			Incentive{
+				tests.GenerateAddress().String(),
+				sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))},
+				10,
+				time.Now(),
+				0,
+				RewardCurveSqrt,
+				100000,
+				"",
+				nil,
+				"",
+				nil,
+				"",
+			},
+			true,
+		},
+		{
+			"Register incentive - invalid reward curve",
+			"test",
+			"test desc",
+			Incentive{
+				tests.GenerateAddress().String(),
+				sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))},
+				10,
+				time.Now(),
+				0,
+				RewardCurve(5),
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -160,6 +265,13 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				0,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -173,6 +285,13 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -184,6 +303,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 			tc.incentive.Contract,
 			tc.incentive.Allocations,
 			tc.incentive.Epochs,
+			tc.incentive.RewardCurve,
+			tc.incentive.MaxGasPerParticipant,
 		)
 		err := tx.ValidateBasic()
 
@@ -195,6 +316,78 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 	}
 }
 
+func (suite *ProposalTestSuite) TestRegisterIncentiveSetProposal() {
+	contract := tests.GenerateAddress().String()
+	deployer := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	allocations := sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))}
+
+	testCases := []struct {
+		name       string
+		proposal   *RegisterIncentiveSetProposal
+		expectPass bool
+	}{
+		{
+			"Register incentive set - valid contracts",
+			&RegisterIncentiveSetProposal{"test", "test desc", "pools", []string{contract}, "", allocations, 10, RewardCurveLinear, 0},
+			true,
+		},
+		{
+			"Register incentive set - valid deployer",
+			&RegisterIncentiveSetProposal{"test", "test desc", "pools", nil, deployer, allocations, 10, RewardCurveSqrt, 1000},
+			true,
+		},
+		{
+			"Register incentive set - blank name",
+			&RegisterIncentiveSetProposal{"test", "test desc", " ", []string{contract}, "", allocations, 10, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			"Register incentive set - no members",
+			&RegisterIncentiveSetProposal{"test", "test desc", "pools", nil, "", allocations, 10, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			"Register incentive set - invalid contract",
+			&RegisterIncentiveSetProposal{"test", "test desc", "pools", []string{"0x"}, "", allocations, 10, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			"Register incentive set - duplicated contract",
+			&RegisterIncentiveSetProposal{"test", "test desc", "pools", []string{contract, contract}, "", allocations, 10, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			"Register incentive set - invalid deployer",
+			&RegisterIncentiveSetProposal{"test", "test desc", "pools", nil, "evmos1invalid", allocations, 10, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			"Register incentive set - empty allocations",
+			&RegisterIncentiveSetProposal{"test", "test desc", "pools", []string{contract}, "", sdk.DecCoins{}, 10, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			"Register incentive set - zero epochs",
+			&RegisterIncentiveSetProposal{"test", "test desc", "pools", []string{contract}, "", allocations, 0, RewardCurveLinear, 0},
+			false,
+		},
+		{
+			"Register incentive set - missing title",
+			&RegisterIncentiveSetProposal{"", "test desc", "pools", []string{contract}, "", allocations, 10, RewardCurveLinear, 0},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.name)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.name)
+		}
+	}
+}
+
 func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 	testCases := []struct {
 		name        string
@@ -213,6 +406,13 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				5,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			true,
 		},
@@ -226,6 +426,13 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -239,6 +446,13 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -252,6 +466,13 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -265,6 +486,13 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
@@ -278,6 +506,13 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				RewardCurveLinear,
+				0,
+				"",
+				nil,
+				"",
+				nil,
+				"",
 			},
 			false,
 		},
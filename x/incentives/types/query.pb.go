@@ -442,6 +442,386 @@ func (m *QueryGasMeterResponse) GetGasMeter() uint64 {
 	return 0
 }
 
+// QueryParticipantWeightsRequest is the request type for the
+// Query/ParticipantWeights RPC method.
+type QueryParticipantWeightsRequest struct {
+	// contract is the hex contract address of a incentivized smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParticipantWeightsRequest) Reset()         { *m = QueryParticipantWeightsRequest{} }
+func (m *QueryParticipantWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantWeightsRequest) ProtoMessage()    {}
+func (*QueryParticipantWeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{8}
+}
+func (m *QueryParticipantWeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantWeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipantWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantWeightsRequest.Merge(m, src)
+}
+func (m *QueryParticipantWeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantWeightsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipantWeightsRequest proto.InternalMessageInfo
+
+func (m *QueryParticipantWeightsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryParticipantWeightsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParticipantWeightsResponse is the response type for the
+// Query/ParticipantWeights RPC method.
+type QueryParticipantWeightsResponse struct {
+	// participant_weights is a slice of the reward weights of the participants
+	// of an incentivized smart contract
+	ParticipantWeights []ParticipantWeight `protobuf:"bytes,1,rep,name=participant_weights,json=participantWeights,proto3" json:"participant_weights"`
+	// total_effective_gas is the sum of the effective gas of all participants
+	TotalEffectiveGas github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=total_effective_gas,json=totalEffectiveGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_effective_gas"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParticipantWeightsResponse) Reset()         { *m = QueryParticipantWeightsResponse{} }
+func (m *QueryParticipantWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantWeightsResponse) ProtoMessage()    {}
+func (*QueryParticipantWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{9}
+}
+func (m *QueryParticipantWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipantWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantWeightsResponse.Merge(m, src)
+}
+func (m *QueryParticipantWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantWeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipantWeightsResponse proto.InternalMessageInfo
+
+func (m *QueryParticipantWeightsResponse) GetParticipantWeights() []ParticipantWeight {
+	if m != nil {
+		return m.ParticipantWeights
+	}
+	return nil
+}
+
+func (m *QueryParticipantWeightsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParticipantRewardsRequest is the request type for the
+// Query/ParticipantRewards RPC method.
+type QueryParticipantRewardsRequest struct {
+	// participant is the hex address of a user
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QueryParticipantRewardsRequest) Reset()         { *m = QueryParticipantRewardsRequest{} }
+func (m *QueryParticipantRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantRewardsRequest) ProtoMessage()    {}
+func (*QueryParticipantRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{10}
+}
+func (m *QueryParticipantRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipantRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantRewardsRequest.Merge(m, src)
+}
+func (m *QueryParticipantRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipantRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryParticipantRewardsRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// QueryParticipantRewardsResponse is the response type for the
+// Query/ParticipantRewards RPC method.
+type QueryParticipantRewardsResponse struct {
+	// rewards are the unclaimed rewards of the participant
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *QueryParticipantRewardsResponse) Reset()         { *m = QueryParticipantRewardsResponse{} }
+func (m *QueryParticipantRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantRewardsResponse) ProtoMessage()    {}
+func (*QueryParticipantRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{11}
+}
+func (m *QueryParticipantRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipantRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantRewardsResponse.Merge(m, src)
+}
+func (m *QueryParticipantRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipantRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryParticipantRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// QuerySimulateIncentiveRequest is the request type for the
+// Query/SimulateIncentive RPC method.
+type QuerySimulateIncentiveRequest struct {
+	// contract is the hex address of the smart contract to be incentivized
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// allocations defines the denoms and percentage of rewards to be allocated
+	Allocations github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=allocations,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"allocations"`
+	// epochs is the number of epochs of the incentive
+	Epochs uint32 `protobuf:"varint,3,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	// total_gas is the expected gas spent on the contract during one epoch. If
+	// zero, the gas of the current gas meters of the contract is used.
+	TotalGas uint64 `protobuf:"varint,4,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+}
+
+func (m *QuerySimulateIncentiveRequest) Reset()         { *m = QuerySimulateIncentiveRequest{} }
+func (m *QuerySimulateIncentiveRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateIncentiveRequest) ProtoMessage()    {}
+func (*QuerySimulateIncentiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{12}
+}
+func (m *QuerySimulateIncentiveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateIncentiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateIncentiveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateIncentiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateIncentiveRequest.Merge(m, src)
+}
+func (m *QuerySimulateIncentiveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateIncentiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateIncentiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateIncentiveRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateIncentiveRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QuerySimulateIncentiveRequest) GetAllocations() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func (m *QuerySimulateIncentiveRequest) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+func (m *QuerySimulateIncentiveRequest) GetTotalGas() uint64 {
+	if m != nil {
+		return m.TotalGas
+	}
+	return 0
+}
+
+// QuerySimulateIncentiveResponse is the response type for the
+// Query/SimulateIncentive RPC method.
+type QuerySimulateIncentiveResponse struct {
+	// rewards are the projected rewards of the incentive for one epoch, based on
+	// the current balance of the inflation pool
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// total_rewards are the projected rewards over all the epochs of the incentive
+	TotalRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_rewards,json=totalRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_rewards"`
+	// total_gas is the gas spent on the contract used for the projection
+	TotalGas uint64 `protobuf:"varint,3,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	// reward_per_gas is the projected reward per unit of gas spent on the contract
+	// during one epoch, before the reward scaler cap is applied
+	RewardPerGas github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=reward_per_gas,json=rewardPerGas,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_gas"`
+	// allocation_meters are the allocation meters of the allocation denoms after
+	// the incentive is registered
+	AllocationMeters github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=allocation_meters,json=allocationMeters,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"allocation_meters"`
+	// allocation_headroom is the allocation capacity left for each allocation
+	// denom after the incentive is registered
+	AllocationHeadroom github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=allocation_headroom,json=allocationHeadroom,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"allocation_headroom"`
+}
+
+func (m *QuerySimulateIncentiveResponse) Reset()         { *m = QuerySimulateIncentiveResponse{} }
+func (m *QuerySimulateIncentiveResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateIncentiveResponse) ProtoMessage()    {}
+func (*QuerySimulateIncentiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{13}
+}
+func (m *QuerySimulateIncentiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateIncentiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateIncentiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateIncentiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateIncentiveResponse.Merge(m, src)
+}
+func (m *QuerySimulateIncentiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateIncentiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateIncentiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateIncentiveResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateIncentiveResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QuerySimulateIncentiveResponse) GetTotalRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalRewards
+	}
+	return nil
+}
+
+func (m *QuerySimulateIncentiveResponse) GetTotalGas() uint64 {
+	if m != nil {
+		return m.TotalGas
+	}
+	return 0
+}
+
+func (m *QuerySimulateIncentiveResponse) GetRewardPerGas() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerGas
+	}
+	return nil
+}
+
+func (m *QuerySimulateIncentiveResponse) GetAllocationMeters() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AllocationMeters
+	}
+	return nil
+}
+
+func (m *QuerySimulateIncentiveResponse) GetAllocationHeadroom() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AllocationHeadroom
+	}
+	return nil
+}
+
 // QueryAllocationMetersRequest is the request type for the
 // Query/AllocationMeters RPC method.
 type QueryAllocationMetersRequest struct {
@@ -453,7 +833,7 @@ func (m *QueryAllocationMetersRequest) Reset()         { *m = QueryAllocationMet
 func (m *QueryAllocationMetersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllocationMetersRequest) ProtoMessage()    {}
 func (*QueryAllocationMetersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{8}
+	return fileDescriptor_ee5d2766935e7631, []int{14}
 }
 func (m *QueryAllocationMetersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +882,7 @@ func (m *QueryAllocationMetersResponse) Reset()         { *m = QueryAllocationMe
 func (m *QueryAllocationMetersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllocationMetersResponse) ProtoMessage()    {}
 func (*QueryAllocationMetersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{9}
+	return fileDescriptor_ee5d2766935e7631, []int{15}
 }
 func (m *QueryAllocationMetersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +936,7 @@ func (m *QueryAllocationMeterRequest) Reset()         { *m = QueryAllocationMete
 func (m *QueryAllocationMeterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllocationMeterRequest) ProtoMessage()    {}
 func (*QueryAllocationMeterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{10}
+	return fileDescriptor_ee5d2766935e7631, []int{16}
 }
 func (m *QueryAllocationMeterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,7 +983,7 @@ func (m *QueryAllocationMeterResponse) Reset()         { *m = QueryAllocationMet
 func (m *QueryAllocationMeterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllocationMeterResponse) ProtoMessage()    {}
 func (*QueryAllocationMeterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{11}
+	return fileDescriptor_ee5d2766935e7631, []int{17}
 }
 func (m *QueryAllocationMeterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,7 +1027,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{12}
+	return fileDescriptor_ee5d2766935e7631, []int{18}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +1067,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{13}
+	return fileDescriptor_ee5d2766935e7631, []int{19}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,6 +1112,12 @@ func init() {
 	proto.RegisterType((*QueryGasMetersResponse)(nil), "evmos.incentives.v1.QueryGasMetersResponse")
 	proto.RegisterType((*QueryGasMeterRequest)(nil), "evmos.incentives.v1.QueryGasMeterRequest")
 	proto.RegisterType((*QueryGasMeterResponse)(nil), "evmos.incentives.v1.QueryGasMeterResponse")
+	proto.RegisterType((*QueryParticipantWeightsRequest)(nil), "evmos.incentives.v1.QueryParticipantWeightsRequest")
+	proto.RegisterType((*QueryParticipantWeightsResponse)(nil), "evmos.incentives.v1.QueryParticipantWeightsResponse")
+	proto.RegisterType((*QueryParticipantRewardsRequest)(nil), "evmos.incentives.v1.QueryParticipantRewardsRequest")
+	proto.RegisterType((*QueryParticipantRewardsResponse)(nil), "evmos.incentives.v1.QueryParticipantRewardsResponse")
+	proto.RegisterType((*QuerySimulateIncentiveRequest)(nil), "evmos.incentives.v1.QuerySimulateIncentiveRequest")
+	proto.RegisterType((*QuerySimulateIncentiveResponse)(nil), "evmos.incentives.v1.QuerySimulateIncentiveResponse")
 	proto.RegisterType((*QueryAllocationMetersRequest)(nil), "evmos.incentives.v1.QueryAllocationMetersRequest")
 	proto.RegisterType((*QueryAllocationMetersResponse)(nil), "evmos.incentives.v1.QueryAllocationMetersResponse")
 	proto.RegisterType((*QueryAllocationMeterRequest)(nil), "evmos.incentives.v1.QueryAllocationMeterRequest")
@@ -743,60 +1129,83 @@ func init() {
 func init() { proto.RegisterFile("evmos/incentives/v1/query.proto", fileDescriptor_ee5d2766935e7631) }
 
 var fileDescriptor_ee5d2766935e7631 = []byte{
-	// 836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0x3b, 0xfc, 0x80, 0xb4, 0xc3, 0x01, 0x7e, 0x43, 0x55, 0xd2, 0xc2, 0x16, 0x56, 0x03,
-	0xb5, 0xc0, 0x2e, 0x2d, 0xc6, 0xa8, 0x27, 0xad, 0x44, 0xe2, 0xc1, 0x04, 0x1b, 0x4f, 0xc6, 0x44,
-	0xa7, 0xcb, 0xb8, 0x6e, 0xa4, 0x3b, 0x4b, 0x67, 0x69, 0x24, 0x15, 0x63, 0x7c, 0x05, 0x24, 0x5e,
-	0x3c, 0x78, 0x33, 0x26, 0xea, 0xc1, 0xd7, 0xc1, 0x91, 0xc4, 0xc4, 0x78, 0x52, 0x03, 0x1e, 0x7c,
-	0x19, 0xa6, 0xb3, 0x33, 0xdb, 0xdd, 0x65, 0x4b, 0x17, 0x83, 0x17, 0x68, 0x67, 0x9f, 0x3f, 0x9f,
-	0xef, 0xf3, 0x30, 0xdf, 0x05, 0x16, 0x48, 0xab, 0x41, 0x99, 0x6e, 0xd9, 0x06, 0xb1, 0x5d, 0xab,
-	0x45, 0x98, 0xde, 0x2a, 0xeb, 0x9b, 0x5b, 0xa4, 0xb9, 0xad, 0x39, 0x4d, 0xea, 0x52, 0x34, 0xce,
-	0x03, 0xb4, 0x6e, 0x80, 0xd6, 0x2a, 0xe7, 0x4a, 0x06, 0x65, 0x9d, 0xb4, 0x3a, 0x66, 0xc4, 0x8b,
-	0xd6, 0x5b, 0xe5, 0x3a, 0x71, 0x71, 0x59, 0x77, 0xb0, 0x69, 0xd9, 0xd8, 0xb5, 0xa8, 0xed, 0x15,
-	0xc8, 0x29, 0xc1, 0x58, 0x19, 0x65, 0x50, 0x4b, 0x3e, 0x9f, 0x89, 0x23, 0x30, 0x89, 0x4d, 0x98,
-	0xc5, 0x44, 0xc8, 0x85, 0xb8, 0x90, 0x00, 0x91, 0x17, 0x95, 0x35, 0xa9, 0x49, 0xf9, 0x47, 0xbd,
-	0xf3, 0x49, 0x9c, 0x4e, 0x9a, 0x94, 0x9a, 0x1b, 0x44, 0xc7, 0x8e, 0xa5, 0x63, 0xdb, 0xa6, 0x2e,
-	0x67, 0x13, 0x39, 0xea, 0x23, 0x78, 0xf6, 0x6e, 0x07, 0xff, 0xb6, 0x5f, 0xac, 0x46, 0x36, 0xb7,
-	0x08, 0x73, 0xd1, 0x2d, 0x08, 0xbb, 0x52, 0x26, 0xc0, 0x34, 0x28, 0x8e, 0x54, 0x66, 0x35, 0x4f,
-	0x8b, 0xd6, 0xd1, 0xa2, 0x79, 0x53, 0x12, 0x8a, 0xb4, 0x35, 0x6c, 0x12, 0x91, 0x5b, 0x0b, 0x64,
-	0xaa, 0x1f, 0x00, 0x3c, 0x77, 0xa4, 0x05, 0x73, 0xa8, 0xcd, 0x08, 0x5a, 0x81, 0xb0, 0xab, 0x62,
-	0x02, 0x4c, 0xff, 0x57, 0x1c, 0xa9, 0x28, 0x5a, 0xcc, 0xc0, 0x35, 0x3f, 0xb9, 0x3a, 0xb8, 0xf7,
-	0xbd, 0x90, 0xaa, 0x05, 0xf2, 0xd0, 0x6a, 0x88, 0x74, 0x80, 0x93, 0xce, 0xf5, 0x25, 0xf5, 0x10,
-	0x42, 0xa8, 0xcb, 0xf0, 0x4c, 0x98, 0x54, 0xce, 0x22, 0x07, 0xd3, 0x06, 0xb5, 0xdd, 0x26, 0x36,
-	0x5c, 0x3e, 0x89, 0x4c, 0xcd, 0xff, 0xae, 0x3e, 0x88, 0x4e, 0xd0, 0x57, 0x57, 0x85, 0x19, 0x9f,
-	0x52, 0x0c, 0x30, 0x99, 0xb8, 0x6e, 0x9a, 0xda, 0x16, 0x48, 0xab, 0x98, 0xdd, 0x21, 0x2e, 0x69,
-	0xb2, 0x04, 0x48, 0x91, 0xd5, 0x0d, 0xfc, 0xf5, 0xea, 0xde, 0x03, 0xa1, 0x2d, 0xd0, 0xdd, 0xd7,
-	0x06, 0x4d, 0xcc, 0x1e, 0x36, 0xf8, 0xa9, 0xd8, 0xdc, 0x54, 0xac, 0x38, 0x99, 0x2b, 0xb5, 0x99,
-	0xb2, 0xd6, 0xe9, 0xed, 0xed, 0x1e, 0xcc, 0x86, 0x30, 0x93, 0xcc, 0x68, 0x1a, 0x8e, 0x38, 0xb8,
-	0xe9, 0x5a, 0x86, 0xe5, 0x60, 0xdb, 0xe5, 0xdd, 0x33, 0xb5, 0xe0, 0x91, 0x7a, 0x29, 0x32, 0x7a,
-	0x5f, 0x7b, 0x1e, 0x66, 0x7c, 0xed, 0xbc, 0xee, 0x60, 0x2d, 0x2d, 0x55, 0xa9, 0x8f, 0xe1, 0x24,
-	0xcf, 0xba, 0xb1, 0xb1, 0x41, 0x0d, 0x8e, 0x17, 0xde, 0xdb, 0x69, 0x5d, 0xab, 0xdf, 0x00, 0x4e,
-	0xf5, 0x68, 0x24, 0x30, 0x5f, 0xc0, 0xff, 0xb1, 0xff, 0x2c, 0xbc, 0xa9, 0xc9, 0x50, 0x43, 0xd9,
-	0x6a, 0x85, 0x18, 0x37, 0xa9, 0x65, 0x57, 0x97, 0x3b, 0x8b, 0xfa, 0xf4, 0xa3, 0x30, 0x6f, 0x5a,
-	0xee, 0x93, 0xad, 0xba, 0x66, 0xd0, 0x86, 0x2e, 0x3c, 0xcc, 0xfb, 0xb5, 0xc8, 0xd6, 0x9f, 0xea,
-	0xee, 0xb6, 0x43, 0x98, 0xcc, 0x61, 0xb5, 0x31, 0x1c, 0xe1, 0x38, 0xcd, 0x6b, 0x99, 0x8f, 0x53,
-	0x2a, 0x27, 0x9a, 0x85, 0x43, 0xeb, 0xc4, 0xa6, 0x0d, 0xb1, 0x62, 0xef, 0x8b, 0xfa, 0x16, 0xc4,
-	0x2f, 0xc2, 0x1f, 0xcf, 0x73, 0x38, 0x16, 0x1d, 0x8f, 0x58, 0xc7, 0x3f, 0x98, 0xce, 0x68, 0x64,
-	0x3a, 0x6a, 0x16, 0x22, 0x4e, 0xb7, 0x86, 0x9b, 0xb8, 0x21, 0xff, 0x38, 0xd4, 0x35, 0x38, 0x1e,
-	0x3a, 0x15, 0xa8, 0x57, 0xe1, 0xb0, 0xc3, 0x4f, 0x04, 0x60, 0x3e, 0xf6, 0xa2, 0x79, 0x49, 0xe2,
-	0x9a, 0x89, 0x84, 0xca, 0xd7, 0x34, 0x1c, 0xe2, 0x25, 0xd1, 0x2e, 0x80, 0xb0, 0x6b, 0xc1, 0x68,
-	0x3e, 0xb6, 0x46, 0xfc, 0xbb, 0x20, 0xb7, 0x90, 0x2c, 0xd8, 0xc3, 0x55, 0xe7, 0x5e, 0x7d, 0xf9,
-	0xf5, 0x7a, 0x60, 0x06, 0x15, 0xf4, 0xe3, 0x5f, 0x5b, 0xe8, 0x0d, 0x80, 0x19, 0x3f, 0x1f, 0x95,
-	0x12, 0x34, 0x91, 0x40, 0xf3, 0x89, 0x62, 0x05, 0x4f, 0x85, 0xf3, 0x2c, 0xa0, 0x52, 0x1f, 0x1e,
-	0xbd, 0x2d, 0xdd, 0x61, 0x87, 0xa3, 0xf9, 0xae, 0x77, 0x1c, 0x5a, 0xd4, 0x98, 0x8f, 0x43, 0x3b,
-	0x62, 0xa3, 0x7d, 0xd0, 0xba, 0x0e, 0x1b, 0x44, 0x7b, 0x07, 0x60, 0x5a, 0x56, 0x42, 0x17, 0xfb,
-	0x77, 0x93, 0x60, 0xa5, 0x24, 0xa1, 0x82, 0xeb, 0x3a, 0xe7, 0xba, 0x86, 0xae, 0x24, 0xe7, 0xd2,
-	0xdb, 0x01, 0xf3, 0xdc, 0x41, 0x1f, 0x01, 0x1c, 0x8b, 0x5a, 0x13, 0x2a, 0xf7, 0x46, 0xe8, 0xe1,
-	0x97, 0xb9, 0xca, 0x49, 0x52, 0x04, 0xbd, 0xc6, 0xe9, 0x8b, 0x68, 0x36, 0x96, 0xfe, 0x88, 0x29,
-	0xa2, 0xcf, 0x00, 0x8e, 0x46, 0x8a, 0xa1, 0xa5, 0xc4, 0x7d, 0x25, 0x69, 0xf9, 0x04, 0x19, 0x02,
-	0xf4, 0x32, 0x07, 0x5d, 0x42, 0x5a, 0x32, 0x50, 0xbd, 0xcd, 0xbd, 0x6d, 0x07, 0xbd, 0x04, 0x70,
-	0xd8, 0xbb, 0xee, 0x68, 0xae, 0x77, 0xd7, 0x90, 0xb7, 0xe4, 0x8a, 0xfd, 0x03, 0x05, 0xd5, 0x79,
-	0x4e, 0x35, 0x85, 0xf2, 0xb1, 0x54, 0x9e, 0xb1, 0x54, 0x57, 0xf7, 0x0e, 0x14, 0xb0, 0x7f, 0xa0,
-	0x80, 0x9f, 0x07, 0x0a, 0xd8, 0x3d, 0x54, 0x52, 0xfb, 0x87, 0x4a, 0xea, 0xdb, 0xa1, 0x92, 0xba,
-	0xbf, 0x18, 0x30, 0x46, 0xaf, 0x80, 0xf7, 0xb3, 0x55, 0x5e, 0xd2, 0x9f, 0x05, 0x8b, 0x71, 0x8f,
-	0xac, 0x0f, 0xf3, 0x7f, 0x44, 0x97, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xfc, 0x0d, 0x20,
-	0x89, 0x0b, 0x00, 0x00,
+	// 1213 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0xcf, 0x4f, 0x24, 0x45,
+	0x14, 0xc7, 0x29, 0x76, 0xc1, 0xe5, 0xb1, 0xb8, 0x50, 0x20, 0xe2, 0x00, 0x33, 0x6c, 0x6b, 0x00,
+	0x61, 0xb7, 0x9b, 0x99, 0x41, 0xa3, 0xeb, 0x45, 0x11, 0x17, 0x3d, 0x98, 0xe0, 0x68, 0x62, 0x62,
+	0xd4, 0xb1, 0x68, 0x8a, 0xa6, 0x23, 0xd3, 0xdd, 0x3b, 0xd5, 0x0c, 0x6e, 0x10, 0x63, 0x36, 0xc6,
+	0x78, 0xdc, 0x44, 0x0f, 0x1e, 0xbc, 0x19, 0x13, 0xd7, 0x83, 0x17, 0x2f, 0x1e, 0xfc, 0x03, 0xf6,
+	0xb8, 0x89, 0x17, 0xe3, 0x61, 0x35, 0xa0, 0x89, 0x7f, 0x86, 0xe9, 0xfa, 0xd1, 0xdd, 0xd3, 0xd3,
+	0x0d, 0x3d, 0x1b, 0xe0, 0xb2, 0x4b, 0x17, 0xef, 0xc7, 0xe7, 0x7d, 0x5f, 0xf5, 0xbc, 0x37, 0x40,
+	0x89, 0xb6, 0x1a, 0x2e, 0x33, 0x6c, 0xc7, 0xa4, 0x8e, 0x6f, 0xb7, 0x28, 0x33, 0x5a, 0x65, 0xe3,
+	0xd6, 0x2e, 0x6d, 0xde, 0xd6, 0xbd, 0xa6, 0xeb, 0xbb, 0x78, 0x94, 0x1b, 0xe8, 0x91, 0x81, 0xde,
+	0x2a, 0x17, 0x16, 0x4c, 0x97, 0x05, 0x6e, 0x1b, 0x84, 0x51, 0x61, 0x6d, 0xb4, 0xca, 0x1b, 0xd4,
+	0x27, 0x65, 0xc3, 0x23, 0x96, 0xed, 0x10, 0xdf, 0x76, 0x1d, 0x11, 0xa0, 0x50, 0x8c, 0xdb, 0x2a,
+	0x2b, 0xd3, 0xb5, 0xd5, 0xef, 0xaf, 0xa6, 0x11, 0x58, 0xd4, 0xa1, 0xcc, 0x66, 0xd2, 0xe4, 0x99,
+	0x34, 0x93, 0xe8, 0x49, 0x5a, 0x8d, 0x59, 0xae, 0xe5, 0xf2, 0x1f, 0x8d, 0xe0, 0x27, 0x79, 0x3a,
+	0x65, 0xb9, 0xae, 0xb5, 0x43, 0x0d, 0xe2, 0xd9, 0x06, 0x71, 0x1c, 0xd7, 0xe7, 0x6c, 0xd2, 0x47,
+	0xfb, 0x08, 0xc6, 0xdf, 0x0a, 0xf0, 0xdf, 0x08, 0x83, 0xd5, 0xe8, 0xad, 0x5d, 0xca, 0x7c, 0x7c,
+	0x13, 0x20, 0x2a, 0x65, 0x02, 0xcd, 0xa0, 0xf9, 0xc1, 0xca, 0xac, 0x2e, 0x6a, 0xd1, 0x83, 0x5a,
+	0x74, 0xa1, 0x92, 0xac, 0x48, 0x5f, 0x27, 0x16, 0x95, 0xbe, 0xb5, 0x98, 0xa7, 0xf6, 0x23, 0x82,
+	0x27, 0x3b, 0x52, 0x30, 0xcf, 0x75, 0x18, 0xc5, 0xab, 0x00, 0x51, 0x15, 0x13, 0x68, 0xe6, 0xc2,
+	0xfc, 0x60, 0xa5, 0xa8, 0xa7, 0x08, 0xae, 0x87, 0xce, 0x2b, 0x17, 0xef, 0x3f, 0x2c, 0xf5, 0xd4,
+	0x62, 0x7e, 0x78, 0xad, 0x8d, 0xb4, 0x97, 0x93, 0xce, 0x9d, 0x48, 0x2a, 0x10, 0xda, 0x50, 0xab,
+	0xf0, 0x44, 0x3b, 0xa9, 0xd2, 0xa2, 0x00, 0x97, 0x4c, 0xd7, 0xf1, 0x9b, 0xc4, 0xf4, 0xb9, 0x12,
+	0x03, 0xb5, 0xf0, 0x59, 0x7b, 0x3f, 0xa9, 0x60, 0x58, 0xdd, 0x0a, 0x0c, 0x84, 0x94, 0x52, 0xc0,
+	0x7c, 0xc5, 0x45, 0x6e, 0xda, 0xbe, 0x44, 0x5a, 0x23, 0xec, 0x4d, 0xea, 0xd3, 0x26, 0xcb, 0x81,
+	0x84, 0x6f, 0xa6, 0x08, 0xf2, 0x28, 0xad, 0xfb, 0x01, 0xc1, 0x78, 0x32, 0x7b, 0x58, 0x1b, 0x58,
+	0x84, 0xd5, 0x1b, 0xfc, 0x54, 0x76, 0x6e, 0x3a, 0xb5, 0x38, 0xe5, 0xab, 0x6a, 0xb3, 0x54, 0xac,
+	0xd3, 0xeb, 0xdb, 0x3b, 0x30, 0xd6, 0x86, 0x99, 0x47, 0xa3, 0x19, 0x18, 0xf4, 0x48, 0xd3, 0xb7,
+	0x4d, 0xdb, 0x23, 0x8e, 0xcf, 0xb3, 0x0f, 0xd4, 0xe2, 0x47, 0xda, 0x72, 0x42, 0xfa, 0xb0, 0xf6,
+	0x49, 0x18, 0x08, 0x6b, 0xe7, 0x71, 0x2f, 0xd6, 0x2e, 0xa9, 0xaa, 0xb4, 0x2f, 0x10, 0x14, 0xb9,
+	0xdb, 0x7a, 0x14, 0xea, 0x5d, 0x6a, 0x5b, 0xdb, 0xfe, 0xb9, 0xb6, 0xee, 0x5e, 0x2f, 0x94, 0x32,
+	0x31, 0x64, 0x1d, 0x1f, 0xc0, 0x68, 0xac, 0xde, 0xfa, 0x9e, 0xf8, 0xb5, 0x6c, 0xe6, 0x6c, 0x6a,
+	0x33, 0x3b, 0xa2, 0xc9, 0xae, 0x62, 0xaf, 0x23, 0x0d, 0xfe, 0x10, 0x46, 0x7d, 0xd7, 0x27, 0x3b,
+	0x75, 0xba, 0xb5, 0x45, 0xcd, 0x20, 0x44, 0xdd, 0x22, 0x4c, 0x28, 0xbd, 0xa2, 0x07, 0x6e, 0x7f,
+	0x3e, 0x2c, 0xcd, 0x5a, 0xb6, 0xbf, 0xbd, 0xbb, 0xa1, 0x9b, 0x6e, 0xc3, 0x90, 0x9f, 0x93, 0xe2,
+	0xbf, 0xeb, 0x6c, 0xf3, 0x63, 0xc3, 0xbf, 0xed, 0x51, 0xa6, 0xaf, 0x52, 0xb3, 0x36, 0xc2, 0x43,
+	0xbd, 0xa6, 0x22, 0xad, 0x91, 0xe4, 0xf5, 0xb9, 0xf0, 0xe8, 0xd7, 0x67, 0xa5, 0xb3, 0x63, 0x35,
+	0xba, 0x47, 0x9a, 0x9b, 0x61, 0xc7, 0x12, 0x97, 0x05, 0x75, 0x5e, 0x96, 0xaf, 0x10, 0x94, 0x32,
+	0x83, 0x48, 0xbd, 0x29, 0x3c, 0xd6, 0x14, 0x47, 0x52, 0xe3, 0xa7, 0xda, 0x68, 0x15, 0xe7, 0xab,
+	0xae, 0xed, 0xac, 0x2c, 0x05, 0xfa, 0xfc, 0xf4, 0x57, 0x69, 0x3e, 0x87, 0x3e, 0x81, 0x03, 0xab,
+	0xa9, 0xd8, 0xda, 0xbf, 0x08, 0xa6, 0x39, 0xca, 0xdb, 0x76, 0x63, 0x77, 0x87, 0xf8, 0xb4, 0x9b,
+	0x8f, 0x33, 0xcc, 0x60, 0x90, 0xec, 0xec, 0xb8, 0xa6, 0x98, 0x12, 0x13, 0xbd, 0x1c, 0x74, 0x2a,
+	0x15, 0x74, 0x95, 0x9a, 0x9c, 0xb5, 0x2a, 0x59, 0x17, 0xf3, 0xf5, 0x52, 0xe0, 0xc6, 0xb3, 0xe0,
+	0x71, 0xe8, 0xa7, 0x9e, 0x6b, 0x6e, 0x33, 0xde, 0xc6, 0xa1, 0x9a, 0x7c, 0x0a, 0xde, 0x34, 0x71,
+	0x85, 0x82, 0x8b, 0x73, 0x51, 0xbc, 0x69, 0xfc, 0x60, 0x8d, 0x30, 0xed, 0x9b, 0x3e, 0x28, 0x66,
+	0xd5, 0x79, 0xae, 0x8a, 0x63, 0x0f, 0x86, 0x04, 0xa6, 0x4a, 0xd6, 0x7b, 0xfa, 0xc9, 0x2e, 0xf3,
+	0x0c, 0xf2, 0x4a, 0xb5, 0x0b, 0x73, 0xa1, 0x5d, 0x18, 0xbc, 0x07, 0x8f, 0x0b, 0x90, 0xba, 0x47,
+	0x9b, 0x52, 0xba, 0x33, 0xea, 0xe2, 0x65, 0x91, 0x68, 0x9d, 0x36, 0x83, 0xc4, 0x9f, 0xc1, 0x48,
+	0xd4, 0x55, 0x35, 0x1b, 0xfa, 0xce, 0x2a, 0xf7, 0x70, 0x94, 0x4b, 0x0e, 0x94, 0x3b, 0x08, 0x46,
+	0x63, 0x00, 0xdb, 0x94, 0x6c, 0x36, 0x5d, 0xb7, 0x31, 0xd1, 0x7f, 0x56, 0x08, 0x38, 0xca, 0xf6,
+	0xba, 0x4c, 0xa6, 0x6d, 0xc1, 0x14, 0xbf, 0x95, 0xaf, 0x24, 0xe8, 0x4e, 0x7b, 0xaf, 0xfa, 0x4f,
+	0xbd, 0xe6, 0x9d, 0x89, 0xe4, 0xed, 0x4f, 0x6d, 0x07, 0x3a, 0xbf, 0x76, 0x9c, 0xe2, 0x5e, 0x36,
+	0x99, 0x56, 0xa9, 0x52, 0x74, 0x0c, 0xfa, 0x36, 0xa9, 0xe3, 0x36, 0xe4, 0x67, 0x99, 0x78, 0xd0,
+	0xbe, 0x43, 0xe9, 0x8d, 0x08, 0xe5, 0xf9, 0x14, 0x86, 0x93, 0xf2, 0xc8, 0x76, 0x9c, 0x81, 0x3a,
+	0x57, 0x12, 0xea, 0x68, 0x63, 0x80, 0xd5, 0xbc, 0x20, 0x0d, 0x75, 0x39, 0xb4, 0x75, 0x18, 0x6d,
+	0x3b, 0x95, 0xa8, 0x2f, 0x42, 0xbf, 0xc7, 0x4f, 0x24, 0xe0, 0x64, 0xd6, 0x70, 0x26, 0x0d, 0x26,
+	0x27, 0xb2, 0x74, 0xa8, 0x7c, 0x39, 0x04, 0x7d, 0x3c, 0x24, 0xbe, 0x8b, 0x00, 0xa2, 0x1d, 0x1c,
+	0x2f, 0xa6, 0xc6, 0x48, 0xff, 0x32, 0x50, 0xb8, 0x96, 0xcf, 0x58, 0xe0, 0x6a, 0x73, 0x77, 0x7e,
+	0xff, 0xe7, 0xeb, 0xde, 0xab, 0xb8, 0x64, 0x1c, 0xff, 0xbd, 0x05, 0x7f, 0x8b, 0x60, 0x20, 0xf4,
+	0xc7, 0x0b, 0x39, 0x92, 0x28, 0xa0, 0xc5, 0x5c, 0xb6, 0x92, 0xa7, 0xc2, 0x79, 0xae, 0xe1, 0x85,
+	0x13, 0x78, 0x8c, 0x7d, 0x35, 0x06, 0x0f, 0x38, 0x5a, 0xb8, 0xf6, 0x1e, 0x87, 0x96, 0xdc, 0xcc,
+	0x0b, 0x8b, 0xb9, 0x6c, 0x73, 0xa1, 0x45, 0x2b, 0x76, 0x1c, 0xed, 0x7b, 0x04, 0x97, 0x54, 0x24,
+	0xfc, 0xec, 0xc9, 0xd9, 0x14, 0xd8, 0x42, 0x1e, 0x53, 0xc9, 0xf5, 0x32, 0xe7, 0xba, 0x81, 0x5f,
+	0xc8, 0xcf, 0x65, 0xec, 0xc7, 0x16, 0xa2, 0x03, 0xfc, 0x1b, 0x02, 0xdc, 0xb9, 0x7c, 0xe2, 0x6a,
+	0x36, 0x44, 0xe6, 0xc6, 0x5c, 0x58, 0xee, 0xce, 0x49, 0xd6, 0xf0, 0x12, 0xaf, 0xe1, 0x39, 0x5c,
+	0x4d, 0xad, 0x21, 0x65, 0xf5, 0x8d, 0x8b, 0xfc, 0x4b, 0x3b, 0xbe, 0x1a, 0xbc, 0xf9, 0xf0, 0xdb,
+	0xd7, 0xc7, 0xc2, 0x72, 0x77, 0x4e, 0xb9, 0xae, 0x86, 0x5c, 0x35, 0x12, 0xa2, 0xff, 0x8a, 0x60,
+	0xa4, 0x63, 0x1d, 0xc2, 0x95, 0xec, 0xfc, 0x59, 0x3b, 0x62, 0xa1, 0xda, 0x95, 0x8f, 0x44, 0xbe,
+	0xc1, 0x91, 0x97, 0x71, 0x25, 0x15, 0x99, 0x49, 0xbf, 0x7a, 0x78, 0x1c, 0x17, 0xfc, 0x1e, 0x82,
+	0xe1, 0xe4, 0x28, 0xc3, 0xe5, 0x6c, 0x8a, 0x8c, 0xf9, 0x5a, 0xa8, 0x74, 0xe3, 0x22, 0xb9, 0x75,
+	0xce, 0x3d, 0x8f, 0x67, 0x53, 0xb9, 0x3b, 0x86, 0x28, 0xfe, 0x19, 0xc1, 0x95, 0x44, 0x30, 0xbc,
+	0x94, 0x3b, 0xaf, 0x22, 0x2d, 0x77, 0xe1, 0x21, 0x41, 0x9f, 0xe7, 0xa0, 0x4b, 0x58, 0xcf, 0x07,
+	0x6a, 0xec, 0xf3, 0x59, 0x78, 0x80, 0x3f, 0x47, 0xd0, 0x2f, 0xc6, 0x03, 0x9e, 0x3b, 0xf6, 0x32,
+	0x46, 0xb3, 0xa8, 0x30, 0x7f, 0xb2, 0xa1, 0xa4, 0x7a, 0x9a, 0x53, 0x4d, 0xe3, 0xc9, 0xac, 0x17,
+	0x2d, 0x18, 0x4b, 0x6b, 0xf7, 0x0f, 0x8b, 0xe8, 0xc1, 0x61, 0x11, 0xfd, 0x7d, 0x58, 0x44, 0x77,
+	0x8f, 0x8a, 0x3d, 0x0f, 0x8e, 0x8a, 0x3d, 0x7f, 0x1c, 0x15, 0x7b, 0xde, 0xbb, 0x1e, 0x1b, 0xa4,
+	0x22, 0x80, 0xf8, 0xb7, 0x55, 0x5e, 0x32, 0x3e, 0x89, 0x07, 0xe3, 0x33, 0x75, 0xa3, 0x9f, 0xff,
+	0xe5, 0xaa, 0xfa, 0xff, 0x00, 0x23, 0xe0, 0x12, 0x26, 0xba, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -819,6 +1228,14 @@ type QueryClient interface {
 	GasMeters(ctx context.Context, in *QueryGasMetersRequest, opts ...grpc.CallOption) (*QueryGasMetersResponse, error)
 	// GasMeter retrieves a active gas meter
 	GasMeter(ctx context.Context, in *QueryGasMeterRequest, opts ...grpc.CallOption) (*QueryGasMeterResponse, error)
+	// ParticipantWeights retrieves the raw and effective reward weights of the
+	// participants of a given contract
+	ParticipantWeights(ctx context.Context, in *QueryParticipantWeightsRequest, opts ...grpc.CallOption) (*QueryParticipantWeightsResponse, error)
+	// ParticipantRewards retrieves the unclaimed rewards of a participant
+	ParticipantRewards(ctx context.Context, in *QueryParticipantRewardsRequest, opts ...grpc.CallOption) (*QueryParticipantRewardsResponse, error)
+	// SimulateIncentive projects the rewards of a hypothetical incentive and its
+	// effect on the allocation meters
+	SimulateIncentive(ctx context.Context, in *QuerySimulateIncentiveRequest, opts ...grpc.CallOption) (*QuerySimulateIncentiveResponse, error)
 	// AllocationMeters retrieves active allocation meters for a given
 	// denomination
 	AllocationMeters(ctx context.Context, in *QueryAllocationMetersRequest, opts ...grpc.CallOption) (*QueryAllocationMetersResponse, error)
@@ -872,6 +1289,33 @@ func (c *queryClient) GasMeter(ctx context.Context, in *QueryGasMeterRequest, op
 	return out, nil
 }
 
+func (c *queryClient) ParticipantWeights(ctx context.Context, in *QueryParticipantWeightsRequest, opts ...grpc.CallOption) (*QueryParticipantWeightsResponse, error) {
+	out := new(QueryParticipantWeightsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/ParticipantWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantRewards(ctx context.Context, in *QueryParticipantRewardsRequest, opts ...grpc.CallOption) (*QueryParticipantRewardsResponse, error) {
+	out := new(QueryParticipantRewardsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/ParticipantRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateIncentive(ctx context.Context, in *QuerySimulateIncentiveRequest, opts ...grpc.CallOption) (*QuerySimulateIncentiveResponse, error) {
+	out := new(QuerySimulateIncentiveResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/SimulateIncentive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AllocationMeters(ctx context.Context, in *QueryAllocationMetersRequest, opts ...grpc.CallOption) (*QueryAllocationMetersResponse, error) {
 	out := new(QueryAllocationMetersResponse)
 	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/AllocationMeters", in, out, opts...)
@@ -909,6 +1353,14 @@ type QueryServer interface {
 	GasMeters(context.Context, *QueryGasMetersRequest) (*QueryGasMetersResponse, error)
 	// GasMeter retrieves a active gas meter
 	GasMeter(context.Context, *QueryGasMeterRequest) (*QueryGasMeterResponse, error)
+	// ParticipantWeights retrieves the raw and effective reward weights of the
+	// participants of a given contract
+	ParticipantWeights(context.Context, *QueryParticipantWeightsRequest) (*QueryParticipantWeightsResponse, error)
+	// ParticipantRewards retrieves the unclaimed rewards of a participant
+	ParticipantRewards(context.Context, *QueryParticipantRewardsRequest) (*QueryParticipantRewardsResponse, error)
+	// SimulateIncentive projects the rewards of a hypothetical incentive and its
+	// effect on the allocation meters
+	SimulateIncentive(context.Context, *QuerySimulateIncentiveRequest) (*QuerySimulateIncentiveResponse, error)
 	// AllocationMeters retrieves active allocation meters for a given
 	// denomination
 	AllocationMeters(context.Context, *QueryAllocationMetersRequest) (*QueryAllocationMetersResponse, error)
@@ -934,6 +1386,15 @@ func (*UnimplementedQueryServer) GasMeters(ctx context.Context, req *QueryGasMet
 func (*UnimplementedQueryServer) GasMeter(ctx context.Context, req *QueryGasMeterRequest) (*QueryGasMeterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasMeter not implemented")
 }
+func (*UnimplementedQueryServer) ParticipantWeights(ctx context.Context, req *QueryParticipantWeightsRequest) (*QueryParticipantWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantWeights not implemented")
+}
+func (*UnimplementedQueryServer) ParticipantRewards(ctx context.Context, req *QueryParticipantRewardsRequest) (*QueryParticipantRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantRewards not implemented")
+}
+func (*UnimplementedQueryServer) SimulateIncentive(ctx context.Context, req *QuerySimulateIncentiveRequest) (*QuerySimulateIncentiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateIncentive not implemented")
+}
 func (*UnimplementedQueryServer) AllocationMeters(ctx context.Context, req *QueryAllocationMetersRequest) (*QueryAllocationMetersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllocationMeters not implemented")
 }
@@ -1020,31 +1481,85 @@ func _Query_GasMeter_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_AllocationMeters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllocationMetersRequest)
+func _Query_ParticipantWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantWeightsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).AllocationMeters(ctx, in)
+		return srv.(QueryServer).ParticipantWeights(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/evmos.incentives.v1.Query/AllocationMeters",
+		FullMethod: "/evmos.incentives.v1.Query/ParticipantWeights",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllocationMeters(ctx, req.(*QueryAllocationMetersRequest))
+		return srv.(QueryServer).ParticipantWeights(ctx, req.(*QueryParticipantWeightsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_AllocationMeter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllocationMeterRequest)
+func _Query_ParticipantRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantRewardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).AllocationMeter(ctx, in)
+		return srv.(QueryServer).ParticipantRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/ParticipantRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantRewards(ctx, req.(*QueryParticipantRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateIncentive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateIncentiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateIncentive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/SimulateIncentive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateIncentive(ctx, req.(*QuerySimulateIncentiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllocationMeters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllocationMetersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllocationMeters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/AllocationMeters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllocationMeters(ctx, req.(*QueryAllocationMetersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllocationMeter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllocationMeterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllocationMeter(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -1094,6 +1609,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GasMeter",
 			Handler:    _Query_GasMeter_Handler,
 		},
+		{
+			MethodName: "ParticipantWeights",
+			Handler:    _Query_ParticipantWeights_Handler,
+		},
+		{
+			MethodName: "ParticipantRewards",
+			Handler:    _Query_ParticipantRewards_Handler,
+		},
+		{
+			MethodName: "SimulateIncentive",
+			Handler:    _Query_SimulateIncentive_Handler,
+		},
 		{
 			MethodName: "AllocationMeters",
 			Handler:    _Query_AllocationMeters_Handler,
@@ -1414,7 +1941,7 @@ func (m *QueryGasMeterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllocationMetersRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParticipantWeightsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1424,12 +1951,12 @@ func (m *QueryAllocationMetersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllocationMetersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParticipantWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllocationMetersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParticipantWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1444,12 +1971,19 @@ func (m *QueryAllocationMetersRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllocationMetersResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParticipantWeightsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1459,12 +1993,12 @@ func (m *QueryAllocationMetersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllocationMetersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParticipantWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllocationMetersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParticipantWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1479,12 +2013,22 @@ func (m *QueryAllocationMetersResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if len(m.AllocationMeters) > 0 {
-		for iNdEx := len(m.AllocationMeters) - 1; iNdEx >= 0; iNdEx-- {
+	{
+		size := m.TotalEffectiveGas.Size()
+		i -= size
+		if _, err := m.TotalEffectiveGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ParticipantWeights) > 0 {
+		for iNdEx := len(m.ParticipantWeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AllocationMeters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ParticipantWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1498,7 +2042,7 @@ func (m *QueryAllocationMetersResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllocationMeterRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParticipantRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1508,27 +2052,27 @@ func (m *QueryAllocationMeterRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllocationMeterRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParticipantRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllocationMeterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParticipantRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllocationMeterResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParticipantRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1538,30 +2082,34 @@ func (m *QueryAllocationMeterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllocationMeterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParticipantRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllocationMeterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParticipantRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.AllocationMeter.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySimulateIncentiveRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1571,20 +2119,51 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySimulateIncentiveRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySimulateIncentiveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.TotalGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySimulateIncentiveResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1594,73 +2173,341 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySimulateIncentiveResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySimulateIncentiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.AllocationHeadroom) > 0 {
+		for iNdEx := len(m.AllocationHeadroom) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllocationHeadroom[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AllocationMeters) > 0 {
+		for iNdEx := len(m.AllocationMeters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllocationMeters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RewardPerGas) > 0 {
+		for iNdEx := len(m.RewardPerGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TotalGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalRewards) > 0 {
+		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAllocationMetersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIncentivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryAllocationMetersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIncentivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllocationMetersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Incentives) > 0 {
-		for _, e := range m.Incentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentiveRequest) Size() (n int) {
+func (m *QueryAllocationMetersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationMetersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationMetersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllocationMeters) > 0 {
+		for iNdEx := len(m.AllocationMeters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllocationMeters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllocationMeterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationMeterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationMeterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllocationMeterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllocationMeterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllocationMeterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AllocationMeter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Incentives) > 0 {
+		for _, e := range m.Incentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentiveRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1749,12 +2596,16 @@ func (m *QueryGasMeterResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllocationMetersRequest) Size() (n int) {
+func (m *QueryParticipantWeightsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -1762,18 +2613,20 @@ func (m *QueryAllocationMetersRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllocationMetersResponse) Size() (n int) {
+func (m *QueryParticipantWeightsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AllocationMeters) > 0 {
-		for _, e := range m.AllocationMeters {
+	if len(m.ParticipantWeights) > 0 {
+		for _, e := range m.ParticipantWeights {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	l = m.TotalEffectiveGas.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -1781,58 +2634,185 @@ func (m *QueryAllocationMetersResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllocationMeterRequest) Size() (n int) {
+func (m *QueryParticipantRewardsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
+	l = len(m.Participant)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAllocationMeterResponse) Size() (n int) {
+func (m *QueryParticipantRewardsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.AllocationMeter.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QuerySimulateIncentiveRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	if m.TotalGas != 0 {
+		n += 1 + sovQuery(uint64(m.TotalGas))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QuerySimulateIncentiveResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryIncentivesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalRewards) > 0 {
+		for _, e := range m.TotalRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalGas != 0 {
+		n += 1 + sovQuery(uint64(m.TotalGas))
+	}
+	if len(m.RewardPerGas) > 0 {
+		for _, e := range m.RewardPerGas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AllocationMeters) > 0 {
+		for _, e := range m.AllocationMeters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AllocationHeadroom) > 0 {
+		for _, e := range m.AllocationHeadroom {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllocationMetersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllocationMetersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllocationMeters) > 0 {
+		for _, e := range m.AllocationMeters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllocationMeterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllocationMeterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllocationMeter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIncentivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -1936,21 +2916,879 @@ func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Incentives = append(m.Incentives, Incentive{})
+			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Incentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasMetersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasMetersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasMetersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasMetersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMeters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasMeters = append(m.GasMeters, GasMeter{})
+			if err := m.GasMeters[len(m.GasMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasMeterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasMeterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasMeterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasMeterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMeter", wireType)
+			}
+			m.GasMeter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMeter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipantWeightsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipantWeightsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipantWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipantWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipantWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipantWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantWeights = append(m.ParticipantWeights, ParticipantWeight{})
+			if err := m.ParticipantWeights[len(m.ParticipantWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEffectiveGas", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1960,27 +3798,27 @@ func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Incentives = append(m.Incentives, Incentive{})
-			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalEffectiveGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2037,7 +3875,7 @@ func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2060,15 +3898,15 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentiveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2096,7 +3934,7 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2119,7 +3957,7 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2142,15 +3980,15 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentiveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incentive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2177,7 +4015,8 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Incentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2202,7 +4041,7 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateIncentiveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2225,10 +4064,10 @@ func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMetersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateIncentiveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMetersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateIncentiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2265,7 +4104,7 @@ func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2292,13 +4131,49 @@ func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allocations = append(m.Allocations, types.DecCoin{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+			}
+			m.TotalGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2320,7 +4195,7 @@ func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateIncentiveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,15 +4218,15 @@ func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMetersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateIncentiveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMetersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasMeters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2378,14 +4253,14 @@ func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasMeters = append(m.GasMeters, GasMeter{})
-			if err := m.GasMeters[len(m.GasMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2412,68 +4287,35 @@ func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalRewards = append(m.TotalRewards, types.Coin{})
+			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TotalGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMeterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMeterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerGas", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2483,29 +4325,31 @@ func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.RewardPerGas = append(m.RewardPerGas, types.DecCoin{})
+			if err := m.RewardPerGas[len(m.RewardPerGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationMeters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2515,79 +4359,31 @@ func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.AllocationMeters = append(m.AllocationMeters, types.DecCoin{})
+			if err := m.AllocationMeters[len(m.AllocationMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMeterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMeterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasMeter", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationHeadroom", wireType)
 			}
-			m.GasMeter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2597,11 +4393,26 @@ func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasMeter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllocationHeadroom = append(m.AllocationHeadroom, types.DecCoin{})
+			if err := m.AllocationHeadroom[len(m.AllocationHeadroom)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
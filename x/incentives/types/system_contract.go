@@ -0,0 +1,47 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SystemContractMethodClaimRewards is the system contract method to claim the
+// accumulated incentive rewards of the caller
+const SystemContractMethodClaimRewards = "claimRewards"
+
+// systemContractABIJSON defines the ABI of the incentives system contract
+const systemContractABIJSON = `[
+  {
+    "type": "function",
+    "name": "claimRewards",
+    "stateMutability": "nonpayable",
+    "inputs": [
+      {"name": "asERC20", "type": "bool"}
+    ],
+    "outputs": []
+  }
+]`
+
+var (
+	// SystemContractAddress is the address of the incentives system contract
+	SystemContractAddress = common.HexToAddress("0x0000000000000000000000000000000000000804")
+
+	// SystemContractABI is the ABI of the incentives system contract
+	SystemContractABI abi.ABI
+)
+
+func init() {
+	var err error
+	SystemContractABI, err = abi.JSON(strings.NewReader(systemContractABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// ClaimRewardsArgs defines the arguments of the claimRewards system contract
+// method
+type ClaimRewardsArgs struct {
+	AsERC20 bool
+}
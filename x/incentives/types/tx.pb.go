@@ -0,0 +1,1222 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/incentives/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgCreateSponsoredIncentive defines a message that creates an incentive
+// funded by the sender. The rewards are escrowed and distributed evenly over
+// the given number of epochs. Any remainder is refunded to the sponsor once the
+// incentive ends.
+type MsgCreateSponsoredIncentive struct {
+	// sponsor is the bech32 address of message sender that funds the incentive
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// contract address of the smart contract to be incentivized
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// rewards are the coins escrowed from the sponsor to be distributed
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// epochs is the number of epochs over which the rewards are distributed
+	Epochs uint32 `protobuf:"varint,4,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	// reward_curve applied to the cumulative gas of each participant
+	RewardCurve RewardCurve `protobuf:"varint,5,opt,name=reward_curve,json=rewardCurve,proto3,enum=evmos.incentives.v1.RewardCurve" json:"reward_curve,omitempty"`
+	// max_gas_per_participant caps the cumulative gas of a participant that is
+	// accounted for rewards during one epoch. Zero means no cap.
+	MaxGasPerParticipant uint64 `protobuf:"varint,6,opt,name=max_gas_per_participant,json=maxGasPerParticipant,proto3" json:"max_gas_per_participant,omitempty"`
+}
+
+func (m *MsgCreateSponsoredIncentive) Reset()         { *m = MsgCreateSponsoredIncentive{} }
+func (m *MsgCreateSponsoredIncentive) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSponsoredIncentive) ProtoMessage()    {}
+func (*MsgCreateSponsoredIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{0}
+}
+func (m *MsgCreateSponsoredIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSponsoredIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSponsoredIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSponsoredIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSponsoredIncentive.Merge(m, src)
+}
+func (m *MsgCreateSponsoredIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSponsoredIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSponsoredIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSponsoredIncentive proto.InternalMessageInfo
+
+func (m *MsgCreateSponsoredIncentive) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgCreateSponsoredIncentive) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgCreateSponsoredIncentive) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *MsgCreateSponsoredIncentive) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+func (m *MsgCreateSponsoredIncentive) GetRewardCurve() RewardCurve {
+	if m != nil {
+		return m.RewardCurve
+	}
+	return RewardCurveLinear
+}
+
+func (m *MsgCreateSponsoredIncentive) GetMaxGasPerParticipant() uint64 {
+	if m != nil {
+		return m.MaxGasPerParticipant
+	}
+	return 0
+}
+
+// MsgCreateSponsoredIncentiveResponse defines the
+// MsgCreateSponsoredIncentive response type
+type MsgCreateSponsoredIncentiveResponse struct {
+}
+
+func (m *MsgCreateSponsoredIncentiveResponse) Reset()         { *m = MsgCreateSponsoredIncentiveResponse{} }
+func (m *MsgCreateSponsoredIncentiveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSponsoredIncentiveResponse) ProtoMessage()    {}
+func (*MsgCreateSponsoredIncentiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{1}
+}
+func (m *MsgCreateSponsoredIncentiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSponsoredIncentiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSponsoredIncentiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSponsoredIncentiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSponsoredIncentiveResponse.Merge(m, src)
+}
+func (m *MsgCreateSponsoredIncentiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSponsoredIncentiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSponsoredIncentiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateSponsoredIncentiveResponse proto.InternalMessageInfo
+
+// MsgClaimIncentiveRewards defines a message that claims the accumulated
+// rewards of a participant
+type MsgClaimIncentiveRewards struct {
+	// participant is the bech32 address of message sender that claims its rewards
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// as_erc20 converts the claimed coins that have a registered token pair to
+	// their ERC20 representation
+	AsERC20 bool `protobuf:"varint,2,opt,name=as_erc20,json=asErc20,proto3" json:"as_erc20,omitempty"`
+}
+
+func (m *MsgClaimIncentiveRewards) Reset()         { *m = MsgClaimIncentiveRewards{} }
+func (m *MsgClaimIncentiveRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimIncentiveRewards) ProtoMessage()    {}
+func (*MsgClaimIncentiveRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{2}
+}
+func (m *MsgClaimIncentiveRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimIncentiveRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimIncentiveRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimIncentiveRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimIncentiveRewards.Merge(m, src)
+}
+func (m *MsgClaimIncentiveRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimIncentiveRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimIncentiveRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimIncentiveRewards proto.InternalMessageInfo
+
+func (m *MsgClaimIncentiveRewards) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *MsgClaimIncentiveRewards) GetAsERC20() bool {
+	if m != nil {
+		return m.AsERC20
+	}
+	return false
+}
+
+// MsgClaimIncentiveRewardsResponse defines the MsgClaimIncentiveRewards
+// response type
+type MsgClaimIncentiveRewardsResponse struct {
+	// rewards are the claimed coins
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) Reset()         { *m = MsgClaimIncentiveRewardsResponse{} }
+func (m *MsgClaimIncentiveRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimIncentiveRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimIncentiveRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{3}
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimIncentiveRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimIncentiveRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimIncentiveRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimIncentiveRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimIncentiveRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgCreateSponsoredIncentive)(nil), "evmos.incentives.v1.MsgCreateSponsoredIncentive")
+	proto.RegisterType((*MsgCreateSponsoredIncentiveResponse)(nil), "evmos.incentives.v1.MsgCreateSponsoredIncentiveResponse")
+	proto.RegisterType((*MsgClaimIncentiveRewards)(nil), "evmos.incentives.v1.MsgClaimIncentiveRewards")
+	proto.RegisterType((*MsgClaimIncentiveRewardsResponse)(nil), "evmos.incentives.v1.MsgClaimIncentiveRewardsResponse")
+}
+
+func init() { proto.RegisterFile("evmos/incentives/v1/tx.proto", fileDescriptor_8acb8c4fd5b75ea3) }
+
+var fileDescriptor_8acb8c4fd5b75ea3 = []byte{
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbd, 0x8e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x24, 0xf9, 0x92, 0x7c, 0x13, 0xa0, 0x30, 0x0b, 0x98, 0xb0, 0x72, 0xac, 0xf0, 0x23,
+	0x37, 0xf1, 0xc4, 0x86, 0x15, 0x68, 0x3b, 0x12, 0xad, 0x56, 0x14, 0x2b, 0xad, 0x4c, 0x47, 0x63,
+	0x4d, 0x26, 0x23, 0xaf, 0x61, 0xe3, 0xb1, 0x66, 0x66, 0x4d, 0x68, 0xa1, 0xa2, 0x43, 0xe2, 0x05,
+	0xa8, 0x79, 0x00, 0x1e, 0x80, 0x6a, 0xcb, 0x95, 0xa0, 0xa0, 0x5a, 0x50, 0x42, 0xc1, 0x63, 0x20,
+	0x8f, 0x9d, 0xc4, 0x85, 0xb3, 0xd2, 0x16, 0x34, 0xc9, 0xdc, 0xb9, 0xe7, 0x9e, 0x7b, 0xee, 0x3d,
+	0xb6, 0xe1, 0x36, 0x4d, 0xa6, 0x4c, 0xa0, 0x30, 0x22, 0x34, 0x92, 0x61, 0x42, 0x05, 0x4a, 0x1c,
+	0x24, 0x67, 0x76, 0xcc, 0x99, 0x64, 0xda, 0x75, 0x95, 0xb5, 0xd7, 0x59, 0x3b, 0x71, 0x3a, 0x06,
+	0x61, 0x22, 0xad, 0x19, 0x63, 0x41, 0x51, 0xe2, 0x8c, 0xa9, 0xc4, 0x0e, 0x22, 0x2c, 0x8c, 0xb2,
+	0xa2, 0xce, 0xbd, 0x32, 0xca, 0x02, 0x45, 0x86, 0xda, 0x0a, 0x58, 0xc0, 0xd4, 0x11, 0xa5, 0xa7,
+	0xfc, 0x76, 0x3b, 0x60, 0x2c, 0x38, 0xa6, 0x08, 0xc7, 0x21, 0xc2, 0x51, 0xc4, 0x24, 0x96, 0x21,
+	0x8b, 0xf2, 0x9a, 0xde, 0xf7, 0x2a, 0xbc, 0x73, 0x20, 0x82, 0x11, 0xa7, 0x58, 0xd2, 0xe7, 0x31,
+	0x8b, 0x04, 0xe3, 0x74, 0xf2, 0x6c, 0x49, 0xad, 0xe9, 0xb0, 0x29, 0xb2, 0x5b, 0x1d, 0x98, 0xc0,
+	0xfa, 0xdf, 0x5b, 0x86, 0x5a, 0x07, 0xb6, 0x08, 0x8b, 0x24, 0xc7, 0x44, 0xea, 0x55, 0x95, 0x5a,
+	0xc5, 0x1a, 0x85, 0x4d, 0x4e, 0x5f, 0x63, 0x3e, 0x11, 0x7a, 0xcd, 0xac, 0x59, 0x6d, 0xf7, 0xb6,
+	0x9d, 0x4d, 0x68, 0xa7, 0x13, 0xda, 0xf9, 0x84, 0xf6, 0x88, 0x85, 0xd1, 0x70, 0x70, 0x7a, 0xde,
+	0xad, 0x7c, 0xfe, 0xd9, 0xb5, 0x82, 0x50, 0x1e, 0x9d, 0x8c, 0x6d, 0xc2, 0xa6, 0x28, 0x5f, 0x47,
+	0xf6, 0xd7, 0x17, 0x93, 0x57, 0x48, 0xbe, 0x89, 0xa9, 0x50, 0x05, 0xc2, 0x5b, 0x72, 0x6b, 0x37,
+	0x61, 0x83, 0xc6, 0x8c, 0x1c, 0x09, 0xbd, 0x6e, 0x02, 0xeb, 0xaa, 0x97, 0x47, 0xda, 0x08, 0x5e,
+	0xc9, 0x20, 0x3e, 0x39, 0xe1, 0x09, 0xd5, 0xff, 0x33, 0x81, 0x75, 0xcd, 0x35, 0xed, 0x92, 0xd5,
+	0xdb, 0x9e, 0x02, 0x8e, 0x52, 0x9c, 0xd7, 0xe6, 0xeb, 0x40, 0xdb, 0x81, 0xb7, 0xa6, 0x78, 0xe6,
+	0x07, 0x58, 0xf8, 0x31, 0xe5, 0x7e, 0x8c, 0xb9, 0x0c, 0x49, 0x18, 0xe3, 0x48, 0xea, 0x0d, 0x13,
+	0x58, 0x75, 0x6f, 0x6b, 0x8a, 0x67, 0xfb, 0x58, 0x1c, 0x52, 0x7e, 0xb8, 0xce, 0xed, 0xd6, 0xff,
+	0x7c, 0xea, 0x56, 0x7a, 0xf7, 0xe1, 0xdd, 0x0b, 0xb6, 0xea, 0x51, 0xb5, 0x44, 0xda, 0x7b, 0x09,
+	0xf5, 0x14, 0x76, 0x8c, 0xc3, 0x69, 0x21, 0x99, 0x0d, 0x67, 0xc2, 0x76, 0xb1, 0x67, 0xb6, 0xfd,
+	0xe2, 0x95, 0xf6, 0x00, 0xb6, 0xb0, 0xf0, 0x29, 0x27, 0xee, 0x40, 0x39, 0xd0, 0x1a, 0xb6, 0xe7,
+	0xe7, 0xdd, 0xe6, 0x53, 0xb1, 0xe7, 0x8d, 0xdc, 0x81, 0xd7, 0xc4, 0x62, 0x2f, 0xcd, 0xe5, 0x92,
+	0xde, 0x03, 0x68, 0x6e, 0x6a, 0xb6, 0x14, 0x54, 0x34, 0x0e, 0xfc, 0x3b, 0xe3, 0xdc, 0x77, 0x35,
+	0x58, 0x3b, 0x10, 0x81, 0xf6, 0x15, 0x40, 0x7d, 0xe3, 0xa3, 0x37, 0x28, 0xf5, 0xeb, 0x82, 0xb5,
+	0x76, 0x9e, 0x5c, 0xb6, 0x62, 0x65, 0xc4, 0xee, 0xdb, 0x6f, 0xbf, 0x3f, 0x56, 0x1f, 0xf5, 0x5c,
+	0x54, 0xfe, 0xf2, 0x22, 0xa2, 0x18, 0x7c, 0xb1, 0xa4, 0xf0, 0x57, 0x08, 0xed, 0x0b, 0x80, 0x37,
+	0xca, 0x2d, 0xec, 0x6f, 0xd4, 0x53, 0x06, 0xef, 0xec, 0x5c, 0x0a, 0xbe, 0xd2, 0xfe, 0x58, 0x69,
+	0x77, 0x7a, 0x68, 0xa3, 0xf6, 0xb4, 0x7c, 0x2d, 0xd8, 0xcf, 0x5d, 0x18, 0xee, 0x9f, 0xce, 0x0d,
+	0x70, 0x36, 0x37, 0xc0, 0xaf, 0xb9, 0x01, 0x3e, 0x2c, 0x8c, 0xca, 0xd9, 0xc2, 0xa8, 0xfc, 0x58,
+	0x18, 0x95, 0x17, 0xfd, 0x82, 0xa5, 0x19, 0x69, 0xf6, 0x9b, 0x38, 0x03, 0x34, 0x2b, 0x36, 0x50,
+	0xee, 0x8e, 0x1b, 0xea, 0x5b, 0xf2, 0xf0, 0xef, 0x00, 0x2f, 0x99, 0xfc, 0x12, 0xfa, 0x04, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateSponsoredIncentive creates an incentive for a contract that is funded
+	// with the sponsor's own coins
+	CreateSponsoredIncentive(ctx context.Context, in *MsgCreateSponsoredIncentive, opts ...grpc.CallOption) (*MsgCreateSponsoredIncentiveResponse, error)
+	// ClaimIncentiveRewards transfers the accumulated rewards of a participant
+	ClaimIncentiveRewards(ctx context.Context, in *MsgClaimIncentiveRewards, opts ...grpc.CallOption) (*MsgClaimIncentiveRewardsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateSponsoredIncentive(ctx context.Context, in *MsgCreateSponsoredIncentive, opts ...grpc.CallOption) (*MsgCreateSponsoredIncentiveResponse, error) {
+	out := new(MsgCreateSponsoredIncentiveResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/CreateSponsoredIncentive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimIncentiveRewards(ctx context.Context, in *MsgClaimIncentiveRewards, opts ...grpc.CallOption) (*MsgClaimIncentiveRewardsResponse, error) {
+	out := new(MsgClaimIncentiveRewardsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/ClaimIncentiveRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateSponsoredIncentive creates an incentive for a contract that is funded
+	// with the sponsor's own coins
+	CreateSponsoredIncentive(context.Context, *MsgCreateSponsoredIncentive) (*MsgCreateSponsoredIncentiveResponse, error)
+	// ClaimIncentiveRewards transfers the accumulated rewards of a participant
+	ClaimIncentiveRewards(context.Context, *MsgClaimIncentiveRewards) (*MsgClaimIncentiveRewardsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateSponsoredIncentive(ctx context.Context, req *MsgCreateSponsoredIncentive) (*MsgCreateSponsoredIncentiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSponsoredIncentive not implemented")
+}
+func (*UnimplementedMsgServer) ClaimIncentiveRewards(ctx context.Context, req *MsgClaimIncentiveRewards) (*MsgClaimIncentiveRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimIncentiveRewards not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateSponsoredIncentive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSponsoredIncentive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSponsoredIncentive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/CreateSponsoredIncentive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSponsoredIncentive(ctx, req.(*MsgCreateSponsoredIncentive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimIncentiveRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimIncentiveRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimIncentiveRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/ClaimIncentiveRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimIncentiveRewards(ctx, req.(*MsgClaimIncentiveRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.incentives.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSponsoredIncentive",
+			Handler:    _Msg_CreateSponsoredIncentive_Handler,
+		},
+		{
+			MethodName: "ClaimIncentiveRewards",
+			Handler:    _Msg_ClaimIncentiveRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/incentives/v1/tx.proto",
+}
+
+func (m *MsgCreateSponsoredIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSponsoredIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSponsoredIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasPerParticipant != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxGasPerParticipant))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RewardCurve != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardCurve))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Epochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSponsoredIncentiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSponsoredIncentiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSponsoredIncentiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimIncentiveRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimIncentiveRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimIncentiveRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AsERC20 {
+		i--
+		if m.AsERC20 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateSponsoredIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovTx(uint64(m.Epochs))
+	}
+	if m.RewardCurve != 0 {
+		n += 1 + sovTx(uint64(m.RewardCurve))
+	}
+	if m.MaxGasPerParticipant != 0 {
+		n += 1 + sovTx(uint64(m.MaxGasPerParticipant))
+	}
+	return n
+}
+
+func (m *MsgCreateSponsoredIncentiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimIncentiveRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AsERC20 {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateSponsoredIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSponsoredIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSponsoredIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardCurve", wireType)
+			}
+			m.RewardCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardCurve |= RewardCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerParticipant", wireType)
+			}
+			m.MaxGasPerParticipant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerParticipant |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSponsoredIncentiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSponsoredIncentiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSponsoredIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimIncentiveRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsERC20", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AsERC20 = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimIncentiveRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -3,14 +3,20 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/evmos/evmos/v10/x/inflation/types"
 )
 
+// FlagBondedRatios defines the bonded ratios assumed by the projection query
+const FlagBondedRatios = "bonded-ratios"
+
 // GetQueryCmd returns the cli query commands for the inflation module.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -27,6 +33,10 @@ func GetQueryCmd() *cobra.Command {
 		GetSkippedEpochs(),
 		GetCirculatingSupply(),
 		GetInflationRate(),
+		GetSchedule(),
+		GetMintRecords(),
+		GetMintRecord(),
+		GetProjection(),
 		GetParams(),
 	)
 
@@ -176,6 +186,168 @@ func GetInflationRate() *cobra.Command {
 	return cmd
 }
 
+// GetSchedule implements a command to return the provisions of the
+// inflation schedule for the next periods
+func GetSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [periods]",
+		Short: "Query the inflation provisions of the next periods, starting with the current one",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryScheduleRequest{}
+			if len(args) > 0 {
+				params.Periods, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.Schedule(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMintRecords implements a command to return the mint records of the past
+// epochs
+func GetMintRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-records",
+		Short: "Query the coins minted and allocated in the past epochs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMintRecordsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.MintRecords(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "mint records")
+
+	return cmd
+}
+
+// GetMintRecord implements a command to return the mint record of an epoch
+func GetMintRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-record EPOCH_NUMBER",
+		Short: "Query the coins minted and allocated in an epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNumber, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryMintRecordRequest{EpochNumber: epochNumber}
+
+			res, err := queryClient.MintRecord(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetProjection implements a command to return the projected inflation
+// provisions of the periods following the current one
+func GetProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projection [periods]",
+		Short: "Query the projected inflation provisions of the periods following the current one",
+		Long: `Query the projected inflation provisions of the periods following the current one.
+The bonded ratios assumed for each period are set with the --bonded-ratios flag. The last
+bonded ratio applies to the remaining periods and the current bonded ratio is used if none is set.`,
+		Example: fmt.Sprintf("%s query %s projection 4 --bonded-ratios 0.5,0.6,0.66", version.AppName, types.ModuleName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryProjectionRequest{}
+			if len(args) > 0 {
+				params.Periods, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			bondedRatios, err := cmd.Flags().GetStringSlice(FlagBondedRatios)
+			if err != nil {
+				return err
+			}
+
+			for _, ratio := range bondedRatios {
+				bondedRatio, err := sdk.NewDecFromStr(ratio)
+				if err != nil {
+					return fmt.Errorf("invalid bonded ratio %s: %w", ratio, err)
+				}
+				params.BondedRatios = append(params.BondedRatios, bondedRatio)
+			}
+
+			res, err := queryClient.Projection(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagBondedRatios, []string{}, "Comma-separated bonded ratios assumed for each period")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetParams implements a command to return the current inflation
 // parameters.
 func GetParams() *cobra.Command {
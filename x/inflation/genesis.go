@@ -35,6 +35,8 @@ func InitGenesis(
 	skippedEpochs := data.SkippedEpochs
 	k.SetSkippedEpochs(ctx, skippedEpochs)
 
+	k.SetEpochOffset(ctx, data.EpochOffset)
+
 	// Get bondedRatio
 	bondedRatio := k.BondedRatio(ctx)
 
@@ -56,5 +58,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		EpochIdentifier: k.GetEpochIdentifier(ctx),
 		EpochsPerPeriod: k.GetEpochsPerPeriod(ctx),
 		SkippedEpochs:   k.GetSkippedEpochs(ctx),
+		EpochOffset:     k.GetEpochOffset(ctx),
 	}
 }
@@ -55,3 +55,21 @@ func (k Keeper) SetSkippedEpochs(ctx sdk.Context, skippedEpochs uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.KeyPrefixSkippedEpochs, sdk.Uint64ToBigEndian(skippedEpochs))
 }
+
+// GetEpochOffset gets the offset of the epoch number used to compute the
+// epochs elapsed in the current period
+func (k Keeper) GetEpochOffset(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixEpochOffset)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetEpochOffset stores the offset of the epoch number
+func (k Keeper) SetEpochOffset(ctx sdk.Context, epochOffset int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixEpochOffset, sdk.Uint64ToBigEndian(uint64(epochOffset)))
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/evmos/evmos/v10/x/inflation/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -11,6 +13,15 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
+const (
+	// defaultSchedulePeriods is the number of periods returned by the Schedule
+	// and Projection queries if unset
+	defaultSchedulePeriods = 10
+	// maxSchedulePeriods is the maximum number of periods returned by the
+	// Schedule and Projection queries
+	maxSchedulePeriods = 100
+)
+
 // Period returns the current period of the inflation module.
 func (k Keeper) Period(
 	c context.Context,
@@ -74,6 +85,119 @@ func (k Keeper) CirculatingSupply(
 	return &types.QueryCirculatingSupplyResponse{CirculatingSupply: coin}, nil
 }
 
+// Schedule returns the provisions of the inflation schedule for the next
+// periods, starting with the current one.
+func (k Keeper) Schedule(
+	c context.Context,
+	req *types.QueryScheduleRequest,
+) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	periods := req.Periods
+	switch {
+	case periods == 0:
+		periods = defaultSchedulePeriods
+	case periods > maxSchedulePeriods:
+		return nil, status.Errorf(codes.InvalidArgument, "periods cannot exceed %d", maxSchedulePeriods)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryScheduleResponse{
+		ScheduleType: k.GetParams(ctx).ScheduleType,
+		Provisions:   k.GetSchedule(ctx, periods),
+	}, nil
+}
+
+// MintRecords returns the mint records of the past epochs.
+func (k Keeper) MintRecords(
+	c context.Context,
+	req *types.QueryMintRecordsRequest,
+) (*types.QueryMintRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.MintRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintRecord)
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_, value []byte) error {
+			var record types.MintRecord
+			if err := k.cdc.Unmarshal(value, &record); err != nil {
+				return err
+			}
+			records = append(records, record)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintRecordsResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MintRecord returns the mint record of an epoch.
+func (k Keeper) MintRecord(
+	c context.Context,
+	req *types.QueryMintRecordRequest,
+) (*types.QueryMintRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := k.GetMintRecord(ctx, req.EpochNumber)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "mint record for epoch %d", req.EpochNumber)
+	}
+
+	return &types.QueryMintRecordResponse{Record: record}, nil
+}
+
+// Projection returns the projected provisions of the periods following the
+// current one for the assumed bonded ratios.
+func (k Keeper) Projection(
+	c context.Context,
+	req *types.QueryProjectionRequest,
+) (*types.QueryProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	periods := req.Periods
+	switch {
+	case periods == 0:
+		periods = defaultSchedulePeriods
+	case periods > maxSchedulePeriods:
+		return nil, status.Errorf(codes.InvalidArgument, "periods cannot exceed %d", maxSchedulePeriods)
+	}
+
+	if uint64(len(req.BondedRatios)) > periods {
+		return nil, status.Errorf(codes.InvalidArgument, "bonded ratios cannot exceed the number of periods %d", periods)
+	}
+
+	for _, bondedRatio := range req.BondedRatios {
+		if bondedRatio.IsNil() || bondedRatio.IsNegative() || bondedRatio.GT(sdk.OneDec()) {
+			return nil, status.Errorf(codes.InvalidArgument, "bonded ratio must be between 0 and 1: %s", bondedRatio)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryProjectionResponse{
+		Projections: k.GetProjection(ctx, periods, req.BondedRatios),
+	}, nil
+}
+
 // Params returns params of the mint module.
 func (k Keeper) Params(
 	c context.Context,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/evmos/v10/x/inflation/types"
@@ -209,3 +210,201 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQuerySchedule() {
+	var (
+		req    *types.QueryScheduleRequest
+		expRes *types.QueryScheduleResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - too many periods",
+			func() {
+				req = &types.QueryScheduleRequest{Periods: 101}
+			},
+			false,
+		},
+		{
+			"pass - default number of periods",
+			func() {
+				req = &types.QueryScheduleRequest{}
+				expRes = &types.QueryScheduleResponse{}
+			},
+			true,
+		},
+		{
+			"pass - table schedule",
+			func() {
+				params := suite.app.InflationKeeper.GetParams(suite.ctx)
+				params.ScheduleType = types.ScheduleTypeTable
+				params.Schedule = []types.SchedulePoint{
+					{Period: 0, AnnualProvision: sdk.NewDec(365)},
+					{Period: 1, AnnualProvision: sdk.NewDec(730)},
+				}
+				suite.app.InflationKeeper.SetParams(suite.ctx, params)
+				suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 365)
+				suite.app.InflationKeeper.SetEpochMintProvision(suite.ctx, sdk.NewDec(1e18))
+
+				req = &types.QueryScheduleRequest{Periods: 2}
+				expRes = &types.QueryScheduleResponse{
+					ScheduleType: types.ScheduleTypeTable,
+					Provisions: []types.PeriodProvision{
+						{
+							Period:             0,
+							PeriodProvision:    sdk.NewDecCoinFromDec(denomMint, sdk.NewDec(365).MulInt64(1e18)),
+							EpochMintProvision: sdk.NewDecCoinFromDec(denomMint, sdk.NewDec(1e18)),
+						},
+						{
+							Period:             1,
+							PeriodProvision:    sdk.NewDecCoinFromDec(denomMint, sdk.NewDec(730).MulInt64(1e18)),
+							EpochMintProvision: sdk.NewDecCoinFromDec(denomMint, sdk.NewDec(2e18)),
+						},
+					},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.Schedule(ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			if len(expRes.Provisions) == 0 {
+				suite.Require().Len(res.Provisions, 10)
+				suite.Require().Equal(suite.app.InflationKeeper.GetPeriod(suite.ctx), res.Provisions[0].Period)
+				return
+			}
+			suite.Require().Equal(expRes, res)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryMintRecords() {
+	suite.SetupTest() // reset
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.MintRecords(ctx, &types.QueryMintRecordsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Records)
+
+	_, err = suite.queryClient.MintRecord(ctx, &types.QueryMintRecordRequest{EpochNumber: 1})
+	suite.Require().Error(err)
+
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		suite.app.InflationKeeper.SetMintRecord(suite.ctx, types.MintRecord{
+			EpochNumber: epoch,
+			Minted:      sdk.NewCoin(denomMint, sdk.NewInt(epoch)),
+		})
+	}
+
+	res, err = suite.queryClient.MintRecords(ctx, &types.QueryMintRecordsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Records, 2)
+	suite.Require().Equal(int64(1), res.Records[0].EpochNumber)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	recordRes, err := suite.queryClient.MintRecord(ctx, &types.QueryMintRecordRequest{EpochNumber: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(denomMint, sdk.NewInt(2)), recordRes.Record.Minted)
+}
+
+func (suite *KeeperTestSuite) TestQueryProjection() {
+	var (
+		req    *types.QueryProjectionRequest
+		expRes *types.QueryProjectionResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - too many periods",
+			func() {
+				req = &types.QueryProjectionRequest{Periods: 101}
+			},
+			false,
+		},
+		{
+			"fail - more bonded ratios than periods",
+			func() {
+				req = &types.QueryProjectionRequest{
+					Periods:      1,
+					BondedRatios: []sdk.Dec{sdk.OneDec(), sdk.OneDec()},
+				}
+			},
+			false,
+		},
+		{
+			"fail - bonded ratio above 1",
+			func() {
+				req = &types.QueryProjectionRequest{
+					Periods:      1,
+					BondedRatios: []sdk.Dec{sdk.NewDec(2)},
+				}
+			},
+			false,
+		},
+		{
+			"pass - last bonded ratio applies to the remaining periods",
+			func() {
+				params := suite.app.InflationKeeper.GetParams(suite.ctx)
+				params.ExponentialCalculation.MaxVariance = sdk.NewDecWithPrec(4, 1)
+				suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+				bondedRatios := []sdk.Dec{sdk.ZeroDec(), sdk.NewDecWithPrec(66, 2)}
+				req = &types.QueryProjectionRequest{Periods: 3, BondedRatios: bondedRatios}
+
+				period := suite.app.InflationKeeper.GetPeriod(suite.ctx)
+				epochsPerPeriod := suite.app.InflationKeeper.GetEpochsPerPeriod(suite.ctx)
+				expRes = &types.QueryProjectionResponse{}
+				for i, bondedRatio := range []sdk.Dec{bondedRatios[0], bondedRatios[1], bondedRatios[1]} {
+					provision := types.CalculateEpochMintProvision(params, period+uint64(i)+1, epochsPerPeriod, bondedRatio)
+					expRes.Projections = append(expRes.Projections, types.PeriodProjection{
+						Period:             period + uint64(i) + 1,
+						BondedRatio:        bondedRatio,
+						PeriodProvision:    sdk.NewDecCoinFromDec(denomMint, provision.MulInt64(epochsPerPeriod)),
+						EpochMintProvision: sdk.NewDecCoinFromDec(denomMint, provision),
+					})
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.Projection(ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(expRes, res)
+			suite.Require().True(res.Projections[0].EpochMintProvision.Amount.GT(res.Projections[1].EpochMintProvision.Amount))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -14,8 +15,10 @@ import (
 func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {
 }
 
-// AfterEpochEnd mints and allocates coins at the end of each epoch end
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+// AfterEpochEnd mints and allocates coins at the end of each epoch end. The
+// epochs that were missed by the catch-up policy of the epoch are minted
+// together with the ending epoch.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber, missedEpochs int64) {
 	params := k.GetParams(ctx)
 	skippedEpochs := k.GetSkippedEpochs(ctx)
 
@@ -25,7 +28,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		if epochIdentifier != epochstypes.DayEpochID {
 			return
 		}
-		skippedEpochs++
+		skippedEpochs += uint64(1 + missedEpochs)
 
 		k.SetSkippedEpochs(ctx, skippedEpochs)
 		k.Logger(ctx).Debug(
@@ -33,6 +36,7 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			"height", ctx.BlockHeight(),
 			"epoch-id", epochIdentifier,
 			"epoch-number", epochNumber,
+			"missed-epochs", missedEpochs,
 			"skipped-epochs", skippedEpochs,
 		)
 		return
@@ -49,40 +53,81 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		panic("the epochMintProvision was not found")
 	}
 
-	mintedCoin := sdk.NewCoin(params.MintDenom, epochMintProvision.TruncateInt())
-	staking, incentives, communityPool, err := k.MintAndAllocateInflation(ctx, mintedCoin)
-	if err != nil {
-		panic(err)
-	}
-
 	period := k.GetPeriod(ctx)
 	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	epochOffset := k.GetEpochOffset(ctx)
 	newProvision := epochMintProvision
 
+	mintedCoin := sdk.NewCoin(params.MintDenom, sdk.ZeroInt())
+	var allocations []types.Allocation
+
+	// mintAndAllocate mints the provision accumulated since the last period
+	// change, so that the bonded ratio of a new period accounts for it
+	pendingProvision := sdk.ZeroDec()
+	mintAndAllocate := func() {
+		coin := sdk.NewCoin(params.MintDenom, pendingProvision.TruncateInt())
+		pendingProvision = sdk.ZeroDec()
+
+		allocated, err := k.MintAndAllocateInflation(ctx, coin)
+		if err != nil {
+			panic(err)
+		}
+
+		mintedCoin = mintedCoin.Add(coin)
+		allocations = types.AddAllocations(allocations, allocated)
+	}
+
+	// Mint the provision of each ended epoch, starting with the missed ones.
+	//
 	// If period is passed, update the period and epochMintProvision. A period is
 	// passed if the current epoch number surpasses the epochsPerPeriod for the
 	// current period. Skipped epochs are subtracted to only account for epochs
-	// where inflation minted tokens.
+	// where inflation minted tokens, as well as the epoch offset that accounts
+	// for changes of the epoch duration.
 	//
 	// Examples:
 	// Given, epochNumber = 1, period = 0, epochPerPeriod = 365, skippedEpochs = 0
 	//   => 1 - 365 * 0 - 0 < 365 --- nothing to do here
 	// Given, epochNumber = 741, period = 1, epochPerPeriod = 365, skippedEpochs = 10
 	//   => 741 - 1 * 365 - 10 > 365 --- a period has passed! we change the epochMintProvision and set a new period
-	if epochNumber-epochsPerPeriod*int64(period)-int64(skippedEpochs) > epochsPerPeriod {
-		period++
-		k.SetPeriod(ctx, period)
-		period = k.GetPeriod(ctx)
-		bondedRatio := k.BondedRatio(ctx)
-		newProvision = types.CalculateEpochMintProvision(
-			params,
-			period,
-			epochsPerPeriod,
-			bondedRatio,
-		)
-		k.SetEpochMintProvision(ctx, newProvision)
+	//
+	// If the provision recalculation interval is set, the epochMintProvision is
+	// also recalculated with the current bonded ratio every interval epochs
+	// within a period, changing by at most the max provision change.
+	for epoch := epochNumber - missedEpochs; epoch <= epochNumber; epoch++ {
+		pendingProvision = pendingProvision.Add(newProvision)
+
+		epochsInPeriod := epoch - epochsPerPeriod*int64(period) - int64(skippedEpochs) - epochOffset
+		switch {
+		case epochsInPeriod > epochsPerPeriod:
+			mintAndAllocate()
+
+			period++
+			k.SetPeriod(ctx, period)
+			period = k.GetPeriod(ctx)
+			bondedRatio := k.BondedRatio(ctx)
+			newProvision = types.CalculateEpochMintProvision(
+				params,
+				period,
+				epochsPerPeriod,
+				bondedRatio,
+			)
+			k.SetEpochMintProvision(ctx, newProvision)
+		case params.ProvisionRecalculationInterval > 0 &&
+			epochsInPeriod > 0 &&
+			uint64(epochsInPeriod)%params.ProvisionRecalculationInterval == 0:
+			mintAndAllocate()
+
+			newProvision = k.recalculateEpochMintProvision(ctx, params, epoch, period, epochsPerPeriod, newProvision)
+		}
 	}
 
+	if pendingProvision.IsPositive() {
+		mintAndAllocate()
+	}
+
+	k.recordMint(ctx, epochNumber, missedEpochs, period, mintedCoin, allocations, params.MintRecordsRetention)
+
 	defer func() {
 		if mintedCoin.Amount.IsInt64() {
 			telemetry.IncrCounterWithLabels(
@@ -91,26 +136,14 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
 			)
 		}
-		if staking.AmountOf(mintedCoin.Denom).IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "staking", "total"},
-				float32(staking.AmountOf(mintedCoin.Denom).Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
-		}
-		if incentives.AmountOf(mintedCoin.Denom).IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "incentives", "total"},
-				float32(incentives.AmountOf(mintedCoin.Denom).Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
-		}
-		if communityPool.AmountOf(mintedCoin.Denom).IsInt64() {
-			telemetry.IncrCounterWithLabels(
-				[]string{types.ModuleName, "allocate", "community_pool", "total"},
-				float32(communityPool.AmountOf(mintedCoin.Denom).Int64()),
-				[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
-			)
+		for _, allocation := range allocations {
+			if allocation.Amount.AmountOf(mintedCoin.Denom).IsInt64() {
+				telemetry.IncrCounterWithLabels(
+					[]string{types.ModuleName, "allocate", allocation.Name, "total"},
+					float32(allocation.Amount.AmountOf(mintedCoin.Denom).Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", mintedCoin.Denom)},
+				)
+			}
 		}
 	}()
 
@@ -118,12 +151,107 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 		sdk.NewEvent(
 			types.EventTypeMint,
 			sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochNumber)),
+			sdk.NewAttribute(types.AttributeKeyMissedEpochs, fmt.Sprintf("%d", missedEpochs)),
 			sdk.NewAttribute(types.AttributeKeyEpochProvisions, newProvision.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
 		),
 	)
 }
 
+// recalculateEpochMintProvision recalculates the epochMintProvision of the
+// current period with the current bonded ratio. The change of the provision is
+// bounded by the max provision change param.
+func (k Keeper) recalculateEpochMintProvision(
+	ctx sdk.Context,
+	params types.Params,
+	epochNumber int64,
+	period uint64,
+	epochsPerPeriod int64,
+	epochMintProvision sdk.Dec,
+) sdk.Dec {
+	maxChange := params.MaxProvisionChange
+	if maxChange.IsNil() {
+		maxChange = sdk.ZeroDec()
+	}
+
+	bondedRatio := k.BondedRatio(ctx)
+	targetProvision := types.CalculateEpochMintProvision(
+		params,
+		period,
+		epochsPerPeriod,
+		bondedRatio,
+	)
+
+	newProvision := types.BoundEpochMintProvision(epochMintProvision, targetProvision, maxChange)
+	k.SetEpochMintProvision(ctx, newProvision)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecalculateProvision,
+			sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochNumber)),
+			sdk.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()),
+			sdk.NewAttribute(types.AttributeKeyTargetEpochProvisions, targetProvision.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochProvisions, newProvision.String()),
+		),
+	)
+
+	return newProvision
+}
+
+// AfterEpochDurationChange rescales the epochs per period and the epoch mint
+// provision when the duration of the inflation epoch changes, so that the
+// length of a period and its total provision remain the same. The epoch offset
+// is adjusted so that the elapsed fraction of the current period is kept.
+func (k Keeper) AfterEpochDurationChange(
+	ctx sdk.Context,
+	epochIdentifier string,
+	epochNumber int64,
+	oldDuration, newDuration time.Duration,
+) {
+	if epochIdentifier != k.GetEpochIdentifier(ctx) || oldDuration <= 0 || newDuration <= 0 {
+		return
+	}
+
+	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	if epochsPerPeriod <= 0 {
+		return
+	}
+
+	period := int64(k.GetPeriod(ctx))
+	skippedEpochs := int64(k.GetSkippedEpochs(ctx))
+	epochOffset := k.GetEpochOffset(ctx)
+
+	// ratio of new epochs per old epoch
+	ratio := sdk.NewDec(int64(oldDuration)).QuoInt64(int64(newDuration))
+
+	newEpochsPerPeriod := ratio.MulInt64(epochsPerPeriod).RoundInt64()
+	if newEpochsPerPeriod < 1 {
+		newEpochsPerPeriod = 1
+	}
+
+	elapsed := epochNumber - epochsPerPeriod*period - skippedEpochs - epochOffset
+	newElapsed := ratio.MulInt64(elapsed).RoundInt64()
+	newEpochOffset := epochNumber - newEpochsPerPeriod*period - skippedEpochs - newElapsed
+
+	k.SetEpochsPerPeriod(ctx, newEpochsPerPeriod)
+	k.SetEpochOffset(ctx, newEpochOffset)
+
+	epochMintProvision, found := k.GetEpochMintProvision(ctx)
+	if found {
+		epochMintProvision = epochMintProvision.MulInt64(epochsPerPeriod).QuoInt64(newEpochsPerPeriod)
+		k.SetEpochMintProvision(ctx, epochMintProvision)
+	}
+
+	k.Logger(ctx).Info(
+		"inflation epoch duration changed",
+		"epoch-id", epochIdentifier,
+		"epoch-number", epochNumber,
+		"epochs-per-period", newEpochsPerPeriod,
+		"epoch-offset", newEpochOffset,
+		"epoch-mint-provision", epochMintProvision.String(),
+	)
+}
+
 // ___________________________________________________________________________________________________
 
 // Hooks wrapper struct for incentives keeper
@@ -143,6 +271,15 @@ func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNu
 	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
 }
 
-func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
-	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber, missedEpochs int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber, missedEpochs)
+}
+
+func (h Hooks) AfterEpochDurationChange(
+	ctx sdk.Context,
+	epochIdentifier string,
+	epochNumber int64,
+	oldDuration, newDuration time.Duration,
+) {
+	h.k.AfterEpochDurationChange(ctx, epochIdentifier, epochNumber, oldDuration, newDuration)
 }
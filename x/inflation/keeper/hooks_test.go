@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
 	"github.com/evmos/evmos/v10/x/inflation/types"
 )
@@ -38,9 +40,9 @@ func (suite *KeeperTestSuite) TestEpochIdentifierAfterEpochEnd() {
 
 			feePoolOrigin := suite.app.DistrKeeper.GetFeePool(suite.ctx)
 			suite.app.EpochsKeeper.BeforeEpochStart(futureCtx, tc.epochIdentifier, newHeight)
-			suite.app.EpochsKeeper.AfterEpochEnd(futureCtx, tc.epochIdentifier, newHeight)
+			suite.app.EpochsKeeper.AfterEpochEnd(futureCtx, tc.epochIdentifier, newHeight, 0)
 
-			suite.app.EpochsKeeper.AfterEpochEnd(futureCtx, tc.epochIdentifier, newHeight)
+			suite.app.EpochsKeeper.AfterEpochEnd(futureCtx, tc.epochIdentifier, newHeight, 0)
 
 			// check the distribution happened as well
 			feePoolNew := suite.app.DistrKeeper.GetFeePool(suite.ctx)
@@ -204,7 +206,7 @@ func (suite *KeeperTestSuite) TestPeriodChangesSkippedEpochsAfterEpochEnd() {
 			// Perform Epoch Hooks
 			futureCtx := suite.ctx.WithBlockTime(time.Now().Add(time.Minute))
 			suite.app.EpochsKeeper.BeforeEpochStart(futureCtx, tc.epochIdentifier, tc.height)
-			suite.app.EpochsKeeper.AfterEpochEnd(futureCtx, tc.epochIdentifier, tc.height)
+			suite.app.EpochsKeeper.AfterEpochEnd(futureCtx, tc.epochIdentifier, tc.height, 0)
 			skippedEpochs := suite.app.InflationKeeper.GetSkippedEpochs(suite.ctx)
 			period := suite.app.InflationKeeper.GetPeriod(suite.ctx)
 
@@ -234,3 +236,204 @@ func (suite *KeeperTestSuite) TestPeriodChangesSkippedEpochsAfterEpochEnd() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestAfterEpochDurationChange() {
+	suite.SetupTest()
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.EnableInflation = true
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+	suite.app.InflationKeeper.SetPeriod(suite.ctx, 1)
+	suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 365)
+	provision, found := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+	suite.Require().True(found)
+
+	// other epochs are ignored
+	suite.app.EpochsKeeper.AfterEpochDurationChange(suite.ctx, epochstypes.WeekEpochID, 466, time.Hour*24*7, time.Hour*24)
+	suite.Require().Equal(int64(365), suite.app.InflationKeeper.GetEpochsPerPeriod(suite.ctx))
+	suite.Require().Zero(suite.app.InflationKeeper.GetEpochOffset(suite.ctx))
+
+	// 101 epochs of the second period have elapsed when the daily epoch is halved
+	suite.app.EpochsKeeper.AfterEpochDurationChange(suite.ctx, epochstypes.DayEpochID, 466, time.Hour*24, time.Hour*12)
+	suite.Require().Equal(int64(730), suite.app.InflationKeeper.GetEpochsPerPeriod(suite.ctx))
+	suite.Require().Equal(int64(466-730-202), suite.app.InflationKeeper.GetEpochOffset(suite.ctx))
+	newProvision, _ := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+	suite.Require().Equal(provision.QuoInt64(2), newProvision)
+
+	// the period ends after the remaining 264 days
+	suite.app.EpochsKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 466+528, 0)
+	suite.Require().Equal(uint64(1), suite.app.InflationKeeper.GetPeriod(suite.ctx))
+	suite.app.EpochsKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 466+529, 0)
+	suite.Require().Equal(uint64(2), suite.app.InflationKeeper.GetPeriod(suite.ctx))
+
+	newProvision, _ = suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+	bondedRatio := suite.app.InflationKeeper.BondedRatio(suite.ctx)
+	suite.Require().Equal(types.CalculateEpochMintProvision(params, 2, 730, bondedRatio), newProvision)
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndMissedEpochs() {
+	testCases := []struct {
+		name            string
+		enableInflation bool
+		epochNumber     int64
+		missedEpochs    int64
+		expPeriod       uint64
+		expSkipped      uint64
+		// expProvisions returns the provisions minted per period
+		expProvisions func(oldProvision, newProvision sdk.Dec) []sdk.Dec
+	}{
+		{
+			"inflation disabled - missed epochs are skipped",
+			false,
+			10,
+			4,
+			0,
+			5,
+			func(_, _ sdk.Dec) []sdk.Dec { return nil },
+		},
+		{
+			"missed epochs within the period",
+			true,
+			10,
+			4,
+			0,
+			0,
+			func(oldProvision, _ sdk.Dec) []sdk.Dec {
+				return []sdk.Dec{oldProvision.MulInt64(5)}
+			},
+		},
+		{
+			"missed epochs across a period change",
+			true,
+			368,
+			5,
+			1,
+			0,
+			func(oldProvision, newProvision sdk.Dec) []sdk.Dec {
+				return []sdk.Dec{oldProvision.MulInt64(4), newProvision.MulInt64(2)}
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			params := suite.app.InflationKeeper.GetParams(suite.ctx)
+			params.EnableInflation = tc.enableInflation
+			suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+			suite.app.InflationKeeper.SetPeriod(suite.ctx, 0)
+			suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 365)
+			oldProvision, found := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+			suite.Require().True(found)
+			supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, denomMint)
+
+			suite.app.EpochsKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, tc.epochNumber, tc.missedEpochs)
+
+			suite.Require().Equal(tc.expPeriod, suite.app.InflationKeeper.GetPeriod(suite.ctx))
+			suite.Require().Equal(tc.expSkipped, suite.app.InflationKeeper.GetSkippedEpochs(suite.ctx))
+
+			newProvision, _ := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+			expMinted := sdk.ZeroInt()
+			for _, provision := range tc.expProvisions(oldProvision, newProvision) {
+				expMinted = expMinted.Add(provision.TruncateInt())
+			}
+			supplyAfter := suite.app.BankKeeper.GetSupply(suite.ctx, denomMint)
+			suite.Require().Equal(expMinted, supplyAfter.Amount.Sub(supplyBefore.Amount))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndTableSchedule() {
+	suite.SetupTest()
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.EnableInflation = true
+	params.ScheduleType = types.ScheduleTypeTable
+	params.Schedule = []types.SchedulePoint{
+		{Period: 0, AnnualProvision: sdk.NewDec(365_000_000)},
+		{Period: 1, AnnualProvision: sdk.NewDec(182_500_000)},
+	}
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+	suite.app.InflationKeeper.SetPeriod(suite.ctx, 0)
+	suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 365)
+
+	// the period ends after 365 epochs
+	suite.app.EpochsKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 366, 0)
+	suite.Require().Equal(uint64(1), suite.app.InflationKeeper.GetPeriod(suite.ctx))
+
+	provision, found := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(500_000).MulInt64(1e18), provision)
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndProvisionRecalculation() {
+	testCases := []struct {
+		name           string
+		interval       uint64
+		epochNumber    int64
+		expRecalculate bool
+	}{
+		{
+			"recalculation disabled",
+			0,
+			10,
+			false,
+		},
+		{
+			"epoch before the recalculation",
+			10,
+			9,
+			false,
+		},
+		{
+			"recalculation within the period",
+			10,
+			10,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			params := suite.app.InflationKeeper.GetParams(suite.ctx)
+			params.ExponentialCalculation.MaxVariance = sdk.NewDecWithPrec(40, 2)
+			params.ProvisionRecalculationInterval = tc.interval
+			params.MaxProvisionChange = sdk.NewDecWithPrec(5, 2)
+			suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+			suite.app.InflationKeeper.SetPeriod(suite.ctx, 0)
+			suite.app.InflationKeeper.SetEpochsPerPeriod(suite.ctx, 365)
+			oldProvision, found := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+			suite.Require().True(found)
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			suite.app.EpochsKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, tc.epochNumber, 0)
+
+			newProvision, _ := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+			recalculated := false
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeRecalculateProvision {
+					recalculated = true
+				}
+			}
+			suite.Require().Equal(tc.expRecalculate, recalculated)
+
+			if !tc.expRecalculate {
+				suite.Require().Equal(oldProvision, newProvision)
+				return
+			}
+
+			targetProvision := types.CalculateEpochMintProvision(
+				params,
+				0,
+				365,
+				suite.app.InflationKeeper.BondedRatio(suite.ctx),
+			)
+			suite.Require().NotEqual(oldProvision, targetProvision)
+			suite.Require().Equal(types.BoundEpochMintProvision(oldProvision, targetProvision, params.MaxProvisionChange), newProvision)
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethermint "github.com/evmos/ethermint/types"
 
 	evmos "github.com/evmos/evmos/v10/types"
@@ -18,16 +22,16 @@ func (k Keeper) MintAndAllocateInflation(
 	ctx sdk.Context,
 	coin sdk.Coin,
 ) (
-	staking, incentives, communityPool sdk.Coins,
+	allocations []types.Allocation,
 	err error,
 ) {
 	// Mint coins for distribution
 	if err := k.MintCoins(ctx, coin); err != nil {
-		return nil, nil, nil, err
+		return nil, err
 	}
 
 	// Allocate minted coins according to allocation proportions (staking, usage
-	// incentives, community pool)
+	// incentives, community pool or the distribution recipients)
 	return k.AllocateExponentialInflation(ctx, coin)
 }
 
@@ -49,54 +53,110 @@ func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 //   - staking rewards -> sdk `auth` module fee collector
 //   - usage incentives -> `x/incentives` module
 //   - community pool -> `sdk `distr` module community pool
+//
+// If the distribution defines recipients, the coins are allocated to them
+// instead. The last recipient receives the remaining module balance. The
+// coins of a recipient that can't receive them, e.g. a blocked address or an
+// unknown module account, are allocated to the community pool.
 func (k Keeper) AllocateExponentialInflation(
 	ctx sdk.Context,
 	mintedCoin sdk.Coin,
 ) (
-	staking, incentives, communityPool sdk.Coins,
+	allocations []types.Allocation,
 	err error,
 ) {
 	params := k.GetParams(ctx)
-	proportions := params.InflationDistribution
-
-	// Allocate staking rewards into fee collector account
-	staking = sdk.NewCoins(k.GetProportions(ctx, mintedCoin, proportions.StakingRewards))
-	err = k.bankKeeper.SendCoinsFromModuleToModule(
-		ctx,
-		types.ModuleName,
-		k.feeCollectorName,
-		staking,
-	)
-	if err != nil {
-		return nil, nil, nil, err
+	recipients := k.GetInflationRecipients(params.InflationDistribution)
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	allocations = make([]types.Allocation, len(recipients))
+	for i, recipient := range recipients {
+		var amount sdk.Coins
+		if i == len(recipients)-1 {
+			// Allocate the remaining module balance to the last recipient
+			amount = k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+		} else {
+			amount = sdk.NewCoins(k.GetProportions(ctx, mintedCoin, recipient.Weight))
+		}
+
+		address := recipient.Address
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.allocateToRecipient(cacheCtx, address, amount); err != nil {
+			k.Logger(ctx).Error(
+				"failed to allocate inflation to recipient, allocating to the community pool",
+				"recipient", recipient.Name,
+				"address", address,
+				"error", err.Error(),
+			)
+
+			address = distrtypes.ModuleName
+			if err := k.allocateToRecipient(ctx, address, amount); err != nil {
+				return nil, err
+			}
+		} else {
+			writeCache()
+		}
+
+		allocations[i] = types.Allocation{
+			Name:    recipient.Name,
+			Address: address,
+			Amount:  amount,
+		}
 	}
 
-	// Allocate usage incentives to incentives module account
-	incentives = sdk.NewCoins(k.GetProportions(ctx, mintedCoin, proportions.UsageIncentives))
-	err = k.bankKeeper.SendCoinsFromModuleToModule(
-		ctx,
-		types.ModuleName,
-		incentivestypes.ModuleName,
-		incentives,
-	)
-	if err != nil {
-		return nil, nil, nil, err
+	return allocations, nil
+}
+
+// GetInflationRecipients returns the recipients of the inflation distribution.
+// If the distribution doesn't define recipients, the staking rewards, usage
+// incentives and community pool proportions are returned as recipients.
+func (k Keeper) GetInflationRecipients(distribution types.InflationDistribution) []types.InflationRecipient {
+	if len(distribution.Recipients) > 0 {
+		return distribution.Recipients
 	}
 
-	// Allocate community pool amount (remaining module balance) to community
-	// pool address
-	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
-	communityPool = k.bankKeeper.GetAllBalances(ctx, moduleAddr)
-	err = k.distrKeeper.FundCommunityPool(
-		ctx,
-		communityPool,
-		moduleAddr,
-	)
-	if err != nil {
-		return nil, nil, nil, err
+	return []types.InflationRecipient{
+		{Name: types.RecipientStaking, Address: k.feeCollectorName, Weight: distribution.StakingRewards},
+		{Name: types.RecipientIncentives, Address: incentivestypes.ModuleName, Weight: distribution.UsageIncentives},
+		{Name: types.RecipientCommunityPool, Address: distrtypes.ModuleName, Weight: distribution.CommunityPool},
+	}
+}
+
+// allocateToRecipient sends coins from the inflation module to a recipient
+// address, which is either a module account name, a bech32 address or a hex
+// address. Coins allocated to the distribution module fund the community pool.
+func (k Keeper) allocateToRecipient(ctx sdk.Context, address string, coins sdk.Coins) error {
+	switch {
+	case address == distrtypes.ModuleName:
+		return k.distrKeeper.FundCommunityPool(
+			ctx,
+			coins,
+			k.accountKeeper.GetModuleAddress(types.ModuleName),
+		)
+	case common.IsHexAddress(address):
+		recipient := sdk.AccAddress(common.HexToAddress(address).Bytes())
+		return k.sendToAccount(ctx, recipient, coins)
+	}
+
+	if recipient, err := sdk.AccAddressFromBech32(address); err == nil {
+		return k.sendToAccount(ctx, recipient, coins)
+	}
+
+	if k.accountKeeper.GetModuleAddress(address) == nil {
+		return fmt.Errorf("inflation recipient module account %s does not exist", address)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, address, coins)
+}
+
+// sendToAccount sends coins from the inflation module to an account address
+// that is not blocked from receiving funds
+func (k Keeper) sendToAccount(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) error {
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return fmt.Errorf("inflation recipient %s is not allowed to receive funds", recipient)
 	}
 
-	return staking, incentives, communityPool, nil
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
 }
 
 // GetAllocationProportion calculates the proportion of coins that is to be
@@ -169,3 +229,69 @@ func (k Keeper) GetInflationRate(ctx sdk.Context) sdk.Dec {
 	// EpochMintProvision * 365 / circulatingSupply * 100
 	return epochMintProvision.Mul(epochsPerPeriod).Quo(circulatingSupply).Mul(sdk.NewDec(100))
 }
+
+// GetSchedule returns the provisions of the given number of periods, starting
+// with the current one. The provision of the current period is the stored
+// epoch mint provision, while the provisions of the next periods are
+// calculated with the current bonded ratio.
+func (k Keeper) GetSchedule(ctx sdk.Context, periods uint64) []types.PeriodProvision {
+	params := k.GetParams(ctx)
+	period := k.GetPeriod(ctx)
+	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	if epochsPerPeriod <= 0 {
+		return []types.PeriodProvision{}
+	}
+
+	bondedRatio := k.BondedRatio(ctx)
+	schedule := make([]types.PeriodProvision, 0, periods)
+
+	for i := uint64(0); i < periods; i++ {
+		epochMintProvision, found := k.GetEpochMintProvision(ctx)
+		if i > 0 || !found {
+			epochMintProvision = types.CalculateEpochMintProvision(params, period+i, epochsPerPeriod, bondedRatio)
+		}
+
+		schedule = append(schedule, types.PeriodProvision{
+			Period:             period + i,
+			PeriodProvision:    sdk.NewDecCoinFromDec(params.MintDenom, epochMintProvision.MulInt64(epochsPerPeriod)),
+			EpochMintProvision: sdk.NewDecCoinFromDec(params.MintDenom, epochMintProvision),
+		})
+	}
+
+	return schedule
+}
+
+// GetProjection returns the provisions of the periods following the current
+// one, calculated with the assumed bonded ratio of each period. The last
+// bonded ratio applies to the remaining periods and the current bonded ratio
+// is used if none is given.
+func (k Keeper) GetProjection(ctx sdk.Context, periods uint64, bondedRatios []sdk.Dec) []types.PeriodProjection {
+	params := k.GetParams(ctx)
+	period := k.GetPeriod(ctx)
+	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	if epochsPerPeriod <= 0 {
+		return []types.PeriodProjection{}
+	}
+
+	if len(bondedRatios) == 0 {
+		bondedRatios = []sdk.Dec{k.BondedRatio(ctx)}
+	}
+
+	projections := make([]types.PeriodProjection, 0, periods)
+	for i := uint64(0); i < periods; i++ {
+		bondedRatio := bondedRatios[len(bondedRatios)-1]
+		if i < uint64(len(bondedRatios)) {
+			bondedRatio = bondedRatios[i]
+		}
+
+		epochMintProvision := types.CalculateEpochMintProvision(params, period+i+1, epochsPerPeriod, bondedRatio)
+		projections = append(projections, types.PeriodProjection{
+			Period:             period + i + 1,
+			BondedRatio:        bondedRatio,
+			PeriodProvision:    sdk.NewDecCoinFromDec(params.MintDenom, epochMintProvision.MulInt64(epochsPerPeriod)),
+			EpochMintProvision: sdk.NewDecCoinFromDec(params.MintDenom, epochMintProvision),
+		})
+	}
+
+	return projections
+}
@@ -5,6 +5,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/evmos/ethermint/tests"
 	ethermint "github.com/evmos/ethermint/types"
 	incentivestypes "github.com/evmos/evmos/v10/x/incentives/types"
 	"github.com/evmos/evmos/v10/x/inflation/types"
@@ -45,7 +48,7 @@ func (suite *KeeperTestSuite) TestMintAndAllocateInflation() {
 
 			tc.malleate()
 
-			_, _, _, err := suite.app.InflationKeeper.MintAndAllocateInflation(suite.ctx, tc.mintCoin)
+			_, err := suite.app.InflationKeeper.MintAndAllocateInflation(suite.ctx, tc.mintCoin)
 
 			// Get balances
 			balanceModule := suite.app.BankKeeper.GetBalance(
@@ -83,6 +86,109 @@ func (suite *KeeperTestSuite) TestMintAndAllocateInflation() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestAllocateInflationRecipients() {
+	contract := tests.GenerateAddress()
+
+	testCases := []struct {
+		name          string
+		recipients    []types.InflationRecipient
+		expContract   sdk.Coin
+		expIncentives sdk.Coin
+		expCommunity  sdk.DecCoins
+		expPass       bool
+	}{
+		{
+			"pass - module, hex address and community pool recipients",
+			[]types.InflationRecipient{
+				{Name: "incentives", Address: incentivestypes.ModuleName, Weight: sdk.NewDecWithPrec(5, 1)},
+				{Name: "grants", Address: contract.Hex(), Weight: sdk.NewDecWithPrec(25, 2)},
+				{Name: "community_pool", Address: distrtypes.ModuleName, Weight: sdk.NewDecWithPrec(25, 2)},
+			},
+			sdk.NewCoin(denomMint, sdk.NewInt(250_000)),
+			sdk.NewCoin(denomMint, sdk.NewInt(500_000)),
+			sdk.NewDecCoins(sdk.NewDecCoin(denomMint, sdk.NewInt(250_000))),
+			true,
+		},
+		{
+			"pass - last recipient receives the remainder",
+			[]types.InflationRecipient{
+				{Name: "community_pool", Address: distrtypes.ModuleName, Weight: sdk.NewDecWithPrec(333333, 6)},
+				{Name: "grants", Address: sdk.AccAddress(contract.Bytes()).String(), Weight: sdk.NewDecWithPrec(666667, 6)},
+			},
+			sdk.NewCoin(denomMint, sdk.NewInt(666_667)),
+			sdk.NewCoin(denomMint, sdk.ZeroInt()),
+			sdk.NewDecCoins(sdk.NewDecCoin(denomMint, sdk.NewInt(333_333))),
+			true,
+		},
+		{
+			"pass - unknown module account falls back to the community pool",
+			[]types.InflationRecipient{
+				{Name: "incentives", Address: incentivestypes.ModuleName, Weight: sdk.NewDecWithPrec(5, 1)},
+				{Name: "unknown", Address: "unknown", Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+			sdk.NewCoin(denomMint, sdk.ZeroInt()),
+			sdk.NewCoin(denomMint, sdk.NewInt(500_000)),
+			sdk.NewDecCoins(sdk.NewDecCoin(denomMint, sdk.NewInt(500_000))),
+			true,
+		},
+		{
+			"pass - blocked address falls back to the community pool",
+			[]types.InflationRecipient{
+				{Name: "bonded_pool", Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(), Weight: sdk.OneDec()},
+			},
+			sdk.NewCoin(denomMint, sdk.ZeroInt()),
+			sdk.NewCoin(denomMint, sdk.ZeroInt()),
+			sdk.NewDecCoins(sdk.NewDecCoin(denomMint, sdk.NewInt(1_000_000))),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.InflationKeeper.GetParams(suite.ctx)
+			params.InflationDistribution = types.InflationDistribution{
+				StakingRewards:  sdk.ZeroDec(),
+				UsageIncentives: sdk.ZeroDec(),
+				CommunityPool:   sdk.ZeroDec(),
+				Recipients:      tc.recipients,
+			}
+			suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+			mintCoin := sdk.NewCoin(denomMint, sdk.NewInt(1_000_000))
+			allocations, err := suite.app.InflationKeeper.MintAndAllocateInflation(suite.ctx, mintCoin)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(allocations, len(tc.recipients))
+			for i, allocation := range allocations {
+				suite.Require().Equal(tc.recipients[i].Name, allocation.Name)
+			}
+
+			balanceModule := suite.app.BankKeeper.GetBalance(
+				suite.ctx,
+				suite.app.AccountKeeper.GetModuleAddress(types.ModuleName),
+				denomMint,
+			)
+			suite.Require().True(balanceModule.IsZero())
+
+			balanceContract := suite.app.BankKeeper.GetBalance(suite.ctx, contract.Bytes(), denomMint)
+			suite.Require().Equal(tc.expContract, balanceContract)
+
+			balanceIncentives := suite.app.BankKeeper.GetBalance(
+				suite.ctx,
+				suite.app.AccountKeeper.GetModuleAddress(incentivestypes.ModuleName),
+				denomMint,
+			)
+			suite.Require().Equal(tc.expIncentives, balanceIncentives)
+			suite.Require().Equal(tc.expCommunity, suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetCirculatingSupplyAndInflationRate() {
 	testCases := []struct {
 		name             string
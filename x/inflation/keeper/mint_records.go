@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/x/inflation/types"
+)
+
+// GetMintRecord returns the mint record of an epoch
+func (k Keeper) GetMintRecord(ctx sdk.Context, epochNumber int64) (types.MintRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintRecord)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(epochNumber)))
+	if len(bz) == 0 {
+		return types.MintRecord{}, false
+	}
+
+	var record types.MintRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetMintRecord stores the mint record of an epoch
+func (k Keeper) SetMintRecord(ctx sdk.Context, record types.MintRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintRecord)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(sdk.Uint64ToBigEndian(uint64(record.EpochNumber)), bz)
+}
+
+// IterateMintRecords iterates over all mint records, sorted by epoch number,
+// and performs a callback function
+func (k Keeper) IterateMintRecords(
+	ctx sdk.Context,
+	handlerFn func(record types.MintRecord) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixMintRecord)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MintRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if handlerFn(record) {
+			break
+		}
+	}
+}
+
+// PruneMintRecords deletes the mint records of the epochs that are older than
+// the retention, counted from the given epoch number. All mint records are
+// deleted if the retention is zero.
+func (k Keeper) PruneMintRecords(ctx sdk.Context, epochNumber int64, retention uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMintRecord)
+
+	// delete the records of the epochs lower or equal to epochNumber - retention
+	end := epochNumber - int64(retention) + 1
+	if end <= 0 {
+		return
+	}
+
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(end)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// recordMint stores the mint record of an ended epoch and prunes the records
+// that exceed the retention
+func (k Keeper) recordMint(
+	ctx sdk.Context,
+	epochNumber, missedEpochs int64,
+	period uint64,
+	minted sdk.Coin,
+	allocations []types.Allocation,
+	retention uint64,
+) {
+	if retention > 0 {
+		k.SetMintRecord(ctx, types.MintRecord{
+			EpochNumber:  epochNumber,
+			MissedEpochs: missedEpochs,
+			Period:       period,
+			Height:       ctx.BlockHeight(),
+			Time:         ctx.BlockTime(),
+			Minted:       minted,
+			Allocations:  allocations,
+		})
+	}
+
+	k.PruneMintRecords(ctx, epochNumber, retention)
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
+	"github.com/evmos/evmos/v10/x/inflation/types"
+)
+
+func (suite *KeeperTestSuite) TestSetGetMintRecord() {
+	expRecord := types.MintRecord{
+		EpochNumber: 3,
+		Period:      1,
+		Minted:      sdk.NewCoin(denomMint, sdk.NewInt(100)),
+		Allocations: []types.Allocation{
+			{Name: "staking", Address: "fee_collector", Amount: sdk.NewCoins(sdk.NewCoin(denomMint, sdk.NewInt(100)))},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		ok       bool
+	}{
+		{
+			"no record",
+			func() {},
+			false,
+		},
+		{
+			"record set",
+			func() {
+				suite.app.InflationKeeper.SetMintRecord(suite.ctx, expRecord)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			record, found := suite.app.InflationKeeper.GetMintRecord(suite.ctx, expRecord.EpochNumber)
+			suite.Require().Equal(tc.ok, found, tc.name)
+			if tc.ok {
+				suite.Require().Equal(expRecord.Minted, record.Minted, tc.name)
+				suite.Require().Equal(expRecord.Allocations, record.Allocations, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneMintRecords() {
+	testCases := []struct {
+		name        string
+		epochNumber int64
+		retention   uint64
+		expEpochs   []int64
+	}{
+		{
+			"records within the retention",
+			5,
+			10,
+			[]int64{1, 2, 3, 4, 5},
+		},
+		{
+			"records exceeding the retention",
+			5,
+			2,
+			[]int64{4, 5},
+		},
+		{
+			"zero retention",
+			5,
+			0,
+			nil,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			for epoch := int64(1); epoch <= 5; epoch++ {
+				suite.app.InflationKeeper.SetMintRecord(suite.ctx, types.MintRecord{EpochNumber: epoch})
+			}
+
+			suite.app.InflationKeeper.PruneMintRecords(suite.ctx, tc.epochNumber, tc.retention)
+
+			var epochs []int64
+			suite.app.InflationKeeper.IterateMintRecords(suite.ctx, func(record types.MintRecord) bool {
+				epochs = append(epochs, record.EpochNumber)
+				return false
+			})
+			suite.Require().Equal(tc.expEpochs, epochs)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndMintRecords() {
+	suite.SetupTest()
+
+	params := suite.app.InflationKeeper.GetParams(suite.ctx)
+	params.MintRecordsRetention = 2
+	suite.app.InflationKeeper.SetParams(suite.ctx, params)
+
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		suite.app.EpochsKeeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, epoch, 0)
+	}
+
+	_, found := suite.app.InflationKeeper.GetMintRecord(suite.ctx, 1)
+	suite.Require().False(found)
+
+	provision, _ := suite.app.InflationKeeper.GetEpochMintProvision(suite.ctx)
+	record, found := suite.app.InflationKeeper.GetMintRecord(suite.ctx, 3)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.ctx.BlockHeight(), record.Height)
+	suite.Require().Equal(sdk.NewCoin(denomMint, provision.TruncateInt()), record.Minted)
+	suite.Require().Len(record.Allocations, 3)
+
+	allocated := sdk.NewCoins()
+	for _, allocation := range record.Allocations {
+		allocated = allocated.Add(allocation.Amount...)
+	}
+	suite.Require().Equal(sdk.NewCoins(record.Minted), allocated)
+}
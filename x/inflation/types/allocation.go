@@ -0,0 +1,22 @@
+package types
+
+// AddAllocations adds the amounts of the allocations to the allocations with
+// the same recipient name. Allocations to new recipients are appended.
+func AddAllocations(allocations, added []Allocation) []Allocation {
+	for _, allocation := range added {
+		found := false
+		for i := range allocations {
+			if allocations[i].Name == allocation.Name {
+				allocations[i].Amount = allocations[i].Amount.Add(allocation.Amount...)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			allocations = append(allocations, allocation)
+		}
+	}
+
+	return allocations
+}
@@ -2,8 +2,12 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint                 = ModuleName
+	EventTypeRecalculateProvision = "recalculate_epoch_mint_provision"
 
-	AttributeKeyEpochProvisions = "epoch_provisions"
-	AttributeEpochNumber        = "epoch_number"
+	AttributeKeyEpochProvisions       = "epoch_provisions"
+	AttributeEpochNumber              = "epoch_number"
+	AttributeKeyMissedEpochs          = "missed_epochs"
+	AttributeKeyBondedRatio           = "bonded_ratio"
+	AttributeKeyTargetEpochProvisions = "target_epoch_provisions"
 )
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -35,6 +36,10 @@ type GenesisState struct {
 	EpochsPerPeriod int64 `protobuf:"varint,4,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
 	// skipped_epochs is the number of epochs that have passed while inflation is disabled
 	SkippedEpochs uint64 `protobuf:"varint,5,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+	// epoch_offset is subtracted from the epoch number, in addition to the skipped
+	// epochs, to compute the epochs elapsed in the current period. It is adjusted
+	// when the duration of the inflation epoch changes.
+	EpochOffset int64 `protobuf:"varint,6,opt,name=epoch_offset,json=epochOffset,proto3" json:"epoch_offset,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -105,6 +110,13 @@ func (m *GenesisState) GetSkippedEpochs() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetEpochOffset() int64 {
+	if m != nil {
+		return m.EpochOffset
+	}
+	return 0
+}
+
 // Params holds parameters for the inflation module.
 type Params struct {
 	// mint_denom specifies the type of coin to mint
@@ -115,6 +127,22 @@ type Params struct {
 	InflationDistribution InflationDistribution `protobuf:"bytes,3,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
 	// enable_inflation is the parameter that enables inflation and halts increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,4,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// schedule_type defines how the provision of each period is calculated
+	ScheduleType InflationScheduleType `protobuf:"varint,5,opt,name=schedule_type,json=scheduleType,proto3,enum=evmos.inflation.v1.InflationScheduleType" json:"schedule_type,omitempty"`
+	// schedule defines the annual provisions of the table and piecewise-linear
+	// schedule types, sorted by period
+	Schedule []SchedulePoint `protobuf:"bytes,6,rep,name=schedule,proto3" json:"schedule"`
+	// mint_records_retention is the number of epochs for which the mint records
+	// are kept. Mint records are disabled if it is zero.
+	MintRecordsRetention uint64 `protobuf:"varint,7,opt,name=mint_records_retention,json=mintRecordsRetention,proto3" json:"mint_records_retention,omitempty"`
+	// provision_recalculation_interval is the number of epochs after which the
+	// epoch mint provision is recalculated with the current bonded ratio within a
+	// period. The provision is only recalculated at the start of a period if it
+	// is zero.
+	ProvisionRecalculationInterval uint64 `protobuf:"varint,8,opt,name=provision_recalculation_interval,json=provisionRecalculationInterval,proto3" json:"provision_recalculation_interval,omitempty"`
+	// max_provision_change is the maximum change of the epoch mint provision on
+	// each recalculation within a period, relative to the current provision
+	MaxProvisionChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_provision_change,json=maxProvisionChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_provision_change"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -178,6 +206,34 @@ func (m *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (m *Params) GetScheduleType() InflationScheduleType {
+	if m != nil {
+		return m.ScheduleType
+	}
+	return ScheduleTypeExponential
+}
+
+func (m *Params) GetSchedule() []SchedulePoint {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *Params) GetMintRecordsRetention() uint64 {
+	if m != nil {
+		return m.MintRecordsRetention
+	}
+	return 0
+}
+
+func (m *Params) GetProvisionRecalculationInterval() uint64 {
+	if m != nil {
+		return m.ProvisionRecalculationInterval
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.inflation.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.inflation.v1.Params")
@@ -186,33 +242,45 @@ func init() {
 func init() { proto.RegisterFile("evmos/inflation/v1/genesis.proto", fileDescriptor_1cb8eee530db1235) }
 
 var fileDescriptor_1cb8eee530db1235 = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x36, 0xc1, 0x22, 0x5b, 0xa0, 0xb0, 0x82, 0x60, 0x45, 0xc2, 0x58, 0x91, 0x90, 0xdc,
-	0x0a, 0xd9, 0xa4, 0x5c, 0x38, 0x97, 0x16, 0xd4, 0x5b, 0x64, 0x6e, 0x5c, 0x56, 0xfe, 0x98, 0xb8,
-	0x2b, 0xec, 0xdd, 0x95, 0x77, 0x63, 0x95, 0x7f, 0xc1, 0x9f, 0xe0, 0xbf, 0xf4, 0xd8, 0x23, 0xa7,
-	0x0a, 0x25, 0x7f, 0x04, 0x79, 0xd7, 0x38, 0x95, 0xea, 0x8b, 0xe5, 0x7d, 0xef, 0xcd, 0x7b, 0x33,
-	0xa3, 0xc1, 0x3e, 0x34, 0x95, 0x50, 0x11, 0xe3, 0xeb, 0x32, 0xd1, 0x4c, 0xf0, 0xa8, 0x59, 0x46,
-	0x05, 0x70, 0x50, 0x4c, 0x85, 0xb2, 0x16, 0x5a, 0x10, 0x62, 0x14, 0x61, 0xaf, 0x08, 0x9b, 0xe5,
-	0xfc, 0x65, 0x21, 0x0a, 0x61, 0xe8, 0xa8, 0xfd, 0xb3, 0xca, 0xf9, 0x62, 0xc0, 0x6b, 0x5f, 0x66,
-	0x34, 0x8b, 0x3b, 0x84, 0x9f, 0x7c, 0xb5, 0xfe, 0xdf, 0x74, 0xa2, 0x81, 0x7c, 0xc2, 0x8e, 0x4c,
-	0xea, 0xa4, 0x52, 0x2e, 0xf2, 0x51, 0x70, 0x78, 0x3a, 0x0f, 0x1f, 0xe6, 0x85, 0x2b, 0xa3, 0x38,
-	0x9b, 0xdc, 0xdc, 0xbd, 0x1d, 0xc5, 0x9d, 0x9e, 0xcc, 0xb0, 0x23, 0xa1, 0x66, 0x22, 0x77, 0x0f,
-	0x7c, 0x14, 0x4c, 0xe2, 0xee, 0x45, 0x8e, 0xf1, 0x73, 0x90, 0x22, 0xbb, 0xa2, 0x2c, 0x07, 0xae,
-	0xd9, 0x9a, 0x41, 0xed, 0x8e, 0x7d, 0x14, 0x4c, 0xe3, 0x23, 0x83, 0x5f, 0xf6, 0x30, 0x39, 0xc1,
-	0x2f, 0x0c, 0xa4, 0xa8, 0x84, 0x9a, 0x76, 0x6e, 0x13, 0x1f, 0x05, 0xe3, 0x4e, 0xab, 0x56, 0x50,
-	0xaf, 0xac, 0xed, 0x3b, 0xfc, 0x4c, 0xfd, 0x60, 0x52, 0x42, 0x4e, 0x2d, 0xe5, 0x3e, 0x32, 0xb1,
-	0x4f, 0x3b, 0xf4, 0xc2, 0x80, 0x8b, 0xdf, 0x07, 0xd8, 0xb1, 0xed, 0x92, 0x37, 0x18, 0x57, 0x8c,
-	0x6b, 0x9a, 0x03, 0x17, 0x95, 0x19, 0x6f, 0x1a, 0x4f, 0x5b, 0xe4, 0xbc, 0x05, 0x08, 0xc3, 0xaf,
-	0xe1, 0x5a, 0x0a, 0xde, 0x76, 0x93, 0x94, 0x34, 0x4b, 0xca, 0x6c, 0x63, 0x47, 0x36, 0x03, 0x1d,
-	0x9e, 0x9e, 0x0c, 0xad, 0xe2, 0x62, 0x5f, 0xf2, 0x79, 0x5f, 0xd1, 0xad, 0x66, 0x06, 0x83, 0x2c,
-	0x59, 0xe3, 0x59, 0x6f, 0x42, 0x73, 0xa6, 0x74, 0xcd, 0xd2, 0x8d, 0x49, 0x1a, 0x9b, 0xa4, 0xe3,
-	0xa1, 0xa4, 0xcb, 0xff, 0x8f, 0xf3, 0x7b, 0x05, 0x5d, 0xd0, 0x2b, 0x36, 0x44, 0x9a, 0xd5, 0xf3,
-	0x24, 0x2d, 0x81, 0xf6, 0xbc, 0x59, 0xe7, 0xe3, 0xf8, 0xc8, 0xe2, 0xbd, 0xe7, 0xd9, 0x97, 0x9b,
-	0xad, 0x87, 0x6e, 0xb7, 0x1e, 0xfa, 0xbb, 0xf5, 0xd0, 0xaf, 0x9d, 0x37, 0xba, 0xdd, 0x79, 0xa3,
-	0x3f, 0x3b, 0x6f, 0xf4, 0xfd, 0x7d, 0xc1, 0xf4, 0xd5, 0x26, 0x0d, 0x33, 0x51, 0x45, 0xf6, 0xa2,
-	0xec, 0xb7, 0x59, 0x7e, 0x88, 0xae, 0xef, 0x5d, 0x97, 0xfe, 0x29, 0x41, 0xa5, 0x8e, 0xb9, 0xab,
-	0x8f, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa2, 0xbd, 0xac, 0x94, 0xc9, 0x02, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0x9b, 0x36, 0x5f, 0x33, 0xe9, 0xcf, 0xc7, 0xa8, 0x04, 0xab, 0x12, 0xae, 0x5b, 0x09,
+	0x94, 0x56, 0x60, 0xd3, 0xc2, 0x82, 0x75, 0x7f, 0x80, 0x6e, 0x20, 0x72, 0x59, 0xb1, 0x31, 0x8e,
+	0x7d, 0x93, 0x8c, 0x1a, 0xcf, 0x58, 0x33, 0x93, 0x28, 0x5d, 0xf2, 0x06, 0x3c, 0x00, 0x0f, 0xd4,
+	0x65, 0x97, 0x88, 0x45, 0x85, 0x9a, 0x17, 0x41, 0xbe, 0xe3, 0x38, 0x91, 0xb0, 0xc4, 0x26, 0xf1,
+	0x9c, 0x73, 0xee, 0xb9, 0x33, 0x67, 0xe6, 0x12, 0x17, 0x26, 0xa9, 0x50, 0x3e, 0xe3, 0xfd, 0x51,
+	0xa4, 0x99, 0xe0, 0xfe, 0xe4, 0xd8, 0x1f, 0x00, 0x07, 0xc5, 0x94, 0x97, 0x49, 0xa1, 0x05, 0xa5,
+	0xa8, 0xf0, 0x4a, 0x85, 0x37, 0x39, 0xde, 0xdd, 0x19, 0x88, 0x81, 0x40, 0xda, 0xcf, 0xbf, 0x8c,
+	0x72, 0xf7, 0xa0, 0xc2, 0x6b, 0x51, 0x86, 0x9a, 0x83, 0x6f, 0x2b, 0x64, 0xe3, 0xbd, 0xf1, 0xbf,
+	0xd2, 0x91, 0x06, 0xfa, 0x96, 0x34, 0xb2, 0x48, 0x46, 0xa9, 0xb2, 0x2d, 0xd7, 0xea, 0xb4, 0x4e,
+	0x76, 0xbd, 0xbf, 0xfb, 0x79, 0x5d, 0x54, 0x9c, 0xae, 0xde, 0xde, 0xef, 0xd5, 0x82, 0x42, 0x4f,
+	0xdb, 0xa4, 0x91, 0x81, 0x64, 0x22, 0xb1, 0x57, 0x5c, 0xab, 0xb3, 0x1a, 0x14, 0x2b, 0x7a, 0x48,
+	0xfe, 0x87, 0x4c, 0xc4, 0xc3, 0x90, 0x25, 0xc0, 0x35, 0xeb, 0x33, 0x90, 0x76, 0xdd, 0xb5, 0x3a,
+	0xcd, 0x60, 0x1b, 0xf1, 0xcb, 0x12, 0xa6, 0x47, 0xe4, 0x11, 0x42, 0x2a, 0xcc, 0x40, 0x86, 0x85,
+	0xdb, 0xaa, 0x6b, 0x75, 0xea, 0x85, 0x56, 0x75, 0x41, 0x76, 0x8d, 0xed, 0x33, 0xb2, 0xa5, 0xae,
+	0x59, 0x96, 0x41, 0x12, 0x1a, 0xca, 0x5e, 0xc3, 0xb6, 0x9b, 0x05, 0x7a, 0x81, 0x20, 0xdd, 0x27,
+	0x1b, 0xa6, 0xbb, 0xe8, 0xf7, 0x15, 0x68, 0xbb, 0x81, 0x6e, 0x2d, 0xc4, 0x3e, 0x21, 0x74, 0xf0,
+	0x63, 0x8d, 0x34, 0xcc, 0x89, 0xe8, 0x53, 0x42, 0x52, 0xc6, 0x75, 0x98, 0x00, 0x17, 0x29, 0x26,
+	0xd0, 0x0c, 0x9a, 0x39, 0x72, 0x9e, 0x03, 0x94, 0x91, 0x27, 0x30, 0xcd, 0x04, 0xcf, 0x37, 0x1c,
+	0x8d, 0xc2, 0x38, 0x1a, 0xc5, 0x63, 0x93, 0x0a, 0x9e, 0xb9, 0x75, 0x72, 0x54, 0x95, 0xd6, 0xc5,
+	0xa2, 0xe4, 0x6c, 0x51, 0x51, 0xa4, 0xd7, 0x86, 0x4a, 0x96, 0xf6, 0x49, 0xbb, 0x34, 0x09, 0x13,
+	0xa6, 0xb4, 0x64, 0xbd, 0x31, 0x76, 0xaa, 0x63, 0xa7, 0xc3, 0xaa, 0x4e, 0x97, 0xf3, 0xc5, 0xf9,
+	0x52, 0x41, 0xd1, 0xe8, 0x31, 0xab, 0x22, 0xf1, 0x76, 0x78, 0xd4, 0x1b, 0x41, 0x58, 0xf2, 0x98,
+	0xf8, 0x7a, 0xb0, 0x6d, 0xf0, 0xd2, 0x93, 0x7e, 0x24, 0x9b, 0x2a, 0x1e, 0x42, 0x32, 0x1e, 0x41,
+	0xa8, 0x6f, 0x32, 0xc0, 0xc0, 0xb7, 0xfe, 0xb1, 0x93, 0xab, 0xa2, 0xe2, 0xf3, 0x4d, 0x06, 0xc1,
+	0x86, 0x5a, 0x5a, 0xd1, 0x33, 0xb2, 0x3e, 0x5f, 0xdb, 0x0d, 0xb7, 0xde, 0x69, 0x9d, 0xec, 0x57,
+	0x59, 0xcd, 0x1d, 0xba, 0x82, 0x71, 0x5d, 0x1c, 0xa6, 0x2c, 0xa4, 0x6f, 0x48, 0x1b, 0x6f, 0x4c,
+	0x42, 0x2c, 0x64, 0xa2, 0x42, 0x09, 0x3a, 0x0f, 0x53, 0x70, 0xfb, 0x3f, 0x7c, 0x0e, 0x3b, 0x39,
+	0x1b, 0x18, 0x32, 0x98, 0x73, 0xf4, 0x03, 0x71, 0x33, 0x29, 0x26, 0x4c, 0xe5, 0xe9, 0x4a, 0x58,
+	0xba, 0xc8, 0x90, 0x71, 0x0d, 0x72, 0x12, 0x8d, 0xec, 0x75, 0xac, 0x77, 0x4a, 0x5d, 0xb0, 0x2c,
+	0xbb, 0x2c, 0x54, 0xf4, 0x2b, 0xd9, 0x49, 0xa3, 0x69, 0xb8, 0x70, 0x8b, 0x87, 0x11, 0x1f, 0x80,
+	0xdd, 0xcc, 0xdf, 0xce, 0xa9, 0x97, 0xef, 0xf6, 0xd7, 0xfd, 0xde, 0xf3, 0x01, 0xd3, 0xc3, 0x71,
+	0xcf, 0x8b, 0x45, 0xea, 0xc7, 0x42, 0xe5, 0x63, 0x69, 0xfe, 0x5e, 0xaa, 0xe4, 0xda, 0xcf, 0xc3,
+	0x54, 0xde, 0x39, 0xc4, 0x01, 0x4d, 0xa3, 0x69, 0x77, 0x6e, 0x75, 0x86, 0x4e, 0xa7, 0xef, 0x6e,
+	0x1f, 0x1c, 0xeb, 0xee, 0xc1, 0xb1, 0x7e, 0x3f, 0x38, 0xd6, 0xf7, 0x99, 0x53, 0xbb, 0x9b, 0x39,
+	0xb5, 0x9f, 0x33, 0xa7, 0xf6, 0xe5, 0xc5, 0x92, 0xab, 0x99, 0x75, 0xf3, 0x3b, 0x39, 0x7e, 0xe5,
+	0x4f, 0x97, 0xe6, 0x1e, 0xfd, 0x7b, 0x0d, 0x9c, 0xf8, 0xd7, 0x7f, 0x06, 0x00, 0x72, 0x53, 0xf0,
+	0x67, 0x63, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +303,11 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochOffset != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochOffset))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SkippedEpochs != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SkippedEpochs))
 		i--
@@ -290,6 +363,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxProvisionChange.Size()
+		i -= size
+		if _, err := m.MaxProvisionChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ProvisionRecalculationInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProvisionRecalculationInterval))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MintRecordsRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MintRecordsRetention))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ScheduleType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ScheduleType))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.EnableInflation {
 		i--
 		if m.EnableInflation {
@@ -362,6 +474,9 @@ func (m *GenesisState) Size() (n int) {
 	if m.SkippedEpochs != 0 {
 		n += 1 + sovGenesis(uint64(m.SkippedEpochs))
 	}
+	if m.EpochOffset != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochOffset))
+	}
 	return n
 }
 
@@ -382,6 +497,23 @@ func (m *Params) Size() (n int) {
 	if m.EnableInflation {
 		n += 2
 	}
+	if m.ScheduleType != 0 {
+		n += 1 + sovGenesis(uint64(m.ScheduleType))
+	}
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintRecordsRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.MintRecordsRetention))
+	}
+	if m.ProvisionRecalculationInterval != 0 {
+		n += 1 + sovGenesis(uint64(m.ProvisionRecalculationInterval))
+	}
+	l = m.MaxProvisionChange.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -542,6 +674,25 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochOffset", wireType)
+			}
+			m.EpochOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -710,6 +861,131 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableInflation = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleType", wireType)
+			}
+			m.ScheduleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleType |= InflationScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, SchedulePoint{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecordsRetention", wireType)
+			}
+			m.MintRecordsRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintRecordsRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvisionRecalculationInterval", wireType)
+			}
+			m.ProvisionRecalculationInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProvisionRecalculationInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProvisionChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxProvisionChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
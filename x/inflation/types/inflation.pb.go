@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,8 +29,45 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationScheduleType defines how the provision of each period is calculated
+type InflationScheduleType int32
+
+const (
+	// INFLATION_SCHEDULE_TYPE_EXPONENTIAL calculates the provision with the
+	// exponential_calculation params.
+	ScheduleTypeExponential InflationScheduleType = 0
+	// INFLATION_SCHEDULE_TYPE_TABLE uses the annual provision of the last
+	// schedule point whose period is lower or equal to the period.
+	ScheduleTypeTable InflationScheduleType = 1
+	// INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR interpolates the annual provision
+	// linearly between two consecutive schedule points. The annual provision of
+	// the last point applies to all following periods.
+	ScheduleTypePiecewiseLinear InflationScheduleType = 2
+)
+
+var InflationScheduleType_name = map[int32]string{
+	0: "INFLATION_SCHEDULE_TYPE_EXPONENTIAL",
+	1: "INFLATION_SCHEDULE_TYPE_TABLE",
+	2: "INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR",
+}
+
+var InflationScheduleType_value = map[string]int32{
+	"INFLATION_SCHEDULE_TYPE_EXPONENTIAL":      0,
+	"INFLATION_SCHEDULE_TYPE_TABLE":            1,
+	"INFLATION_SCHEDULE_TYPE_PIECEWISE_LINEAR": 2,
+}
+
+func (x InflationScheduleType) String() string {
+	return proto.EnumName(InflationScheduleType_name, int32(x))
+}
+
+func (InflationScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{0}
+}
+
 // InflationDistribution defines the distribution in which inflation is
-// allocated through minting on each epoch (staking, incentives, community). It
+// allocated through minting on each epoch (staking, incentives, community, or
+// a list of recipients). It
 // excludes the team vesting distribution, as this is minted once at genesis.
 // The initial InflationDistribution can be calculated from the Evmos Token
 // Model like this:
@@ -41,6 +83,10 @@ type InflationDistribution struct {
 	// community_pool defines the proportion of the minted minted_denom that is to
 	// be allocated to the community pool
 	CommunityPool github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=community_pool,json=communityPool,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool"`
+	// recipients defines the proportions of the minted mint_denom that are to be
+	// allocated to each recipient. If set, it replaces the staking_rewards,
+	// usage_incentives and community_pool proportions, which must be zero.
+	Recipients []InflationRecipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *InflationDistribution) Reset()         { *m = InflationDistribution{} }
@@ -76,6 +122,138 @@ func (m *InflationDistribution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InflationDistribution proto.InternalMessageInfo
 
+func (m *InflationDistribution) GetRecipients() []InflationRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// InflationRecipient defines a recipient of the inflation distribution
+type InflationRecipient struct {
+	// name identifies the recipient in events and telemetry
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address of the recipient, either a module account name, a bech32 address
+	// or a hex address. The distribution module name allocates to the community
+	// pool.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the proportion of the minted mint_denom that is to be
+	// allocated to the recipient
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *InflationRecipient) Reset()         { *m = InflationRecipient{} }
+func (m *InflationRecipient) String() string { return proto.CompactTextString(m) }
+func (*InflationRecipient) ProtoMessage()    {}
+func (*InflationRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{1}
+}
+func (m *InflationRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationRecipient.Merge(m, src)
+}
+func (m *InflationRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationRecipient proto.InternalMessageInfo
+
+func (m *InflationRecipient) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *InflationRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// Allocation defines the coins allocated to a recipient of the inflation
+// distribution
+type Allocation struct {
+	// name of the recipient
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address of the recipient
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount allocated to the recipient
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Allocation) Reset()         { *m = Allocation{} }
+func (m *Allocation) String() string { return proto.CompactTextString(m) }
+func (*Allocation) ProtoMessage()    {}
+func (*Allocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{2}
+}
+func (m *Allocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Allocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Allocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Allocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Allocation.Merge(m, src)
+}
+func (m *Allocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Allocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Allocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Allocation proto.InternalMessageInfo
+
+func (m *Allocation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Allocation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Allocation) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // ExponentialCalculation holds factors to calculate exponential inflation on
 // each period. Calculation reference:
 // periodProvision = exponentialDecay       *  bondingIncentive
@@ -98,7 +276,7 @@ func (m *ExponentialCalculation) Reset()         { *m = ExponentialCalculation{}
 func (m *ExponentialCalculation) String() string { return proto.CompactTextString(m) }
 func (*ExponentialCalculation) ProtoMessage()    {}
 func (*ExponentialCalculation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d064cb35c3ff7df8, []int{1}
+	return fileDescriptor_d064cb35c3ff7df8, []int{3}
 }
 func (m *ExponentialCalculation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -127,9 +305,297 @@ func (m *ExponentialCalculation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExponentialCalculation proto.InternalMessageInfo
 
+// SchedulePoint defines the annual provision of a period for the table and
+// piecewise-linear schedule types
+type SchedulePoint struct {
+	// period from which the annual provision applies
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// annual_provision is the provision of the period, in units of the display
+	// denomination of the mint denom (e.g. evmos)
+	AnnualProvision github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_provision,json=annualProvision,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provision"`
+}
+
+func (m *SchedulePoint) Reset()         { *m = SchedulePoint{} }
+func (m *SchedulePoint) String() string { return proto.CompactTextString(m) }
+func (*SchedulePoint) ProtoMessage()    {}
+func (*SchedulePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{4}
+}
+func (m *SchedulePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePoint.Merge(m, src)
+}
+func (m *SchedulePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePoint proto.InternalMessageInfo
+
+func (m *SchedulePoint) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// PeriodProvision defines the provisions of a period of the inflation schedule
+type PeriodProvision struct {
+	// period of the schedule
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// period_provision is the provision minted over the whole period
+	PeriodProvision types.DecCoin `protobuf:"bytes,2,opt,name=period_provision,json=periodProvision,proto3" json:"period_provision"`
+	// epoch_mint_provision is the provision minted on each epoch of the period
+	EpochMintProvision types.DecCoin `protobuf:"bytes,3,opt,name=epoch_mint_provision,json=epochMintProvision,proto3" json:"epoch_mint_provision"`
+}
+
+func (m *PeriodProvision) Reset()         { *m = PeriodProvision{} }
+func (m *PeriodProvision) String() string { return proto.CompactTextString(m) }
+func (*PeriodProvision) ProtoMessage()    {}
+func (*PeriodProvision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{5}
+}
+func (m *PeriodProvision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodProvision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodProvision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodProvision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodProvision.Merge(m, src)
+}
+func (m *PeriodProvision) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodProvision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodProvision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodProvision proto.InternalMessageInfo
+
+func (m *PeriodProvision) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodProvision) GetPeriodProvision() types.DecCoin {
+	if m != nil {
+		return m.PeriodProvision
+	}
+	return types.DecCoin{}
+}
+
+func (m *PeriodProvision) GetEpochMintProvision() types.DecCoin {
+	if m != nil {
+		return m.EpochMintProvision
+	}
+	return types.DecCoin{}
+}
+
+// MintRecord defines the coins minted and allocated at the end of an epoch
+type MintRecord struct {
+	// epoch_number of the ended epoch
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// missed_epochs minted together with the ended epoch
+	MissedEpochs int64 `protobuf:"varint,2,opt,name=missed_epochs,json=missedEpochs,proto3" json:"missed_epochs,omitempty"`
+	// period after the epoch ended
+	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// height of the block that ended the epoch
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block that ended the epoch
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// minted coin of the epoch
+	Minted types.Coin `protobuf:"bytes,6,opt,name=minted,proto3" json:"minted"`
+	// allocations of the minted coin to the inflation recipients
+	Allocations []Allocation `protobuf:"bytes,7,rep,name=allocations,proto3" json:"allocations"`
+}
+
+func (m *MintRecord) Reset()         { *m = MintRecord{} }
+func (m *MintRecord) String() string { return proto.CompactTextString(m) }
+func (*MintRecord) ProtoMessage()    {}
+func (*MintRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{6}
+}
+func (m *MintRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintRecord.Merge(m, src)
+}
+func (m *MintRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintRecord proto.InternalMessageInfo
+
+func (m *MintRecord) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *MintRecord) GetMissedEpochs() int64 {
+	if m != nil {
+		return m.MissedEpochs
+	}
+	return 0
+}
+
+func (m *MintRecord) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *MintRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MintRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *MintRecord) GetMinted() types.Coin {
+	if m != nil {
+		return m.Minted
+	}
+	return types.Coin{}
+}
+
+func (m *MintRecord) GetAllocations() []Allocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+// PeriodProjection defines the projected provisions of a period for an assumed
+// bonded ratio
+type PeriodProjection struct {
+	// period of the projection
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// bonded_ratio assumed for the period
+	BondedRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonded_ratio"`
+	// period_provision is the provision minted over the whole period
+	PeriodProvision types.DecCoin `protobuf:"bytes,3,opt,name=period_provision,json=periodProvision,proto3" json:"period_provision"`
+	// epoch_mint_provision is the provision minted on each epoch of the period
+	EpochMintProvision types.DecCoin `protobuf:"bytes,4,opt,name=epoch_mint_provision,json=epochMintProvision,proto3" json:"epoch_mint_provision"`
+}
+
+func (m *PeriodProjection) Reset()         { *m = PeriodProjection{} }
+func (m *PeriodProjection) String() string { return proto.CompactTextString(m) }
+func (*PeriodProjection) ProtoMessage()    {}
+func (*PeriodProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d064cb35c3ff7df8, []int{7}
+}
+func (m *PeriodProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodProjection.Merge(m, src)
+}
+func (m *PeriodProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodProjection proto.InternalMessageInfo
+
+func (m *PeriodProjection) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodProjection) GetPeriodProvision() types.DecCoin {
+	if m != nil {
+		return m.PeriodProvision
+	}
+	return types.DecCoin{}
+}
+
+func (m *PeriodProjection) GetEpochMintProvision() types.DecCoin {
+	if m != nil {
+		return m.EpochMintProvision
+	}
+	return types.DecCoin{}
+}
+
 func init() {
+	proto.RegisterEnum("evmos.inflation.v1.InflationScheduleType", InflationScheduleType_name, InflationScheduleType_value)
 	proto.RegisterType((*InflationDistribution)(nil), "evmos.inflation.v1.InflationDistribution")
+	proto.RegisterType((*InflationRecipient)(nil), "evmos.inflation.v1.InflationRecipient")
+	proto.RegisterType((*Allocation)(nil), "evmos.inflation.v1.Allocation")
 	proto.RegisterType((*ExponentialCalculation)(nil), "evmos.inflation.v1.ExponentialCalculation")
+	proto.RegisterType((*SchedulePoint)(nil), "evmos.inflation.v1.SchedulePoint")
+	proto.RegisterType((*PeriodProvision)(nil), "evmos.inflation.v1.PeriodProvision")
+	proto.RegisterType((*MintRecord)(nil), "evmos.inflation.v1.MintRecord")
+	proto.RegisterType((*PeriodProjection)(nil), "evmos.inflation.v1.PeriodProjection")
 }
 
 func init() {
@@ -137,31 +603,68 @@ func init() {
 }
 
 var fileDescriptor_d064cb35c3ff7df8 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x4e, 0xe3, 0x30,
-	0x10, 0xc7, 0xe3, 0xee, 0x87, 0xb4, 0xde, 0xdd, 0x76, 0x15, 0xed, 0xae, 0xa2, 0x3d, 0xa4, 0xab,
-	0x1e, 0x10, 0x07, 0x48, 0xa8, 0xb8, 0x72, 0x2a, 0x05, 0xa9, 0x37, 0xa8, 0xf8, 0x10, 0x5c, 0x22,
-	0xc7, 0x35, 0xc1, 0x6a, 0xe2, 0x89, 0x6c, 0x27, 0xa4, 0x6f, 0xc1, 0x33, 0xf0, 0x34, 0x3d, 0xf6,
-	0x88, 0x38, 0x54, 0xa8, 0x7d, 0x0d, 0x0e, 0xc8, 0x49, 0x69, 0x7b, 0xce, 0x25, 0x19, 0xdb, 0xff,
-	0xf9, 0xd9, 0xf3, 0xb7, 0x07, 0x77, 0x58, 0x9e, 0x80, 0xf2, 0xb9, 0xb8, 0x8b, 0x89, 0xe6, 0x20,
-	0xfc, 0xbc, 0xbb, 0x19, 0x78, 0xa9, 0x04, 0x0d, 0xb6, 0x5d, 0x6a, 0xbc, 0xcd, 0x74, 0xde, 0xfd,
-	0xf7, 0x3b, 0x82, 0x08, 0xca, 0x65, 0xdf, 0x44, 0x95, 0xb2, 0xf3, 0xd4, 0xc0, 0x7f, 0x06, 0x1f,
-	0xb2, 0x3e, 0x57, 0x5a, 0xf2, 0x30, 0x33, 0xb1, 0x7d, 0x8d, 0x5b, 0x4a, 0x93, 0x31, 0x17, 0x51,
-	0x20, 0xd9, 0x03, 0x91, 0x23, 0xe5, 0xa0, 0xff, 0x68, 0xf7, 0x5b, 0xcf, 0x9b, 0xce, 0xdb, 0xd6,
-	0xcb, 0xbc, 0xbd, 0x13, 0x71, 0x7d, 0x9f, 0x85, 0x1e, 0x85, 0xc4, 0xa7, 0xa0, 0xcc, 0xa1, 0xaa,
-	0xdf, 0xbe, 0x1a, 0x8d, 0x7d, 0x3d, 0x49, 0x99, 0xf2, 0xfa, 0x8c, 0x0e, 0x9b, 0x2b, 0xcc, 0xb0,
-	0xa2, 0xd8, 0x37, 0xf8, 0x57, 0xa6, 0x48, 0xc4, 0x02, 0x2e, 0x28, 0x13, 0x9a, 0xe7, 0x4c, 0x39,
-	0x8d, 0x5a, 0xe4, 0x56, 0xc9, 0x19, 0xac, 0x31, 0xf6, 0x25, 0x6e, 0x52, 0x48, 0x92, 0x4c, 0x70,
-	0x3d, 0x09, 0x52, 0x80, 0xd8, 0xf9, 0x54, 0x0b, 0xfc, 0x73, 0x4d, 0x39, 0x03, 0x88, 0x3b, 0x6f,
-	0x0d, 0xfc, 0xf7, 0xa4, 0x48, 0x41, 0x98, 0x7d, 0x48, 0x7c, 0x4c, 0x62, 0x9a, 0x55, 0x8e, 0xd9,
-	0x47, 0x18, 0x91, 0x9a, 0xbe, 0x20, 0x62, 0xb2, 0x65, 0xcd, 0xda, 0x91, 0x34, 0xd9, 0xb4, 0x66,
-	0x81, 0x88, 0x1a, 0xaf, 0x42, 0x10, 0x23, 0x73, 0xbf, 0x9a, 0xc8, 0x88, 0x69, 0xe7, 0x73, 0x3d,
-	0xaf, 0x56, 0x94, 0x8b, 0x12, 0x62, 0x9f, 0xe3, 0x1f, 0x09, 0x29, 0x82, 0x9c, 0x48, 0x4e, 0x04,
-	0x65, 0xce, 0x97, 0x5a, 0xd0, 0xef, 0x09, 0x29, 0xae, 0x56, 0x88, 0xde, 0xe9, 0x74, 0xe1, 0xa2,
-	0xd9, 0xc2, 0x45, 0xaf, 0x0b, 0x17, 0x3d, 0x2e, 0x5d, 0x6b, 0xb6, 0x74, 0xad, 0xe7, 0xa5, 0x6b,
-	0xdd, 0xee, 0x6d, 0xe1, 0xaa, 0xb6, 0xa8, 0xbe, 0x79, 0xf7, 0xc0, 0x2f, 0xb6, 0x5a, 0xa4, 0x04,
-	0x87, 0x5f, 0xcb, 0x27, 0x7f, 0xf8, 0x1e, 0x00, 0x00, 0xff, 0xff, 0x53, 0x51, 0xc3, 0x26, 0x42,
-	0x03, 0x00, 0x00,
+	// 966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x1b, 0xf6, 0xda, 0xfe, 0xdc, 0x8f, 0x71, 0xd2, 0x98, 0x51, 0x1b, 0x8c, 0x0b, 0xb6, 0x71, 0xa5,
+	0x2a, 0x42, 0xb0, 0xdb, 0x84, 0x03, 0x3d, 0x70, 0x89, 0xe3, 0x8d, 0xb0, 0xe4, 0xb8, 0xee, 0xc6,
+	0xa5, 0x94, 0xcb, 0x6a, 0x3c, 0x3b, 0xb5, 0x87, 0xee, 0xce, 0xac, 0x76, 0x66, 0x9d, 0xe4, 0xca,
+	0x09, 0xe5, 0xd4, 0x3f, 0x10, 0x21, 0xc1, 0x8d, 0x3f, 0xc1, 0xb5, 0x27, 0xd4, 0x23, 0xe2, 0xd0,
+	0xa2, 0xe4, 0xca, 0x81, 0x1f, 0xc0, 0x01, 0xcd, 0xec, 0xda, 0x59, 0xda, 0xa4, 0x2a, 0x0b, 0x17,
+	0x7b, 0xe6, 0xd5, 0x3c, 0xcf, 0xbc, 0xf3, 0xcc, 0xf3, 0xbe, 0xb3, 0xa0, 0x43, 0xe6, 0x01, 0x17,
+	0x16, 0x65, 0x8f, 0x7c, 0x24, 0x29, 0x67, 0xd6, 0x7c, 0xf3, 0x7c, 0x62, 0x86, 0x11, 0x97, 0x1c,
+	0x42, 0xbd, 0xc6, 0x3c, 0x0f, 0xcf, 0x37, 0x1b, 0x4d, 0xcc, 0x85, 0x02, 0x4e, 0x90, 0x20, 0xd6,
+	0x7c, 0x73, 0x42, 0x24, 0xda, 0xb4, 0x30, 0xa7, 0x29, 0xa6, 0x71, 0x6d, 0xca, 0xa7, 0x5c, 0x0f,
+	0x2d, 0x35, 0x4a, 0xa3, 0xad, 0x29, 0xe7, 0x53, 0x9f, 0x58, 0x7a, 0x36, 0x89, 0x1f, 0x59, 0x92,
+	0x06, 0x44, 0x48, 0x14, 0x84, 0xc9, 0x82, 0xce, 0x1f, 0x45, 0x70, 0xbd, 0xbf, 0xd8, 0xa7, 0x47,
+	0x85, 0x8c, 0xe8, 0x24, 0x56, 0x63, 0xf8, 0x00, 0xac, 0x09, 0x89, 0x1e, 0x53, 0x36, 0x75, 0x23,
+	0x72, 0x80, 0x22, 0x4f, 0xd4, 0x8d, 0xb6, 0xb1, 0xf1, 0x56, 0xd7, 0x7c, 0xfa, 0xbc, 0x55, 0xf8,
+	0xf5, 0x79, 0xeb, 0xd6, 0x94, 0xca, 0x59, 0x3c, 0x31, 0x31, 0x0f, 0xac, 0x34, 0xb9, 0xe4, 0xef,
+	0x63, 0xe1, 0x3d, 0xb6, 0xe4, 0x51, 0x48, 0x84, 0xd9, 0x23, 0xd8, 0xb9, 0x9a, 0xd2, 0x38, 0x09,
+	0x0b, 0x7c, 0x08, 0x6a, 0xb1, 0x40, 0x53, 0xe2, 0x52, 0x86, 0x09, 0x93, 0x74, 0x4e, 0x44, 0xbd,
+	0x98, 0x8b, 0x79, 0x4d, 0xf3, 0xf4, 0x97, 0x34, 0xf0, 0x3e, 0xb8, 0x8a, 0x79, 0x10, 0xc4, 0x8c,
+	0xca, 0x23, 0x37, 0xe4, 0xdc, 0xaf, 0x97, 0x72, 0x11, 0xaf, 0x2e, 0x59, 0x46, 0x9c, 0xfb, 0x70,
+	0x00, 0x40, 0x44, 0x30, 0x0d, 0x29, 0x61, 0x52, 0xd4, 0xcb, 0xed, 0xd2, 0x46, 0x75, 0xeb, 0x96,
+	0xf9, 0xea, 0x25, 0x99, 0x4b, 0x25, 0x9d, 0xc5, 0xf2, 0x6e, 0x59, 0x6d, 0xed, 0x64, 0xf0, 0x9d,
+	0x63, 0x03, 0xc0, 0x57, 0x17, 0x42, 0x08, 0xca, 0x0c, 0x05, 0x24, 0x11, 0xd9, 0xd1, 0x63, 0x58,
+	0x07, 0x57, 0x90, 0xe7, 0x45, 0x44, 0xa4, 0x0a, 0x39, 0x8b, 0x29, 0xdc, 0x05, 0x95, 0x03, 0x42,
+	0xa7, 0x33, 0x99, 0xf3, 0x84, 0x29, 0xba, 0xf3, 0x9d, 0x01, 0xc0, 0xb6, 0xef, 0x73, 0xac, 0xb3,
+	0xf9, 0x87, 0x49, 0x60, 0x50, 0x41, 0x01, 0x8f, 0x99, 0x4a, 0x42, 0x69, 0xf2, 0xae, 0x99, 0xec,
+	0x65, 0x2a, 0x93, 0x9a, 0xa9, 0x49, 0xcd, 0x1d, 0x4e, 0x59, 0xf7, 0xb6, 0xca, 0xef, 0xc7, 0x17,
+	0xad, 0x8d, 0x37, 0xc8, 0x4f, 0x01, 0x84, 0x93, 0x52, 0x77, 0xfe, 0x2c, 0x82, 0x75, 0xfb, 0x30,
+	0xe4, 0x4c, 0x5d, 0x32, 0xf2, 0x77, 0x90, 0x8f, 0xe3, 0x44, 0x3b, 0xf8, 0x19, 0x30, 0x50, 0x4e,
+	0x53, 0x1a, 0x48, 0xa1, 0xa3, 0x9c, 0xc6, 0x33, 0x22, 0x85, 0xc6, 0x39, 0xb5, 0x37, 0xb0, 0x32,
+	0xea, 0x84, 0x33, 0x4f, 0x15, 0x97, 0x44, 0xd1, 0x94, 0xc8, 0x7a, 0x39, 0x9f, 0x51, 0x53, 0x96,
+	0xb1, 0x26, 0x81, 0xf7, 0xc0, 0x4a, 0x80, 0x0e, 0xdd, 0x39, 0x8a, 0x28, 0x62, 0x98, 0xd4, 0xff,
+	0x97, 0x8b, 0xb4, 0x1a, 0xa0, 0xc3, 0x2f, 0x52, 0x8a, 0xce, 0x37, 0x06, 0x58, 0xdd, 0xc7, 0x33,
+	0xe2, 0xc5, 0x3e, 0x19, 0x71, 0xca, 0x24, 0x5c, 0x07, 0x95, 0x90, 0x44, 0x94, 0x7b, 0x5a, 0xfa,
+	0xb2, 0x93, 0xce, 0x54, 0x5d, 0x23, 0xc6, 0x62, 0xe4, 0xbb, 0x61, 0xc4, 0xe7, 0x54, 0x50, 0xce,
+	0xf2, 0xd6, 0x75, 0xc2, 0x33, 0x5a, 0xd0, 0x74, 0x7e, 0x36, 0xc0, 0xda, 0x48, 0xef, 0xb2, 0x8c,
+	0x5d, 0x9a, 0xc6, 0x1e, 0xa8, 0x25, 0xa3, 0x97, 0xd2, 0xa8, 0x6e, 0xbd, 0x77, 0xa1, 0x3d, 0x7b,
+	0x04, 0x6b, 0x87, 0x26, 0x85, 0xba, 0x16, 0xbe, 0xb4, 0xcd, 0x18, 0x5c, 0x23, 0x21, 0xc7, 0x33,
+	0x37, 0xa0, 0x4c, 0x66, 0x28, 0x4b, 0x6f, 0x4c, 0x09, 0x35, 0x7e, 0x8f, 0x32, 0x79, 0x7e, 0xa0,
+	0x9f, 0x8a, 0x00, 0xa8, 0x88, 0x43, 0x30, 0x8f, 0x3c, 0xf8, 0x01, 0x58, 0x49, 0x36, 0x61, 0x71,
+	0x30, 0x21, 0x91, 0x3e, 0x51, 0xc9, 0xa9, 0xea, 0xd8, 0x50, 0x87, 0xe0, 0x4d, 0xb0, 0x1a, 0x50,
+	0x21, 0x88, 0xe7, 0xea, 0x68, 0x52, 0x8b, 0x25, 0x67, 0x25, 0x09, 0xda, 0x3a, 0x96, 0xd1, 0xa4,
+	0xf4, 0x37, 0x4d, 0xd6, 0x41, 0x65, 0x96, 0x74, 0x8b, 0xb2, 0x46, 0xa5, 0x33, 0x78, 0x07, 0x94,
+	0xd5, 0x83, 0xa0, 0x7d, 0x52, 0xdd, 0x6a, 0x98, 0xc9, 0x6b, 0x61, 0x2e, 0x5e, 0x0b, 0x73, 0xbc,
+	0x78, 0x2d, 0xba, 0xff, 0x57, 0x47, 0x79, 0xf2, 0xa2, 0x65, 0x38, 0x1a, 0x01, 0x3f, 0x05, 0x15,
+	0x25, 0x08, 0xf1, 0xea, 0x95, 0xb6, 0xf1, 0xfa, 0xd2, 0x4f, 0x54, 0x48, 0x97, 0xc3, 0x5d, 0x50,
+	0x45, 0xcb, 0x7e, 0x23, 0xea, 0x57, 0x74, 0xe3, 0x68, 0x5e, 0xd4, 0x4c, 0xcf, 0xdb, 0x52, 0x4a,
+	0x91, 0x05, 0x76, 0xbe, 0x2f, 0x82, 0xda, 0xd2, 0x12, 0x5f, 0x13, 0x2c, 0x5f, 0xe7, 0x89, 0x7b,
+	0x60, 0x45, 0x15, 0x0a, 0xf1, 0xdc, 0x48, 0xc1, 0x73, 0xda, 0xb2, 0x9a, 0x70, 0x38, 0x8a, 0xe2,
+	0x42, 0x9b, 0x95, 0xfe, 0x7b, 0x9b, 0x95, 0xff, 0x8d, 0xcd, 0x3e, 0xfc, 0xdd, 0xc8, 0xbc, 0xee,
+	0x8b, 0x2a, 0x1e, 0x1f, 0x85, 0x04, 0xf6, 0xc0, 0xcd, 0xfe, 0x70, 0x77, 0xb0, 0x3d, 0xee, 0xdf,
+	0x1d, 0xba, 0xfb, 0x3b, 0x9f, 0xdb, 0xbd, 0xfb, 0x03, 0xdb, 0x1d, 0x3f, 0x1c, 0xd9, 0xae, 0xfd,
+	0xe5, 0xe8, 0xee, 0xd0, 0x1e, 0x8e, 0xfb, 0xdb, 0x83, 0x5a, 0xa1, 0x71, 0xe3, 0xf8, 0xa4, 0xfd,
+	0x4e, 0x16, 0x9a, 0xe9, 0xc5, 0xf0, 0x0e, 0x78, 0xff, 0x32, 0x96, 0xf1, 0x76, 0x77, 0x60, 0xd7,
+	0x8c, 0xc6, 0xf5, 0xe3, 0x93, 0xf6, 0xdb, 0x59, 0xfc, 0x18, 0x4d, 0x7c, 0x02, 0xf7, 0xc0, 0xc6,
+	0x65, 0xc8, 0x51, 0xdf, 0xde, 0xb1, 0x1f, 0xf4, 0xf7, 0x6d, 0x77, 0xd0, 0x1f, 0xda, 0xdb, 0x4e,
+	0xad, 0xd8, 0x68, 0x1d, 0x9f, 0xb4, 0x6f, 0x64, 0x49, 0x46, 0x94, 0x60, 0x72, 0x40, 0x05, 0x19,
+	0x50, 0x46, 0x50, 0xd4, 0x28, 0x7f, 0xfb, 0x43, 0xb3, 0xd0, 0xdd, 0x7d, 0x7a, 0xda, 0x34, 0x9e,
+	0x9d, 0x36, 0x8d, 0xdf, 0x4e, 0x9b, 0xc6, 0x93, 0xb3, 0x66, 0xe1, 0xd9, 0x59, 0xb3, 0xf0, 0xcb,
+	0x59, 0xb3, 0xf0, 0xd5, 0x47, 0x99, 0x2b, 0x4e, 0x3e, 0xc0, 0x92, 0xdf, 0xf9, 0xe6, 0x6d, 0xeb,
+	0x30, 0xf3, 0x31, 0xa6, 0x2f, 0x7b, 0x52, 0xd1, 0x05, 0xf0, 0xc9, 0x5f, 0x03, 0x00, 0xdb, 0x6a,
+	0xa2, 0x02, 0xac, 0x09, 0x00, 0x00,
 }
 
 func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -184,6 +687,20 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.CommunityPool.Size()
 		i -= size
@@ -217,7 +734,7 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ExponentialCalculation) Marshal() (dAtA []byte, err error) {
+func (m *InflationRecipient) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -227,121 +744,1370 @@ func (m *ExponentialCalculation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExponentialCalculation) MarshalTo(dAtA []byte) (int, error) {
+func (m *InflationRecipient) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InflationRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.MaxVariance.Size()
-		i -= size
-		if _, err := m.MaxVariance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInflation(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.BondingTarget.Size()
-		i -= size
-		if _, err := m.BondingTarget.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInflation(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.C.Size()
+		size := m.Weight.Size()
 		i -= size
-		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintInflation(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	{
-		size := m.R.Size()
-		i -= size
-		if _, err := m.R.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInflation(dAtA, i, uint64(size))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.A.Size()
-		i -= size
-		if _, err := m.A.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintInflation(dAtA, i, uint64(size))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovInflation(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Allocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *InflationDistribution) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Allocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExponentialCalculation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExponentialCalculation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.StakingRewards.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.UsageIncentives.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.CommunityPool.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	return n
+	{
+		size := m.MaxVariance.Size()
+		i -= size
+		if _, err := m.MaxVariance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BondingTarget.Size()
+		i -= size
+		if _, err := m.BondingTarget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.C.Size()
+		i -= size
+		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.R.Size()
+		i -= size
+		if _, err := m.R.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.A.Size()
+		i -= size
+		if _, err := m.A.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ExponentialCalculation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SchedulePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvision.Size()
+		i -= size
+		if _, err := m.AnnualProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochMintProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PeriodProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Minted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintInflation(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Period != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissedEpochs != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.MissedEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochMintProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PeriodProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInflation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InflationDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.UsageIncentives.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InflationRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *Allocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExponentialCalculation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.A.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.R.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.C.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.BondingTarget.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.MaxVariance.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *SchedulePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovInflation(uint64(m.Period))
+	}
+	l = m.AnnualProvision.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *PeriodProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovInflation(uint64(m.Period))
+	}
+	l = m.PeriodProvision.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *MintRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovInflation(uint64(m.EpochNumber))
+	}
+	if m.MissedEpochs != 0 {
+		n += 1 + sovInflation(uint64(m.MissedEpochs))
+	}
+	if m.Period != 0 {
+		n += 1 + sovInflation(uint64(m.Period))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInflation(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PeriodProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovInflation(uint64(m.Period))
+	}
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.PeriodProvision.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func sovInflation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInflation(x uint64) (n int) {
+	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageIncentives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsageIncentives.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, InflationRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Allocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Allocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Allocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.R.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondingTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVariance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.A.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.R.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.C.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.BondingTarget.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.MaxVariance.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	return n
-}
 
-func sovInflation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozInflation(x uint64) (n int) {
-	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
+func (m *PeriodProvision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -364,17 +2130,17 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeriodProvision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeriodProvision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var stringLen uint64
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -384,31 +2150,16 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Period |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthInflation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthInflation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsageIncentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodProvision", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -418,31 +2169,30 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInflation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInflation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UsageIncentives.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PeriodProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -452,23 +2202,22 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInflation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInflation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -493,7 +2242,7 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
+func (m *MintRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -516,17 +2265,93 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEpochs", wireType)
+			}
+			m.MissedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -536,31 +2361,30 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInflation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInflation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -570,31 +2394,30 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInflation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInflation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.R.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -604,29 +2427,98 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInflation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInflation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Allocations = append(m.Allocations, Allocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondingTarget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -654,15 +2546,15 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondingTarget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxVariance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodProvision", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowInflation
@@ -672,23 +2564,55 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthInflation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthInflation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxVariance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PeriodProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13,12 +13,67 @@ func CalculateEpochMintProvision(
 	epochsPerPeriod int64,
 	bondedRatio sdk.Dec,
 ) sdk.Dec {
-	x := period                                              // period
-	a := params.ExponentialCalculation.A                     // initial value
-	r := params.ExponentialCalculation.R                     // reduction factor
-	c := params.ExponentialCalculation.C                     // long term inflation
-	bTarget := params.ExponentialCalculation.BondingTarget   // bonding target
-	maxVariance := params.ExponentialCalculation.MaxVariance // max percentage that inflation can be increased by
+	periodProvision := CalculatePeriodProvision(params, period, bondedRatio)
+
+	// epochProvision = periodProvision / epochsPerPeriod
+	epochProvision := periodProvision.Quo(sdk.NewDec(epochsPerPeriod))
+
+	// Multiply epochMintProvision with power reduction (10^18 for evmos) as the
+	// calculation is based on `evmos` and the issued tokens need to be given in
+	// `aevmos`
+	epochProvision = epochProvision.Mul(sdk.NewDecFromInt(ethermint.PowerReduction))
+	return epochProvision
+}
+
+// BoundEpochMintProvision returns the target epoch mint provision, bounded to
+// the max change relative to the current epoch mint provision. The max change
+// is given as a fraction of the current provision, e.g. 0.05 for 5%.
+func BoundEpochMintProvision(current, target, maxChange sdk.Dec) sdk.Dec {
+	maxDelta := current.Mul(maxChange)
+
+	switch {
+	case target.GT(current.Add(maxDelta)):
+		return current.Add(maxDelta)
+	case target.LT(current.Sub(maxDelta)):
+		return current.Sub(maxDelta)
+	default:
+		return target
+	}
+}
+
+// CalculatePeriodProvision returns the provision of a period in `evmos`
+// according to the schedule type of the params. The exponential calculation
+// is used if the schedule is empty.
+func CalculatePeriodProvision(
+	params Params,
+	period uint64,
+	bondedRatio sdk.Dec,
+) sdk.Dec {
+	if len(params.Schedule) > 0 {
+		switch params.ScheduleType {
+		case ScheduleTypeTable:
+			return tablePeriodProvision(params.Schedule, period)
+		case ScheduleTypePiecewiseLinear:
+			return piecewiseLinearPeriodProvision(params.Schedule, period)
+		}
+	}
+
+	return exponentialPeriodProvision(params.ExponentialCalculation, period, bondedRatio)
+}
+
+// exponentialPeriodProvision returns the provision of a period calculated with
+// the exponential calculation params
+func exponentialPeriodProvision(
+	calculation ExponentialCalculation,
+	period uint64,
+	bondedRatio sdk.Dec,
+) sdk.Dec {
+	x := period                            // period
+	a := calculation.A                     // initial value
+	r := calculation.R                     // reduction factor
+	c := calculation.C                     // long term inflation
+	bTarget := calculation.BondingTarget   // bonding target
+	maxVariance := calculation.MaxVariance // max percentage that inflation can be increased by
 
 	// exponentialDecay := a * (1 - r) ^ x + c
 	decay := sdk.OneDec().Sub(r)
@@ -34,14 +89,47 @@ func CalculateEpochMintProvision(
 	bondingIncentive := sdk.OneDec().Add(maxVariance).Sub(sub)
 
 	// periodProvision = exponentialDecay * bondingIncentive
-	periodProvision := exponentialDecay.Mul(bondingIncentive)
+	return exponentialDecay.Mul(bondingIncentive)
+}
 
-	// epochProvision = periodProvision / epochsPerPeriod
-	epochProvision := periodProvision.Quo(sdk.NewDec(epochsPerPeriod))
+// tablePeriodProvision returns the annual provision of the last schedule point
+// whose period is lower or equal to the given period. The periods before the
+// first schedule point use its annual provision.
+func tablePeriodProvision(schedule []SchedulePoint, period uint64) sdk.Dec {
+	if len(schedule) == 0 {
+		return sdk.ZeroDec()
+	}
 
-	// Multiply epochMintProvision with power reduction (10^18 for evmos) as the
-	// calculation is based on `evmos` and the issued tokens need to be given in
-	// `aevmos`
-	epochProvision = epochProvision.Mul(sdk.NewDecFromInt(ethermint.PowerReduction))
-	return epochProvision
+	provision := schedule[0].AnnualProvision
+	for _, point := range schedule[1:] {
+		if point.Period > period {
+			break
+		}
+		provision = point.AnnualProvision
+	}
+	return provision
+}
+
+// piecewiseLinearPeriodProvision interpolates the annual provision of a
+// period between the schedule points that surround it. The annual provisions
+// of the first and last schedule points apply to the periods before and after
+// them.
+func piecewiseLinearPeriodProvision(schedule []SchedulePoint, period uint64) sdk.Dec {
+	for i := 1; i < len(schedule); i++ {
+		start, end := schedule[i-1], schedule[i]
+		if period >= end.Period {
+			continue
+		}
+		if period < start.Period {
+			return start.AnnualProvision
+		}
+
+		// provision = start + (end - start) * (period - start period) / (end period - start period)
+		delta := end.AnnualProvision.Sub(start.AnnualProvision).
+			MulInt64(int64(period - start.Period)).
+			QuoInt64(int64(end.Period - start.Period))
+		return start.AnnualProvision.Add(delta)
+	}
+
+	return tablePeriodProvision(schedule, period)
 }
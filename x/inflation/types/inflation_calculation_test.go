@@ -140,3 +140,107 @@ func (suite *InflationTestSuite) TestCalculateEpochMintProvision() {
 		})
 	}
 }
+
+func (suite *InflationTestSuite) TestCalculatePeriodProvision() {
+	schedule := []SchedulePoint{
+		{Period: 2, AnnualProvision: sdk.NewDec(100)},
+		{Period: 4, AnnualProvision: sdk.NewDec(50)},
+		{Period: 8, AnnualProvision: sdk.NewDec(10)},
+	}
+
+	tableParams := DefaultParams()
+	tableParams.ScheduleType = ScheduleTypeTable
+	tableParams.Schedule = schedule
+
+	linearParams := DefaultParams()
+	linearParams.ScheduleType = ScheduleTypePiecewiseLinear
+	linearParams.Schedule = schedule
+
+	emptyParams := DefaultParams()
+	emptyParams.ScheduleType = ScheduleTypeTable
+
+	testCases := []struct {
+		name         string
+		params       Params
+		period       uint64
+		expProvision sdk.Dec
+	}{
+		{"table - before the first point", tableParams, 0, sdk.NewDec(100)},
+		{"table - first point", tableParams, 2, sdk.NewDec(100)},
+		{"table - between points", tableParams, 3, sdk.NewDec(100)},
+		{"table - second point", tableParams, 4, sdk.NewDec(50)},
+		{"table - after the last point", tableParams, 20, sdk.NewDec(10)},
+		{"piecewise-linear - before the first point", linearParams, 0, sdk.NewDec(100)},
+		{"piecewise-linear - first point", linearParams, 2, sdk.NewDec(100)},
+		{"piecewise-linear - between points", linearParams, 3, sdk.NewDec(75)},
+		{"piecewise-linear - second point", linearParams, 4, sdk.NewDec(50)},
+		{"piecewise-linear - between points with remainder", linearParams, 5, sdk.NewDec(40)},
+		{"piecewise-linear - after the last point", linearParams, 20, sdk.NewDec(10)},
+		{"empty schedule - exponential", emptyParams, 0, sdk.NewDec(309_375_000)},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			provision := CalculatePeriodProvision(tc.params, tc.period, sdk.OneDec())
+			suite.Require().Equal(tc.expProvision, provision)
+		})
+	}
+
+	// the epoch provision is the period provision split over the epochs
+	epochProvision := CalculateEpochMintProvision(tableParams, 4, 5, sdk.OneDec())
+	suite.Require().Equal(sdk.NewDec(10).MulInt64(1e18), epochProvision)
+}
+
+func (suite *InflationTestSuite) TestBoundEpochMintProvision() {
+	bondingParams := DefaultParams()
+	bondingParams.ExponentialCalculation.MaxVariance = sdk.NewDecWithPrec(40, 2)
+	epochsPerPeriod := int64(365)
+
+	// provisions of the initial period at and below the bonding target
+	bondedProvision := CalculateEpochMintProvision(bondingParams, 0, epochsPerPeriod, sdk.OneDec())
+	unbondedProvision := CalculateEpochMintProvision(bondingParams, 0, epochsPerPeriod, sdk.ZeroDec())
+	suite.Require().Equal(sdk.MustNewDecFromStr("847602739726027397260274.000000000000000000"), bondedProvision)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1186643835616438356164384.000000000000000000"), unbondedProvision)
+
+	testCases := []struct {
+		name         string
+		current      sdk.Dec
+		target       sdk.Dec
+		maxChange    sdk.Dec
+		expProvision sdk.Dec
+	}{
+		{
+			"target within the max change",
+			bondedProvision,
+			unbondedProvision,
+			sdk.NewDecWithPrec(50, 2),
+			unbondedProvision,
+		},
+		{
+			"increase bounded by the max change",
+			bondedProvision,
+			unbondedProvision,
+			sdk.NewDecWithPrec(10, 2),
+			sdk.MustNewDecFromStr("932363013698630136986301.400000000000000000"),
+		},
+		{
+			"decrease bounded by the max change",
+			unbondedProvision,
+			bondedProvision,
+			sdk.NewDecWithPrec(10, 2),
+			sdk.MustNewDecFromStr("1067979452054794520547945.600000000000000000"),
+		},
+		{
+			"zero max change",
+			bondedProvision,
+			unbondedProvision,
+			sdk.ZeroDec(),
+			bondedProvision,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			provision := BoundEpochMintProvision(tc.current, tc.target, tc.maxChange)
+			suite.Require().Equal(tc.expProvision, provision)
+		})
+	}
+}
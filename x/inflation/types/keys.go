@@ -19,6 +19,8 @@ const (
 	prefixEpochIdentifier
 	prefixEpochsPerPeriod
 	prefixSkippedEpochs
+	prefixEpochOffset
+	prefixMintRecord
 )
 
 // KVStore key prefixes
@@ -28,4 +30,6 @@ var (
 	KeyPrefixEpochIdentifier    = []byte{prefixEpochIdentifier}
 	KeyPrefixEpochsPerPeriod    = []byte{prefixEpochsPerPeriod}
 	KeyPrefixSkippedEpochs      = []byte{prefixSkippedEpochs}
+	KeyPrefixEpochOffset        = []byte{prefixEpochOffset}
+	KeyPrefixMintRecord         = []byte{prefixMintRecord}
 )
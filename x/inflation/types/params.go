@@ -3,21 +3,50 @@ package types
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/ethereum/go-ethereum/common"
 	evm "github.com/evmos/ethermint/x/evm/types"
 )
 
 var DefaultInflationDenom = evm.DefaultEVMDenom
 
+// Names of the inflation recipients of the staking rewards, usage incentives
+// and community pool proportions
+const (
+	RecipientStaking       = "staking"
+	RecipientIncentives    = "incentives"
+	RecipientCommunityPool = "community_pool"
+)
+
+// moduleNameRegex matches the names of module accounts
+var moduleNameRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
 // Parameter store keys
 var (
-	ParamStoreKeyMintDenom              = []byte("ParamStoreKeyMintDenom")
-	ParamStoreKeyExponentialCalculation = []byte("ParamStoreKeyExponentialCalculation")
-	ParamStoreKeyInflationDistribution  = []byte("ParamStoreKeyInflationDistribution")
-	ParamStoreKeyEnableInflation        = []byte("ParamStoreKeyEnableInflation")
+	ParamStoreKeyMintDenom                      = []byte("ParamStoreKeyMintDenom")
+	ParamStoreKeyExponentialCalculation         = []byte("ParamStoreKeyExponentialCalculation")
+	ParamStoreKeyInflationDistribution          = []byte("ParamStoreKeyInflationDistribution")
+	ParamStoreKeyEnableInflation                = []byte("ParamStoreKeyEnableInflation")
+	ParamStoreKeyScheduleType                   = []byte("ParamStoreKeyScheduleType")
+	ParamStoreKeySchedule                       = []byte("ParamStoreKeySchedule")
+	ParamStoreKeyMintRecordsRetention           = []byte("ParamStoreKeyMintRecordsRetention")
+	ParamStoreKeyProvisionRecalculationInterval = []byte("ParamStoreKeyProvisionRecalculationInterval")
+	ParamStoreKeyMaxProvisionChange             = []byte("ParamStoreKeyMaxProvisionChange")
+)
+
+const (
+	// DefaultMintRecordsRetention keeps the mint records of one year of daily
+	// epochs
+	DefaultMintRecordsRetention = 365
+	// MaxMintRecordsRetention is the maximum number of epochs for which mint
+	// records are kept
+	MaxMintRecordsRetention = 3650
 )
 
 // ParamTable for inflation module
@@ -55,7 +84,9 @@ func DefaultParams() Params {
 			UsageIncentives: sdk.NewDecWithPrec(333333333, 9), // 0.33 = 25% / (1 - 25%)
 			CommunityPool:   sdk.NewDecWithPrec(133333333, 9), // 0.13 = 10% / (1 - 25%)
 		},
-		EnableInflation: true,
+		EnableInflation:      true,
+		MintRecordsRetention: DefaultMintRecordsRetention,
+		MaxProvisionChange:   sdk.NewDecWithPrec(5, 2), // 5%
 	}
 }
 
@@ -66,6 +97,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyExponentialCalculation, &p.ExponentialCalculation, validateExponentialCalculation),
 		paramtypes.NewParamSetPair(ParamStoreKeyInflationDistribution, &p.InflationDistribution, validateInflationDistribution),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableInflation, &p.EnableInflation, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyScheduleType, &p.ScheduleType, validateScheduleType),
+		paramtypes.NewParamSetPair(ParamStoreKeySchedule, &p.Schedule, validateSchedule),
+		paramtypes.NewParamSetPair(ParamStoreKeyMintRecordsRetention, &p.MintRecordsRetention, validateMintRecordsRetention),
+		paramtypes.NewParamSetPair(ParamStoreKeyProvisionRecalculationInterval, &p.ProvisionRecalculationInterval, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxProvisionChange, &p.MaxProvisionChange, validateMaxProvisionChange),
 	}
 }
 
@@ -133,6 +169,10 @@ func validateInflationDistribution(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if len(v.Recipients) > 0 {
+		return validateInflationRecipients(v)
+	}
+
 	if v.StakingRewards.IsNegative() {
 		return errors.New("staking distribution ratio must not be negative")
 	}
@@ -153,6 +193,100 @@ func validateInflationDistribution(i interface{}) error {
 	return nil
 }
 
+// validateInflationRecipients validates the recipients of an inflation
+// distribution, which replace the staking, usage incentives and community pool
+// proportions.
+func validateInflationRecipients(v InflationDistribution) error {
+	if !v.StakingRewards.IsZero() || !v.UsageIncentives.IsZero() || !v.CommunityPool.IsZero() {
+		return errors.New("staking, usage incentives and community pool distribution ratios must be zero when recipients are set")
+	}
+
+	names := make(map[string]bool, len(v.Recipients))
+	totalWeight := sdk.ZeroDec()
+	for _, recipient := range v.Recipients {
+		if strings.TrimSpace(recipient.Name) == "" {
+			return errors.New("recipient name cannot be blank")
+		}
+
+		if names[recipient.Name] {
+			return fmt.Errorf("duplicate recipient name: %s", recipient.Name)
+		}
+		names[recipient.Name] = true
+
+		if err := ValidateRecipientAddress(recipient.Address); err != nil {
+			return fmt.Errorf("invalid address for recipient %s: %w", recipient.Name, err)
+		}
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return fmt.Errorf("weight of recipient %s must be positive", recipient.Name)
+		}
+
+		totalWeight = totalWeight.Add(recipient.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return errors.New("total recipient weights should be 1")
+	}
+
+	return nil
+}
+
+// ValidateRecipientAddress validates that the address of an inflation
+// recipient is either a hex address, a bech32 account address or a module
+// account name. Bech32 addresses of other chains are rejected.
+func ValidateRecipientAddress(address string) error {
+	accPrefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	switch {
+	case common.IsHexAddress(address):
+		return nil
+	case strings.HasPrefix(address, accPrefix+"1"):
+		_, err := sdk.AccAddressFromBech32(address)
+		return err
+	}
+
+	if hrp, _, err := bech32.DecodeAndConvert(address); err == nil {
+		return fmt.Errorf("invalid bech32 prefix %q, expected %q", hrp, accPrefix)
+	}
+
+	if !moduleNameRegex.MatchString(address) {
+		return fmt.Errorf("invalid module name: %q", address)
+	}
+
+	return nil
+}
+
+func validateScheduleType(i interface{}) error {
+	v, ok := i.(InflationScheduleType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationScheduleType_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inflation schedule type: %d", v)
+	}
+
+	return nil
+}
+
+func validateSchedule(i interface{}) error {
+	v, ok := i.([]SchedulePoint)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, point := range v {
+		if point.AnnualProvision.IsNil() || point.AnnualProvision.IsNegative() {
+			return fmt.Errorf("annual provision of period %d cannot be nil or negative", point.Period)
+		}
+		if j > 0 && point.Period <= v[j-1].Period {
+			return fmt.Errorf("schedule periods must be strictly increasing: %d after %d", point.Period, v[j-1].Period)
+		}
+	}
+
+	return nil
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -162,6 +296,46 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateMintRecordsRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxMintRecordsRetention {
+		return fmt.Errorf("mint records retention cannot exceed %d epochs: %d", MaxMintRecordsRetention, v)
+	}
+
+	return nil
+}
+
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxProvisionChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset max provision change doesn't allow any change
+	if v.IsNil() {
+		return nil
+	}
+
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max provision change must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	if err := validateMintDenom(p.MintDenom); err != nil {
 		return err
@@ -172,6 +346,21 @@ func (p Params) Validate() error {
 	if err := validateInflationDistribution(p.InflationDistribution); err != nil {
 		return err
 	}
+	if err := validateScheduleType(p.ScheduleType); err != nil {
+		return err
+	}
+	if err := validateSchedule(p.Schedule); err != nil {
+		return err
+	}
+	if p.ScheduleType != ScheduleTypeExponential && len(p.Schedule) == 0 {
+		return fmt.Errorf("schedule cannot be empty with the %s schedule type", p.ScheduleType)
+	}
+	if err := validateMintRecordsRetention(p.MintRecordsRetention); err != nil {
+		return err
+	}
+	if err := validateMaxProvisionChange(p.MaxProvisionChange); err != nil {
+		return err
+	}
 
 	return validateBool(p.EnableInflation)
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/evmos/ethermint/tests"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -35,6 +36,11 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		CommunityPool:   sdk.NewDecWithPrec(133333, 6),
 	}
 
+	validSchedule := []SchedulePoint{
+		{Period: 0, AnnualProvision: sdk.NewDec(300_000_000)},
+		{Period: 4, AnnualProvision: sdk.NewDec(100_000_000)},
+	}
+
 	testCases := []struct {
 		name     string
 		params   Params
@@ -253,6 +259,261 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			},
 			true,
 		},
+		{
+			"valid - inflation distribution - recipients",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  sdk.ZeroDec(),
+					UsageIncentives: sdk.ZeroDec(),
+					CommunityPool:   sdk.ZeroDec(),
+					Recipients: []InflationRecipient{
+						{Name: "incentives", Address: "incentives", Weight: sdk.NewDecWithPrec(5, 1)},
+						{Name: "grants", Address: "0x5dCA2483280D9727c80b5518faC4556617fb19AA", Weight: sdk.NewDecWithPrec(25, 2)},
+						{Name: "community_pool", Address: "distribution", Weight: sdk.NewDecWithPrec(25, 2)},
+					},
+				},
+				EnableInflation: true,
+			},
+			false,
+		},
+		{
+			"invalid - inflation distribution - recipients with staking rewards",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  sdk.OneDec(),
+					UsageIncentives: sdk.ZeroDec(),
+					CommunityPool:   sdk.ZeroDec(),
+					Recipients: []InflationRecipient{
+						{Name: "incentives", Address: "incentives", Weight: sdk.OneDec()},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - blank recipient name",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  sdk.ZeroDec(),
+					UsageIncentives: sdk.ZeroDec(),
+					CommunityPool:   sdk.ZeroDec(),
+					Recipients: []InflationRecipient{
+						{Name: "", Address: "incentives", Weight: sdk.OneDec()},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - duplicate recipient name",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  sdk.ZeroDec(),
+					UsageIncentives: sdk.ZeroDec(),
+					CommunityPool:   sdk.ZeroDec(),
+					Recipients: []InflationRecipient{
+						{Name: "incentives", Address: "incentives", Weight: sdk.NewDecWithPrec(5, 1)},
+						{Name: "incentives", Address: "distribution", Weight: sdk.NewDecWithPrec(5, 1)},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - invalid bech32 recipient address",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  sdk.ZeroDec(),
+					UsageIncentives: sdk.ZeroDec(),
+					CommunityPool:   sdk.ZeroDec(),
+					Recipients: []InflationRecipient{
+						{Name: "grants", Address: sdk.GetConfig().GetBech32AccountAddrPrefix() + "1invalid", Weight: sdk.OneDec()},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - bech32 recipient address with another prefix",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  sdk.ZeroDec(),
+					UsageIncentives: sdk.ZeroDec(),
+					CommunityPool:   sdk.ZeroDec(),
+					Recipients: []InflationRecipient{
+						{Name: "grants", Address: sdk.MustBech32ifyAddressBytes("osmo", tests.GenerateAddress().Bytes()), Weight: sdk.OneDec()},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - invalid module name",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  sdk.ZeroDec(),
+					UsageIncentives: sdk.ZeroDec(),
+					CommunityPool:   sdk.ZeroDec(),
+					Recipients: []InflationRecipient{
+						{Name: "grants", Address: "Grants Module", Weight: sdk.OneDec()},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - zero recipient weight",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  sdk.ZeroDec(),
+					UsageIncentives: sdk.ZeroDec(),
+					CommunityPool:   sdk.ZeroDec(),
+					Recipients: []InflationRecipient{
+						{Name: "incentives", Address: "incentives", Weight: sdk.OneDec()},
+						{Name: "distribution", Address: "distribution", Weight: sdk.ZeroDec()},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - inflation distribution - recipient weights unequal 1",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution: InflationDistribution{
+					StakingRewards:  sdk.ZeroDec(),
+					UsageIncentives: sdk.ZeroDec(),
+					CommunityPool:   sdk.ZeroDec(),
+					Recipients: []InflationRecipient{
+						{Name: "incentives", Address: "incentives", Weight: sdk.NewDecWithPrec(5, 1)},
+						{Name: "distribution", Address: "distribution", Weight: sdk.NewDecWithPrec(4, 1)},
+					},
+				},
+				EnableInflation: true,
+			},
+			true,
+		},
+		{
+			"invalid - mint records retention exceeds maximum",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				MintRecordsRetention:   MaxMintRecordsRetention + 1,
+			},
+			true,
+		},
+		{
+			"invalid - max provision change above 1",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				MaxProvisionChange:     sdk.NewDecWithPrec(11, 1),
+			},
+			true,
+		},
+		{
+			"invalid - negative max provision change",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				MaxProvisionChange:     sdk.NewDecWithPrec(-1, 1),
+			},
+			true,
+		},
+		{
+			"valid - table schedule",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				ScheduleType:           ScheduleTypeTable,
+				Schedule:               validSchedule,
+			},
+			false,
+		},
+		{
+			"invalid - unknown schedule type",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				ScheduleType:           InflationScheduleType(3),
+				Schedule:               validSchedule,
+			},
+			true,
+		},
+		{
+			"invalid - piecewise-linear schedule without points",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				ScheduleType:           ScheduleTypePiecewiseLinear,
+			},
+			true,
+		},
+		{
+			"invalid - schedule periods not increasing",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				ScheduleType:           ScheduleTypeTable,
+				Schedule: []SchedulePoint{
+					{Period: 1, AnnualProvision: sdk.NewDec(100)},
+					{Period: 1, AnnualProvision: sdk.NewDec(50)},
+				},
+			},
+			true,
+		},
+		{
+			"invalid - negative annual provision",
+			Params{
+				MintDenom:              "aevmos",
+				ExponentialCalculation: validExponentialCalculation,
+				InflationDistribution:  validInflationDistribution,
+				EnableInflation:        true,
+				ScheduleType:           ScheduleTypeTable,
+				Schedule: []SchedulePoint{
+					{Period: 0, AnnualProvision: sdk.NewDec(-1)},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
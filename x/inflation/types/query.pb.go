@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -447,6 +448,404 @@ func (m *QueryInflationRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryInflationRateResponse proto.InternalMessageInfo
 
+// QueryScheduleRequest is the request type for the Query/Schedule RPC method.
+type QueryScheduleRequest struct {
+	// periods is the number of periods to return, including the current one.
+	// Defaults to 10.
+	Periods uint64 `protobuf:"varint,1,opt,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (m *QueryScheduleRequest) Reset()         { *m = QueryScheduleRequest{} }
+func (m *QueryScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleRequest) ProtoMessage()    {}
+func (*QueryScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{10}
+}
+func (m *QueryScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleRequest.Merge(m, src)
+}
+func (m *QueryScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryScheduleRequest) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+// QueryScheduleResponse is the response type for the Query/Schedule RPC
+// method.
+type QueryScheduleResponse struct {
+	// schedule_type of the inflation params
+	ScheduleType InflationScheduleType `protobuf:"varint,1,opt,name=schedule_type,json=scheduleType,proto3,enum=evmos.inflation.v1.InflationScheduleType" json:"schedule_type,omitempty"`
+	// provisions of the next periods, starting with the current one
+	Provisions []PeriodProvision `protobuf:"bytes,2,rep,name=provisions,proto3" json:"provisions"`
+}
+
+func (m *QueryScheduleResponse) Reset()         { *m = QueryScheduleResponse{} }
+func (m *QueryScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleResponse) ProtoMessage()    {}
+func (*QueryScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{11}
+}
+func (m *QueryScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleResponse.Merge(m, src)
+}
+func (m *QueryScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleResponse) GetScheduleType() InflationScheduleType {
+	if m != nil {
+		return m.ScheduleType
+	}
+	return ScheduleTypeExponential
+}
+
+func (m *QueryScheduleResponse) GetProvisions() []PeriodProvision {
+	if m != nil {
+		return m.Provisions
+	}
+	return nil
+}
+
+// QueryMintRecordsRequest is the request type for the Query/MintRecords RPC
+// method.
+type QueryMintRecordsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintRecordsRequest) Reset()         { *m = QueryMintRecordsRequest{} }
+func (m *QueryMintRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRecordsRequest) ProtoMessage()    {}
+func (*QueryMintRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{12}
+}
+func (m *QueryMintRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRecordsRequest.Merge(m, src)
+}
+func (m *QueryMintRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryMintRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintRecordsResponse is the response type for the Query/MintRecords RPC
+// method.
+type QueryMintRecordsResponse struct {
+	// records of the past epochs, sorted by epoch number
+	Records []MintRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintRecordsResponse) Reset()         { *m = QueryMintRecordsResponse{} }
+func (m *QueryMintRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRecordsResponse) ProtoMessage()    {}
+func (*QueryMintRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{13}
+}
+func (m *QueryMintRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRecordsResponse.Merge(m, src)
+}
+func (m *QueryMintRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryMintRecordsResponse) GetRecords() []MintRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryMintRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintRecordRequest is the request type for the Query/MintRecord RPC
+// method.
+type QueryMintRecordRequest struct {
+	// epoch_number of the mint record
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *QueryMintRecordRequest) Reset()         { *m = QueryMintRecordRequest{} }
+func (m *QueryMintRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRecordRequest) ProtoMessage()    {}
+func (*QueryMintRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{14}
+}
+func (m *QueryMintRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRecordRequest.Merge(m, src)
+}
+func (m *QueryMintRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintRecordRequest proto.InternalMessageInfo
+
+func (m *QueryMintRecordRequest) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+// QueryMintRecordResponse is the response type for the Query/MintRecord RPC
+// method.
+type QueryMintRecordResponse struct {
+	// record of the epoch
+	Record MintRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *QueryMintRecordResponse) Reset()         { *m = QueryMintRecordResponse{} }
+func (m *QueryMintRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRecordResponse) ProtoMessage()    {}
+func (*QueryMintRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{15}
+}
+func (m *QueryMintRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRecordResponse.Merge(m, src)
+}
+func (m *QueryMintRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintRecordResponse proto.InternalMessageInfo
+
+func (m *QueryMintRecordResponse) GetRecord() MintRecord {
+	if m != nil {
+		return m.Record
+	}
+	return MintRecord{}
+}
+
+// QueryProjectionRequest is the request type for the Query/Projection RPC
+// method.
+type QueryProjectionRequest struct {
+	// periods is the number of periods to project after the current one.
+	// Defaults to 10.
+	Periods uint64 `protobuf:"varint,1,opt,name=periods,proto3" json:"periods,omitempty"`
+	// bonded_ratios assumed for each projected period. The last bonded ratio
+	// applies to the remaining periods. Defaults to the current bonded ratio.
+	BondedRatios []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,rep,name=bonded_ratios,json=bondedRatios,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonded_ratios"`
+}
+
+func (m *QueryProjectionRequest) Reset()         { *m = QueryProjectionRequest{} }
+func (m *QueryProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionRequest) ProtoMessage()    {}
+func (*QueryProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{16}
+}
+func (m *QueryProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionRequest.Merge(m, src)
+}
+func (m *QueryProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryProjectionRequest) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+// QueryProjectionResponse is the response type for the Query/Projection RPC
+// method.
+type QueryProjectionResponse struct {
+	// projections of the periods following the current one
+	Projections []PeriodProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QueryProjectionResponse) Reset()         { *m = QueryProjectionResponse{} }
+func (m *QueryProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionResponse) ProtoMessage()    {}
+func (*QueryProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{17}
+}
+func (m *QueryProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionResponse.Merge(m, src)
+}
+func (m *QueryProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryProjectionResponse) GetProjections() []PeriodProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -455,7 +854,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b9f1b5d47c7fd7, []int{10}
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{18}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -494,7 +893,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b9f1b5d47c7fd7, []int{11}
+	return fileDescriptor_91b9f1b5d47c7fd7, []int{19}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -541,6 +940,14 @@ func init() {
 	proto.RegisterType((*QueryCirculatingSupplyResponse)(nil), "evmos.inflation.v1.QueryCirculatingSupplyResponse")
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "evmos.inflation.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "evmos.inflation.v1.QueryInflationRateResponse")
+	proto.RegisterType((*QueryScheduleRequest)(nil), "evmos.inflation.v1.QueryScheduleRequest")
+	proto.RegisterType((*QueryScheduleResponse)(nil), "evmos.inflation.v1.QueryScheduleResponse")
+	proto.RegisterType((*QueryMintRecordsRequest)(nil), "evmos.inflation.v1.QueryMintRecordsRequest")
+	proto.RegisterType((*QueryMintRecordsResponse)(nil), "evmos.inflation.v1.QueryMintRecordsResponse")
+	proto.RegisterType((*QueryMintRecordRequest)(nil), "evmos.inflation.v1.QueryMintRecordRequest")
+	proto.RegisterType((*QueryMintRecordResponse)(nil), "evmos.inflation.v1.QueryMintRecordResponse")
+	proto.RegisterType((*QueryProjectionRequest)(nil), "evmos.inflation.v1.QueryProjectionRequest")
+	proto.RegisterType((*QueryProjectionResponse)(nil), "evmos.inflation.v1.QueryProjectionResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.inflation.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.inflation.v1.QueryParamsResponse")
 }
@@ -548,50 +955,75 @@ func init() {
 func init() { proto.RegisterFile("evmos/inflation/v1/query.proto", fileDescriptor_91b9f1b5d47c7fd7) }
 
 var fileDescriptor_91b9f1b5d47c7fd7 = []byte{
-	// 682 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x3f, 0x6f, 0xd3, 0x4c,
-	0x1c, 0xc7, 0x73, 0x8f, 0xfa, 0x04, 0x71, 0xa8, 0x95, 0x7a, 0x54, 0x08, 0x4c, 0x71, 0x2a, 0x0b,
-	0x4a, 0x55, 0xe8, 0x5d, 0x93, 0x2e, 0xcc, 0x2d, 0x20, 0x31, 0x20, 0x4a, 0x2a, 0x16, 0x96, 0xc8,
-	0x71, 0x0f, 0xf7, 0xd4, 0xe6, 0xee, 0xea, 0x73, 0x2c, 0x3a, 0x20, 0x21, 0x78, 0x03, 0x48, 0x6c,
-	0x0c, 0x2c, 0x0c, 0x48, 0x5d, 0x78, 0x1b, 0x1d, 0x2b, 0xb1, 0x20, 0x86, 0x82, 0x1a, 0x5e, 0x08,
-	0xf2, 0xf9, 0x9c, 0xc6, 0xf5, 0x39, 0x6d, 0x07, 0x96, 0xc4, 0xf9, 0xfd, 0xfd, 0xfa, 0xeb, 0xfb,
-	0x38, 0xd0, 0xa5, 0x49, 0x4f, 0x28, 0xc2, 0xf8, 0xab, 0x1d, 0x3f, 0x66, 0x82, 0x93, 0xa4, 0x49,
-	0x76, 0xfb, 0x34, 0xda, 0xc3, 0x32, 0x12, 0xb1, 0x40, 0x48, 0xe7, 0xf1, 0x30, 0x8f, 0x93, 0xa6,
-	0xe3, 0x06, 0x42, 0xa5, 0x4d, 0x5d, 0x5f, 0x51, 0x92, 0x34, 0xbb, 0x34, 0xf6, 0x9b, 0x24, 0x10,
-	0x8c, 0x67, 0x3d, 0xce, 0x9c, 0x65, 0x66, 0x48, 0x39, 0x55, 0x4c, 0x99, 0x8a, 0x99, 0x50, 0x84,
-	0x42, 0x5f, 0x92, 0xf4, 0xca, 0x44, 0x67, 0x43, 0x21, 0xc2, 0x1d, 0x4a, 0x7c, 0xc9, 0x88, 0xcf,
-	0xb9, 0x88, 0x75, 0xb7, 0xe9, 0xf1, 0x66, 0x20, 0x7a, 0x9e, 0x0a, 0x5b, 0xa7, 0x11, 0x13, 0x9b,
-	0x6d, 0xba, 0xdb, 0xa7, 0x2a, 0xf6, 0x96, 0xe0, 0xd5, 0x42, 0x54, 0x49, 0xc1, 0x15, 0x45, 0xd7,
-	0x60, 0x5d, 0xea, 0xc8, 0x75, 0x30, 0x07, 0x16, 0x26, 0xda, 0xe6, 0x97, 0x37, 0x07, 0x5d, 0x5d,
-	0xfe, 0x48, 0x8a, 0x60, 0xeb, 0x29, 0xe3, 0xf1, 0x7a, 0x24, 0x12, 0xa6, 0x98, 0xe0, 0xf9, 0xc0,
-	0xaf, 0x00, 0x36, 0x2a, 0x4b, 0xcc, 0xf4, 0xf7, 0x00, 0xce, 0xd0, 0x34, 0xdd, 0xe9, 0x31, 0x1e,
-	0x77, 0x64, 0x5e, 0xa0, 0x97, 0x5d, 0x69, 0xcd, 0xe2, 0xcc, 0x20, 0x9c, 0x1a, 0x84, 0x8d, 0x41,
-	0xf8, 0x21, 0x0d, 0xd6, 0x04, 0xe3, 0xab, 0x2b, 0x07, 0x47, 0x8d, 0xda, 0xfe, 0xaf, 0xc6, 0xbd,
-	0x90, 0xc5, 0x5b, 0xfd, 0x2e, 0x0e, 0x44, 0x8f, 0x18, 0x43, 0xb3, 0xaf, 0x25, 0xb5, 0xb9, 0x4d,
-	0xe2, 0x3d, 0x49, 0x55, 0xde, 0xa3, 0xda, 0x88, 0x96, 0xd4, 0x78, 0x37, 0xe1, 0x0d, 0x2d, 0x74,
-	0x63, 0x9b, 0x49, 0x49, 0x37, 0xb5, 0x5e, 0x95, 0xdf, 0xc6, 0x1a, 0x74, 0x6c, 0x49, 0x73, 0x03,
-	0x77, 0xe0, 0x94, 0xca, 0x12, 0x1d, 0x3d, 0x58, 0x19, 0x9b, 0x26, 0xd5, 0x68, 0xb9, 0xd7, 0x80,
-	0xb7, 0xf4, 0x90, 0x35, 0x16, 0x05, 0xfd, 0xf4, 0x59, 0xf2, 0x70, 0xa3, 0x2f, 0xe5, 0xce, 0x5e,
-	0xbe, 0xe5, 0x0b, 0x30, 0x7e, 0x5a, 0x2a, 0xcc, 0xaa, 0xb7, 0x00, 0xa2, 0xe0, 0x24, 0xdb, 0x51,
-	0x3a, 0xfd, 0xef, 0x9c, 0x9a, 0x0e, 0x4e, 0x4b, 0x19, 0x1a, 0xf5, 0x24, 0x3f, 0x90, 0x6d, 0x3f,
-	0xa6, 0xf9, 0x2d, 0x28, 0x63, 0xd4, 0xa9, 0xa4, 0x51, 0xff, 0x02, 0x4e, 0x0d, 0x8f, 0x71, 0x27,
-	0xf2, 0x63, 0xaa, 0x85, 0x5f, 0x5e, 0xc5, 0xa9, 0xb4, 0x9f, 0x47, 0x8d, 0xf9, 0xf3, 0x49, 0x6b,
-	0x4f, 0xb2, 0xd1, 0xf1, 0x27, 0x67, 0xd9, 0x8f, 0xfc, 0xde, 0xf0, 0x99, 0x3d, 0xcb, 0xcf, 0xb2,
-	0x89, 0x1a, 0x0d, 0x0f, 0x60, 0x5d, 0xea, 0x88, 0x31, 0xcd, 0xc1, 0x65, 0x26, 0x71, 0xd6, 0xb3,
-	0x3a, 0x91, 0xea, 0x6a, 0x9b, 0xfa, 0xd6, 0xe7, 0x4b, 0xf0, 0x7f, 0x3d, 0x11, 0xbd, 0x81, 0xf5,
-	0x8c, 0x10, 0x34, 0x6f, 0xeb, 0x2e, 0x83, 0xe5, 0xdc, 0x3d, 0xb3, 0x2e, 0x93, 0xe7, 0x79, 0xef,
-	0xbe, 0xff, 0xf9, 0xf8, 0xdf, 0x2c, 0x72, 0x88, 0x05, 0xfb, 0x0c, 0x3b, 0xf4, 0x0d, 0x40, 0x54,
-	0xe6, 0x09, 0xb5, 0x2a, 0x77, 0x54, 0xf2, 0xe9, 0xac, 0x5c, 0xa8, 0xc7, 0x68, 0x5c, 0xd6, 0x1a,
-	0x17, 0xd1, 0x82, 0x4d, 0xa3, 0x8d, 0x64, 0xf4, 0x09, 0xc0, 0xc9, 0x02, 0x3b, 0x68, 0xa9, 0x72,
-	0xb1, 0x0d, 0x40, 0x07, 0x9f, 0xb7, 0xdc, 0x48, 0x5c, 0xd4, 0x12, 0x6f, 0x23, 0xcf, 0x26, 0xb1,
-	0x08, 0x2b, 0xda, 0x07, 0x70, 0xba, 0x44, 0x1c, 0x6a, 0x56, 0x6e, 0xac, 0xe2, 0xd7, 0x69, 0x5d,
-	0xa4, 0xc5, 0x08, 0xc5, 0x5a, 0xe8, 0x02, 0x9a, 0xb7, 0x09, 0x2d, 0x93, 0xae, 0x9d, 0x2c, 0xc0,
-	0x35, 0xc6, 0x49, 0x1b, 0xa1, 0x63, 0x9c, 0xb4, 0x32, 0x3b, 0xde, 0xc9, 0x22, 0xcd, 0x9a, 0x0b,
-	0xcd, 0xca, 0x38, 0x2e, 0x46, 0x21, 0x1d, 0xc7, 0x45, 0x01, 0xdb, 0x33, 0xb8, 0xc8, 0x70, 0x7d,
-	0x7c, 0x70, 0xec, 0x82, 0xc3, 0x63, 0x17, 0xfc, 0x3e, 0x76, 0xc1, 0x87, 0x81, 0x5b, 0x3b, 0x1c,
-	0xb8, 0xb5, 0x1f, 0x03, 0xb7, 0xf6, 0xf2, 0xfe, 0xc8, 0x8b, 0x25, 0xeb, 0xcf, 0x3e, 0x93, 0xe6,
-	0x32, 0x79, 0x3d, 0x32, 0x4b, 0xbf, 0x62, 0xba, 0x75, 0xfd, 0x17, 0xb9, 0xf2, 0x37, 0x00, 0x00,
-	0xff, 0xff, 0xea, 0xf4, 0xef, 0xc4, 0xce, 0x07, 0x00, 0x00,
+	// 1086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x33, 0x69, 0x49, 0xe1, 0x6d, 0x36, 0x52, 0xa7, 0xa1, 0x5d, 0x4c, 0xf0, 0x2e, 0x26,
+	0x6c, 0xb6, 0x49, 0x63, 0x67, 0x37, 0x07, 0x90, 0x40, 0x1c, 0x12, 0x28, 0xaa, 0x04, 0x25, 0x38,
+	0x20, 0x24, 0x2e, 0x2b, 0xaf, 0x77, 0x70, 0x4c, 0xb3, 0x1e, 0xd7, 0xe3, 0x5d, 0x11, 0xa1, 0x4a,
+	0x08, 0x84, 0x38, 0x21, 0x21, 0xb8, 0x71, 0xe0, 0xc2, 0x01, 0xa9, 0x17, 0x24, 0xfe, 0x8a, 0x1e,
+	0x2b, 0x71, 0x41, 0x1c, 0x0a, 0x4a, 0x38, 0xf2, 0x47, 0x20, 0xcf, 0x8f, 0x5d, 0x3b, 0xb6, 0x13,
+	0xe7, 0xc0, 0x25, 0xb1, 0x67, 0xde, 0x8f, 0xcf, 0xbc, 0x79, 0x7e, 0x5f, 0x2d, 0xe8, 0x64, 0x32,
+	0xa2, 0xcc, 0xf2, 0x83, 0x4f, 0x0e, 0x9d, 0xd8, 0xa7, 0x81, 0x35, 0xe9, 0x5a, 0xf7, 0xc7, 0x24,
+	0x3a, 0x32, 0xc3, 0x88, 0xc6, 0x14, 0x63, 0xbe, 0x6f, 0x4e, 0xf7, 0xcd, 0x49, 0x57, 0x5b, 0x77,
+	0x29, 0x4b, 0x9c, 0x06, 0x0e, 0x23, 0xc2, 0xd8, 0x9a, 0x74, 0x07, 0x24, 0x76, 0xba, 0x56, 0xe8,
+	0x78, 0x7e, 0x20, 0x0c, 0xb9, 0xbf, 0xa6, 0xa7, 0x6d, 0x95, 0x95, 0x4b, 0x7d, 0xb5, 0xdf, 0x2a,
+	0xc8, 0xef, 0x91, 0x80, 0x30, 0x9f, 0x49, 0x0b, 0xa3, 0xc0, 0x62, 0x86, 0x23, 0x6c, 0x96, 0x3d,
+	0xea, 0x51, 0xfe, 0x68, 0x25, 0x4f, 0x72, 0x75, 0xc5, 0xa3, 0xd4, 0x3b, 0x24, 0x96, 0x13, 0xfa,
+	0x96, 0x13, 0x04, 0x34, 0xe6, 0x2e, 0x32, 0xae, 0xb1, 0x0c, 0xf8, 0xfd, 0x84, 0x7d, 0x8f, 0x44,
+	0x3e, 0x1d, 0xda, 0xe4, 0xfe, 0x98, 0xb0, 0xd8, 0xd8, 0x84, 0x6b, 0x99, 0x55, 0x16, 0xd2, 0x80,
+	0x11, 0x7c, 0x1d, 0x16, 0x42, 0xbe, 0xd2, 0x40, 0x2d, 0xd4, 0xb9, 0x6c, 0xcb, 0x37, 0xa3, 0x05,
+	0x3a, 0x37, 0x7f, 0x2b, 0xa4, 0xee, 0xc1, 0xbb, 0x7e, 0x10, 0xef, 0x45, 0x74, 0xe2, 0x33, 0x9f,
+	0x06, 0x2a, 0xe0, 0x2f, 0x08, 0x9a, 0xa5, 0x26, 0x32, 0xfa, 0x57, 0x08, 0x96, 0x49, 0xb2, 0xdd,
+	0x1f, 0xf9, 0x41, 0xdc, 0x0f, 0x95, 0x01, 0x4f, 0x56, 0xeb, 0xad, 0x98, 0xa2, 0x88, 0x66, 0x52,
+	0x44, 0x53, 0x16, 0xd1, 0x7c, 0x93, 0xb8, 0xbb, 0xd4, 0x0f, 0x76, 0xb6, 0x1f, 0x3d, 0x69, 0xce,
+	0x3d, 0xfc, 0xab, 0xb9, 0xe1, 0xf9, 0xf1, 0xc1, 0x78, 0x60, 0xba, 0x74, 0x64, 0xc9, 0xa2, 0x8b,
+	0x7f, 0x9b, 0x6c, 0x78, 0xcf, 0x8a, 0x8f, 0x42, 0xc2, 0x94, 0x0f, 0xb3, 0x31, 0xc9, 0xd1, 0x18,
+	0xcf, 0xc3, 0x73, 0x1c, 0x74, 0xff, 0x9e, 0x1f, 0x86, 0x64, 0xc8, 0x79, 0x99, 0x3a, 0xc6, 0x2e,
+	0x68, 0x45, 0x9b, 0xf2, 0x00, 0x2f, 0xc3, 0x12, 0x13, 0x1b, 0x7d, 0x1e, 0x98, 0xc9, 0x32, 0xd5,
+	0x59, 0xda, 0xdc, 0x68, 0xc2, 0x0b, 0x3c, 0xc8, 0xae, 0x1f, 0xb9, 0xe3, 0xe4, 0x02, 0x03, 0x6f,
+	0x7f, 0x1c, 0x86, 0x87, 0x47, 0x2a, 0xcb, 0xcf, 0x08, 0xf4, 0x32, 0x0b, 0x99, 0xea, 0x0b, 0x04,
+	0xd8, 0x9d, 0xed, 0xf6, 0x19, 0xdf, 0xfe, 0xff, 0x2a, 0x75, 0xd5, 0x3d, 0x8d, 0x32, 0x2d, 0xd4,
+	0x1d, 0xd5, 0x85, 0xb6, 0x13, 0x13, 0x75, 0x04, 0x06, 0x5a, 0xd1, 0xa6, 0xa4, 0xff, 0x10, 0x96,
+	0xa6, 0xbd, 0xdb, 0x8f, 0x9c, 0x98, 0x70, 0xf0, 0x67, 0x76, 0xcc, 0x04, 0xed, 0xcf, 0x27, 0xcd,
+	0x76, 0x35, 0x34, 0xbb, 0xee, 0xa7, 0xc3, 0x1b, 0x5b, 0xb0, 0x2c, 0x6e, 0xc7, 0x3d, 0x20, 0xc3,
+	0xf1, 0xa1, 0x82, 0xc1, 0x0d, 0xb8, 0x22, 0x1a, 0x55, 0x5d, 0x88, 0x7a, 0x35, 0x7e, 0x43, 0xf0,
+	0xec, 0x29, 0x17, 0x89, 0x78, 0x17, 0xea, 0x4c, 0xae, 0xf5, 0x93, 0x84, 0xdc, 0x73, 0xa9, 0x77,
+	0xd3, 0xcc, 0x4f, 0x02, 0x73, 0x7a, 0x48, 0x15, 0xe5, 0x83, 0xa3, 0x90, 0xd8, 0x8b, 0x2c, 0xf5,
+	0x86, 0xef, 0x00, 0x4c, 0x1b, 0x9a, 0x35, 0xe6, 0x5b, 0x97, 0x3a, 0xb5, 0xde, 0x4b, 0x45, 0xc1,
+	0xc4, 0x27, 0x37, 0xed, 0xc7, 0x9d, 0xcb, 0x49, 0x4d, 0xec, 0x94, 0xb3, 0xe1, 0xc0, 0x0d, 0xce,
+	0x9c, 0xf4, 0xad, 0x4d, 0x5c, 0x1a, 0x0d, 0x55, 0x7f, 0xe2, 0xdb, 0x00, 0xb3, 0xd9, 0x23, 0xbb,
+	0xa1, 0x9d, 0xe9, 0x06, 0x31, 0xd5, 0x54, 0x4f, 0xec, 0x39, 0x9e, 0xaa, 0x92, 0x9d, 0xf2, 0x4c,
+	0x3a, 0xb0, 0x91, 0xcf, 0x21, 0x4b, 0xf3, 0x06, 0x5c, 0x89, 0xc4, 0x52, 0x03, 0xf1, 0x73, 0xe8,
+	0x45, 0xe7, 0x98, 0x79, 0xca, 0x23, 0x28, 0x27, 0xfc, 0x76, 0x06, 0x72, 0x9e, 0x43, 0xae, 0x9d,
+	0x0b, 0x29, 0x92, 0x67, 0x28, 0x5f, 0x83, 0xeb, 0xa7, 0x20, 0x55, 0x1d, 0x5e, 0x84, 0x45, 0x31,
+	0x49, 0x82, 0xf1, 0x68, 0x40, 0x22, 0x5e, 0x89, 0x4b, 0x76, 0x8d, 0xaf, 0xdd, 0xe5, 0x4b, 0xc6,
+	0x47, 0xb9, 0x2a, 0x4e, 0x0f, 0xf8, 0x3a, 0x2c, 0x08, 0x56, 0x59, 0xc1, 0x6a, 0xe7, 0x93, 0x3e,
+	0xc6, 0x37, 0x48, 0x62, 0xed, 0x45, 0xf4, 0x53, 0xe2, 0xc6, 0xb3, 0x29, 0x58, 0xde, 0x88, 0x78,
+	0x1f, 0xea, 0x03, 0x1a, 0x0c, 0xc9, 0x30, 0xf9, 0x1c, 0x7c, 0x2a, 0x3a, 0xe4, 0xe2, 0x1f, 0xc4,
+	0xa2, 0x08, 0x62, 0xf3, 0x18, 0x86, 0x07, 0x37, 0x72, 0x20, 0xf2, 0x88, 0xef, 0x40, 0x2d, 0x9c,
+	0xae, 0xaa, 0x7b, 0x5c, 0x3d, 0xb3, 0x1f, 0xa5, 0xb1, 0x3c, 0x6d, 0xda, 0x7d, 0x26, 0x22, 0x4e,
+	0xe4, 0x8c, 0xa6, 0xc3, 0xf2, 0x3d, 0xb8, 0x96, 0x59, 0x95, 0xa9, 0x5f, 0x85, 0x85, 0x90, 0xaf,
+	0xc8, 0xea, 0x6a, 0x85, 0x59, 0xb9, 0x85, 0xaa, 0xac, 0xb0, 0xef, 0xfd, 0x5b, 0x83, 0xa7, 0x78,
+	0x44, 0xfc, 0x00, 0x16, 0x04, 0x17, 0x6e, 0x17, 0x79, 0xe7, 0x15, 0x4d, 0x5b, 0x3b, 0xd7, 0x4e,
+	0xe0, 0x19, 0xc6, 0x97, 0xbf, 0xff, 0xf3, 0xc3, 0xfc, 0x0a, 0xd6, 0xac, 0x02, 0xc5, 0x15, 0xd7,
+	0x85, 0x7f, 0x45, 0x80, 0xf3, 0x42, 0x86, 0x7b, 0xa5, 0x39, 0x4a, 0x85, 0x51, 0xdb, 0xbe, 0x90,
+	0x8f, 0x64, 0xdc, 0xe2, 0x8c, 0xeb, 0xb8, 0x53, 0xc4, 0x58, 0x24, 0xa1, 0xf8, 0x47, 0x04, 0xf5,
+	0x8c, 0x68, 0xe1, 0xcd, 0xd2, 0xc4, 0x45, 0xca, 0xa7, 0x99, 0x55, 0xcd, 0x25, 0xe2, 0x3a, 0x47,
+	0x5c, 0xc5, 0x46, 0x11, 0x62, 0x56, 0x25, 0xf1, 0x43, 0x04, 0x57, 0x73, 0x52, 0x87, 0xbb, 0xa5,
+	0x19, 0xcb, 0x84, 0x53, 0xeb, 0x5d, 0xc4, 0x45, 0x82, 0x9a, 0x1c, 0xb4, 0x83, 0xdb, 0x45, 0xa0,
+	0x79, 0x89, 0xe5, 0x95, 0xcc, 0xa8, 0xda, 0x19, 0x95, 0x2c, 0x92, 0x46, 0xcd, 0xac, 0x6a, 0x5e,
+	0xa5, 0x92, 0x59, 0x19, 0xc5, 0x5f, 0x23, 0x78, 0x5a, 0x89, 0x10, 0xee, 0x94, 0x5f, 0x59, 0x56,
+	0x20, 0xb5, 0x9b, 0x15, 0x2c, 0x25, 0xcd, 0x2a, 0xa7, 0xd1, 0xf1, 0x4a, 0xe1, 0xbd, 0xaa, 0xd4,
+	0xdf, 0x23, 0xa8, 0xa5, 0xa4, 0x03, 0x6f, 0x94, 0x26, 0xc8, 0x8b, 0x98, 0x76, 0xab, 0x9a, 0xb1,
+	0x04, 0xea, 0x70, 0x20, 0x03, 0xb7, 0x8a, 0x80, 0xf8, 0x57, 0xa0, 0x74, 0xe7, 0x27, 0x04, 0x30,
+	0x8b, 0x80, 0xd7, 0x2b, 0xa4, 0x51, 0x48, 0x1b, 0x95, 0x6c, 0x25, 0xd1, 0x2b, 0x9c, 0xa8, 0x8b,
+	0xad, 0xf3, 0x88, 0xac, 0xcf, 0xd3, 0x22, 0xf5, 0x00, 0x7f, 0x8b, 0x00, 0x66, 0x83, 0xf6, 0x0c,
+	0xc0, 0x9c, 0xb2, 0x68, 0x1b, 0x95, 0x6c, 0x25, 0x60, 0x9b, 0x03, 0xb6, 0xb0, 0x5e, 0x38, 0xe2,
+	0x66, 0x00, 0xc9, 0x94, 0xe5, 0x93, 0xf7, 0xac, 0x29, 0x9b, 0x1e, 0xf9, 0xda, 0xda, 0xb9, 0x76,
+	0x95, 0xa6, 0xac, 0x18, 0xfe, 0xb7, 0x1f, 0x1d, 0xeb, 0xe8, 0xf1, 0xb1, 0x8e, 0xfe, 0x3e, 0xd6,
+	0xd1, 0x77, 0x27, 0xfa, 0xdc, 0xe3, 0x13, 0x7d, 0xee, 0x8f, 0x13, 0x7d, 0xee, 0xe3, 0x5b, 0x29,
+	0x39, 0x14, 0xfe, 0xe2, 0xef, 0xa4, 0xbb, 0x65, 0x7d, 0x96, 0x8a, 0xc5, 0x85, 0x71, 0xb0, 0xc0,
+	0x7f, 0xe9, 0x6c, 0xff, 0x37, 0x00, 0x57, 0xbc, 0x3a, 0xdd, 0xe5, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -617,6 +1049,16 @@ type QueryClient interface {
 	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
+	// Schedule retrieves the provisions of the inflation schedule for the next
+	// periods, starting with the current one.
+	Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error)
+	// MintRecords retrieves the mint records of the past epochs.
+	MintRecords(ctx context.Context, in *QueryMintRecordsRequest, opts ...grpc.CallOption) (*QueryMintRecordsResponse, error)
+	// MintRecord retrieves the mint record of an epoch.
+	MintRecord(ctx context.Context, in *QueryMintRecordRequest, opts ...grpc.CallOption) (*QueryMintRecordResponse, error)
+	// Projection retrieves the projected provisions of the periods following the
+	// current one for assumed bonded ratios.
+	Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -674,6 +1116,42 @@ func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateR
 	return out, nil
 }
 
+func (c *queryClient) Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error) {
+	out := new(QueryScheduleResponse)
+	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/Schedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintRecords(ctx context.Context, in *QueryMintRecordsRequest, opts ...grpc.CallOption) (*QueryMintRecordsResponse, error) {
+	out := new(QueryMintRecordsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/MintRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintRecord(ctx context.Context, in *QueryMintRecordRequest, opts ...grpc.CallOption) (*QueryMintRecordResponse, error) {
+	out := new(QueryMintRecordResponse)
+	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/MintRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Projection(ctx context.Context, in *QueryProjectionRequest, opts ...grpc.CallOption) (*QueryProjectionResponse, error) {
+	out := new(QueryProjectionResponse)
+	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/Projection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/evmos.inflation.v1.Query/Params", in, out, opts...)
@@ -696,6 +1174,16 @@ type QueryServer interface {
 	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
 	// InflationRate retrieves the inflation rate of the current period.
 	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// Schedule retrieves the provisions of the inflation schedule for the next
+	// periods, starting with the current one.
+	Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error)
+	// MintRecords retrieves the mint records of the past epochs.
+	MintRecords(context.Context, *QueryMintRecordsRequest) (*QueryMintRecordsResponse, error)
+	// MintRecord retrieves the mint record of an epoch.
+	MintRecord(context.Context, *QueryMintRecordRequest) (*QueryMintRecordResponse, error)
+	// Projection retrieves the projected provisions of the periods following the
+	// current one for assumed bonded ratios.
+	Projection(context.Context, *QueryProjectionRequest) (*QueryProjectionResponse, error)
 	// Params retrieves the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -719,6 +1207,18 @@ func (*UnimplementedQueryServer) CirculatingSupply(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) InflationRate(ctx context.Context, req *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
 }
+func (*UnimplementedQueryServer) Schedule(ctx context.Context, req *QueryScheduleRequest) (*QueryScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedule not implemented")
+}
+func (*UnimplementedQueryServer) MintRecords(ctx context.Context, req *QueryMintRecordsRequest) (*QueryMintRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintRecords not implemented")
+}
+func (*UnimplementedQueryServer) MintRecord(ctx context.Context, req *QueryMintRecordRequest) (*QueryMintRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintRecord not implemented")
+}
+func (*UnimplementedQueryServer) Projection(ctx context.Context, req *QueryProjectionRequest) (*QueryProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projection not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -817,17 +1317,89 @@ func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_Schedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).Schedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/evmos.inflation.v1.Query/Params",
+		FullMethod: "/evmos.inflation.v1.Query/Schedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedule(ctx, req.(*QueryScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.inflation.v1.Query/MintRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintRecords(ctx, req.(*QueryMintRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.inflation.v1.Query/MintRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintRecord(ctx, req.(*QueryMintRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Projection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.inflation.v1.Query/Projection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projection(ctx, req.(*QueryProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.inflation.v1.Query/Params",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
@@ -859,6 +1431,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InflationRate",
 			Handler:    _Query_InflationRate_Handler,
 		},
+		{
+			MethodName: "Schedule",
+			Handler:    _Query_Schedule_Handler,
+		},
+		{
+			MethodName: "MintRecords",
+			Handler:    _Query_MintRecords_Handler,
+		},
+		{
+			MethodName: "MintRecord",
+			Handler:    _Query_MintRecord_Handler,
+		},
+		{
+			MethodName: "Projection",
+			Handler:    _Query_Projection_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1138,7 +1726,7 @@ func (m *QueryInflationRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryScheduleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1148,20 +1736,25 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Periods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryScheduleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1171,169 +1764,1080 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Provisions) > 0 {
+		for iNdEx := len(m.Provisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	if m.ScheduleType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ScheduleType))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMintRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPeriodRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryMintRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPeriodResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMintRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Period != 0 {
-		n += 1 + sovQuery(uint64(m.Period))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochMintProvisionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryEpochMintProvisionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.EpochMintProvision.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryMintRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySkippedEpochsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMintRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySkippedEpochsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SkippedEpochs != 0 {
-		n += 1 + sovQuery(uint64(m.SkippedEpochs))
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCirculatingSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCirculatingSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CirculatingSupply.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryMintRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInflationRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMintRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInflationRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.InflationRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
+	if len(m.BondedRatios) > 0 {
+		for iNdEx := len(m.BondedRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BondedRatios[iNdEx].Size()
+				i -= size
+				if _, err := m.BondedRatios[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Periods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPeriodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *QueryEpochMintProvisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochMintProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochMintProvision.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QuerySkippedEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QuerySkippedEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SkippedEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.SkippedEpochs))
+	}
+	return n
+}
+
+func (m *QueryCirculatingSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCirculatingSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		n += 1 + sovQuery(uint64(m.Periods))
+	}
+	return n
+}
+
+func (m *QueryScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScheduleType != 0 {
+		n += 1 + sovQuery(uint64(m.ScheduleType))
+	}
+	if len(m.Provisions) > 0 {
+		for _, e := range m.Provisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMintRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func (m *QueryMintRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		n += 1 + sovQuery(uint64(m.Periods))
+	}
+	if len(m.BondedRatios) > 0 {
+		for _, e := range m.BondedRatios {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkippedEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkippedEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkippedEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkippedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedEpochs", wireType)
+			}
+			m.SkippedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1356,10 +2860,10 @@ func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPeriodRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1383,7 +2887,7 @@ func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1406,17 +2910,17 @@ func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPeriodResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
 			}
-			m.Period = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1426,11 +2930,26 @@ func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1452,7 +2971,7 @@ func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1475,12 +2994,31 @@ func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1502,7 +3040,7 @@ func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1525,15 +3063,34 @@ func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleType", wireType)
+			}
+			m.ScheduleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleType |= InflationScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provisions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1560,7 +3117,8 @@ func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Provisions = append(m.Provisions, PeriodProvision{})
+			if err := m.Provisions[len(m.Provisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1585,7 +3143,7 @@ func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1608,12 +3166,48 @@ func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySkippedEpochsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySkippedEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1635,7 +3229,7 @@ func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1658,17 +3252,17 @@ func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySkippedEpochsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySkippedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkippedEpochs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			m.SkippedEpochs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1678,11 +3272,62 @@ func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SkippedEpochs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, MintRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1704,7 +3349,7 @@ func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1727,12 +3372,31 @@ func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1754,7 +3418,7 @@ func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1777,15 +3441,15 @@ func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1812,7 +3476,7 @@ func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1837,7 +3501,7 @@ func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1860,12 +3524,67 @@ func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatios", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.BondedRatios = append(m.BondedRatios, v)
+			if err := m.BondedRatios[len(m.BondedRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1887,7 +3606,7 @@ func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1910,17 +3629,17 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1930,23 +3649,23 @@ func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Projections = append(m.Projections, PeriodProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
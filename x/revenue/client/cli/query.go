@@ -29,6 +29,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryDeployerRevenues(),
 		GetCmdQueryWithdrawerRevenues(),
+		GetCmdQueryContractEarnings(),
+		GetCmdQueryWithdrawerEarnings(),
+		GetCmdQueryEpochEarnings(),
+		GetCmdQueryTopEarners(),
+		GetCmdQueryDeveloperSharesOverrides(),
+		GetCmdQueryDeveloperSharesCategories(),
+		GetCmdQueryContractCategories(),
 	)
 
 	return feesQueryCmd
@@ -209,3 +216,255 @@ func GetCmdQueryWithdrawerRevenues() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryContractEarnings implements a command to return the cumulative
+// earnings of a contract
+func GetCmdQueryContractEarnings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-earnings CONTRACT_ADDRESS",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the cumulative developer revenue distributed for a contract",
+		Long:    "Query the cumulative developer revenue distributed for a contract by hex address",
+		Example: fmt.Sprintf("%s query revenue contract-earnings <contract-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryContractEarningsRequest{ContractAddress: args[0]}
+
+			// Query store
+			res, err := queryClient.ContractEarnings(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryWithdrawerEarnings implements a command to return the cumulative
+// earnings of a withdraw address
+func GetCmdQueryWithdrawerEarnings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdrawer-earnings WITHDRAWER_ADDRESS",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the cumulative developer revenue received by a withdrawer address",
+		Long:    "Query the cumulative developer revenue received by a withdrawer address across all contracts",
+		Example: fmt.Sprintf("%s query revenue withdrawer-earnings <withdrawer-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryWithdrawerEarningsRequest{WithdrawerAddress: args[0]}
+
+			// Query store
+			res, err := queryClient.WithdrawerEarnings(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEpochEarnings implements a command to return the per-epoch
+// earnings of a contract
+func GetCmdQueryEpochEarnings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "epoch-earnings CONTRACT_ADDRESS",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the developer revenue distributed for a contract in each epoch",
+		Long:    "Query the developer revenue distributed for a contract in each epoch. Epoch earnings are only recorded if an earnings epoch identifier is set in the module parameters",
+		Example: fmt.Sprintf("%s query revenue epoch-earnings <contract-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.EpochEarnings(context.Background(), &types.QueryEpochEarningsRequest{
+				ContractAddress: args[0],
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "epoch-earnings")
+	return cmd
+}
+
+// GetCmdQueryTopEarners implements a command to return the cumulative earnings
+// of all contracts sorted in descending order
+func GetCmdQueryTopEarners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "top-earners",
+		Args:    cobra.NoArgs,
+		Short:   "Query the contracts with the highest cumulative developer revenue",
+		Long:    "Query the cumulative developer revenue of all contracts, sorted by the amount earned in the EVM denomination in descending order",
+		Example: fmt.Sprintf("%s query revenue top-earners --limit 10", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.TopEarners(context.Background(), &types.QueryTopEarnersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "top-earners")
+	return cmd
+}
+
+// GetCmdQueryDeveloperSharesOverrides implements a command to return all the
+// developer shares overrides set by governance
+func GetCmdQueryDeveloperSharesOverrides() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "developer-shares-overrides",
+		Args:  cobra.NoArgs,
+		Short: "Query all developer shares overrides set by governance",
+		Long:  "Query all contracts with a developer shares value that overrides the global DeveloperShares param",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.DeveloperSharesOverrides(context.Background(), &types.QueryDeveloperSharesOverridesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "developer-shares-overrides")
+	return cmd
+}
+
+// GetCmdQueryDeveloperSharesCategories implements a command to return all the
+// developer shares categories set by governance
+func GetCmdQueryDeveloperSharesCategories() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "developer-shares-categories",
+		Args:  cobra.NoArgs,
+		Short: "Query all developer shares categories set by governance",
+		Long:  "Query all categories with a developer shares value that applies to their contracts instead of the global DeveloperShares param",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.DeveloperSharesCategories(context.Background(), &types.QueryDeveloperSharesCategoriesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "developer-shares-categories")
+	return cmd
+}
+
+// GetCmdQueryContractCategories implements a command to return the developer
+// shares categories assigned to contracts by governance
+func GetCmdQueryContractCategories() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-categories",
+		Args:  cobra.NoArgs,
+		Short: "Query the developer shares categories assigned to contracts",
+		Long:  "Query all contracts that are assigned to a developer shares category by governance",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.ContractCategories(context.Background(), &types.QueryContractCategoriesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract-categories")
+	return cmd
+}
@@ -3,13 +3,18 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	ethermint "github.com/evmos/ethermint/types"
 
@@ -175,3 +180,298 @@ func NewUpdateRevenue() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetDeveloperSharesOverrideProposalCmd implements the command to submit a
+// set-developer-shares-override proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewSetDeveloperSharesOverrideProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-developer-shares-override CONTRACT_ADDRESSES DEVELOPER_SHARES",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to set the developer shares of a comma-separated list of contracts",
+		Long:    "Submit a proposal to set the developer shares of a comma-separated list of contracts. The override takes precedence over the global DeveloperShares param.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal set-developer-shares-override <contract>,<contract> 0.75 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			contracts, err := parseContracts(args[0])
+			if err != nil {
+				return err
+			}
+
+			developerShares, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewSetDeveloperSharesOverrideProposal(title, description, contracts, developerShares)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewRemoveDeveloperSharesOverrideProposalCmd implements the command to submit
+// a remove-developer-shares-override proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewRemoveDeveloperSharesOverrideProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-developer-shares-override CONTRACT_ADDRESSES",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to remove the developer shares override of a comma-separated list of contracts",
+		Long:    "Submit a proposal to remove the developer shares override of a comma-separated list of contracts. The global DeveloperShares param applies to the contracts afterwards.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal remove-developer-shares-override <contract>,<contract> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			contracts, err := parseContracts(args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewRemoveDeveloperSharesOverrideProposal(title, description, contracts)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewSetDeveloperSharesCategoryProposalCmd implements the command to submit a
+// set-developer-shares-category proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewSetDeveloperSharesCategoryProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-developer-shares-category CATEGORY DEVELOPER_SHARES [CONTRACT_ADDRESSES]",
+		Args:    cobra.RangeArgs(2, 3),
+		Short:   "Submit a proposal to set the developer shares of a category and assign a comma-separated list of contracts to it",
+		Long:    "Submit a proposal to set the developer shares of a category and assign a comma-separated list of contracts to it. The category takes precedence over the global DeveloperShares param, while the overrides of single contracts take precedence over the category.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal set-developer-shares-category public-goods 0.75 <contract>,<contract> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			developerShares, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			var contracts []string
+			if len(args) == 3 {
+				contracts, err = parseContracts(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewSetDeveloperSharesCategoryProposal(title, description, args[0], developerShares, contracts)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// NewRemoveDeveloperSharesCategoryProposalCmd implements the command to submit
+// a remove-developer-shares-category proposal
+//
+//nolint:staticcheck // we use deprecated flags
+func NewRemoveDeveloperSharesCategoryProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-developer-shares-category CATEGORY [CONTRACT_ADDRESSES]",
+		Args:    cobra.RangeArgs(1, 2),
+		Short:   "Submit a proposal to remove a comma-separated list of contracts from a category, or the whole category",
+		Long:    "Submit a proposal to remove a comma-separated list of contracts from a category. If no contracts are given, the category is removed together with all its contracts. The global DeveloperShares param applies to the removed contracts afterwards.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal remove-developer-shares-category public-goods <contract>,<contract> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			var contracts []string
+			if len(args) == 2 {
+				contracts, err = parseContracts(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewRemoveDeveloperSharesCategoryProposal(title, description, args[0], contracts)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// addProposalFlags adds the required governance proposal flags to a command
+//
+//nolint:staticcheck // we use deprecated flags
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+}
+
+// parseContracts parses a comma-separated list of hex contract addresses
+func parseContracts(arg string) ([]string, error) {
+	contracts := strings.Split(arg, ",")
+	for i, contract := range contracts {
+		contract = strings.TrimSpace(contract)
+		if !common.IsHexAddress(contract) {
+			return nil, fmt.Errorf("invalid contract address: %s", contract)
+		}
+		contracts[i] = contract
+	}
+	return contracts, nil
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/evmos/evmos/v10/x/revenue/client/cli"
+)
+
+var (
+	SetDeveloperSharesOverrideProposalHandler    = govclient.NewProposalHandler(cli.NewSetDeveloperSharesOverrideProposalCmd)
+	RemoveDeveloperSharesOverrideProposalHandler = govclient.NewProposalHandler(cli.NewRemoveDeveloperSharesOverrideProposalCmd)
+	SetDeveloperSharesCategoryProposalHandler    = govclient.NewProposalHandler(cli.NewSetDeveloperSharesCategoryProposalCmd)
+	RemoveDeveloperSharesCategoryProposalHandler = govclient.NewProposalHandler(cli.NewRemoveDeveloperSharesCategoryProposalCmd)
+)
@@ -28,12 +28,42 @@ func InitGenesis(
 			k.SetWithdrawerMap(ctx, withdrawer, contract)
 		}
 	}
+
+	for _, earnings := range data.ContractEarnings {
+		k.SetContractEarnings(ctx, earnings)
+	}
+
+	for _, earnings := range data.WithdrawerEarnings {
+		k.SetWithdrawerEarnings(ctx, earnings)
+	}
+
+	for _, earnings := range data.EpochEarnings {
+		k.SetEpochEarnings(ctx, earnings)
+	}
+
+	for _, override := range data.DeveloperSharesOverrides {
+		k.SetDeveloperSharesOverride(ctx, override)
+	}
+
+	for _, category := range data.DeveloperSharesCategories {
+		k.SetDeveloperSharesCategory(ctx, category)
+	}
+
+	for _, contractCategory := range data.ContractCategories {
+		k.SetContractCategory(ctx, contractCategory)
+	}
 }
 
 // ExportGenesis export module state
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		Revenues: k.GetRevenues(ctx),
+		Params:                    k.GetParams(ctx),
+		Revenues:                  k.GetRevenues(ctx),
+		ContractEarnings:          k.GetAllContractEarnings(ctx),
+		WithdrawerEarnings:        k.GetAllWithdrawerEarnings(ctx),
+		EpochEarnings:             k.GetAllEpochEarnings(ctx),
+		DeveloperSharesOverrides:  k.GetDeveloperSharesOverrides(ctx),
+		DeveloperSharesCategories: k.GetDeveloperSharesCategories(ctx),
+		ContractCategories:        k.GetContractCategories(ctx),
 	}
 }
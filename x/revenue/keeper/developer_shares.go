@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+// GetDeveloperShares returns the developer shares that apply to a contract. If
+// governance has set an override for the contract it is returned. Otherwise,
+// the developer shares of the category assigned to the contract are returned
+// and, if the contract has no category, it falls back to the global
+// DeveloperShares param.
+func (k Keeper) GetDeveloperShares(
+	ctx sdk.Context,
+	contract common.Address,
+	params types.Params,
+) sdk.Dec {
+	if override, found := k.GetDeveloperSharesOverride(ctx, contract); found {
+		return override.DeveloperShares
+	}
+
+	contractCategory, found := k.GetContractCategory(ctx, contract)
+	if !found {
+		return params.DeveloperShares
+	}
+
+	category, found := k.GetDeveloperSharesCategory(ctx, contractCategory.Category)
+	if !found {
+		return params.DeveloperShares
+	}
+
+	return category.DeveloperShares
+}
+
+// GetDeveloperSharesOverrides returns all developer shares overrides.
+func (k Keeper) GetDeveloperSharesOverrides(ctx sdk.Context) []types.DeveloperSharesOverride {
+	overrides := []types.DeveloperSharesOverride{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixDeveloperSharesOverride)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var override types.DeveloperSharesOverride
+		k.cdc.MustUnmarshal(iterator.Value(), &override)
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides
+}
+
+// GetDeveloperSharesOverride returns the developer shares override for a
+// contract
+func (k Keeper) GetDeveloperSharesOverride(
+	ctx sdk.Context,
+	contract common.Address,
+) (types.DeveloperSharesOverride, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperSharesOverride)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.DeveloperSharesOverride{}, false
+	}
+
+	var override types.DeveloperSharesOverride
+	k.cdc.MustUnmarshal(bz, &override)
+	return override, true
+}
+
+// SetDeveloperSharesOverride stores the developer shares override for a
+// contract
+func (k Keeper) SetDeveloperSharesOverride(ctx sdk.Context, override types.DeveloperSharesOverride) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperSharesOverride)
+	key := override.GetContractAddr()
+	bz := k.cdc.MustMarshal(&override)
+	store.Set(key.Bytes(), bz)
+}
+
+// DeleteDeveloperSharesOverride deletes the developer shares override for a
+// contract
+func (k Keeper) DeleteDeveloperSharesOverride(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperSharesOverride)
+	store.Delete(contract.Bytes())
+}
+
+// IsDeveloperSharesOverrideSet checks if a developer shares override is set for
+// a contract
+func (k Keeper) IsDeveloperSharesOverrideSet(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperSharesOverride)
+	return store.Has(contract.Bytes())
+}
+
+// GetDeveloperSharesCategories returns all developer shares categories.
+func (k Keeper) GetDeveloperSharesCategories(ctx sdk.Context) []types.DeveloperSharesCategory {
+	categories := []types.DeveloperSharesCategory{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixDeveloperSharesCategory)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var category types.DeveloperSharesCategory
+		k.cdc.MustUnmarshal(iterator.Value(), &category)
+
+		categories = append(categories, category)
+	}
+
+	return categories
+}
+
+// GetDeveloperSharesCategory returns the developer shares category with the
+// given name
+func (k Keeper) GetDeveloperSharesCategory(
+	ctx sdk.Context,
+	name string,
+) (types.DeveloperSharesCategory, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperSharesCategory)
+	bz := store.Get([]byte(name))
+	if len(bz) == 0 {
+		return types.DeveloperSharesCategory{}, false
+	}
+
+	var category types.DeveloperSharesCategory
+	k.cdc.MustUnmarshal(bz, &category)
+	return category, true
+}
+
+// SetDeveloperSharesCategory stores a developer shares category
+func (k Keeper) SetDeveloperSharesCategory(ctx sdk.Context, category types.DeveloperSharesCategory) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperSharesCategory)
+	bz := k.cdc.MustMarshal(&category)
+	store.Set([]byte(category.Name), bz)
+}
+
+// DeleteDeveloperSharesCategory deletes the developer shares category with the
+// given name
+func (k Keeper) DeleteDeveloperSharesCategory(ctx sdk.Context, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperSharesCategory)
+	store.Delete([]byte(name))
+}
+
+// GetContractCategories returns the developer shares categories assigned to
+// all contracts.
+func (k Keeper) GetContractCategories(ctx sdk.Context) []types.ContractCategory {
+	contractCategories := []types.ContractCategory{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixContractCategory)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var contractCategory types.ContractCategory
+		k.cdc.MustUnmarshal(iterator.Value(), &contractCategory)
+
+		contractCategories = append(contractCategories, contractCategory)
+	}
+
+	return contractCategories
+}
+
+// GetContractCategory returns the developer shares category assigned to a
+// contract
+func (k Keeper) GetContractCategory(
+	ctx sdk.Context,
+	contract common.Address,
+) (types.ContractCategory, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractCategory)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.ContractCategory{}, false
+	}
+
+	var contractCategory types.ContractCategory
+	k.cdc.MustUnmarshal(bz, &contractCategory)
+	return contractCategory, true
+}
+
+// SetContractCategory assigns a developer shares category to a contract,
+// replacing any previous category
+func (k Keeper) SetContractCategory(ctx sdk.Context, contractCategory types.ContractCategory) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractCategory)
+	key := contractCategory.GetContractAddr()
+	bz := k.cdc.MustMarshal(&contractCategory)
+	store.Set(key.Bytes(), bz)
+}
+
+// DeleteContractCategory deletes the developer shares category assigned to a
+// contract
+func (k Keeper) DeleteContractCategory(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractCategory)
+	store.Delete(contract.Bytes())
+}
@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestGetDeveloperShares() {
+	overrideShares := sdk.NewDecWithPrec(90, 2)
+	categoryShares := sdk.NewDecWithPrec(80, 2)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expShares func(params types.Params) sdk.Dec
+	}{
+		{
+			"no override - global param",
+			func() {},
+			func(params types.Params) sdk.Dec { return params.DeveloperShares },
+		},
+		{
+			"override set",
+			func() {
+				err := suite.app.RevenueKeeper.SetDeveloperSharesOverrides(suite.ctx, []common.Address{contract}, overrideShares)
+				suite.Require().NoError(err)
+			},
+			func(_ types.Params) sdk.Dec { return overrideShares },
+		},
+		{
+			"override set and removed - global param",
+			func() {
+				err := suite.app.RevenueKeeper.SetDeveloperSharesOverrides(suite.ctx, []common.Address{contract}, overrideShares)
+				suite.Require().NoError(err)
+				err = suite.app.RevenueKeeper.RemoveDeveloperSharesOverrides(suite.ctx, []common.Address{contract})
+				suite.Require().NoError(err)
+			},
+			func(params types.Params) sdk.Dec { return params.DeveloperShares },
+		},
+		{
+			"category set",
+			func() {
+				err := suite.app.RevenueKeeper.UpdateDeveloperSharesCategory(suite.ctx, "public-goods", categoryShares, []common.Address{contract})
+				suite.Require().NoError(err)
+			},
+			func(_ types.Params) sdk.Dec { return categoryShares },
+		},
+		{
+			"category and override set - override",
+			func() {
+				err := suite.app.RevenueKeeper.UpdateDeveloperSharesCategory(suite.ctx, "public-goods", categoryShares, []common.Address{contract})
+				suite.Require().NoError(err)
+				err = suite.app.RevenueKeeper.SetDeveloperSharesOverrides(suite.ctx, []common.Address{contract}, overrideShares)
+				suite.Require().NoError(err)
+			},
+			func(_ types.Params) sdk.Dec { return overrideShares },
+		},
+		{
+			"category of other contracts - global param",
+			func() {
+				err := suite.app.RevenueKeeper.UpdateDeveloperSharesCategory(suite.ctx, "public-goods", categoryShares, []common.Address{tests.GenerateAddress()})
+				suite.Require().NoError(err)
+			},
+			func(params types.Params) sdk.Dec { return params.DeveloperShares },
+		},
+		{
+			"contract removed from category - global param",
+			func() {
+				err := suite.app.RevenueKeeper.UpdateDeveloperSharesCategory(suite.ctx, "public-goods", categoryShares, []common.Address{contract})
+				suite.Require().NoError(err)
+				err = suite.app.RevenueKeeper.RemoveDeveloperSharesCategory(suite.ctx, "public-goods", []common.Address{contract})
+				suite.Require().NoError(err)
+			},
+			func(params types.Params) sdk.Dec { return params.DeveloperShares },
+		},
+		{
+			"category removed - global param",
+			func() {
+				err := suite.app.RevenueKeeper.UpdateDeveloperSharesCategory(suite.ctx, "public-goods", categoryShares, []common.Address{contract})
+				suite.Require().NoError(err)
+				err = suite.app.RevenueKeeper.RemoveDeveloperSharesCategory(suite.ctx, "public-goods", nil)
+				suite.Require().NoError(err)
+			},
+			func(params types.Params) sdk.Dec { return params.DeveloperShares },
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+
+			params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+			shares := suite.app.RevenueKeeper.GetDeveloperShares(suite.ctx, contract, params)
+			suite.Require().Equal(tc.expShares(params), shares)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveDeveloperSharesOverrides() {
+	suite.SetupTest()
+
+	err := suite.app.RevenueKeeper.RemoveDeveloperSharesOverrides(suite.ctx, []common.Address{tests.GenerateAddress()})
+	suite.Require().ErrorIs(err, types.ErrDeveloperSharesOverrideNotFound)
+
+	params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+	params.EnableRevenue = false
+	suite.app.RevenueKeeper.SetParams(suite.ctx, params)
+
+	err = suite.app.RevenueKeeper.SetDeveloperSharesOverrides(suite.ctx, []common.Address{contract}, sdk.OneDec())
+	suite.Require().ErrorIs(err, types.ErrRevenueDisabled)
+}
+
+func (suite *KeeperTestSuite) TestDeveloperSharesOverridesQuery() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	contract2 := tests.GenerateAddress()
+	err := suite.app.RevenueKeeper.SetDeveloperSharesOverrides(suite.ctx, []common.Address{contract, contract2}, sdk.OneDec())
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.DeveloperSharesOverrides(ctx, &types.QueryDeveloperSharesOverridesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(
+		[]types.DeveloperSharesOverride{
+			types.NewDeveloperSharesOverride(contract, sdk.OneDec()),
+			types.NewDeveloperSharesOverride(contract2, sdk.OneDec()),
+		},
+		res.Overrides,
+	)
+}
+
+func (suite *KeeperTestSuite) TestRemoveDeveloperSharesCategory() {
+	suite.SetupTest()
+
+	contract2 := tests.GenerateAddress()
+
+	err := suite.app.RevenueKeeper.RemoveDeveloperSharesCategory(suite.ctx, "public-goods", nil)
+	suite.Require().ErrorIs(err, types.ErrDeveloperSharesCategoryNotFound)
+
+	err = suite.app.RevenueKeeper.UpdateDeveloperSharesCategory(suite.ctx, "public-goods", sdk.OneDec(), []common.Address{contract})
+	suite.Require().NoError(err)
+	err = suite.app.RevenueKeeper.UpdateDeveloperSharesCategory(suite.ctx, "spam", sdk.ZeroDec(), []common.Address{contract2})
+	suite.Require().NoError(err)
+
+	// the contract is assigned to another category
+	err = suite.app.RevenueKeeper.RemoveDeveloperSharesCategory(suite.ctx, "public-goods", []common.Address{contract2})
+	suite.Require().ErrorIs(err, types.ErrContractCategoryNotFound)
+
+	// removing a category removes the assignments of its contracts only
+	err = suite.app.RevenueKeeper.RemoveDeveloperSharesCategory(suite.ctx, "public-goods", nil)
+	suite.Require().NoError(err)
+
+	_, found := suite.app.RevenueKeeper.GetDeveloperSharesCategory(suite.ctx, "public-goods")
+	suite.Require().False(found)
+	_, found = suite.app.RevenueKeeper.GetContractCategory(suite.ctx, contract)
+	suite.Require().False(found)
+	_, found = suite.app.RevenueKeeper.GetContractCategory(suite.ctx, contract2)
+	suite.Require().True(found)
+
+	params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+	params.EnableRevenue = false
+	suite.app.RevenueKeeper.SetParams(suite.ctx, params)
+
+	err = suite.app.RevenueKeeper.UpdateDeveloperSharesCategory(suite.ctx, "public-goods", sdk.OneDec(), nil)
+	suite.Require().ErrorIs(err, types.ErrRevenueDisabled)
+}
+
+func (suite *KeeperTestSuite) TestDeveloperSharesCategoriesQuery() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	contract2 := tests.GenerateAddress()
+	err := suite.app.RevenueKeeper.UpdateDeveloperSharesCategory(suite.ctx, "public-goods", sdk.OneDec(), []common.Address{contract, contract2})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.DeveloperSharesCategories(ctx, &types.QueryDeveloperSharesCategoriesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(
+		[]types.DeveloperSharesCategory{types.NewDeveloperSharesCategory("public-goods", sdk.OneDec())},
+		res.Categories,
+	)
+
+	contractsRes, err := suite.queryClient.ContractCategories(ctx, &types.QueryContractCategoriesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(
+		[]types.ContractCategory{
+			types.NewContractCategory(contract, "public-goods"),
+			types.NewContractCategory(contract2, "public-goods"),
+		},
+		contractsRes.ContractCategories,
+	)
+}
@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+// AddEarnings records the developer revenue distributed for a contract to its
+// withdrawer. If an earnings epoch identifier and retention are set in the
+// params, the revenue is also recorded in the bucket of the current epoch.
+func (k Keeper) AddEarnings(
+	ctx sdk.Context,
+	contract common.Address,
+	withdrawer sdk.AccAddress,
+	fees sdk.Coins,
+) {
+	contractEarnings, _ := k.GetContractEarnings(ctx, contract)
+	contractEarnings.ContractAddress = contract.String()
+	contractEarnings.Earnings = contractEarnings.Earnings.Add(fees...)
+	k.SetContractEarnings(ctx, contractEarnings)
+
+	withdrawerEarnings, _ := k.GetWithdrawerEarnings(ctx, withdrawer)
+	withdrawerEarnings.WithdrawerAddress = withdrawer.String()
+	withdrawerEarnings.Earnings = withdrawerEarnings.Earnings.Add(fees...)
+	k.SetWithdrawerEarnings(ctx, withdrawerEarnings)
+
+	params := k.GetParams(ctx)
+	if params.EarningsEpochIdentifier == "" || params.EarningsRetention == 0 {
+		return
+	}
+
+	epochInfo, found := k.epochsKeeper.GetEpochInfo(ctx, params.EarningsEpochIdentifier)
+	if !found {
+		return
+	}
+
+	epochEarnings, _ := k.GetEpochEarnings(ctx, contract, epochInfo.CurrentEpoch)
+	epochEarnings.ContractAddress = contract.String()
+	epochEarnings.EpochNumber = epochInfo.CurrentEpoch
+	epochEarnings.Earnings = epochEarnings.Earnings.Add(fees...)
+	k.SetEpochEarnings(ctx, epochEarnings)
+}
+
+// GetContractEarnings returns the cumulative earnings of a contract
+func (k Keeper) GetContractEarnings(
+	ctx sdk.Context,
+	contract common.Address,
+) (types.ContractEarnings, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractEarnings)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.ContractEarnings{}, false
+	}
+
+	var earnings types.ContractEarnings
+	k.cdc.MustUnmarshal(bz, &earnings)
+	return earnings, true
+}
+
+// SetContractEarnings stores the cumulative earnings of a contract and updates
+// its position in the earnings rank index.
+func (k Keeper) SetContractEarnings(ctx sdk.Context, earnings types.ContractEarnings) {
+	contract := earnings.GetContractAddr()
+	evmDenom := k.getEarningsRankDenom(ctx)
+
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEarningsRank)
+	if prevEarnings, found := k.GetContractEarnings(ctx, contract); found {
+		rankStore.Delete(types.GetKeyEarningsRank(prevEarnings.Earnings.AmountOf(evmDenom), contract))
+	}
+	rankStore.Set(types.GetKeyEarningsRank(earnings.Earnings.AmountOf(evmDenom), contract), contract.Bytes())
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractEarnings)
+	bz := k.cdc.MustMarshal(&earnings)
+	store.Set(contract.Bytes(), bz)
+}
+
+// getEarningsRankDenom returns the denomination by which the earnings rank
+// index is sorted, which is the EVM denomination. If the EVM denomination
+// changed since the index was built, the index is rebuilt so that it doesn't
+// keep entries for the amounts of the previous denomination.
+func (k Keeper) getEarningsRankDenom(ctx sdk.Context) string {
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+
+	store := ctx.KVStore(k.storeKey)
+	if string(store.Get(types.KeyEarningsRankDenom)) == evmDenom {
+		return evmDenom
+	}
+
+	k.rebuildEarningsRank(ctx, evmDenom)
+	store.Set(types.KeyEarningsRankDenom, []byte(evmDenom))
+	return evmDenom
+}
+
+// rebuildEarningsRank deletes all the entries of the earnings rank index and
+// indexes the cumulative earnings of all contracts by the amount of the given
+// denomination.
+func (k Keeper) rebuildEarningsRank(ctx sdk.Context, denom string) {
+	rankStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEarningsRank)
+
+	iterator := rankStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		rankStore.Delete(key)
+	}
+
+	for _, earnings := range k.GetAllContractEarnings(ctx) {
+		contract := earnings.GetContractAddr()
+		rankStore.Set(types.GetKeyEarningsRank(earnings.Earnings.AmountOf(denom), contract), contract.Bytes())
+	}
+}
+
+// GetAllContractEarnings returns the cumulative earnings of all contracts
+func (k Keeper) GetAllContractEarnings(ctx sdk.Context) []types.ContractEarnings {
+	earnings := []types.ContractEarnings{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixContractEarnings)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var contractEarnings types.ContractEarnings
+		k.cdc.MustUnmarshal(iterator.Value(), &contractEarnings)
+
+		earnings = append(earnings, contractEarnings)
+	}
+
+	return earnings
+}
+
+// GetWithdrawerEarnings returns the cumulative earnings of a withdrawer
+func (k Keeper) GetWithdrawerEarnings(
+	ctx sdk.Context,
+	withdrawer sdk.AccAddress,
+) (types.WithdrawerEarnings, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawerEarnings)
+	bz := store.Get(withdrawer.Bytes())
+	if len(bz) == 0 {
+		return types.WithdrawerEarnings{}, false
+	}
+
+	var earnings types.WithdrawerEarnings
+	k.cdc.MustUnmarshal(bz, &earnings)
+	return earnings, true
+}
+
+// SetWithdrawerEarnings stores the cumulative earnings of a withdrawer
+func (k Keeper) SetWithdrawerEarnings(ctx sdk.Context, earnings types.WithdrawerEarnings) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawerEarnings)
+	key := earnings.GetWithdrawerAddr()
+	bz := k.cdc.MustMarshal(&earnings)
+	store.Set(key.Bytes(), bz)
+}
+
+// GetAllWithdrawerEarnings returns the cumulative earnings of all withdrawers
+func (k Keeper) GetAllWithdrawerEarnings(ctx sdk.Context) []types.WithdrawerEarnings {
+	earnings := []types.WithdrawerEarnings{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixWithdrawerEarnings)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var withdrawerEarnings types.WithdrawerEarnings
+		k.cdc.MustUnmarshal(iterator.Value(), &withdrawerEarnings)
+
+		earnings = append(earnings, withdrawerEarnings)
+	}
+
+	return earnings
+}
+
+// GetEpochEarnings returns the earnings of a contract during a given epoch
+func (k Keeper) GetEpochEarnings(
+	ctx sdk.Context,
+	contract common.Address,
+	epochNumber int64,
+) (types.EpochEarnings, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixEpochEarnings(contract))
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(epochNumber)))
+	if len(bz) == 0 {
+		return types.EpochEarnings{}, false
+	}
+
+	var earnings types.EpochEarnings
+	k.cdc.MustUnmarshal(bz, &earnings)
+	return earnings, true
+}
+
+// SetEpochEarnings stores the earnings of a contract during a given epoch
+func (k Keeper) SetEpochEarnings(ctx sdk.Context, earnings types.EpochEarnings) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixEpochEarnings(earnings.GetContractAddr()))
+	bz := k.cdc.MustMarshal(&earnings)
+	store.Set(sdk.Uint64ToBigEndian(uint64(earnings.EpochNumber)), bz)
+}
+
+// PruneEpochEarnings deletes the earnings buckets of all contracts for the
+// epochs that are older than the retention, counted from the given epoch
+// number. All buckets are deleted if the retention is zero.
+func (k Keeper) PruneEpochEarnings(ctx sdk.Context, epochNumber int64, retention uint64) {
+	// delete the buckets of the epochs lower or equal to epochNumber - retention
+	end := epochNumber - int64(retention) + 1
+	if end <= 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochEarnings)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the contract address followed by the epoch number
+		key := iterator.Key()
+		if sdk.BigEndianToUint64(key[common.AddressLength:]) < uint64(end) {
+			keys = append(keys, key)
+		}
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllEpochEarnings returns the earnings of all contracts for all recorded
+// epochs
+func (k Keeper) GetAllEpochEarnings(ctx sdk.Context) []types.EpochEarnings {
+	earnings := []types.EpochEarnings{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixEpochEarnings)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epochEarnings types.EpochEarnings
+		k.cdc.MustUnmarshal(iterator.Value(), &epochEarnings)
+
+		earnings = append(earnings, epochEarnings)
+	}
+
+	return earnings
+}
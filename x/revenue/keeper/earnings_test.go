@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/tests"
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestAddEarnings() {
+	var expEpochEarnings []types.EpochEarnings
+	fees := sdk.Coins{sdk.NewCoin("aevmos", sdk.NewInt(100))}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"epoch buckets disabled",
+			func() {
+				expEpochEarnings = []types.EpochEarnings{}
+			},
+		},
+		{
+			"epoch buckets disabled - zero retention",
+			func() {
+				params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+				params.EarningsEpochIdentifier = epochstypes.DayEpochID
+				params.EarningsRetention = 0
+				suite.app.RevenueKeeper.SetParams(suite.ctx, params)
+
+				expEpochEarnings = []types.EpochEarnings{}
+			},
+		},
+		{
+			"epoch buckets enabled",
+			func() {
+				params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+				params.EarningsEpochIdentifier = epochstypes.DayEpochID
+				suite.app.RevenueKeeper.SetParams(suite.ctx, params)
+
+				epochInfo, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, epochstypes.DayEpochID)
+				suite.Require().True(found)
+
+				expEpochEarnings = []types.EpochEarnings{
+					{
+						ContractAddress: contract.String(),
+						EpochNumber:     epochInfo.CurrentEpoch,
+						Earnings:        fees.Add(fees...),
+					},
+				}
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+
+			suite.app.RevenueKeeper.AddEarnings(suite.ctx, contract, withdraw, fees)
+			suite.app.RevenueKeeper.AddEarnings(suite.ctx, contract, withdraw, fees)
+
+			contractEarnings, found := suite.app.RevenueKeeper.GetContractEarnings(suite.ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(fees.Add(fees...), contractEarnings.Earnings)
+
+			withdrawerEarnings, found := suite.app.RevenueKeeper.GetWithdrawerEarnings(suite.ctx, withdraw)
+			suite.Require().True(found)
+			suite.Require().Equal(fees.Add(fees...), withdrawerEarnings.Earnings)
+
+			epochEarnings := suite.app.RevenueKeeper.GetAllEpochEarnings(suite.ctx)
+			suite.Require().Equal(expEpochEarnings, epochEarnings)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneEpochEarnings() {
+	testCases := []struct {
+		name        string
+		epochNumber int64
+		retention   uint64
+		expEpochs   []int64
+	}{
+		{"retention covers all epochs", 5, 5, []int64{1, 2, 3, 4, 5}},
+		{"retention greater than epoch number", 5, 10, []int64{1, 2, 3, 4, 5}},
+		{"prune older epochs", 5, 2, []int64{4, 5}},
+		{"zero retention prunes all epochs", 5, 0, nil},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			contract2 := tests.GenerateAddress()
+			for i := int64(1); i <= 5; i++ {
+				for _, c := range []common.Address{contract, contract2} {
+					suite.app.RevenueKeeper.SetEpochEarnings(suite.ctx, types.EpochEarnings{
+						ContractAddress: c.String(),
+						EpochNumber:     i,
+						Earnings:        sdk.Coins{sdk.NewCoin(suite.denom, sdk.NewInt(100))},
+					})
+				}
+			}
+
+			suite.app.RevenueKeeper.PruneEpochEarnings(suite.ctx, tc.epochNumber, tc.retention)
+
+			for i := int64(1); i <= 5; i++ {
+				for _, c := range []common.Address{contract, contract2} {
+					_, found := suite.app.RevenueKeeper.GetEpochEarnings(suite.ctx, c, i)
+					suite.Require().Equal(containsEpoch(tc.expEpochs, i), found, "epoch %d", i)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndPrunesEpochEarnings() {
+	testCases := []struct {
+		name            string
+		epochIdentifier string
+		expEpochs       []int64
+	}{
+		{"earnings epoch identifier", epochstypes.DayEpochID, []int64{4, 5}},
+		{"other epoch identifier", epochstypes.WeekEpochID, []int64{1, 2, 3, 4, 5}},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+			params.EarningsEpochIdentifier = epochstypes.DayEpochID
+			params.EarningsRetention = 2
+			suite.app.RevenueKeeper.SetParams(suite.ctx, params)
+
+			for i := int64(1); i <= 5; i++ {
+				suite.app.RevenueKeeper.SetEpochEarnings(suite.ctx, types.EpochEarnings{
+					ContractAddress: contract.String(),
+					EpochNumber:     i,
+					Earnings:        sdk.Coins{sdk.NewCoin(suite.denom, sdk.NewInt(100))},
+				})
+			}
+
+			suite.app.EpochsKeeper.AfterEpochEnd(suite.ctx, tc.epochIdentifier, 5, 0)
+
+			for i := int64(1); i <= 5; i++ {
+				_, found := suite.app.RevenueKeeper.GetEpochEarnings(suite.ctx, contract, i)
+				suite.Require().Equal(containsEpoch(tc.expEpochs, i), found, "epoch %d", i)
+			}
+		})
+	}
+}
+
+func containsEpoch(epochs []int64, epoch int64) bool {
+	for _, e := range epochs {
+		if e == epoch {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *KeeperTestSuite) TestTopEarners() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	contract2 := tests.GenerateAddress()
+	contract3 := tests.GenerateAddress()
+
+	suite.app.RevenueKeeper.AddEarnings(suite.ctx, contract, withdraw, sdk.Coins{sdk.NewCoin(suite.denom, sdk.NewInt(200))})
+	suite.app.RevenueKeeper.AddEarnings(suite.ctx, contract2, withdraw, sdk.Coins{sdk.NewCoin(suite.denom, sdk.NewInt(300))})
+	suite.app.RevenueKeeper.AddEarnings(suite.ctx, contract3, withdraw, sdk.Coins{sdk.NewCoin(suite.denom, sdk.NewInt(100))})
+	// move the first contract to the top of the rank
+	suite.app.RevenueKeeper.AddEarnings(suite.ctx, contract, withdraw, sdk.Coins{sdk.NewCoin(suite.denom, sdk.NewInt(200))})
+
+	res, err := suite.queryClient.TopEarners(ctx, &types.QueryTopEarnersRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ContractEarnings, 3)
+	suite.Require().Equal(contract.String(), res.ContractEarnings[0].ContractAddress)
+	suite.Require().Equal(contract2.String(), res.ContractEarnings[1].ContractAddress)
+	suite.Require().Equal(contract3.String(), res.ContractEarnings[2].ContractAddress)
+
+	withdrawerRes, err := suite.queryClient.WithdrawerEarnings(ctx, &types.QueryWithdrawerEarningsRequest{
+		WithdrawerAddress: withdraw.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(800), withdrawerRes.WithdrawerEarnings.Earnings.AmountOf(suite.denom))
+}
+
+func (suite *KeeperTestSuite) TestTopEarnersEvmDenomChange() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	otherDenom := "aother"
+	contract2 := tests.GenerateAddress()
+
+	suite.app.RevenueKeeper.AddEarnings(suite.ctx, contract, withdraw, sdk.Coins{sdk.NewCoin(suite.denom, sdk.NewInt(200))})
+	suite.app.RevenueKeeper.AddEarnings(suite.ctx, contract2, withdraw, sdk.Coins{sdk.NewCoin(suite.denom, sdk.NewInt(100))})
+	suite.app.RevenueKeeper.AddEarnings(suite.ctx, contract2, withdraw, sdk.Coins{sdk.NewCoin(otherDenom, sdk.NewInt(100))})
+
+	evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+	evmParams.EvmDenom = otherDenom
+	suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+
+	// the rank index is rebuilt with the amounts of the new EVM denom
+	suite.app.RevenueKeeper.AddEarnings(suite.ctx, contract, withdraw, sdk.Coins{sdk.NewCoin(otherDenom, sdk.NewInt(50))})
+
+	res, err := suite.queryClient.TopEarners(ctx, &types.QueryTopEarnersRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ContractEarnings, 2)
+	suite.Require().Equal(contract2.String(), res.ContractEarnings[0].ContractAddress)
+	suite.Require().Equal(contract.String(), res.ContractEarnings[1].ContractAddress)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// BeforeEpochStart performs a no-op
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// AfterEpochDurationChange performs a no-op
+func (k Keeper) AfterEpochDurationChange(_ sdk.Context, _ string, _ int64, _, _ time.Duration) {}
+
+// AfterEpochEnd prunes the earnings buckets that exceed the retention at the
+// end of each earnings epoch, so that the buckets are not iterated when the
+// fees of a transaction are distributed.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber, _ int64) {
+	params := k.GetParams(ctx)
+
+	// check if epochIdentifier signal equals the identifier in the params
+	if params.EarningsEpochIdentifier == "" || epochIdentifier != params.EarningsEpochIdentifier {
+		return
+	}
+
+	k.PruneEpochEarnings(ctx, epochNumber, params.EarningsRetention)
+}
+
+// BeforeEpochStart implements EpochHooks
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+// AfterEpochEnd implements EpochHooks
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber, missedEpochs int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber, missedEpochs)
+}
+
+// AfterEpochDurationChange implements EpochHooks
+func (h Hooks) AfterEpochDurationChange(
+	ctx sdk.Context,
+	epochIdentifier string,
+	epochNumber int64,
+	oldDuration, newDuration time.Duration,
+) {
+	h.k.AfterEpochDurationChange(ctx, epochIdentifier, epochNumber, oldDuration, newDuration)
+}
@@ -62,7 +62,8 @@ func (k Keeper) PostTxProcessing(
 	}
 
 	txFee := sdk.NewIntFromUint64(receipt.GasUsed).Mul(sdk.NewIntFromBigInt(msg.GasPrice()))
-	developerFee := (params.DeveloperShares).MulInt(txFee).TruncateInt()
+	developerShares := k.GetDeveloperShares(ctx, *contract, params)
+	developerFee := developerShares.MulInt(txFee).TruncateInt()
 	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
 	fees := sdk.Coins{{Denom: evmDenom, Amount: developerFee}}
 
@@ -81,6 +82,8 @@ func (k Keeper) PostTxProcessing(
 		)
 	}
 
+	k.AddEarnings(ctx, *contract, withdrawer, fees)
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -190,3 +191,263 @@ func (k Keeper) WithdrawerRevenues( // nolint: dupl
 		Pagination:        pageRes,
 	}, nil
 }
+
+// ContractEarnings returns the cumulative earnings of a given contract
+func (k Keeper) ContractEarnings(
+	c context.Context,
+	req *types.QueryContractEarningsRequest,
+) (*types.QueryContractEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if strings.TrimSpace(req.ContractAddress) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"contract address is empty",
+		)
+	}
+
+	// check if the contract is a non-zero hex address
+	if err := ethermint.ValidateNonZeroAddress(req.ContractAddress); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be non-zero hex ('0x...')", req.ContractAddress,
+		)
+	}
+
+	contract := common.HexToAddress(req.ContractAddress)
+	earnings, found := k.GetContractEarnings(ctx, contract)
+	if !found {
+		earnings = types.ContractEarnings{ContractAddress: contract.String()}
+	}
+
+	return &types.QueryContractEarningsResponse{ContractEarnings: earnings}, nil
+}
+
+// WithdrawerEarnings returns the cumulative earnings of a given withdraw
+// address
+func (k Keeper) WithdrawerEarnings(
+	c context.Context,
+	req *types.QueryWithdrawerEarningsRequest,
+) (*types.QueryWithdrawerEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if strings.TrimSpace(req.WithdrawerAddress) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"withdraw address is empty",
+		)
+	}
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for withdraw addr %s, should be bech32 ('evmos...')", req.WithdrawerAddress,
+		)
+	}
+
+	earnings, found := k.GetWithdrawerEarnings(ctx, withdrawer)
+	if !found {
+		earnings = types.WithdrawerEarnings{WithdrawerAddress: withdrawer.String()}
+	}
+
+	return &types.QueryWithdrawerEarningsResponse{WithdrawerEarnings: earnings}, nil
+}
+
+// EpochEarnings returns the earnings of a given contract for each recorded
+// epoch
+func (k Keeper) EpochEarnings(
+	c context.Context,
+	req *types.QueryEpochEarningsRequest,
+) (*types.QueryEpochEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if strings.TrimSpace(req.ContractAddress) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"contract address is empty",
+		)
+	}
+
+	// check if the contract is a non-zero hex address
+	if err := ethermint.ValidateNonZeroAddress(req.ContractAddress); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be non-zero hex ('0x...')", req.ContractAddress,
+		)
+	}
+
+	var earnings []types.EpochEarnings
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetKeyPrefixEpochEarnings(common.HexToAddress(req.ContractAddress)),
+	)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var epochEarnings types.EpochEarnings
+		if err := k.cdc.Unmarshal(value, &epochEarnings); err != nil {
+			return err
+		}
+		earnings = append(earnings, epochEarnings)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochEarningsResponse{
+		EpochEarnings: earnings,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// TopEarners returns the cumulative earnings of all contracts, sorted by the
+// amount earned in the EVM denomination in descending order
+func (k Keeper) TopEarners(
+	c context.Context,
+	req *types.QueryTopEarnersRequest,
+) (*types.QueryTopEarnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pageReq = *req.Pagination
+	}
+	// the rank index is sorted in ascending order
+	pageReq.Reverse = !pageReq.Reverse
+
+	var earnings []types.ContractEarnings
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEarningsRank)
+
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		contractEarnings, found := k.GetContractEarnings(ctx, common.BytesToAddress(value))
+		if !found {
+			return fmt.Errorf("earnings not found for contract %s", common.BytesToAddress(value))
+		}
+		earnings = append(earnings, contractEarnings)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopEarnersResponse{
+		ContractEarnings: earnings,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// DeveloperSharesOverrides returns all developer shares overrides set by
+// governance
+func (k Keeper) DeveloperSharesOverrides(
+	c context.Context,
+	req *types.QueryDeveloperSharesOverridesRequest,
+) (*types.QueryDeveloperSharesOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var overrides []types.DeveloperSharesOverride
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperSharesOverride)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var override types.DeveloperSharesOverride
+		if err := k.cdc.Unmarshal(value, &override); err != nil {
+			return err
+		}
+		overrides = append(overrides, override)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeveloperSharesOverridesResponse{
+		Overrides:  overrides,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DeveloperSharesCategories returns all developer shares categories set by
+// governance
+func (k Keeper) DeveloperSharesCategories(
+	c context.Context,
+	req *types.QueryDeveloperSharesCategoriesRequest,
+) (*types.QueryDeveloperSharesCategoriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var categories []types.DeveloperSharesCategory
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperSharesCategory)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var category types.DeveloperSharesCategory
+		if err := k.cdc.Unmarshal(value, &category); err != nil {
+			return err
+		}
+		categories = append(categories, category)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeveloperSharesCategoriesResponse{
+		Categories: categories,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ContractCategories returns the developer shares categories assigned to
+// contracts by governance
+func (k Keeper) ContractCategories(
+	c context.Context,
+	req *types.QueryContractCategoriesRequest,
+) (*types.QueryContractCategoriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var contractCategories []types.ContractCategory
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractCategory)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var contractCategory types.ContractCategory
+		if err := k.cdc.Unmarshal(value, &contractCategory); err != nil {
+			return err
+		}
+		contractCategories = append(contractCategories, contractCategory)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContractCategoriesResponse{
+		ContractCategories: contractCategories,
+		Pagination:         pageRes,
+	}, nil
+}
@@ -21,6 +21,7 @@ type Keeper struct {
 
 	bankKeeper       types.BankKeeper
 	evmKeeper        types.EVMKeeper
+	epochsKeeper     types.EpochsKeeper
 	feeCollectorName string
 }
 
@@ -31,6 +32,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	bk types.BankKeeper,
 	evmKeeper types.EVMKeeper,
+	epochsKeeper types.EpochsKeeper,
 	feeCollector string,
 ) Keeper {
 	// set KeyTable if it has not already been set
@@ -44,6 +46,7 @@ func NewKeeper(
 		paramstore:       ps,
 		bankKeeper:       bk,
 		evmKeeper:        evmKeeper,
+		epochsKeeper:     epochsKeeper,
 		feeCollectorName: feeCollector,
 	}
 }
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+// SetDeveloperSharesOverrides sets the developer shares that apply to each of
+// the given contracts, replacing any previous override
+func (k Keeper) SetDeveloperSharesOverrides(
+	ctx sdk.Context,
+	contracts []common.Address,
+	developerShares sdk.Dec,
+) error {
+	// check if the fees are globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return types.ErrRevenueDisabled
+	}
+
+	for _, contract := range contracts {
+		k.SetDeveloperSharesOverride(ctx, types.NewDeveloperSharesOverride(contract, developerShares))
+	}
+
+	return nil
+}
+
+// RemoveDeveloperSharesOverrides deletes the developer shares override of each
+// of the given contracts, so that the global DeveloperShares param applies
+func (k Keeper) RemoveDeveloperSharesOverrides(
+	ctx sdk.Context,
+	contracts []common.Address,
+) error {
+	// check if the fees are globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return types.ErrRevenueDisabled
+	}
+
+	for _, contract := range contracts {
+		if !k.IsDeveloperSharesOverrideSet(ctx, contract) {
+			return errorsmod.Wrapf(
+				types.ErrDeveloperSharesOverrideNotFound,
+				"contract %s", contract,
+			)
+		}
+
+		k.DeleteDeveloperSharesOverride(ctx, contract)
+	}
+
+	return nil
+}
+
+// UpdateDeveloperSharesCategory sets the developer shares of a category and
+// assigns the given contracts to it, replacing their previous category
+func (k Keeper) UpdateDeveloperSharesCategory(
+	ctx sdk.Context,
+	category string,
+	developerShares sdk.Dec,
+	contracts []common.Address,
+) error {
+	// check if the fees are globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return types.ErrRevenueDisabled
+	}
+
+	k.SetDeveloperSharesCategory(ctx, types.NewDeveloperSharesCategory(category, developerShares))
+
+	for _, contract := range contracts {
+		k.SetContractCategory(ctx, types.NewContractCategory(contract, category))
+	}
+
+	return nil
+}
+
+// RemoveDeveloperSharesCategory removes the given contracts from a category,
+// so that the global DeveloperShares param applies to them. If no contracts
+// are given, the category is deleted together with the assignments of all its
+// contracts.
+func (k Keeper) RemoveDeveloperSharesCategory(
+	ctx sdk.Context,
+	category string,
+	contracts []common.Address,
+) error {
+	// check if the fees are globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return types.ErrRevenueDisabled
+	}
+
+	if _, found := k.GetDeveloperSharesCategory(ctx, category); !found {
+		return errorsmod.Wrapf(
+			types.ErrDeveloperSharesCategoryNotFound,
+			"category %s", category,
+		)
+	}
+
+	if len(contracts) > 0 {
+		for _, contract := range contracts {
+			contractCategory, found := k.GetContractCategory(ctx, contract)
+			if !found || contractCategory.Category != category {
+				return errorsmod.Wrapf(
+					types.ErrContractCategoryNotFound,
+					"contract %s, category %s", contract, category,
+				)
+			}
+
+			k.DeleteContractCategory(ctx, contract)
+		}
+
+		return nil
+	}
+
+	for _, contractCategory := range k.GetContractCategories(ctx) {
+		if contractCategory.Category == category {
+			k.DeleteContractCategory(ctx, contractCategory.GetContractAddr())
+		}
+	}
+
+	k.DeleteDeveloperSharesCategory(ctx, category)
+	return nil
+}
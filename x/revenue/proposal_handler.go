@@ -0,0 +1,117 @@
+package revenue
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/revenue/keeper"
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+// NewRevenueProposalHandler creates a governance handler to manage new
+// proposal types.
+func NewRevenueProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		switch c := content.(type) {
+		case *types.SetDeveloperSharesOverrideProposal:
+			return handleSetDeveloperSharesOverrideProposal(ctx, k, c)
+		case *types.RemoveDeveloperSharesOverrideProposal:
+			return handleRemoveDeveloperSharesOverrideProposal(ctx, k, c)
+		case *types.SetDeveloperSharesCategoryProposal:
+			return handleSetDeveloperSharesCategoryProposal(ctx, k, c)
+		case *types.RemoveDeveloperSharesCategoryProposal:
+			return handleRemoveDeveloperSharesCategoryProposal(ctx, k, c)
+		default:
+			return errorsmod.Wrapf(
+				errortypes.ErrUnknownRequest,
+				"unrecognized %s proposal content type: %T", types.ModuleName, c,
+			)
+		}
+	}
+}
+
+func handleSetDeveloperSharesOverrideProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.SetDeveloperSharesOverrideProposal,
+) error {
+	if err := k.SetDeveloperSharesOverrides(ctx, hexToAddresses(p.Contracts), p.DeveloperShares); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDeveloperSharesOverride,
+			sdk.NewAttribute(types.AttributeKeyContract, strings.Join(p.Contracts, ",")),
+			sdk.NewAttribute(types.AttributeKeyDeveloperShares, p.DeveloperShares.String()),
+		),
+	)
+	return nil
+}
+
+func handleRemoveDeveloperSharesOverrideProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.RemoveDeveloperSharesOverrideProposal,
+) error {
+	if err := k.RemoveDeveloperSharesOverrides(ctx, hexToAddresses(p.Contracts)); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveDeveloperSharesOverride,
+			sdk.NewAttribute(types.AttributeKeyContract, strings.Join(p.Contracts, ",")),
+		),
+	)
+	return nil
+}
+
+func handleSetDeveloperSharesCategoryProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.SetDeveloperSharesCategoryProposal,
+) error {
+	if err := k.UpdateDeveloperSharesCategory(ctx, p.Category, p.DeveloperShares, hexToAddresses(p.Contracts)); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDeveloperSharesCategory,
+			sdk.NewAttribute(types.AttributeKeyCategory, p.Category),
+			sdk.NewAttribute(types.AttributeKeyContract, strings.Join(p.Contracts, ",")),
+			sdk.NewAttribute(types.AttributeKeyDeveloperShares, p.DeveloperShares.String()),
+		),
+	)
+	return nil
+}
+
+func handleRemoveDeveloperSharesCategoryProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.RemoveDeveloperSharesCategoryProposal,
+) error {
+	if err := k.RemoveDeveloperSharesCategory(ctx, p.Category, hexToAddresses(p.Contracts)); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveDeveloperSharesCategory,
+			sdk.NewAttribute(types.AttributeKeyCategory, p.Category),
+			sdk.NewAttribute(types.AttributeKeyContract, strings.Join(p.Contracts, ",")),
+		),
+	)
+	return nil
+}
+
+func hexToAddresses(contracts []string) []common.Address {
+	addresses := make([]common.Address, len(contracts))
+	for i, contract := range contracts {
+		addresses[i] = common.HexToAddress(contract)
+	}
+	return addresses
+}
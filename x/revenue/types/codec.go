@@ -5,6 +5,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 var (
@@ -43,6 +44,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateRevenue{},
 	)
 
+	registry.RegisterImplementations(
+		(*govv1beta1.Content)(nil),
+		&SetDeveloperSharesOverrideProposal{},
+		&RemoveDeveloperSharesOverrideProposal{},
+		&SetDeveloperSharesCategoryProposal{},
+		&RemoveDeveloperSharesCategoryProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
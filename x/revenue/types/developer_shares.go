@@ -0,0 +1,90 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewDeveloperSharesOverride returns an instance of DeveloperSharesOverride
+func NewDeveloperSharesOverride(contract common.Address, developerShares sdk.Dec) DeveloperSharesOverride {
+	return DeveloperSharesOverride{
+		ContractAddress: contract.String(),
+		DeveloperShares: developerShares,
+	}
+}
+
+// GetContractAddr returns the contract address
+func (dso DeveloperSharesOverride) GetContractAddr() common.Address {
+	return common.HexToAddress(dso.ContractAddress)
+}
+
+// Validate performs a stateless validation of a DeveloperSharesOverride
+func (dso DeveloperSharesOverride) Validate() error {
+	if err := ethermint.ValidateNonZeroAddress(dso.ContractAddress); err != nil {
+		return err
+	}
+
+	return validateShares(dso.DeveloperShares)
+}
+
+// MaxCategoryLength is the maximum length of the name of a developer shares
+// category
+const MaxCategoryLength = 64
+
+// NewDeveloperSharesCategory returns an instance of DeveloperSharesCategory
+func NewDeveloperSharesCategory(name string, developerShares sdk.Dec) DeveloperSharesCategory {
+	return DeveloperSharesCategory{
+		Name:            name,
+		DeveloperShares: developerShares,
+	}
+}
+
+// Validate performs a stateless validation of a DeveloperSharesCategory
+func (dsc DeveloperSharesCategory) Validate() error {
+	if err := ValidateCategory(dsc.Name); err != nil {
+		return err
+	}
+
+	return validateShares(dsc.DeveloperShares)
+}
+
+// NewContractCategory returns an instance of ContractCategory
+func NewContractCategory(contract common.Address, category string) ContractCategory {
+	return ContractCategory{
+		ContractAddress: contract.String(),
+		Category:        category,
+	}
+}
+
+// GetContractAddr returns the contract address
+func (cc ContractCategory) GetContractAddr() common.Address {
+	return common.HexToAddress(cc.ContractAddress)
+}
+
+// Validate performs a stateless validation of a ContractCategory
+func (cc ContractCategory) Validate() error {
+	if err := ethermint.ValidateNonZeroAddress(cc.ContractAddress); err != nil {
+		return err
+	}
+
+	return ValidateCategory(cc.Category)
+}
+
+// ValidateCategory checks that the name of a developer shares category is not
+// blank and doesn't exceed the maximum length
+func ValidateCategory(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("category cannot be blank")
+	}
+
+	if len(name) > MaxCategoryLength {
+		return fmt.Errorf("category length cannot be greater than %d, got %d", MaxCategoryLength, len(name))
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// GetContractAddr returns the contract address
+func (ce ContractEarnings) GetContractAddr() common.Address {
+	return common.HexToAddress(ce.ContractAddress)
+}
+
+// Validate performs a stateless validation of a ContractEarnings
+func (ce ContractEarnings) Validate() error {
+	if err := ethermint.ValidateNonZeroAddress(ce.ContractAddress); err != nil {
+		return err
+	}
+
+	return ce.Earnings.Validate()
+}
+
+// GetWithdrawerAddr returns the withdrawer address
+func (we WithdrawerEarnings) GetWithdrawerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(we.WithdrawerAddress)
+}
+
+// Validate performs a stateless validation of a WithdrawerEarnings
+func (we WithdrawerEarnings) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(we.WithdrawerAddress); err != nil {
+		return err
+	}
+
+	return we.Earnings.Validate()
+}
+
+// GetContractAddr returns the contract address
+func (ee EpochEarnings) GetContractAddr() common.Address {
+	return common.HexToAddress(ee.ContractAddress)
+}
+
+// Validate performs a stateless validation of an EpochEarnings
+func (ee EpochEarnings) Validate() error {
+	if err := ethermint.ValidateNonZeroAddress(ee.ContractAddress); err != nil {
+		return err
+	}
+
+	if ee.EpochNumber < 0 {
+		return fmt.Errorf("epoch number cannot be negative: %d", ee.EpochNumber)
+	}
+
+	return ee.Earnings.Validate()
+}
@@ -6,10 +6,13 @@ import (
 
 // errors
 var (
-	ErrInternalRevenue              = errorsmod.Register(ModuleName, 2, "internal revenue error")
-	ErrRevenueDisabled              = errorsmod.Register(ModuleName, 3, "revenue module is disabled by governance")
-	ErrRevenueAlreadyRegistered     = errorsmod.Register(ModuleName, 4, "revenue already exists for given contract")
-	ErrRevenueNoContractDeployed    = errorsmod.Register(ModuleName, 5, "no contract deployed")
-	ErrRevenueContractNotRegistered = errorsmod.Register(ModuleName, 6, "no revenue registered for contract")
-	ErrRevenueDeployerIsNotEOA      = errorsmod.Register(ModuleName, 7, "no revenue registered for contract")
+	ErrInternalRevenue                 = errorsmod.Register(ModuleName, 2, "internal revenue error")
+	ErrRevenueDisabled                 = errorsmod.Register(ModuleName, 3, "revenue module is disabled by governance")
+	ErrRevenueAlreadyRegistered        = errorsmod.Register(ModuleName, 4, "revenue already exists for given contract")
+	ErrRevenueNoContractDeployed       = errorsmod.Register(ModuleName, 5, "no contract deployed")
+	ErrRevenueContractNotRegistered    = errorsmod.Register(ModuleName, 6, "no revenue registered for contract")
+	ErrRevenueDeployerIsNotEOA         = errorsmod.Register(ModuleName, 7, "no revenue registered for contract")
+	ErrDeveloperSharesOverrideNotFound = errorsmod.Register(ModuleName, 8, "no developer shares override set for contract")
+	ErrDeveloperSharesCategoryNotFound = errorsmod.Register(ModuleName, 9, "developer shares category not found")
+	ErrContractCategoryNotFound        = errorsmod.Register(ModuleName, 10, "contract not assigned to developer shares category")
 )
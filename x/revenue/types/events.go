@@ -7,6 +7,13 @@ const (
 	EventTypeUpdateRevenue        = "update_revenue"
 	EventTypeDistributeDevRevenue = "distribute_dev_revenue"
 
+	EventTypeSetDeveloperSharesOverride    = "set_developer_shares_override"
+	EventTypeRemoveDeveloperSharesOverride = "remove_developer_shares_override"
+	EventTypeSetDeveloperSharesCategory    = "set_developer_shares_category"
+	EventTypeRemoveDeveloperSharesCategory = "remove_developer_shares_category"
+
 	AttributeKeyContract          = "contract"
 	AttributeKeyWithdrawerAddress = "withdrawer_address"
+	AttributeKeyDeveloperShares   = "developer_shares"
+	AttributeKeyCategory          = "category"
 )
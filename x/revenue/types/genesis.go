@@ -35,5 +35,88 @@ func (gs GenesisState) Validate() error {
 		seenContract[fs.ContractAddress] = true
 	}
 
+	seenEarnings := make(map[string]bool)
+	for _, ce := range gs.ContractEarnings {
+		if seenEarnings[ce.ContractAddress] {
+			return fmt.Errorf("contract earnings duplicated on genesis '%s'", ce.ContractAddress)
+		}
+
+		if err := ce.Validate(); err != nil {
+			return err
+		}
+
+		seenEarnings[ce.ContractAddress] = true
+	}
+
+	seenWithdrawer := make(map[string]bool)
+	for _, we := range gs.WithdrawerEarnings {
+		if seenWithdrawer[we.WithdrawerAddress] {
+			return fmt.Errorf("withdrawer earnings duplicated on genesis '%s'", we.WithdrawerAddress)
+		}
+
+		if err := we.Validate(); err != nil {
+			return err
+		}
+
+		seenWithdrawer[we.WithdrawerAddress] = true
+	}
+
+	seenEpoch := make(map[string]bool)
+	for _, ee := range gs.EpochEarnings {
+		key := fmt.Sprintf("%s/%d", ee.ContractAddress, ee.EpochNumber)
+		if seenEpoch[key] {
+			return fmt.Errorf("epoch earnings duplicated on genesis '%s'", key)
+		}
+
+		if err := ee.Validate(); err != nil {
+			return err
+		}
+
+		seenEpoch[key] = true
+	}
+
+	seenOverride := make(map[string]bool)
+	for _, dso := range gs.DeveloperSharesOverrides {
+		if seenOverride[dso.ContractAddress] {
+			return fmt.Errorf("developer shares override duplicated on genesis '%s'", dso.ContractAddress)
+		}
+
+		if err := dso.Validate(); err != nil {
+			return err
+		}
+
+		seenOverride[dso.ContractAddress] = true
+	}
+
+	seenCategory := make(map[string]bool)
+	for _, dsc := range gs.DeveloperSharesCategories {
+		if seenCategory[dsc.Name] {
+			return fmt.Errorf("developer shares category duplicated on genesis '%s'", dsc.Name)
+		}
+
+		if err := dsc.Validate(); err != nil {
+			return err
+		}
+
+		seenCategory[dsc.Name] = true
+	}
+
+	seenContractCategory := make(map[string]bool)
+	for _, cc := range gs.ContractCategories {
+		if seenContractCategory[cc.ContractAddress] {
+			return fmt.Errorf("contract category duplicated on genesis '%s'", cc.ContractAddress)
+		}
+
+		if err := cc.Validate(); err != nil {
+			return err
+		}
+
+		if !seenCategory[cc.Category] {
+			return fmt.Errorf("category '%s' of contract '%s' not found on genesis", cc.Category, cc.ContractAddress)
+		}
+
+		seenContractCategory[cc.ContractAddress] = true
+	}
+
 	return gs.Params.Validate()
 }
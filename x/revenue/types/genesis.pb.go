@@ -30,6 +30,20 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// revenues is a slice of active registered contracts for fee distribution
 	Revenues []Revenue `protobuf:"bytes,2,rep,name=revenues,proto3" json:"revenues"`
+	// contract_earnings is a slice of the cumulative earnings per contract
+	ContractEarnings []ContractEarnings `protobuf:"bytes,3,rep,name=contract_earnings,json=contractEarnings,proto3" json:"contract_earnings"`
+	// withdrawer_earnings is a slice of the cumulative earnings per withdrawer
+	WithdrawerEarnings []WithdrawerEarnings `protobuf:"bytes,4,rep,name=withdrawer_earnings,json=withdrawerEarnings,proto3" json:"withdrawer_earnings"`
+	// epoch_earnings is a slice of the earnings per contract and epoch
+	EpochEarnings []EpochEarnings `protobuf:"bytes,5,rep,name=epoch_earnings,json=epochEarnings,proto3" json:"epoch_earnings"`
+	// developer_shares_overrides is a slice of the governance-set developer
+	// shares per contract
+	DeveloperSharesOverrides []DeveloperSharesOverride `protobuf:"bytes,6,rep,name=developer_shares_overrides,json=developerSharesOverrides,proto3" json:"developer_shares_overrides"`
+	// developer_shares_categories is a slice of the governance-set developer
+	// shares per category
+	DeveloperSharesCategories []DeveloperSharesCategory `protobuf:"bytes,7,rep,name=developer_shares_categories,json=developerSharesCategories,proto3" json:"developer_shares_categories"`
+	// contract_categories is a slice of the categories assigned to contracts
+	ContractCategories []ContractCategory `protobuf:"bytes,8,rep,name=contract_categories,json=contractCategories,proto3" json:"contract_categories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +93,48 @@ func (m *GenesisState) GetRevenues() []Revenue {
 	return nil
 }
 
+func (m *GenesisState) GetContractEarnings() []ContractEarnings {
+	if m != nil {
+		return m.ContractEarnings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawerEarnings() []WithdrawerEarnings {
+	if m != nil {
+		return m.WithdrawerEarnings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochEarnings() []EpochEarnings {
+	if m != nil {
+		return m.EpochEarnings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeveloperSharesOverrides() []DeveloperSharesOverride {
+	if m != nil {
+		return m.DeveloperSharesOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeveloperSharesCategories() []DeveloperSharesCategory {
+	if m != nil {
+		return m.DeveloperSharesCategories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractCategories() []ContractCategory {
+	if m != nil {
+		return m.ContractCategories
+	}
+	return nil
+}
+
 // Params defines the revenue module params
 type Params struct {
 	// enable_revenue defines a parameter to enable the revenue module
@@ -89,6 +145,12 @@ type Params struct {
 	// addr_derivation_cost_create defines the cost of address derivation for
 	// verifying the contract deployer at fee registration
 	AddrDerivationCostCreate uint64 `protobuf:"varint,3,opt,name=addr_derivation_cost_create,json=addrDerivationCostCreate,proto3" json:"addr_derivation_cost_create,omitempty"`
+	// earnings_epoch_identifier defines the epoch identifier used to bucket the
+	// contract earnings per epoch. Epoch buckets are not recorded if empty
+	EarningsEpochIdentifier string `protobuf:"bytes,4,opt,name=earnings_epoch_identifier,json=earningsEpochIdentifier,proto3" json:"earnings_epoch_identifier,omitempty"`
+	// earnings_retention is the number of epochs for which the earnings buckets
+	// of a contract are kept. Epoch buckets are not recorded if zero
+	EarningsRetention uint64 `protobuf:"varint,5,opt,name=earnings_retention,json=earningsRetention,proto3" json:"earnings_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -138,6 +200,20 @@ func (m *Params) GetAddrDerivationCostCreate() uint64 {
 	return 0
 }
 
+func (m *Params) GetEarningsEpochIdentifier() string {
+	if m != nil {
+		return m.EarningsEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetEarningsRetention() uint64 {
+	if m != nil {
+		return m.EarningsRetention
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.revenue.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.revenue.v1.Params")
@@ -146,29 +222,43 @@ func init() {
 func init() { proto.RegisterFile("evmos/revenue/v1/genesis.proto", fileDescriptor_649d64d9c3438055) }
 
 var fileDescriptor_649d64d9c3438055 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x4b, 0xf3, 0x40,
-	0x10, 0xc6, 0xb3, 0x6f, 0x4b, 0xe9, 0xbb, 0xf5, 0x4f, 0x09, 0x1e, 0x62, 0x85, 0xb4, 0x14, 0x94,
-	0x20, 0xb8, 0xb1, 0x15, 0xbc, 0x88, 0x97, 0xb6, 0xe0, 0x55, 0xd2, 0x93, 0x5e, 0xc2, 0x36, 0x19,
-	0xd2, 0x60, 0x9b, 0x0d, 0xbb, 0xdb, 0xa0, 0x67, 0xbf, 0x80, 0x1f, 0xab, 0xde, 0x7a, 0x14, 0x0f,
-	0x45, 0xda, 0x2f, 0x22, 0xd9, 0x8d, 0x41, 0xec, 0x25, 0x19, 0xe6, 0x79, 0x9e, 0xdf, 0x0c, 0x3b,
-	0xd8, 0x86, 0x6c, 0xce, 0x84, 0xcb, 0x21, 0x83, 0x64, 0x01, 0x6e, 0xd6, 0x73, 0x23, 0x48, 0x40,
-	0xc4, 0x82, 0xa4, 0x9c, 0x49, 0x66, 0x36, 0x95, 0x4e, 0x0a, 0x9d, 0x64, 0xbd, 0xd6, 0x6e, 0xe2,
-	0x47, 0x54, 0x89, 0xd6, 0x51, 0xc4, 0x22, 0xa6, 0x4a, 0x37, 0xaf, 0x74, 0xb7, 0xfb, 0x8a, 0xf0,
-	0xde, 0x9d, 0x26, 0x8f, 0x25, 0x95, 0x60, 0x5e, 0xe3, 0x5a, 0x4a, 0x39, 0x9d, 0x0b, 0x0b, 0x75,
-	0x90, 0xd3, 0xe8, 0x5b, 0xe4, 0xef, 0x24, 0x72, 0xaf, 0xf4, 0x41, 0x75, 0xb9, 0x6e, 0x1b, 0x5e,
-	0xe1, 0x36, 0x6f, 0x70, 0xbd, 0xb0, 0x08, 0xeb, 0x5f, 0xa7, 0xe2, 0x34, 0xfa, 0xc7, 0xbb, 0x49,
-	0x4f, 0x97, 0x45, 0xb4, 0x0c, 0x74, 0xdf, 0x11, 0xae, 0x69, 0xaa, 0x79, 0x8a, 0x0f, 0x20, 0xa1,
-	0x93, 0x19, 0xf8, 0x85, 0xaa, 0xf6, 0xa8, 0x7b, 0xfb, 0xba, 0x5b, 0x10, 0xcc, 0x07, 0xdc, 0x0c,
-	0x21, 0x83, 0x19, 0x4b, 0x81, 0xfb, 0x62, 0x4a, 0xb9, 0x1a, 0x8b, 0x9c, 0xff, 0x03, 0x92, 0xb3,
-	0x3f, 0xd7, 0xed, 0xb3, 0x28, 0x96, 0xd3, 0xc5, 0x84, 0x04, 0x6c, 0xee, 0x06, 0x4c, 0xe4, 0x6f,
-	0xa3, 0x7f, 0x17, 0x22, 0x7c, 0x72, 0xe5, 0x4b, 0x0a, 0x82, 0x8c, 0x20, 0xf0, 0x0e, 0x4b, 0xce,
-	0x58, 0x61, 0xcc, 0x5b, 0x7c, 0x42, 0xc3, 0x90, 0xfb, 0x21, 0xf0, 0x38, 0xa3, 0x32, 0x66, 0x89,
-	0x1f, 0x30, 0x21, 0xfd, 0x80, 0x03, 0x95, 0x60, 0x55, 0x3a, 0xc8, 0xa9, 0x7a, 0x56, 0x6e, 0x19,
-	0x95, 0x8e, 0x21, 0x13, 0x72, 0xa8, 0xf4, 0xc1, 0x68, 0xb9, 0xb1, 0xd1, 0x6a, 0x63, 0xa3, 0xaf,
-	0x8d, 0x8d, 0xde, 0xb6, 0xb6, 0xb1, 0xda, 0xda, 0xc6, 0xc7, 0xd6, 0x36, 0x1e, 0xcf, 0x7f, 0x6d,
-	0xa4, 0x8f, 0xa5, 0xbf, 0x59, 0xef, 0xd2, 0x7d, 0x2e, 0x0f, 0xa7, 0x36, 0x9b, 0xd4, 0xd4, 0x79,
-	0xae, 0xbe, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16, 0xe7, 0x21, 0x0b, 0x08, 0x02, 0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x41, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc6, 0x9b, 0xad, 0xeb, 0x7f, 0x7f, 0x8f, 0x8d, 0xcd, 0x43, 0xc2, 0xeb, 0xa4, 0xb4, 0xaa,
+	0x00, 0x15, 0xa4, 0x25, 0x74, 0x48, 0x1c, 0x40, 0x5c, 0xda, 0x4e, 0x08, 0x09, 0x09, 0x94, 0x09,
+	0xa1, 0xc1, 0x21, 0x72, 0x93, 0x97, 0xd4, 0x62, 0x8d, 0x2b, 0xdb, 0x4b, 0xd9, 0xb7, 0xe0, 0xc3,
+	0xf0, 0x01, 0x38, 0xee, 0xb8, 0x23, 0xe2, 0x30, 0xa1, 0xf6, 0x8b, 0xa0, 0x38, 0x4e, 0x56, 0x92,
+	0x49, 0x70, 0x69, 0xad, 0xf7, 0x79, 0x9f, 0xdf, 0x13, 0xc7, 0x6f, 0x8c, 0x6c, 0x48, 0x26, 0x5c,
+	0xba, 0x02, 0x12, 0x88, 0xcf, 0xc0, 0x4d, 0x7a, 0x6e, 0x04, 0x31, 0x48, 0x26, 0x9d, 0xa9, 0xe0,
+	0x8a, 0xe3, 0x6d, 0xad, 0x3b, 0x46, 0x77, 0x92, 0x5e, 0xb3, 0xea, 0xc8, 0x45, 0xed, 0x68, 0xde,
+	0x89, 0x78, 0xc4, 0xf5, 0xd2, 0x4d, 0x57, 0x59, 0xb5, 0xf3, 0x7d, 0x0d, 0xdd, 0x7a, 0x99, 0x91,
+	0x8f, 0x15, 0x55, 0x80, 0x9f, 0xa2, 0xc6, 0x94, 0x0a, 0x3a, 0x91, 0xc4, 0x6a, 0x5b, 0xdd, 0x8d,
+	0x43, 0xe2, 0x94, 0x93, 0x9c, 0xb7, 0x5a, 0xef, 0xd7, 0x2f, 0xae, 0x5a, 0x35, 0xcf, 0x74, 0xe3,
+	0xe7, 0x68, 0xdd, 0xb4, 0x48, 0xb2, 0xd2, 0x5e, 0xed, 0x6e, 0x1c, 0xee, 0x55, 0x9d, 0x5e, 0xb6,
+	0x34, 0xd6, 0xc2, 0x80, 0xdf, 0xa1, 0x9d, 0x80, 0xc7, 0x4a, 0xd0, 0x40, 0xf9, 0x40, 0x45, 0xcc,
+	0xe2, 0x48, 0x92, 0x55, 0x4d, 0xe9, 0x54, 0x29, 0x03, 0xd3, 0x7a, 0x64, 0x3a, 0x0d, 0x6e, 0x3b,
+	0x28, 0xd5, 0xf1, 0x47, 0xb4, 0x3b, 0x63, 0x6a, 0x1c, 0x0a, 0x3a, 0x03, 0x71, 0x0d, 0xae, 0x6b,
+	0xf0, 0xbd, 0x2a, 0xf8, 0x7d, 0xd1, 0x5c, 0x42, 0xe3, 0x59, 0x45, 0xc1, 0xaf, 0xd1, 0x16, 0x4c,
+	0x79, 0x30, 0xbe, 0xe6, 0xae, 0x69, 0x6e, 0xab, 0xca, 0x3d, 0x4a, 0xfb, 0x4a, 0xc8, 0x4d, 0x58,
+	0x2e, 0xe2, 0x09, 0x6a, 0x86, 0x90, 0xc0, 0x29, 0x9f, 0x82, 0xf0, 0xe5, 0x98, 0x0a, 0x90, 0x3e,
+	0x4f, 0x40, 0x08, 0x16, 0x82, 0x24, 0x0d, 0x4d, 0x7e, 0x58, 0x25, 0x0f, 0x73, 0xcf, 0xb1, 0xb6,
+	0xbc, 0x31, 0x0e, 0x93, 0x41, 0xc2, 0x9b, 0x65, 0x89, 0x39, 0xda, 0xaf, 0xc4, 0x05, 0x54, 0x41,
+	0xc4, 0x05, 0x03, 0x49, 0xfe, 0xfb, 0xc7, 0xbc, 0x41, 0x66, 0x39, 0x37, 0x79, 0x7b, 0xe1, 0x8d,
+	0x32, 0x03, 0x89, 0x4f, 0xd0, 0x6e, 0x71, 0xc2, 0x4b, 0x41, 0xeb, 0x7f, 0x3b, 0xe3, 0x52, 0x02,
+	0x0e, 0xfe, 0xac, 0x33, 0x90, 0x9d, 0x6f, 0x2b, 0xa8, 0x91, 0x8d, 0x24, 0xbe, 0x8f, 0xb6, 0x20,
+	0xa6, 0xa3, 0x53, 0xf0, 0x0d, 0x4a, 0x0f, 0xf1, 0xba, 0xb7, 0x99, 0x55, 0xcd, 0xf8, 0xe1, 0x13,
+	0xb4, 0x5d, 0xde, 0x3d, 0x59, 0x69, 0x5b, 0xdd, 0xff, 0xfb, 0x4e, 0x9a, 0xf2, 0xf3, 0xaa, 0xf5,
+	0x20, 0x62, 0x6a, 0x7c, 0x36, 0x72, 0x02, 0x3e, 0x71, 0x03, 0x2e, 0xd3, 0x0f, 0x2b, 0xfb, 0x3b,
+	0x90, 0xe1, 0x67, 0x57, 0x9d, 0x4f, 0x41, 0x3a, 0x43, 0x08, 0xbc, 0xdb, 0xa5, 0x1d, 0xe3, 0x17,
+	0x68, 0x9f, 0x86, 0xa1, 0xf0, 0x43, 0x10, 0x2c, 0xa1, 0x8a, 0xf1, 0xd8, 0x0f, 0xb8, 0x54, 0x7e,
+	0x20, 0x80, 0x2a, 0x20, 0xab, 0x6d, 0xab, 0x5b, 0xf7, 0x48, 0xda, 0x32, 0x2c, 0x3a, 0x06, 0x5c,
+	0xaa, 0x81, 0xd6, 0xf1, 0x33, 0xb4, 0x97, 0x8f, 0x93, 0x9f, 0x4d, 0x17, 0x0b, 0x21, 0x56, 0xec,
+	0x13, 0x03, 0x41, 0xea, 0xe9, 0x23, 0x7a, 0x77, 0xf3, 0x06, 0x3d, 0x55, 0xaf, 0x0a, 0x19, 0x1f,
+	0x20, 0x5c, 0x78, 0x05, 0xa8, 0xb4, 0xce, 0x63, 0xb2, 0xa6, 0x13, 0x77, 0x72, 0xc5, 0xcb, 0x85,
+	0xfe, 0xf0, 0x62, 0x6e, 0x5b, 0x97, 0x73, 0xdb, 0xfa, 0x35, 0xb7, 0xad, 0xaf, 0x0b, 0xbb, 0x76,
+	0xb9, 0xb0, 0x6b, 0x3f, 0x16, 0x76, 0xed, 0xc3, 0xa3, 0xa5, 0xcd, 0x67, 0x97, 0x4a, 0xf6, 0x9b,
+	0xf4, 0x1e, 0xbb, 0x5f, 0x8a, 0x0b, 0x46, 0xbf, 0x84, 0x51, 0x43, 0x5f, 0x23, 0x4f, 0x7e, 0x0f,
+	0x00, 0xab, 0xb1, 0xca, 0x6f, 0xb0, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -191,6 +281,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractCategories) > 0 {
+		for iNdEx := len(m.ContractCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DeveloperSharesCategories) > 0 {
+		for iNdEx := len(m.DeveloperSharesCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperSharesCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DeveloperSharesOverrides) > 0 {
+		for iNdEx := len(m.DeveloperSharesOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperSharesOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EpochEarnings) > 0 {
+		for iNdEx := len(m.EpochEarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochEarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.WithdrawerEarnings) > 0 {
+		for iNdEx := len(m.WithdrawerEarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawerEarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ContractEarnings) > 0 {
+		for iNdEx := len(m.ContractEarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractEarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Revenues) > 0 {
 		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -238,6 +412,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EarningsRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EarningsRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EarningsEpochIdentifier) > 0 {
+		i -= len(m.EarningsEpochIdentifier)
+		copy(dAtA[i:], m.EarningsEpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EarningsEpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.AddrDerivationCostCreate != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.AddrDerivationCostCreate))
 		i--
@@ -291,6 +477,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractEarnings) > 0 {
+		for _, e := range m.ContractEarnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawerEarnings) > 0 {
+		for _, e := range m.WithdrawerEarnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochEarnings) > 0 {
+		for _, e := range m.EpochEarnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeveloperSharesOverrides) > 0 {
+		for _, e := range m.DeveloperSharesOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeveloperSharesCategories) > 0 {
+		for _, e := range m.DeveloperSharesCategories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractCategories) > 0 {
+		for _, e := range m.ContractCategories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -308,6 +530,13 @@ func (m *Params) Size() (n int) {
 	if m.AddrDerivationCostCreate != 0 {
 		n += 1 + sovGenesis(uint64(m.AddrDerivationCostCreate))
 	}
+	l = len(m.EarningsEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EarningsRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.EarningsRetention))
+	}
 	return n
 }
 
@@ -413,6 +642,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractEarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractEarnings = append(m.ContractEarnings, ContractEarnings{})
+			if err := m.ContractEarnings[len(m.ContractEarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerEarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerEarnings = append(m.WithdrawerEarnings, WithdrawerEarnings{})
+			if err := m.WithdrawerEarnings[len(m.WithdrawerEarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochEarnings = append(m.EpochEarnings, EpochEarnings{})
+			if err := m.EpochEarnings[len(m.EpochEarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperSharesOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperSharesOverrides = append(m.DeveloperSharesOverrides, DeveloperSharesOverride{})
+			if err := m.DeveloperSharesOverrides[len(m.DeveloperSharesOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperSharesCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperSharesCategories = append(m.DeveloperSharesCategories, DeveloperSharesCategory{})
+			if err := m.DeveloperSharesCategories[len(m.DeveloperSharesCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCategories = append(m.ContractCategories, ContractCategory{})
+			if err := m.ContractCategories[len(m.ContractCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -536,6 +969,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarningsEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarningsEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarningsRetention", wireType)
+			}
+			m.EarningsRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarningsRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
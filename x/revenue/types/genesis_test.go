@@ -159,6 +159,64 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with developer shares category",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				DeveloperSharesCategories: []DeveloperSharesCategory{
+					{Name: "public-goods", DeveloperShares: sdk.NewDecWithPrec(9, 1)},
+				},
+				ContractCategories: []ContractCategory{
+					{ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec7", Category: "public-goods"},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated developer shares category",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				DeveloperSharesCategories: []DeveloperSharesCategory{
+					{Name: "public-goods", DeveloperShares: sdk.NewDecWithPrec(9, 1)},
+					{Name: "public-goods", DeveloperShares: sdk.NewDecWithPrec(8, 1)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - blank category",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				DeveloperSharesCategories: []DeveloperSharesCategory{
+					{Name: " ", DeveloperShares: sdk.NewDecWithPrec(9, 1)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - contract category not found",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ContractCategories: []ContractCategory{
+					{ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec7", Category: "public-goods"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated contract category",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				DeveloperSharesCategories: []DeveloperSharesCategory{
+					{Name: "public-goods", DeveloperShares: sdk.NewDecWithPrec(9, 1)},
+				},
+				ContractCategories: []ContractCategory{
+					{ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec7", Category: "public-goods"},
+					{ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec7", Category: "public-goods"},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -9,6 +9,8 @@ import (
 
 	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
 )
 
 // AccountKeeper defines the expected interface needed to retrieve account info.
@@ -31,3 +33,9 @@ type EVMKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
 	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
 }
+
+// EpochsKeeper defines the expected epochs keeper interface used to bucket the
+// contract earnings per epoch
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
+}
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // constants
 const (
@@ -19,6 +22,14 @@ const (
 	prefixRevenue = iota + 1
 	prefixDeployer
 	prefixWithdrawer
+	prefixContractEarnings
+	prefixWithdrawerEarnings
+	prefixEpochEarnings
+	prefixEarningsRank
+	prefixDeveloperSharesOverride
+	prefixEarningsRankDenom
+	prefixDeveloperSharesCategory
+	prefixContractCategory
 )
 
 // KVStore key prefixes
@@ -26,6 +37,18 @@ var (
 	KeyPrefixRevenue    = []byte{prefixRevenue}
 	KeyPrefixDeployer   = []byte{prefixDeployer}
 	KeyPrefixWithdrawer = []byte{prefixWithdrawer}
+
+	KeyPrefixContractEarnings   = []byte{prefixContractEarnings}
+	KeyPrefixWithdrawerEarnings = []byte{prefixWithdrawerEarnings}
+	KeyPrefixEpochEarnings      = []byte{prefixEpochEarnings}
+	KeyPrefixEarningsRank       = []byte{prefixEarningsRank}
+
+	KeyPrefixDeveloperSharesOverride = []byte{prefixDeveloperSharesOverride}
+
+	KeyEarningsRankDenom = []byte{prefixEarningsRankDenom}
+
+	KeyPrefixDeveloperSharesCategory = []byte{prefixDeveloperSharesCategory}
+	KeyPrefixContractCategory        = []byte{prefixContractCategory}
 )
 
 // GetKeyPrefixDeployer returns the KVStore key prefix for storing
@@ -39,3 +62,17 @@ func GetKeyPrefixDeployer(deployerAddress sdk.AccAddress) []byte {
 func GetKeyPrefixWithdrawer(withdrawerAddress sdk.AccAddress) []byte {
 	return append(KeyPrefixWithdrawer, withdrawerAddress.Bytes()...)
 }
+
+// GetKeyPrefixEpochEarnings returns the KVStore key prefix for storing the
+// per-epoch earnings of a contract
+func GetKeyPrefixEpochEarnings(contract common.Address) []byte {
+	return append(KeyPrefixEpochEarnings, contract.Bytes()...)
+}
+
+// GetKeyEarningsRank returns the KVStore key of a contract in the earnings
+// rank index. The amount is encoded as a fixed-length big-endian byte slice so
+// that the index is sorted by amount.
+func GetKeyEarningsRank(amount sdk.Int, contract common.Address) []byte {
+	bz := amount.BigInt().FillBytes(make([]byte, 32))
+	return append(bz, contract.Bytes()...)
+}
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
 )
 
 // Parameter store key
@@ -14,10 +16,18 @@ var (
 	// Cost for executing `crypto.CreateAddress` must be at least 36 gas for the
 	// contained keccak256(word) operation
 	DefaultAddrDerivationCostCreate = uint64(50)
+	// DefaultEarningsRetention keeps the earnings buckets of one year of daily
+	// epochs
+	DefaultEarningsRetention = uint64(365)
+	// MaxEarningsRetention is the maximum number of epochs for which the
+	// earnings buckets of a contract can be kept
+	MaxEarningsRetention = uint64(3650)
 
 	ParamStoreKeyEnableRevenue            = []byte("EnableRevenue")
 	ParamStoreKeyDeveloperShares          = []byte("DeveloperShares")
 	ParamStoreKeyAddrDerivationCostCreate = []byte("AddrDerivationCostCreate")
+	ParamStoreKeyEarningsEpochIdentifier  = []byte("EarningsEpochIdentifier")
+	ParamStoreKeyEarningsRetention        = []byte("EarningsRetention")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -30,11 +40,15 @@ func NewParams(
 	enableRevenue bool,
 	developerShares sdk.Dec,
 	addrDerivationCostCreate uint64,
+	earningsEpochIdentifier string,
+	earningsRetention uint64,
 ) Params {
 	return Params{
 		EnableRevenue:            enableRevenue,
 		DeveloperShares:          developerShares,
 		AddrDerivationCostCreate: addrDerivationCostCreate,
+		EarningsEpochIdentifier:  earningsEpochIdentifier,
+		EarningsRetention:        earningsRetention,
 	}
 }
 
@@ -43,6 +57,7 @@ func DefaultParams() Params {
 		EnableRevenue:            DefaultEnableRevenue,
 		DeveloperShares:          DefaultDeveloperShares,
 		AddrDerivationCostCreate: DefaultAddrDerivationCostCreate,
+		EarningsRetention:        DefaultEarningsRetention,
 	}
 }
 
@@ -52,6 +67,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableRevenue, &p.EnableRevenue, validateBool),
 		paramtypes.NewParamSetPair(ParamStoreKeyDeveloperShares, &p.DeveloperShares, validateShares),
 		paramtypes.NewParamSetPair(ParamStoreKeyAddrDerivationCostCreate, &p.AddrDerivationCostCreate, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyEarningsEpochIdentifier, &p.EarningsEpochIdentifier, validateEarningsEpochIdentifier),
+		paramtypes.NewParamSetPair(ParamStoreKeyEarningsRetention, &p.EarningsRetention, validateEarningsRetention),
 	}
 }
 
@@ -73,6 +90,34 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+// validateEarningsEpochIdentifier allows an empty identifier, which disables
+// the per-epoch earnings buckets
+func validateEarningsEpochIdentifier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	return epochstypes.ValidateEpochIdentifierString(v)
+}
+
+func validateEarningsRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxEarningsRetention {
+		return fmt.Errorf("earnings retention cannot exceed %d epochs: %d", MaxEarningsRetention, v)
+	}
+
+	return nil
+}
+
 func validateShares(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 
@@ -102,5 +147,11 @@ func (p Params) Validate() error {
 	if err := validateShares(p.DeveloperShares); err != nil {
 		return err
 	}
-	return validateUint64(p.AddrDerivationCostCreate)
+	if err := validateUint64(p.AddrDerivationCostCreate); err != nil {
+		return err
+	}
+	if err := validateEarningsEpochIdentifier(p.EarningsEpochIdentifier); err != nil {
+		return err
+	}
+	return validateEarningsRetention(p.EarningsRetention)
 }
@@ -30,17 +30,17 @@ func TestParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid: enabled",
-			NewParams(true, devShares, derivCostCreate),
+			NewParams(true, devShares, derivCostCreate, "", DefaultEarningsRetention),
 			false,
 		},
 		{
 			"valid: disabled",
-			NewParams(false, devShares, derivCostCreate),
+			NewParams(false, devShares, derivCostCreate, "", DefaultEarningsRetention),
 			false,
 		},
 		{
 			"valid: 100% devs",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), derivCostCreate},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), derivCostCreate, "", DefaultEarningsRetention},
 			false,
 		},
 		{
@@ -50,19 +50,39 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"invalid: share > 1",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), derivCostCreate},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), derivCostCreate, "", DefaultEarningsRetention},
 			true,
 		},
 		{
 			"invalid: share < 0",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), derivCostCreate},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), derivCostCreate, "", DefaultEarningsRetention},
 			true,
 		},
 		{
 			"invalid: wrong address derivation cost",
-			NewParams(true, devShares, 50),
+			NewParams(true, devShares, 50, "", DefaultEarningsRetention),
 			false,
 		},
+		{
+			"valid: earnings epoch identifier",
+			NewParams(true, devShares, derivCostCreate, "day", DefaultEarningsRetention),
+			false,
+		},
+		{
+			"invalid: blank earnings epoch identifier",
+			NewParams(true, devShares, derivCostCreate, "  ", DefaultEarningsRetention),
+			true,
+		},
+		{
+			"valid: zero earnings retention",
+			NewParams(true, devShares, derivCostCreate, "day", 0),
+			false,
+		},
+		{
+			"invalid: earnings retention exceeds max",
+			NewParams(true, devShares, derivCostCreate, "day", MaxEarningsRetention+1),
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		err := tc.params.Validate()
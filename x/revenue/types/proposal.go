@@ -0,0 +1,210 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// constants
+const (
+	ProposalTypeSetDeveloperSharesOverride    string = "SetDeveloperSharesOverride"
+	ProposalTypeRemoveDeveloperSharesOverride string = "RemoveDeveloperSharesOverride"
+	ProposalTypeSetDeveloperSharesCategory    string = "SetDeveloperSharesCategory"
+	ProposalTypeRemoveDeveloperSharesCategory string = "RemoveDeveloperSharesCategory"
+)
+
+// Implements Proposal Interface
+var (
+	_ govv1beta1.Content = &SetDeveloperSharesOverrideProposal{}
+	_ govv1beta1.Content = &RemoveDeveloperSharesOverrideProposal{}
+	_ govv1beta1.Content = &SetDeveloperSharesCategoryProposal{}
+	_ govv1beta1.Content = &RemoveDeveloperSharesCategoryProposal{}
+)
+
+func init() {
+	govv1beta1.RegisterProposalType(ProposalTypeSetDeveloperSharesOverride)
+	govv1beta1.RegisterProposalType(ProposalTypeRemoveDeveloperSharesOverride)
+	govv1beta1.RegisterProposalType(ProposalTypeSetDeveloperSharesCategory)
+	govv1beta1.RegisterProposalType(ProposalTypeRemoveDeveloperSharesCategory)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&SetDeveloperSharesOverrideProposal{}, "revenue/SetDeveloperSharesOverrideProposal", nil)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&RemoveDeveloperSharesOverrideProposal{}, "revenue/RemoveDeveloperSharesOverrideProposal", nil)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&SetDeveloperSharesCategoryProposal{}, "revenue/SetDeveloperSharesCategoryProposal", nil)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&RemoveDeveloperSharesCategoryProposal{}, "revenue/RemoveDeveloperSharesCategoryProposal", nil)
+}
+
+// NewSetDeveloperSharesOverrideProposal returns new instance of
+// SetDeveloperSharesOverrideProposal
+func NewSetDeveloperSharesOverrideProposal(
+	title, description string,
+	contracts []string,
+	developerShares sdk.Dec,
+) govv1beta1.Content {
+	return &SetDeveloperSharesOverrideProposal{
+		Title:           title,
+		Description:     description,
+		Contracts:       contracts,
+		DeveloperShares: developerShares,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*SetDeveloperSharesOverrideProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*SetDeveloperSharesOverrideProposal) ProposalType() string {
+	return ProposalTypeSetDeveloperSharesOverride
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *SetDeveloperSharesOverrideProposal) ValidateBasic() error {
+	if err := validateContracts(p.Contracts); err != nil {
+		return err
+	}
+
+	if err := validateShares(p.DeveloperShares); err != nil {
+		return err
+	}
+
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// NewRemoveDeveloperSharesOverrideProposal returns new instance of
+// RemoveDeveloperSharesOverrideProposal
+func NewRemoveDeveloperSharesOverrideProposal(
+	title, description string,
+	contracts []string,
+) govv1beta1.Content {
+	return &RemoveDeveloperSharesOverrideProposal{
+		Title:       title,
+		Description: description,
+		Contracts:   contracts,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RemoveDeveloperSharesOverrideProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RemoveDeveloperSharesOverrideProposal) ProposalType() string {
+	return ProposalTypeRemoveDeveloperSharesOverride
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *RemoveDeveloperSharesOverrideProposal) ValidateBasic() error {
+	if err := validateContracts(p.Contracts); err != nil {
+		return err
+	}
+
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// NewSetDeveloperSharesCategoryProposal returns new instance of
+// SetDeveloperSharesCategoryProposal
+func NewSetDeveloperSharesCategoryProposal(
+	title, description string,
+	category string,
+	developerShares sdk.Dec,
+	contracts []string,
+) govv1beta1.Content {
+	return &SetDeveloperSharesCategoryProposal{
+		Title:           title,
+		Description:     description,
+		Category:        category,
+		DeveloperShares: developerShares,
+		Contracts:       contracts,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*SetDeveloperSharesCategoryProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*SetDeveloperSharesCategoryProposal) ProposalType() string {
+	return ProposalTypeSetDeveloperSharesCategory
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *SetDeveloperSharesCategoryProposal) ValidateBasic() error {
+	if err := ValidateCategory(p.Category); err != nil {
+		return err
+	}
+
+	if err := validateShares(p.DeveloperShares); err != nil {
+		return err
+	}
+
+	// the contracts are optional when only the developer shares are updated
+	if len(p.Contracts) > 0 {
+		if err := validateContracts(p.Contracts); err != nil {
+			return err
+		}
+	}
+
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// NewRemoveDeveloperSharesCategoryProposal returns new instance of
+// RemoveDeveloperSharesCategoryProposal
+func NewRemoveDeveloperSharesCategoryProposal(
+	title, description string,
+	category string,
+	contracts []string,
+) govv1beta1.Content {
+	return &RemoveDeveloperSharesCategoryProposal{
+		Title:       title,
+		Description: description,
+		Category:    category,
+		Contracts:   contracts,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RemoveDeveloperSharesCategoryProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RemoveDeveloperSharesCategoryProposal) ProposalType() string {
+	return ProposalTypeRemoveDeveloperSharesCategory
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (p *RemoveDeveloperSharesCategoryProposal) ValidateBasic() error {
+	if err := ValidateCategory(p.Category); err != nil {
+		return err
+	}
+
+	// the whole category is removed if no contracts are given
+	if len(p.Contracts) > 0 {
+		if err := validateContracts(p.Contracts); err != nil {
+			return err
+		}
+	}
+
+	return govv1beta1.ValidateAbstract(p)
+}
+
+// validateContracts checks that the contracts are non-empty, valid, non-zero
+// hex addresses without duplicates
+func validateContracts(contracts []string) error {
+	if len(contracts) == 0 {
+		return errors.New("contracts cannot be empty")
+	}
+
+	seenContracts := make(map[string]bool)
+	for _, contract := range contracts {
+		if err := ethermint.ValidateNonZeroAddress(contract); err != nil {
+			return err
+		}
+
+		if seenContracts[contract] {
+			return fmt.Errorf("duplicate contract %s", contract)
+		}
+
+		seenContracts[contract] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type ProposalTestSuite struct {
+	suite.Suite
+}
+
+func TestProposalTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalTestSuite))
+}
+
+func (suite *ProposalTestSuite) TestKeysTypes() {
+	suite.Require().Equal("revenue", (&SetDeveloperSharesOverrideProposal{}).ProposalRoute())
+	suite.Require().Equal("SetDeveloperSharesOverride", (&SetDeveloperSharesOverrideProposal{}).ProposalType())
+	suite.Require().Equal("revenue", (&RemoveDeveloperSharesOverrideProposal{}).ProposalRoute())
+	suite.Require().Equal("RemoveDeveloperSharesOverride", (&RemoveDeveloperSharesOverrideProposal{}).ProposalType())
+	suite.Require().Equal("revenue", (&SetDeveloperSharesCategoryProposal{}).ProposalRoute())
+	suite.Require().Equal("SetDeveloperSharesCategory", (&SetDeveloperSharesCategoryProposal{}).ProposalType())
+	suite.Require().Equal("revenue", (&RemoveDeveloperSharesCategoryProposal{}).ProposalRoute())
+	suite.Require().Equal("RemoveDeveloperSharesCategory", (&RemoveDeveloperSharesCategoryProposal{}).ProposalType())
+}
+
+func (suite *ProposalTestSuite) TestSetDeveloperSharesOverrideProposal() {
+	contract := tests.GenerateAddress().String()
+
+	testCases := []struct {
+		name       string
+		contracts  []string
+		shares     sdk.Dec
+		expectPass bool
+	}{
+		{"valid", []string{contract, tests.GenerateAddress().String()}, sdk.NewDecWithPrec(75, 2), true},
+		{"valid - zero shares", []string{contract}, sdk.ZeroDec(), true},
+		{"invalid - empty contracts", []string{}, sdk.NewDecWithPrec(75, 2), false},
+		{"invalid - contract address", []string{"0x123"}, sdk.NewDecWithPrec(75, 2), false},
+		{"invalid - duplicate contract", []string{contract, contract}, sdk.NewDecWithPrec(75, 2), false},
+		{"invalid - shares > 1", []string{contract}, sdk.NewDec(2), false},
+		{"invalid - negative shares", []string{contract}, sdk.NewDec(-1), false},
+	}
+
+	for _, tc := range testCases {
+		proposal := NewSetDeveloperSharesOverrideProposal("test", "test desc", tc.contracts, tc.shares)
+		err := proposal.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestRemoveDeveloperSharesOverrideProposal() {
+	testCases := []struct {
+		name       string
+		contracts  []string
+		expectPass bool
+	}{
+		{"valid", []string{tests.GenerateAddress().String()}, true},
+		{"invalid - empty contracts", []string{}, false},
+		{"invalid - zero address", []string{"0x0000000000000000000000000000000000000000"}, false},
+	}
+
+	for _, tc := range testCases {
+		proposal := NewRemoveDeveloperSharesOverrideProposal("test", "test desc", tc.contracts)
+		err := proposal.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestSetDeveloperSharesCategoryProposal() {
+	contract := tests.GenerateAddress().String()
+
+	testCases := []struct {
+		name       string
+		category   string
+		shares     sdk.Dec
+		contracts  []string
+		expectPass bool
+	}{
+		{"valid", "public-goods", sdk.NewDecWithPrec(9, 1), []string{contract, tests.GenerateAddress().String()}, true},
+		{"valid - no contracts", "public-goods", sdk.NewDecWithPrec(9, 1), nil, true},
+		{"invalid - blank category", " ", sdk.NewDecWithPrec(9, 1), []string{contract}, false},
+		{"invalid - category too long", strings.Repeat("a", MaxCategoryLength+1), sdk.NewDecWithPrec(9, 1), []string{contract}, false},
+		{"invalid - shares > 1", "public-goods", sdk.NewDec(2), []string{contract}, false},
+		{"invalid - duplicate contract", "public-goods", sdk.NewDecWithPrec(9, 1), []string{contract, contract}, false},
+	}
+
+	for _, tc := range testCases {
+		proposal := NewSetDeveloperSharesCategoryProposal("test", "test desc", tc.category, tc.shares, tc.contracts)
+		err := proposal.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestRemoveDeveloperSharesCategoryProposal() {
+	testCases := []struct {
+		name       string
+		category   string
+		contracts  []string
+		expectPass bool
+	}{
+		{"valid", "public-goods", []string{tests.GenerateAddress().String()}, true},
+		{"valid - whole category", "public-goods", nil, true},
+		{"invalid - blank category", "", nil, false},
+		{"invalid - zero address", "public-goods", []string{"0x0000000000000000000000000000000000000000"}, false},
+	}
+
+	for _, tc := range testCases {
+		proposal := NewRemoveDeveloperSharesCategoryProposal("test", "test desc", tc.category, tc.contracts)
+		err := proposal.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
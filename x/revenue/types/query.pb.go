@@ -530,846 +530,3955 @@ func (m *QueryWithdrawerRevenuesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryRevenuesRequest)(nil), "evmos.revenue.v1.QueryRevenuesRequest")
-	proto.RegisterType((*QueryRevenuesResponse)(nil), "evmos.revenue.v1.QueryRevenuesResponse")
-	proto.RegisterType((*QueryRevenueRequest)(nil), "evmos.revenue.v1.QueryRevenueRequest")
-	proto.RegisterType((*QueryRevenueResponse)(nil), "evmos.revenue.v1.QueryRevenueResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.revenue.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.revenue.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryDeployerRevenuesRequest)(nil), "evmos.revenue.v1.QueryDeployerRevenuesRequest")
-	proto.RegisterType((*QueryDeployerRevenuesResponse)(nil), "evmos.revenue.v1.QueryDeployerRevenuesResponse")
-	proto.RegisterType((*QueryWithdrawerRevenuesRequest)(nil), "evmos.revenue.v1.QueryWithdrawerRevenuesRequest")
-	proto.RegisterType((*QueryWithdrawerRevenuesResponse)(nil), "evmos.revenue.v1.QueryWithdrawerRevenuesResponse")
+// QueryContractEarningsRequest is the request type for the
+// Query/ContractEarnings RPC method.
+type QueryContractEarningsRequest struct {
+	// contract_address of a registered contract in hex format
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func init() { proto.RegisterFile("evmos/revenue/v1/query.proto", fileDescriptor_5e4b17cda6e8e927) }
-
-var fileDescriptor_5e4b17cda6e8e927 = []byte{
-	// 660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xcf, 0x54, 0xfb, 0xef, 0xf5, 0x60, 0x3a, 0x56, 0x88, 0x4b, 0xdc, 0x96, 0xc5, 0xfe, 0x85,
-	0xee, 0x74, 0x2b, 0x2a, 0xe2, 0x45, 0x4b, 0xd1, 0x93, 0xd0, 0xee, 0x45, 0xf0, 0xa0, 0x4c, 0x92,
-	0x61, 0xbb, 0xd0, 0xee, 0x6c, 0x77, 0x36, 0x5b, 0x83, 0x04, 0xc1, 0x2f, 0xa0, 0xe2, 0xa1, 0x78,
-	0xf0, 0x0b, 0x78, 0xf4, 0x53, 0xf4, 0x58, 0xf0, 0xe2, 0x49, 0x24, 0xf1, 0x2b, 0x78, 0x97, 0xcc,
-	0xcc, 0xa6, 0xcd, 0xae, 0xdb, 0x58, 0x29, 0x78, 0x09, 0xcb, 0xfb, 0xf7, 0xfb, 0xbd, 0xdf, 0xbc,
-	0xf7, 0x02, 0x55, 0x96, 0xec, 0x71, 0x41, 0x22, 0x96, 0xb0, 0xa0, 0xc9, 0x48, 0xe2, 0x90, 0xfd,
-	0x26, 0x8b, 0x5a, 0x76, 0x18, 0xf1, 0x98, 0xe3, 0xb2, 0xf4, 0xda, 0xda, 0x6b, 0x27, 0x8e, 0xb1,
-	0x52, 0xe7, 0xa2, 0x97, 0x50, 0xa3, 0x82, 0xa9, 0x50, 0x92, 0x38, 0x35, 0x16, 0x53, 0x87, 0x84,
-	0xd4, 0xf3, 0x03, 0x1a, 0xfb, 0x3c, 0x50, 0xd9, 0x86, 0x99, 0xab, 0xed, 0xb1, 0x80, 0x09, 0x5f,
-	0x14, 0xfa, 0x53, 0x20, 0xe5, 0x9f, 0xf1, 0xb8, 0xc7, 0xe5, 0x27, 0xe9, 0x7d, 0x69, 0x6b, 0xd5,
-	0xe3, 0xdc, 0xdb, 0x65, 0x84, 0x86, 0x3e, 0xa1, 0x41, 0xc0, 0x63, 0x09, 0xa9, 0x6b, 0x5a, 0xcf,
-	0x61, 0x66, 0xbb, 0xc7, 0xca, 0x55, 0x95, 0x84, 0xcb, 0xf6, 0x9b, 0x4c, 0xc4, 0xf8, 0x11, 0xc0,
-	0x09, 0xbf, 0x0a, 0x9a, 0x43, 0x4b, 0x53, 0xeb, 0x0b, 0xb6, 0x6a, 0xc6, 0xee, 0x35, 0x63, 0xab,
-	0xbe, 0x75, 0x33, 0xf6, 0x16, 0xf5, 0x98, 0xce, 0x75, 0x4f, 0x65, 0x5a, 0x9f, 0x10, 0x5c, 0xcb,
-	0x00, 0x88, 0x90, 0x07, 0x82, 0xe1, 0xfb, 0x30, 0xa1, 0xe9, 0x8b, 0x0a, 0x9a, 0xbb, 0xb4, 0x34,
-	0xb5, 0x7e, 0xdd, 0xce, 0xca, 0x67, 0xeb, 0xac, 0x8d, 0xcb, 0x47, 0xdf, 0x67, 0x4b, 0x6e, 0x3f,
-	0x01, 0x3f, 0x1e, 0xa0, 0x37, 0x22, 0xe9, 0x2d, 0x0e, 0xa5, 0xa7, 0x90, 0x07, 0xf8, 0x3d, 0x80,
-	0xab, 0xa7, 0xe9, 0xa5, 0xed, 0x2f, 0x43, 0xb9, 0xce, 0x83, 0x38, 0xa2, 0xf5, 0xf8, 0x05, 0x6d,
-	0x34, 0x22, 0x26, 0x84, 0x14, 0x61, 0xd2, 0xbd, 0x92, 0xda, 0x1f, 0x2a, 0xb3, 0xb5, 0x3d, 0xa8,
-	0x60, 0xbf, 0xbf, 0x7b, 0x30, 0xae, 0xe9, 0x6a, 0xf9, 0x86, 0xb6, 0x97, 0xc6, 0x5b, 0x33, 0x80,
-	0x65, 0xc9, 0x2d, 0x1a, 0xd1, 0xbd, 0xf4, 0x49, 0xac, 0x27, 0x9a, 0x6a, 0x6a, 0xd5, 0x38, 0x77,
-	0x60, 0x2c, 0x94, 0x16, 0x0d, 0x53, 0xc9, 0xc3, 0xa8, 0x0c, 0x8d, 0xa2, 0xa3, 0xad, 0xf7, 0x08,
-	0xaa, 0xb2, 0xde, 0x26, 0x0b, 0x77, 0x79, 0x8b, 0x45, 0xd9, 0x11, 0x58, 0x86, 0x72, 0x43, 0xbb,
-	0xb2, 0x1a, 0xa4, 0x76, 0xad, 0x41, 0x66, 0x5a, 0x46, 0xfe, 0x79, 0x5a, 0x0e, 0x11, 0xdc, 0x28,
-	0xe0, 0xa4, 0xbb, 0x5d, 0x05, 0x9c, 0x7d, 0x18, 0x3d, 0x3f, 0x93, 0xee, 0x74, 0xe6, 0x69, 0x2e,
-	0x72, 0x4e, 0x0e, 0x11, 0x98, 0x92, 0xd9, 0x53, 0x3f, 0xde, 0x69, 0x44, 0xf4, 0x20, 0xaf, 0xd7,
-	0x2a, 0xe0, 0x83, 0xbe, 0x33, 0xa3, 0xd8, 0xf4, 0x89, 0xe7, 0xa2, 0x35, 0xfb, 0x88, 0x60, 0xb6,
-	0x90, 0xd9, 0xff, 0x55, 0x6d, 0xfd, 0xd7, 0x28, 0x8c, 0x4a, 0x6e, 0xf8, 0x35, 0x4c, 0xa4, 0xac,
-	0xf0, 0x42, 0x7e, 0x42, 0xff, 0x74, 0x83, 0x8c, 0xc5, 0xa1, 0x71, 0x0a, 0xd2, 0xb2, 0xde, 0x7c,
-	0xfd, 0xf9, 0x61, 0xa4, 0x8a, 0x0d, 0x52, 0x74, 0x21, 0x05, 0x7e, 0x8b, 0x60, 0x5c, 0x27, 0xe2,
-	0xf9, 0xb3, 0x0b, 0xa7, 0xf8, 0x0b, 0xc3, 0xc2, 0x34, 0xfc, 0x6d, 0x09, 0x4f, 0xf0, 0x6a, 0x31,
-	0x3c, 0x79, 0x95, 0xd5, 0xbf, 0x8d, 0x0f, 0x60, 0x4c, 0x2d, 0x26, 0xbe, 0x59, 0x00, 0x34, 0xb0,
-	0xff, 0xc6, 0xfc, 0x90, 0x28, 0xcd, 0x66, 0x4e, 0xb2, 0x31, 0x70, 0x25, 0xcf, 0x46, 0x6d, 0x3e,
-	0xfe, 0x8c, 0xa0, 0x9c, 0x5d, 0x30, 0x6c, 0x17, 0x54, 0x2f, 0xb8, 0x0e, 0x06, 0xf9, 0xeb, 0xf8,
-	0xf3, 0xa8, 0x94, 0x3d, 0x38, 0x6d, 0xfc, 0x05, 0x01, 0xce, 0x4f, 0x36, 0x5e, 0x2b, 0x80, 0x2f,
-	0x5c, 0x4f, 0xc3, 0x39, 0x47, 0x86, 0xa6, 0x7c, 0x57, 0x52, 0x76, 0x30, 0x39, 0x8b, 0x72, 0x7e,
-	0xe7, 0xdb, 0x1b, 0x9b, 0x47, 0x1d, 0x13, 0x1d, 0x77, 0x4c, 0xf4, 0xa3, 0x63, 0xa2, 0x77, 0x5d,
-	0xb3, 0x74, 0xdc, 0x35, 0x4b, 0xdf, 0xba, 0x66, 0xe9, 0xd9, 0x8a, 0xe7, 0xc7, 0x3b, 0xcd, 0x9a,
-	0x5d, 0xe7, 0x7b, 0xba, 0xa8, 0xfa, 0x4d, 0x9c, 0x35, 0xf2, 0xb2, 0x0f, 0x10, 0xb7, 0x42, 0x26,
-	0x6a, 0x63, 0xf2, 0x2f, 0xfa, 0xd6, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x29, 0x18, 0xab, 0x3a,
-	0x74, 0x08, 0x00, 0x00,
+func (m *QueryContractEarningsRequest) Reset()         { *m = QueryContractEarningsRequest{} }
+func (m *QueryContractEarningsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractEarningsRequest) ProtoMessage()    {}
+func (*QueryContractEarningsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{10}
+}
+func (m *QueryContractEarningsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractEarningsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractEarningsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractEarningsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractEarningsRequest.Merge(m, src)
+}
+func (m *QueryContractEarningsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractEarningsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractEarningsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryContractEarningsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Revenues retrieves all registered revenues
-	Revenues(ctx context.Context, in *QueryRevenuesRequest, opts ...grpc.CallOption) (*QueryRevenuesResponse, error)
-	// Revenue retrieves a registered revenue for a given contract address
-	Revenue(ctx context.Context, in *QueryRevenueRequest, opts ...grpc.CallOption) (*QueryRevenueResponse, error)
-	// Params retrieves the revenue module params
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// DeployerRevenues retrieves all revenues that a given deployer has
-	// registered
-	DeployerRevenues(ctx context.Context, in *QueryDeployerRevenuesRequest, opts ...grpc.CallOption) (*QueryDeployerRevenuesResponse, error)
-	// WithdrawerRevenues retrieves all revenues with a given withdrawer
-	// address
-	WithdrawerRevenues(ctx context.Context, in *QueryWithdrawerRevenuesRequest, opts ...grpc.CallOption) (*QueryWithdrawerRevenuesResponse, error)
+func (m *QueryContractEarningsRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryContractEarningsResponse is the response type for the
+// Query/ContractEarnings RPC method.
+type QueryContractEarningsResponse struct {
+	// contract_earnings is the cumulative earnings of the queried contract
+	ContractEarnings ContractEarnings `protobuf:"bytes,1,opt,name=contract_earnings,json=contractEarnings,proto3" json:"contract_earnings"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryContractEarningsResponse) Reset()         { *m = QueryContractEarningsResponse{} }
+func (m *QueryContractEarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractEarningsResponse) ProtoMessage()    {}
+func (*QueryContractEarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{11}
 }
-
-func (c *queryClient) Revenues(ctx context.Context, in *QueryRevenuesRequest, opts ...grpc.CallOption) (*QueryRevenuesResponse, error) {
-	out := new(QueryRevenuesResponse)
-	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/Revenues", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryContractEarningsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractEarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractEarningsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryContractEarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractEarningsResponse.Merge(m, src)
+}
+func (m *QueryContractEarningsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractEarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractEarningsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Revenue(ctx context.Context, in *QueryRevenueRequest, opts ...grpc.CallOption) (*QueryRevenueResponse, error) {
-	out := new(QueryRevenueResponse)
-	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/Revenue", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractEarningsResponse proto.InternalMessageInfo
+
+func (m *QueryContractEarningsResponse) GetContractEarnings() ContractEarnings {
+	if m != nil {
+		return m.ContractEarnings
 	}
-	return out, nil
+	return ContractEarnings{}
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryWithdrawerEarningsRequest is the request type for the
+// Query/WithdrawerEarnings RPC method.
+type QueryWithdrawerEarningsRequest struct {
+	// withdrawer_address in bech32 format
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
 }
 
-func (c *queryClient) DeployerRevenues(ctx context.Context, in *QueryDeployerRevenuesRequest, opts ...grpc.CallOption) (*QueryDeployerRevenuesResponse, error) {
-	out := new(QueryDeployerRevenuesResponse)
-	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/DeployerRevenues", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryWithdrawerEarningsRequest) Reset()         { *m = QueryWithdrawerEarningsRequest{} }
+func (m *QueryWithdrawerEarningsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawerEarningsRequest) ProtoMessage()    {}
+func (*QueryWithdrawerEarningsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{12}
+}
+func (m *QueryWithdrawerEarningsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawerEarningsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawerEarningsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryWithdrawerEarningsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawerEarningsRequest.Merge(m, src)
+}
+func (m *QueryWithdrawerEarningsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawerEarningsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawerEarningsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) WithdrawerRevenues(ctx context.Context, in *QueryWithdrawerRevenuesRequest, opts ...grpc.CallOption) (*QueryWithdrawerRevenuesResponse, error) {
-	out := new(QueryWithdrawerRevenuesResponse)
-	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/WithdrawerRevenues", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryWithdrawerEarningsRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawerEarningsRequest) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Revenues retrieves all registered revenues
-	Revenues(context.Context, *QueryRevenuesRequest) (*QueryRevenuesResponse, error)
-	// Revenue retrieves a registered revenue for a given contract address
-	Revenue(context.Context, *QueryRevenueRequest) (*QueryRevenueResponse, error)
-	// Params retrieves the revenue module params
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// DeployerRevenues retrieves all revenues that a given deployer has
-	// registered
-	DeployerRevenues(context.Context, *QueryDeployerRevenuesRequest) (*QueryDeployerRevenuesResponse, error)
-	// WithdrawerRevenues retrieves all revenues with a given withdrawer
-	// address
-	WithdrawerRevenues(context.Context, *QueryWithdrawerRevenuesRequest) (*QueryWithdrawerRevenuesResponse, error)
+// QueryWithdrawerEarningsResponse is the response type for the
+// Query/WithdrawerEarnings RPC method.
+type QueryWithdrawerEarningsResponse struct {
+	// withdrawer_earnings is the cumulative earnings of the queried withdrawer
+	WithdrawerEarnings WithdrawerEarnings `protobuf:"bytes,1,opt,name=withdrawer_earnings,json=withdrawerEarnings,proto3" json:"withdrawer_earnings"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryWithdrawerEarningsResponse) Reset()         { *m = QueryWithdrawerEarningsResponse{} }
+func (m *QueryWithdrawerEarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawerEarningsResponse) ProtoMessage()    {}
+func (*QueryWithdrawerEarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{13}
 }
-
-func (*UnimplementedQueryServer) Revenues(ctx context.Context, req *QueryRevenuesRequest) (*QueryRevenuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Revenues not implemented")
+func (m *QueryWithdrawerEarningsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Revenue(ctx context.Context, req *QueryRevenueRequest) (*QueryRevenueResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Revenue not implemented")
+func (m *QueryWithdrawerEarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawerEarningsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryWithdrawerEarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawerEarningsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) DeployerRevenues(ctx context.Context, req *QueryDeployerRevenuesRequest) (*QueryDeployerRevenuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeployerRevenues not implemented")
+func (m *QueryWithdrawerEarningsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) WithdrawerRevenues(ctx context.Context, req *QueryWithdrawerRevenuesRequest) (*QueryWithdrawerRevenuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawerRevenues not implemented")
+func (m *QueryWithdrawerEarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawerEarningsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryWithdrawerEarningsResponse proto.InternalMessageInfo
 
-func _Query_Revenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRevenuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Revenues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.revenue.v1.Query/Revenues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Revenues(ctx, req.(*QueryRevenuesRequest))
+func (m *QueryWithdrawerEarningsResponse) GetWithdrawerEarnings() WithdrawerEarnings {
+	if m != nil {
+		return m.WithdrawerEarnings
 	}
-	return interceptor(ctx, in, info, handler)
+	return WithdrawerEarnings{}
 }
 
-func _Query_Revenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRevenueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Revenue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.revenue.v1.Query/Revenue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Revenue(ctx, req.(*QueryRevenueRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryEpochEarningsRequest is the request type for the Query/EpochEarnings
+// RPC method.
+type QueryEpochEarningsRequest struct {
+	// contract_address of a registered contract in hex format
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.revenue.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryEpochEarningsRequest) Reset()         { *m = QueryEpochEarningsRequest{} }
+func (m *QueryEpochEarningsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochEarningsRequest) ProtoMessage()    {}
+func (*QueryEpochEarningsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{14}
+}
+func (m *QueryEpochEarningsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochEarningsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochEarningsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEpochEarningsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochEarningsRequest.Merge(m, src)
+}
+func (m *QueryEpochEarningsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochEarningsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochEarningsRequest.DiscardUnknown(m)
 }
 
-func _Query_DeployerRevenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDeployerRevenuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEpochEarningsRequest proto.InternalMessageInfo
+
+func (m *QueryEpochEarningsRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DeployerRevenues(ctx, in)
+	return ""
+}
+
+func (m *QueryEpochEarningsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.revenue.v1.Query/DeployerRevenues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DeployerRevenues(ctx, req.(*QueryDeployerRevenuesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_WithdrawerRevenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWithdrawerRevenuesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WithdrawerRevenues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.revenue.v1.Query/WithdrawerRevenues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WithdrawerRevenues(ctx, req.(*QueryWithdrawerRevenuesRequest))
+// QueryEpochEarningsResponse is the response type for the Query/EpochEarnings
+// RPC method.
+type QueryEpochEarningsResponse struct {
+	// epoch_earnings is the slice of earnings per epoch for the queried contract
+	EpochEarnings []EpochEarnings `protobuf:"bytes,1,rep,name=epoch_earnings,json=epochEarnings,proto3" json:"epoch_earnings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEpochEarningsResponse) Reset()         { *m = QueryEpochEarningsResponse{} }
+func (m *QueryEpochEarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochEarningsResponse) ProtoMessage()    {}
+func (*QueryEpochEarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{15}
+}
+func (m *QueryEpochEarningsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochEarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochEarningsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEpochEarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochEarningsResponse.Merge(m, src)
+}
+func (m *QueryEpochEarningsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochEarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochEarningsResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.revenue.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Revenues",
-			Handler:    _Query_Revenues_Handler,
-		},
-		{
-			MethodName: "Revenue",
-			Handler:    _Query_Revenue_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DeployerRevenues",
-			Handler:    _Query_DeployerRevenues_Handler,
-		},
-		{
-			MethodName: "WithdrawerRevenues",
-			Handler:    _Query_WithdrawerRevenues_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/revenue/v1/query.proto",
+var xxx_messageInfo_QueryEpochEarningsResponse proto.InternalMessageInfo
+
+func (m *QueryEpochEarningsResponse) GetEpochEarnings() []EpochEarnings {
+	if m != nil {
+		return m.EpochEarnings
+	}
+	return nil
 }
 
-func (m *QueryRevenuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochEarningsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRevenuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTopEarnersRequest is the request type for the Query/TopEarners RPC
+// method.
+type QueryTopEarnersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRevenuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTopEarnersRequest) Reset()         { *m = QueryTopEarnersRequest{} }
+func (m *QueryTopEarnersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopEarnersRequest) ProtoMessage()    {}
+func (*QueryTopEarnersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{16}
+}
+func (m *QueryTopEarnersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopEarnersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopEarnersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTopEarnersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopEarnersRequest.Merge(m, src)
+}
+func (m *QueryTopEarnersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopEarnersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopEarnersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRevenuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTopEarnersRequest proto.InternalMessageInfo
+
+func (m *QueryTopEarnersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRevenuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTopEarnersResponse is the response type for the Query/TopEarners RPC
+// method.
+type QueryTopEarnersResponse struct {
+	// contract_earnings is the slice of cumulative contract earnings sorted by
+	// the amount earned in the EVM denomination, in descending order
+	ContractEarnings []ContractEarnings `protobuf:"bytes,1,rep,name=contract_earnings,json=contractEarnings,proto3" json:"contract_earnings"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Revenues) > 0 {
-		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Revenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryTopEarnersResponse) Reset()         { *m = QueryTopEarnersResponse{} }
+func (m *QueryTopEarnersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopEarnersResponse) ProtoMessage()    {}
+func (*QueryTopEarnersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{17}
+}
+func (m *QueryTopEarnersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopEarnersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopEarnersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRevenueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTopEarnersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopEarnersResponse.Merge(m, src)
 }
-
-func (m *QueryRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTopEarnersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopEarnersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopEarnersResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryTopEarnersResponse proto.InternalMessageInfo
+
+func (m *QueryTopEarnersResponse) GetContractEarnings() []ContractEarnings {
+	if m != nil {
+		return m.ContractEarnings
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryRevenueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTopEarnersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDeveloperSharesOverridesRequest is the request type for the
+// Query/DeveloperSharesOverrides RPC method.
+type QueryDeveloperSharesOverridesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Revenue.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDeveloperSharesOverridesRequest) Reset()         { *m = QueryDeveloperSharesOverridesRequest{} }
+func (m *QueryDeveloperSharesOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperSharesOverridesRequest) ProtoMessage()    {}
+func (*QueryDeveloperSharesOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{18}
+}
+func (m *QueryDeveloperSharesOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperSharesOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperSharesOverridesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDeveloperSharesOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperSharesOverridesRequest.Merge(m, src)
+}
+func (m *QueryDeveloperSharesOverridesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperSharesOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperSharesOverridesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeveloperSharesOverridesRequest proto.InternalMessageInfo
+
+func (m *QueryDeveloperSharesOverridesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDeveloperSharesOverridesResponse is the response type for the
+// Query/DeveloperSharesOverrides RPC method.
+type QueryDeveloperSharesOverridesResponse struct {
+	// overrides is the slice of all developer shares overrides
+	Overrides []DeveloperSharesOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDeveloperSharesOverridesResponse) Reset()         { *m = QueryDeveloperSharesOverridesResponse{} }
+func (m *QueryDeveloperSharesOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperSharesOverridesResponse) ProtoMessage()    {}
+func (*QueryDeveloperSharesOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{19}
+}
+func (m *QueryDeveloperSharesOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperSharesOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperSharesOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperSharesOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperSharesOverridesResponse.Merge(m, src)
+}
+func (m *QueryDeveloperSharesOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperSharesOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperSharesOverridesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeveloperSharesOverridesResponse proto.InternalMessageInfo
+
+func (m *QueryDeveloperSharesOverridesResponse) GetOverrides() []DeveloperSharesOverride {
+	if m != nil {
+		return m.Overrides
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDeveloperSharesOverridesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// QueryDeveloperSharesCategoriesRequest is the request type for the
+// Query/DeveloperSharesCategories RPC method.
+type QueryDeveloperSharesCategoriesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeveloperSharesCategoriesRequest) Reset()         { *m = QueryDeveloperSharesCategoriesRequest{} }
+func (m *QueryDeveloperSharesCategoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperSharesCategoriesRequest) ProtoMessage()    {}
+func (*QueryDeveloperSharesCategoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{20}
+}
+func (m *QueryDeveloperSharesCategoriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperSharesCategoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperSharesCategoriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDeveloperSharesCategoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperSharesCategoriesRequest.Merge(m, src)
+}
+func (m *QueryDeveloperSharesCategoriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperSharesCategoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperSharesCategoriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDeployerRevenuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDeveloperSharesCategoriesRequest proto.InternalMessageInfo
+
+func (m *QueryDeveloperSharesCategoriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDeployerRevenuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDeveloperSharesCategoriesResponse is the response type for the
+// Query/DeveloperSharesCategories RPC method.
+type QueryDeveloperSharesCategoriesResponse struct {
+	// categories is the slice of all developer shares categories
+	Categories []DeveloperSharesCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDeployerRevenuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDeveloperSharesCategoriesResponse) Reset() {
+	*m = QueryDeveloperSharesCategoriesResponse{}
+}
+func (m *QueryDeveloperSharesCategoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperSharesCategoriesResponse) ProtoMessage()    {}
+func (*QueryDeveloperSharesCategoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{21}
+}
+func (m *QueryDeveloperSharesCategoriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperSharesCategoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperSharesCategoriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.DeployerAddress) > 0 {
-		i -= len(m.DeployerAddress)
-		copy(dAtA[i:], m.DeployerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeployerAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryDeveloperSharesCategoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperSharesCategoriesResponse.Merge(m, src)
+}
+func (m *QueryDeveloperSharesCategoriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperSharesCategoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperSharesCategoriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperSharesCategoriesResponse proto.InternalMessageInfo
+
+func (m *QueryDeveloperSharesCategoriesResponse) GetCategories() []DeveloperSharesCategory {
+	if m != nil {
+		return m.Categories
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDeployerRevenuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDeveloperSharesCategoriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDeployerRevenuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractCategoriesRequest is the request type for the
+// Query/ContractCategories RPC method.
+type QueryContractCategoriesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDeployerRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractAddresses) > 0 {
-		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ContractAddresses[iNdEx])
-			copy(dAtA[i:], m.ContractAddresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryContractCategoriesRequest) Reset()         { *m = QueryContractCategoriesRequest{} }
+func (m *QueryContractCategoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractCategoriesRequest) ProtoMessage()    {}
+func (*QueryContractCategoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{22}
+}
+func (m *QueryContractCategoriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractCategoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractCategoriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryContractCategoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractCategoriesRequest.Merge(m, src)
+}
+func (m *QueryContractCategoriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractCategoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractCategoriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawerRevenuesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractCategoriesRequest proto.InternalMessageInfo
+
+func (m *QueryContractCategoriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawerRevenuesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryContractCategoriesResponse is the response type for the
+// Query/ContractCategories RPC method.
+type QueryContractCategoriesResponse struct {
+	// contract_categories is the slice of the categories assigned to contracts
+	ContractCategories []ContractCategory `protobuf:"bytes,1,rep,name=contract_categories,json=contractCategories,proto3" json:"contract_categories"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryWithdrawerRevenuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryContractCategoriesResponse) Reset()         { *m = QueryContractCategoriesResponse{} }
+func (m *QueryContractCategoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractCategoriesResponse) ProtoMessage()    {}
+func (*QueryContractCategoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{23}
+}
+func (m *QueryContractCategoriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractCategoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractCategoriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.WithdrawerAddress) > 0 {
-		i -= len(m.WithdrawerAddress)
-		copy(dAtA[i:], m.WithdrawerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawerAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryContractCategoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractCategoriesResponse.Merge(m, src)
+}
+func (m *QueryContractCategoriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractCategoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractCategoriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWithdrawerRevenuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContractCategoriesResponse proto.InternalMessageInfo
+
+func (m *QueryContractCategoriesResponse) GetContractCategories() []ContractCategory {
+	if m != nil {
+		return m.ContractCategories
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWithdrawerRevenuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryContractCategoriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryWithdrawerRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func init() {
+	proto.RegisterType((*QueryRevenuesRequest)(nil), "evmos.revenue.v1.QueryRevenuesRequest")
+	proto.RegisterType((*QueryRevenuesResponse)(nil), "evmos.revenue.v1.QueryRevenuesResponse")
+	proto.RegisterType((*QueryRevenueRequest)(nil), "evmos.revenue.v1.QueryRevenueRequest")
+	proto.RegisterType((*QueryRevenueResponse)(nil), "evmos.revenue.v1.QueryRevenueResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.revenue.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.revenue.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryDeployerRevenuesRequest)(nil), "evmos.revenue.v1.QueryDeployerRevenuesRequest")
+	proto.RegisterType((*QueryDeployerRevenuesResponse)(nil), "evmos.revenue.v1.QueryDeployerRevenuesResponse")
+	proto.RegisterType((*QueryWithdrawerRevenuesRequest)(nil), "evmos.revenue.v1.QueryWithdrawerRevenuesRequest")
+	proto.RegisterType((*QueryWithdrawerRevenuesResponse)(nil), "evmos.revenue.v1.QueryWithdrawerRevenuesResponse")
+	proto.RegisterType((*QueryContractEarningsRequest)(nil), "evmos.revenue.v1.QueryContractEarningsRequest")
+	proto.RegisterType((*QueryContractEarningsResponse)(nil), "evmos.revenue.v1.QueryContractEarningsResponse")
+	proto.RegisterType((*QueryWithdrawerEarningsRequest)(nil), "evmos.revenue.v1.QueryWithdrawerEarningsRequest")
+	proto.RegisterType((*QueryWithdrawerEarningsResponse)(nil), "evmos.revenue.v1.QueryWithdrawerEarningsResponse")
+	proto.RegisterType((*QueryEpochEarningsRequest)(nil), "evmos.revenue.v1.QueryEpochEarningsRequest")
+	proto.RegisterType((*QueryEpochEarningsResponse)(nil), "evmos.revenue.v1.QueryEpochEarningsResponse")
+	proto.RegisterType((*QueryTopEarnersRequest)(nil), "evmos.revenue.v1.QueryTopEarnersRequest")
+	proto.RegisterType((*QueryTopEarnersResponse)(nil), "evmos.revenue.v1.QueryTopEarnersResponse")
+	proto.RegisterType((*QueryDeveloperSharesOverridesRequest)(nil), "evmos.revenue.v1.QueryDeveloperSharesOverridesRequest")
+	proto.RegisterType((*QueryDeveloperSharesOverridesResponse)(nil), "evmos.revenue.v1.QueryDeveloperSharesOverridesResponse")
+	proto.RegisterType((*QueryDeveloperSharesCategoriesRequest)(nil), "evmos.revenue.v1.QueryDeveloperSharesCategoriesRequest")
+	proto.RegisterType((*QueryDeveloperSharesCategoriesResponse)(nil), "evmos.revenue.v1.QueryDeveloperSharesCategoriesResponse")
+	proto.RegisterType((*QueryContractCategoriesRequest)(nil), "evmos.revenue.v1.QueryContractCategoriesRequest")
+	proto.RegisterType((*QueryContractCategoriesResponse)(nil), "evmos.revenue.v1.QueryContractCategoriesResponse")
+}
+
+func init() { proto.RegisterFile("evmos/revenue/v1/query.proto", fileDescriptor_5e4b17cda6e8e927) }
+
+var fileDescriptor_5e4b17cda6e8e927 = []byte{
+	// 1156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x98, 0xcb, 0x6f, 0x1c, 0x45,
+	0x10, 0xc6, 0xdd, 0x09, 0x38, 0x71, 0x45, 0x21, 0x9b, 0xb2, 0x01, 0x7b, 0xe4, 0xac, 0xad, 0x91,
+	0x9f, 0x21, 0x9e, 0xc9, 0x1a, 0x88, 0x89, 0x22, 0x20, 0xc4, 0x89, 0x11, 0x12, 0x91, 0x93, 0x05,
+	0x84, 0x00, 0x09, 0x33, 0xde, 0x6d, 0xcd, 0xae, 0x64, 0x4f, 0x4f, 0x66, 0xc6, 0x6b, 0x2c, 0x64,
+	0x90, 0xb8, 0x71, 0xe1, 0x21, 0x0e, 0x11, 0x07, 0xee, 0x88, 0x63, 0xe0, 0xc2, 0x05, 0x04, 0x52,
+	0xa4, 0x1c, 0x23, 0x71, 0xe1, 0x84, 0x90, 0x0d, 0xff, 0x07, 0xda, 0x9e, 0x9a, 0x7d, 0x4c, 0x6f,
+	0xef, 0xc3, 0x0c, 0xca, 0xc5, 0x5a, 0x75, 0x77, 0x55, 0xfd, 0xea, 0xeb, 0xee, 0xed, 0x6f, 0x0d,
+	0x93, 0xbc, 0xb6, 0x2d, 0x42, 0x3b, 0xe0, 0x35, 0xee, 0xed, 0x70, 0xbb, 0x56, 0xb0, 0xef, 0xec,
+	0xf0, 0x60, 0xcf, 0xf2, 0x03, 0x11, 0x09, 0xcc, 0xc9, 0x59, 0x8b, 0x66, 0xad, 0x5a, 0xc1, 0x38,
+	0x5f, 0x12, 0x61, 0x3d, 0x60, 0xd3, 0x09, 0x79, 0xbc, 0xd4, 0xae, 0x15, 0x36, 0x79, 0xe4, 0x14,
+	0x6c, 0xdf, 0x71, 0xab, 0x9e, 0x13, 0x55, 0x85, 0x17, 0x47, 0x1b, 0x79, 0x25, 0xb7, 0xcb, 0x3d,
+	0x1e, 0x56, 0x43, 0xed, 0x7c, 0x52, 0x28, 0x9e, 0x1f, 0x73, 0x85, 0x2b, 0xe4, 0x47, 0xbb, 0xfe,
+	0x89, 0x46, 0x27, 0x5d, 0x21, 0xdc, 0x2d, 0x6e, 0x3b, 0x7e, 0xd5, 0x76, 0x3c, 0x4f, 0x44, 0xb2,
+	0x24, 0xe5, 0x34, 0xdf, 0x87, 0xb1, 0xdb, 0x75, 0xaa, 0x62, 0x9c, 0x29, 0x2c, 0xf2, 0x3b, 0x3b,
+	0x3c, 0x8c, 0x70, 0x0d, 0xa0, 0xc9, 0x37, 0xce, 0xa6, 0xd9, 0xc2, 0xa9, 0xe5, 0x39, 0x2b, 0x6e,
+	0xc6, 0xaa, 0x37, 0x63, 0xc5, 0x7d, 0x53, 0x33, 0xd6, 0x2d, 0xc7, 0xe5, 0x14, 0x5b, 0x6c, 0x89,
+	0x34, 0xbf, 0x65, 0xf0, 0x64, 0xaa, 0x40, 0xe8, 0x0b, 0x2f, 0xe4, 0x78, 0x05, 0x4e, 0x12, 0x7e,
+	0x38, 0xce, 0xa6, 0x8f, 0x2f, 0x9c, 0x5a, 0x9e, 0xb0, 0xd2, 0xf2, 0x59, 0x14, 0x75, 0xed, 0xb1,
+	0x07, 0x7f, 0x4e, 0x0d, 0x15, 0x1b, 0x01, 0xf8, 0x6a, 0x1b, 0xde, 0x31, 0x89, 0x37, 0xdf, 0x13,
+	0x2f, 0xae, 0xdc, 0xc6, 0x77, 0x15, 0x46, 0x5b, 0xf1, 0x92, 0xf6, 0x17, 0x21, 0x57, 0x12, 0x5e,
+	0x14, 0x38, 0xa5, 0x68, 0xc3, 0x29, 0x97, 0x03, 0x1e, 0x86, 0x52, 0x84, 0x91, 0xe2, 0x99, 0x64,
+	0xfc, 0x95, 0x78, 0xd8, 0xbc, 0xdd, 0xae, 0x60, 0xa3, 0xbf, 0xcb, 0x70, 0x82, 0x70, 0x49, 0xbe,
+	0x9e, 0xed, 0x25, 0xeb, 0xcd, 0x31, 0x40, 0x99, 0xf2, 0x96, 0x13, 0x38, 0xdb, 0xc9, 0x96, 0x98,
+	0x37, 0x61, 0xb4, 0x6d, 0x94, 0xea, 0x5c, 0x82, 0x61, 0x5f, 0x8e, 0x50, 0x99, 0x71, 0xb5, 0x4c,
+	0x1c, 0x41, 0x55, 0x68, 0xb5, 0xf9, 0x15, 0x83, 0x49, 0x99, 0xef, 0x3a, 0xf7, 0xb7, 0xc4, 0x1e,
+	0x0f, 0xd2, 0x47, 0x60, 0x11, 0x72, 0x65, 0x9a, 0x4a, 0x6b, 0x90, 0x8c, 0x93, 0x06, 0xb8, 0xd6,
+	0x61, 0x3b, 0x8e, 0x72, 0x5a, 0xee, 0x32, 0x38, 0xa7, 0x61, 0xa2, 0x6e, 0x97, 0x00, 0xd3, 0x1b,
+	0x43, 0xe7, 0x67, 0xa4, 0x78, 0x36, 0xb5, 0x35, 0x59, 0x9e, 0x93, 0xbb, 0x0c, 0xf2, 0x92, 0xec,
+	0xed, 0x6a, 0x54, 0x29, 0x07, 0xce, 0xae, 0xaa, 0xd7, 0x12, 0xe0, 0x6e, 0x63, 0x32, 0xa5, 0xd8,
+	0xd9, 0xe6, 0x4c, 0xd6, 0x9a, 0x7d, 0xc3, 0x60, 0x4a, 0x4b, 0xf6, 0x88, 0x55, 0x7b, 0x8d, 0x8e,
+	0xd8, 0x2a, 0x95, 0xb8, 0xe1, 0x04, 0x5e, 0xd5, 0x73, 0xc3, 0x23, 0x5c, 0xb3, 0x1a, 0x9c, 0xd3,
+	0xa4, 0xa2, 0x1e, 0xdf, 0x82, 0x46, 0x27, 0x1b, 0x9c, 0x26, 0xe9, 0x4a, 0x98, 0xea, 0x95, 0x48,
+	0xa7, 0xa1, 0xcb, 0x91, 0x2b, 0xa5, 0xc6, 0xcd, 0x75, 0x65, 0xdf, 0xd3, 0x4d, 0x0c, 0xb6, 0xef,
+	0xe6, 0xc7, 0x30, 0xa5, 0x4d, 0x48, 0xad, 0xbc, 0x07, 0xa3, 0x2d, 0x19, 0x53, 0xcd, 0xcc, 0xa8,
+	0xcd, 0xa8, 0xa9, 0xa8, 0x1d, 0xdc, 0x55, 0x66, 0xcc, 0xcf, 0x19, 0x4c, 0x48, 0x80, 0x1b, 0xbe,
+	0x28, 0x55, 0x8e, 0xbe, 0x23, 0x99, 0x1d, 0xe0, 0x1f, 0x18, 0x18, 0x9d, 0x80, 0x48, 0x8c, 0xd7,
+	0xe1, 0x09, 0x5e, 0x9f, 0x68, 0xd5, 0xa1, 0xfe, 0x5a, 0x4c, 0xa9, 0x3a, 0xb4, 0x25, 0x20, 0x09,
+	0x4e, 0xf3, 0xd6, 0xc1, 0xec, 0x8e, 0xf6, 0x07, 0xf0, 0x94, 0x84, 0x7e, 0x53, 0xf8, 0xf5, 0xe4,
+	0x3c, 0xc8, 0xfc, 0xe9, 0xfc, 0x89, 0xc1, 0xd3, 0x4a, 0x89, 0xee, 0x87, 0xfd, 0xf8, 0x7f, 0x3b,
+	0xec, 0xd9, 0xa9, 0xe3, 0xc1, 0x0c, 0x7d, 0x8f, 0xd7, 0xf8, 0x96, 0xf0, 0x79, 0xf0, 0x46, 0xc5,
+	0x09, 0x78, 0xb8, 0x5e, 0xe3, 0x41, 0x50, 0x2d, 0x67, 0x6f, 0x33, 0x7e, 0x61, 0x30, 0xdb, 0xa3,
+	0x20, 0x29, 0x77, 0x13, 0x46, 0x44, 0x32, 0x48, 0x8a, 0x2d, 0xaa, 0x8a, 0x69, 0xd2, 0x90, 0x70,
+	0xcd, 0x0c, 0xd9, 0x29, 0x26, 0x3a, 0x37, 0xb0, 0xea, 0x44, 0xdc, 0x15, 0x41, 0x35, 0x7b, 0xc9,
+	0x7e, 0x63, 0x30, 0xd7, 0xab, 0x22, 0x69, 0xb6, 0x0e, 0x50, 0x6a, 0x8c, 0xf6, 0x2d, 0x1a, 0x25,
+	0xda, 0x23, 0xd1, 0x5a, 0x52, 0x64, 0xa7, 0x5a, 0x85, 0xbe, 0x9d, 0x93, 0x13, 0xfe, 0xff, 0xc9,
+	0x75, 0x3f, 0x79, 0x66, 0x3b, 0x95, 0x22, 0x9d, 0xde, 0x81, 0xd1, 0xc6, 0xad, 0x54, 0x04, 0xeb,
+	0x72, 0x2f, 0x53, 0x4a, 0x61, 0xa9, 0x7d, 0x3c, 0x4b, 0xc5, 0x96, 0xff, 0x39, 0x03, 0x8f, 0xcb,
+	0x3e, 0xf0, 0x13, 0x38, 0x99, 0x18, 0x05, 0x9c, 0x53, 0xe1, 0x3a, 0xfd, 0x2c, 0x30, 0xe6, 0x7b,
+	0xae, 0x8b, 0x4b, 0x9a, 0xe6, 0xa7, 0xbf, 0xff, 0xfd, 0xf5, 0xb1, 0x49, 0x34, 0x6c, 0xdd, 0x8f,
+	0x96, 0x10, 0xbf, 0x60, 0x70, 0x82, 0x02, 0x71, 0xb6, 0x7b, 0xe2, 0xa4, 0xfe, 0x5c, 0xaf, 0x65,
+	0x54, 0xfe, 0x79, 0x59, 0xde, 0xc6, 0x25, 0x7d, 0x79, 0xfb, 0xa3, 0xf4, 0x43, 0xb7, 0x8f, 0xbb,
+	0x30, 0x1c, 0x7b, 0x65, 0x9c, 0xd1, 0x14, 0x6a, 0xb3, 0xe4, 0xc6, 0x6c, 0x8f, 0x55, 0x44, 0x33,
+	0x2d, 0x69, 0x0c, 0x1c, 0x57, 0x69, 0x62, 0x33, 0x8e, 0xdf, 0x33, 0xc8, 0xa5, 0x3d, 0x2f, 0x5a,
+	0x9a, 0xec, 0x1a, 0xc3, 0x6e, 0xd8, 0x7d, 0xaf, 0x1f, 0x44, 0xa5, 0xf4, 0x6f, 0x80, 0x7d, 0xbc,
+	0xc7, 0x00, 0x55, 0xb3, 0x89, 0x17, 0x35, 0xe5, 0xb5, 0x8e, 0xd9, 0x28, 0x0c, 0x10, 0x41, 0xc8,
+	0x2b, 0x12, 0xb9, 0x80, 0x76, 0x37, 0x64, 0xd5, 0x8e, 0xed, 0xe3, 0x8f, 0x0c, 0x72, 0xe9, 0x77,
+	0x50, 0xab, 0xb0, 0xc6, 0xaf, 0x1a, 0x76, 0xdf, 0xeb, 0x09, 0xf7, 0x65, 0x89, 0x7b, 0x19, 0x57,
+	0x54, 0xdc, 0xe4, 0xd9, 0xb6, 0x93, 0x63, 0xd8, 0xf1, 0x44, 0xfe, 0xdc, 0xa6, 0x75, 0x03, 0xbc,
+	0xb7, 0xd6, 0x69, 0xf4, 0xc2, 0x00, 0x11, 0x04, 0xbf, 0x2a, 0xe1, 0x5f, 0xc4, 0x2b, 0x5d, 0xe0,
+	0x9b, 0x52, 0x6b, 0x74, 0xbf, 0xc7, 0xe0, 0x74, 0x9b, 0x2f, 0xc3, 0x67, 0x34, 0x24, 0x9d, 0xfc,
+	0xa8, 0x71, 0xa1, 0xbf, 0xc5, 0x44, 0xbc, 0x26, 0x89, 0xaf, 0xe2, 0x4b, 0x47, 0x94, 0xdb, 0x96,
+	0x66, 0x31, 0xc4, 0xcf, 0x18, 0x40, 0xd3, 0x75, 0xe1, 0x82, 0x06, 0x42, 0xf1, 0x7e, 0xc6, 0x62,
+	0x1f, 0x2b, 0x89, 0x75, 0x4e, 0xb2, 0x4e, 0x63, 0xbe, 0x0b, 0x6b, 0x24, 0x7c, 0xfc, 0x95, 0xc1,
+	0xb8, 0xce, 0xd5, 0xe0, 0x25, 0xed, 0x95, 0xef, 0xea, 0xbb, 0x8c, 0x95, 0x81, 0xe3, 0x88, 0xfa,
+	0x39, 0x49, 0x6d, 0xe1, 0x05, 0x95, 0xba, 0x9c, 0xc4, 0x6e, 0x84, 0x32, 0x78, 0xa3, 0xe9, 0x92,
+	0xee, 0x33, 0x98, 0xd0, 0xda, 0x0c, 0xec, 0x13, 0x46, 0x79, 0xdb, 0x8d, 0x17, 0x06, 0x0f, 0xec,
+	0xfd, 0xcd, 0xa7, 0xb4, 0xd1, 0xe2, 0x5b, 0xbe, 0x63, 0x80, 0xea, 0xfb, 0xaf, 0xbd, 0x8d, 0x5a,
+	0x57, 0x62, 0x14, 0x06, 0x88, 0x20, 0xe4, 0x25, 0x89, 0x3c, 0x8f, 0xb3, 0x2a, 0x72, 0x07, 0xd3,
+	0x71, 0xed, 0xfa, 0x83, 0x83, 0x3c, 0x7b, 0x78, 0x90, 0x67, 0x7f, 0x1d, 0xe4, 0xd9, 0x97, 0x87,
+	0xf9, 0xa1, 0x87, 0x87, 0xf9, 0xa1, 0x3f, 0x0e, 0xf3, 0x43, 0xef, 0x9e, 0x77, 0xab, 0x51, 0x65,
+	0x67, 0xd3, 0x2a, 0x89, 0x6d, 0x4a, 0x15, 0xff, 0xad, 0x15, 0x2e, 0xda, 0x1f, 0x36, 0xd2, 0x46,
+	0x7b, 0x3e, 0x0f, 0x37, 0x87, 0xe5, 0x7f, 0x09, 0x9f, 0xfd, 0x77, 0x00, 0xc3, 0x62, 0x97, 0xc0,
+	0xf7, 0x14, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Revenues retrieves all registered revenues
+	Revenues(ctx context.Context, in *QueryRevenuesRequest, opts ...grpc.CallOption) (*QueryRevenuesResponse, error)
+	// Revenue retrieves a registered revenue for a given contract address
+	Revenue(ctx context.Context, in *QueryRevenueRequest, opts ...grpc.CallOption) (*QueryRevenueResponse, error)
+	// Params retrieves the revenue module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DeployerRevenues retrieves all revenues that a given deployer has
+	// registered
+	DeployerRevenues(ctx context.Context, in *QueryDeployerRevenuesRequest, opts ...grpc.CallOption) (*QueryDeployerRevenuesResponse, error)
+	// WithdrawerRevenues retrieves all revenues with a given withdrawer
+	// address
+	WithdrawerRevenues(ctx context.Context, in *QueryWithdrawerRevenuesRequest, opts ...grpc.CallOption) (*QueryWithdrawerRevenuesResponse, error)
+	// ContractEarnings retrieves the cumulative earnings of a registered
+	// contract
+	ContractEarnings(ctx context.Context, in *QueryContractEarningsRequest, opts ...grpc.CallOption) (*QueryContractEarningsResponse, error)
+	// WithdrawerEarnings retrieves the cumulative earnings of a withdrawer
+	// address
+	WithdrawerEarnings(ctx context.Context, in *QueryWithdrawerEarningsRequest, opts ...grpc.CallOption) (*QueryWithdrawerEarningsResponse, error)
+	// EpochEarnings retrieves the per-epoch earnings of a registered contract
+	EpochEarnings(ctx context.Context, in *QueryEpochEarningsRequest, opts ...grpc.CallOption) (*QueryEpochEarningsResponse, error)
+	// TopEarners retrieves the cumulative earnings of all contracts sorted in
+	// descending order
+	TopEarners(ctx context.Context, in *QueryTopEarnersRequest, opts ...grpc.CallOption) (*QueryTopEarnersResponse, error)
+	// DeveloperSharesOverrides retrieves all governance-set developer shares
+	// overrides
+	DeveloperSharesOverrides(ctx context.Context, in *QueryDeveloperSharesOverridesRequest, opts ...grpc.CallOption) (*QueryDeveloperSharesOverridesResponse, error)
+	// DeveloperSharesCategories retrieves all governance-set developer shares
+	// categories
+	DeveloperSharesCategories(ctx context.Context, in *QueryDeveloperSharesCategoriesRequest, opts ...grpc.CallOption) (*QueryDeveloperSharesCategoriesResponse, error)
+	// ContractCategories retrieves the developer shares categories assigned to
+	// contracts
+	ContractCategories(ctx context.Context, in *QueryContractCategoriesRequest, opts ...grpc.CallOption) (*QueryContractCategoriesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Revenues(ctx context.Context, in *QueryRevenuesRequest, opts ...grpc.CallOption) (*QueryRevenuesResponse, error) {
+	out := new(QueryRevenuesResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/Revenues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ContractAddresses) > 0 {
-		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ContractAddresses[iNdEx])
-			copy(dAtA[i:], m.ContractAddresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) Revenue(ctx context.Context, in *QueryRevenueRequest, opts ...grpc.CallOption) (*QueryRevenueResponse, error) {
+	out := new(QueryRevenueResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/Revenue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryRevenuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) DeployerRevenues(ctx context.Context, in *QueryDeployerRevenuesRequest, opts ...grpc.CallOption) (*QueryDeployerRevenuesResponse, error) {
+	out := new(QueryDeployerRevenuesResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/DeployerRevenues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) WithdrawerRevenues(ctx context.Context, in *QueryWithdrawerRevenuesRequest, opts ...grpc.CallOption) (*QueryWithdrawerRevenuesResponse, error) {
+	out := new(QueryWithdrawerRevenuesResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/WithdrawerRevenues", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRevenuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ContractEarnings(ctx context.Context, in *QueryContractEarningsRequest, opts ...grpc.CallOption) (*QueryContractEarningsResponse, error) {
+	out := new(QueryContractEarningsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/ContractEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Revenues) > 0 {
-		for _, e := range m.Revenues {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawerEarnings(ctx context.Context, in *QueryWithdrawerEarningsRequest, opts ...grpc.CallOption) (*QueryWithdrawerEarningsResponse, error) {
+	out := new(QueryWithdrawerEarningsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/WithdrawerEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) EpochEarnings(ctx context.Context, in *QueryEpochEarningsRequest, opts ...grpc.CallOption) (*QueryEpochEarningsResponse, error) {
+	out := new(QueryEpochEarningsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/EpochEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRevenueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) TopEarners(ctx context.Context, in *QueryTopEarnersRequest, opts ...grpc.CallOption) (*QueryTopEarnersResponse, error) {
+	out := new(QueryTopEarnersResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/TopEarners", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) DeveloperSharesOverrides(ctx context.Context, in *QueryDeveloperSharesOverridesRequest, opts ...grpc.CallOption) (*QueryDeveloperSharesOverridesResponse, error) {
+	out := new(QueryDeveloperSharesOverridesResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/DeveloperSharesOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRevenueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DeveloperSharesCategories(ctx context.Context, in *QueryDeveloperSharesCategoriesRequest, opts ...grpc.CallOption) (*QueryDeveloperSharesCategoriesResponse, error) {
+	out := new(QueryDeveloperSharesCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/DeveloperSharesCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Revenue.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ContractCategories(ctx context.Context, in *QueryContractCategoriesRequest, opts ...grpc.CallOption) (*QueryContractCategoriesResponse, error) {
+	out := new(QueryContractCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/ContractCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Revenues retrieves all registered revenues
+	Revenues(context.Context, *QueryRevenuesRequest) (*QueryRevenuesResponse, error)
+	// Revenue retrieves a registered revenue for a given contract address
+	Revenue(context.Context, *QueryRevenueRequest) (*QueryRevenueResponse, error)
+	// Params retrieves the revenue module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DeployerRevenues retrieves all revenues that a given deployer has
+	// registered
+	DeployerRevenues(context.Context, *QueryDeployerRevenuesRequest) (*QueryDeployerRevenuesResponse, error)
+	// WithdrawerRevenues retrieves all revenues with a given withdrawer
+	// address
+	WithdrawerRevenues(context.Context, *QueryWithdrawerRevenuesRequest) (*QueryWithdrawerRevenuesResponse, error)
+	// ContractEarnings retrieves the cumulative earnings of a registered
+	// contract
+	ContractEarnings(context.Context, *QueryContractEarningsRequest) (*QueryContractEarningsResponse, error)
+	// WithdrawerEarnings retrieves the cumulative earnings of a withdrawer
+	// address
+	WithdrawerEarnings(context.Context, *QueryWithdrawerEarningsRequest) (*QueryWithdrawerEarningsResponse, error)
+	// EpochEarnings retrieves the per-epoch earnings of a registered contract
+	EpochEarnings(context.Context, *QueryEpochEarningsRequest) (*QueryEpochEarningsResponse, error)
+	// TopEarners retrieves the cumulative earnings of all contracts sorted in
+	// descending order
+	TopEarners(context.Context, *QueryTopEarnersRequest) (*QueryTopEarnersResponse, error)
+	// DeveloperSharesOverrides retrieves all governance-set developer shares
+	// overrides
+	DeveloperSharesOverrides(context.Context, *QueryDeveloperSharesOverridesRequest) (*QueryDeveloperSharesOverridesResponse, error)
+	// DeveloperSharesCategories retrieves all governance-set developer shares
+	// categories
+	DeveloperSharesCategories(context.Context, *QueryDeveloperSharesCategoriesRequest) (*QueryDeveloperSharesCategoriesResponse, error)
+	// ContractCategories retrieves the developer shares categories assigned to
+	// contracts
+	ContractCategories(context.Context, *QueryContractCategoriesRequest) (*QueryContractCategoriesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Revenues(ctx context.Context, req *QueryRevenuesRequest) (*QueryRevenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revenues not implemented")
+}
+func (*UnimplementedQueryServer) Revenue(ctx context.Context, req *QueryRevenueRequest) (*QueryRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revenue not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DeployerRevenues(ctx context.Context, req *QueryDeployerRevenuesRequest) (*QueryDeployerRevenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployerRevenues not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawerRevenues(ctx context.Context, req *QueryWithdrawerRevenuesRequest) (*QueryWithdrawerRevenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawerRevenues not implemented")
+}
+func (*UnimplementedQueryServer) ContractEarnings(ctx context.Context, req *QueryContractEarningsRequest) (*QueryContractEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractEarnings not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawerEarnings(ctx context.Context, req *QueryWithdrawerEarningsRequest) (*QueryWithdrawerEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawerEarnings not implemented")
+}
+func (*UnimplementedQueryServer) EpochEarnings(ctx context.Context, req *QueryEpochEarningsRequest) (*QueryEpochEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochEarnings not implemented")
+}
+func (*UnimplementedQueryServer) TopEarners(ctx context.Context, req *QueryTopEarnersRequest) (*QueryTopEarnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopEarners not implemented")
+}
+func (*UnimplementedQueryServer) DeveloperSharesOverrides(ctx context.Context, req *QueryDeveloperSharesOverridesRequest) (*QueryDeveloperSharesOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperSharesOverrides not implemented")
+}
+func (*UnimplementedQueryServer) DeveloperSharesCategories(ctx context.Context, req *QueryDeveloperSharesCategoriesRequest) (*QueryDeveloperSharesCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperSharesCategories not implemented")
+}
+func (*UnimplementedQueryServer) ContractCategories(ctx context.Context, req *QueryContractCategoriesRequest) (*QueryContractCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCategories not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Revenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Revenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/Revenues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Revenues(ctx, req.(*QueryRevenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Revenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Revenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/Revenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Revenue(ctx, req.(*QueryRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeployerRevenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeployerRevenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeployerRevenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/DeployerRevenues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeployerRevenues(ctx, req.(*QueryDeployerRevenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawerRevenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawerRevenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawerRevenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/WithdrawerRevenues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawerRevenues(ctx, req.(*QueryWithdrawerRevenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/ContractEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractEarnings(ctx, req.(*QueryContractEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawerEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawerEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawerEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/WithdrawerEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawerEarnings(ctx, req.(*QueryWithdrawerEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochEarningsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/EpochEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochEarnings(ctx, req.(*QueryEpochEarningsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopEarners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopEarnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopEarners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/TopEarners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopEarners(ctx, req.(*QueryTopEarnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeveloperSharesOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperSharesOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperSharesOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/DeveloperSharesOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperSharesOverrides(ctx, req.(*QueryDeveloperSharesOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeveloperSharesCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperSharesCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperSharesCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/DeveloperSharesCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperSharesCategories(ctx, req.(*QueryDeveloperSharesCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/ContractCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractCategories(ctx, req.(*QueryContractCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.revenue.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Revenues",
+			Handler:    _Query_Revenues_Handler,
+		},
+		{
+			MethodName: "Revenue",
+			Handler:    _Query_Revenue_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DeployerRevenues",
+			Handler:    _Query_DeployerRevenues_Handler,
+		},
+		{
+			MethodName: "WithdrawerRevenues",
+			Handler:    _Query_WithdrawerRevenues_Handler,
+		},
+		{
+			MethodName: "ContractEarnings",
+			Handler:    _Query_ContractEarnings_Handler,
+		},
+		{
+			MethodName: "WithdrawerEarnings",
+			Handler:    _Query_WithdrawerEarnings_Handler,
+		},
+		{
+			MethodName: "EpochEarnings",
+			Handler:    _Query_EpochEarnings_Handler,
+		},
+		{
+			MethodName: "TopEarners",
+			Handler:    _Query_TopEarners_Handler,
+		},
+		{
+			MethodName: "DeveloperSharesOverrides",
+			Handler:    _Query_DeveloperSharesOverrides_Handler,
+		},
+		{
+			MethodName: "DeveloperSharesCategories",
+			Handler:    _Query_DeveloperSharesCategories_Handler,
+		},
+		{
+			MethodName: "ContractCategories",
+			Handler:    _Query_ContractCategories_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/revenue/v1/query.proto",
+}
+
+func (m *QueryRevenuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevenuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevenuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevenuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevenuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Revenues) > 0 {
+		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Revenue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeployerRevenuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeployerRevenuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeployerRevenuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeployerAddress) > 0 {
+		i -= len(m.DeployerAddress)
+		copy(dAtA[i:], m.DeployerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeployerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeployerRevenuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeployerRevenuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeployerRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddresses) > 0 {
+		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractAddresses[iNdEx])
+			copy(dAtA[i:], m.ContractAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawerRevenuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawerRevenuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawerRevenuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawerRevenuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawerRevenuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawerRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddresses) > 0 {
+		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractAddresses[iNdEx])
+			copy(dAtA[i:], m.ContractAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractEarningsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractEarningsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractEarningsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractEarningsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractEarningsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractEarningsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ContractEarnings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawerEarningsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawerEarningsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawerEarningsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawerEarningsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawerEarningsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawerEarningsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.WithdrawerEarnings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochEarningsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochEarningsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochEarningsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochEarningsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochEarningsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochEarningsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EpochEarnings) > 0 {
+		for iNdEx := len(m.EpochEarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochEarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopEarnersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopEarnersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopEarnersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopEarnersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopEarnersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopEarnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractEarnings) > 0 {
+		for iNdEx := len(m.ContractEarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractEarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperSharesOverridesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperSharesOverridesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperSharesOverridesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperSharesOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperSharesOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperSharesOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperSharesCategoriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperSharesCategoriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperSharesCategoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperSharesCategoriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperSharesCategoriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperSharesCategoriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Categories) > 0 {
+		for iNdEx := len(m.Categories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Categories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractCategoriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractCategoriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractCategoriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractCategoriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractCategoriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractCategoriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractCategories) > 0 {
+		for iNdEx := len(m.ContractCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRevenuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevenuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revenues) > 0 {
+		for _, e := range m.Revenues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Revenue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeployerRevenuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeployerRevenuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawerRevenuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawerRevenuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractEarningsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractEarningsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContractEarnings.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryWithdrawerEarningsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawerEarningsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WithdrawerEarnings.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochEarningsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochEarningsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochEarnings) > 0 {
+		for _, e := range m.EpochEarnings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopEarnersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopEarnersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractEarnings) > 0 {
+		for _, e := range m.ContractEarnings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeveloperSharesOverridesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeveloperSharesOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeveloperSharesCategoriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeveloperSharesCategoriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Categories) > 0 {
+		for _, e := range m.Categories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractCategoriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractCategoriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractCategories) > 0 {
+		for _, e := range m.ContractCategories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRevenuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevenuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevenuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenues = append(m.Revenues, Revenue{})
+			if err := m.Revenues[len(m.Revenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Revenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployerRevenuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployerRevenuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployerRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployerRevenuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployerRevenuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployerRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawerRevenuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawerRevenuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawerRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWithdrawerRevenuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawerRevenuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawerRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractEarningsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractEarningsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractEarningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractEarningsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractEarningsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractEarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractEarnings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryDeployerRevenuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DeployerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryDeployerRevenuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ContractAddresses) > 0 {
-		for _, s := range m.ContractAddresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryWithdrawerEarningsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawerEarningsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawerEarningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryWithdrawerRevenuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.WithdrawerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryWithdrawerRevenuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ContractAddresses) > 0 {
-		for _, s := range m.ContractAddresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryWithdrawerEarningsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWithdrawerEarningsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWithdrawerEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerEarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawerEarnings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryRevenuesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochEarningsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1392,13 +4501,45 @@ func (m *QueryRevenuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevenuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochEarningsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochEarningsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1455,7 +4596,7 @@ func (m *QueryRevenuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochEarningsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1478,15 +4619,15 @@ func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevenuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochEarningsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revenues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEarnings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1513,8 +4654,8 @@ func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revenues = append(m.Revenues, Revenue{})
-			if err := m.Revenues[len(m.Revenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EpochEarnings = append(m.EpochEarnings, EpochEarnings{})
+			if err := m.EpochEarnings[len(m.EpochEarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1575,7 +4716,7 @@ func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevenueRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTopEarnersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1598,17 +4739,17 @@ func (m *QueryRevenueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevenueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopEarnersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopEarnersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1618,23 +4759,27 @@ func (m *QueryRevenueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1657,7 +4802,7 @@ func (m *QueryRevenueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevenueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTopEarnersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1680,15 +4825,15 @@ func (m *QueryRevenueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevenueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTopEarnersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTopEarnersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractEarnings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1715,7 +4860,44 @@ func (m *QueryRevenueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Revenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractEarnings = append(m.ContractEarnings, ContractEarnings{})
+			if err := m.ContractEarnings[len(m.ContractEarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1740,7 +4922,7 @@ func (m *QueryRevenueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeveloperSharesOverridesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1763,12 +4945,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeveloperSharesOverridesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeveloperSharesOverridesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1790,7 +5008,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeveloperSharesOverridesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1813,15 +5031,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeveloperSharesOverridesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeveloperSharesOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1848,7 +5066,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Overrides = append(m.Overrides, DeveloperSharesOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1873,7 +5128,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeployerRevenuesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeveloperSharesCategoriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1896,45 +5151,13 @@ func (m *QueryDeployerRevenuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeployerRevenuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeveloperSharesCategoriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeployerRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeveloperSharesCategoriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1991,7 +5214,7 @@ func (m *QueryDeployerRevenuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeployerRevenuesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDeveloperSharesCategoriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2014,17 +5237,17 @@ func (m *QueryDeployerRevenuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeployerRevenuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeveloperSharesCategoriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeployerRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeveloperSharesCategoriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2034,23 +5257,25 @@ func (m *QueryDeployerRevenuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Categories = append(m.Categories, DeveloperSharesCategory{})
+			if err := m.Categories[len(m.Categories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2109,7 +5334,7 @@ func (m *QueryDeployerRevenuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawerRevenuesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContractCategoriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2132,45 +5357,13 @@ func (m *QueryWithdrawerRevenuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawerRevenuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractCategoriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawerRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractCategoriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2227,7 +5420,7 @@ func (m *QueryWithdrawerRevenuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawerRevenuesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryContractCategoriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2250,17 +5443,17 @@ func (m *QueryWithdrawerRevenuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawerRevenuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContractCategoriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawerRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContractCategoriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCategories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2270,23 +5463,25 @@ func (m *QueryWithdrawerRevenuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			m.ContractCategories = append(m.ContractCategories, ContractCategory{})
+			if err := m.ContractCategories[len(m.ContractCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -23,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetScheduleCmd(),
+		GetParamsCmd(),
 	)
 	return cmd
 }
@@ -59,3 +61,66 @@ func GetBalancesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetScheduleCmd queries the lockup and vesting schedules of a given vesting
+// account
+func GetScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule ADDRESS",
+		Short: "Gets the lockup and vesting schedules of a vesting account",
+		Long:  "Gets the lockup and vesting periods of a vesting account with their absolute times and status, and the next vesting and unlock events",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryScheduleRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.Schedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd queries the module parameters
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets vesting params",
+		Long:  "Gets vesting params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryParamsRequest{}
+
+			res, err := queryClient.Params(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -24,6 +25,11 @@ const (
 	FlagVesting  = "vesting"
 	FlagClawback = "clawback"
 	FlagFunder   = "funder"
+
+	FlagAmount        = "amount"
+	FlagCutoffPeriod  = "cutoff-period"
+	FlagEffectiveTime = "effective-time"
+	FlagRevoke        = "revoke"
 )
 
 // NewTxCmd returns a root CLI command handler for certain modules/vesting
@@ -41,6 +47,8 @@ func NewTxCmd() *cobra.Command {
 		NewMsgCreateClawbackVestingAccountCmd(),
 		NewMsgClawbackCmd(),
 		NewMsgUpdateVestingFunderCmd(),
+		NewMsgCancelClawbackCmd(),
+		NewMsgApproveFunderCmd(),
 	)
 
 	return txCmd
@@ -138,6 +146,10 @@ func NewMsgClawbackCmd() *cobra.Command {
 		Short: "Transfer unvested amount out of a ClawbackVestingAccount.",
 		Long: `Must be requested by the original funder address (--from).
 		May provide a destination address (--dest), otherwise the coins return to the funder.
+		May limit the clawback to an amount of unvested coins (--amount), removed from the last vesting periods first,
+		or to the unvested coins of the vesting periods starting with a cutoff period index (--cutoff-period).
+		May schedule the clawback at a future unix time (--effective-time), when it is executed at the end of the first block
+		at or after that time.
 		Delegated or undelegating staking tokens will be transferred in the delegated (undelegating) state.
 		The recipient is vulnerable to slashing, and must act to unbond the tokens if desired.`,
 		Args: cobra.ExactArgs(1),
@@ -162,6 +174,23 @@ func NewMsgClawbackCmd() *cobra.Command {
 			}
 
 			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+
+			amountString, _ := cmd.Flags().GetString(FlagAmount)
+			if amountString != "" {
+				msg.Amount, err = sdk.ParseCoinsNormalized(amountString)
+				if err != nil {
+					return fmt.Errorf("bad amount: %w", err)
+				}
+			}
+
+			msg.CutoffPeriod, _ = cmd.Flags().GetUint64(FlagCutoffPeriod)
+
+			effectiveTime, _ := cmd.Flags().GetInt64(FlagEffectiveTime)
+			if effectiveTime > 0 {
+				t := time.Unix(effectiveTime, 0).UTC()
+				msg.EffectiveTime = &t
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -171,6 +200,9 @@ func NewMsgClawbackCmd() *cobra.Command {
 	}
 
 	cmd.Flags().String(FlagDest, "", "address of destination (defaults to funder)")
+	cmd.Flags().String(FlagAmount, "", "amount of unvested coins to claw back (defaults to all unvested coins)")
+	cmd.Flags().Uint64(FlagCutoffPeriod, 0, "index of the first vesting period to claw back")
+	cmd.Flags().Int64(FlagEffectiveTime, 0, "unix time at which the clawback is executed (defaults to immediately)")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -211,3 +243,76 @@ func NewMsgUpdateVestingFunderCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewMsgCancelClawbackCmd returns a CLI command handler for cancelling a
+// pending clawback.
+func NewMsgCancelClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-clawback ADDRESS EFFECTIVE_TIME",
+		Short: "Cancel a pending clawback from a ClawbackVestingAccount.",
+		Long: `Must be requested by the funder address (--from) that scheduled the clawback.
+		Need to provide the ADDRESS of the ClawbackVestingAccount and the EFFECTIVE_TIME of the clawback as unix time.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			effectiveTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("bad effective time: %w", err)
+			}
+
+			msg := types.NewMsgCancelClawback(clientCtx.GetFromAddress(), addr, time.Unix(effectiveTime, 0).UTC())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgApproveFunderCmd returns a CLI command handler for approving a new
+// funder of a ClawbackVestingAccount.
+func NewMsgApproveFunderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-funder FUNDER_ADDRESS",
+		Short: "Approve a new funder to add a grant to a ClawbackVestingAccount.",
+		Long: `Must be signed by the ClawbackVestingAccount (--from).
+		The approval is consumed by the first grant of the funder. It can be revoked with --revoke.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			funder, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			revoke, _ := cmd.Flags().GetBool(FlagRevoke)
+
+			msg := types.NewMsgApproveFunder(clientCtx.GetFromAddress(), funder, revoke)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagRevoke, false, "revoke the approval of the funder")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
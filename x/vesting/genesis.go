@@ -0,0 +1,38 @@
+package vesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/x/vesting/keeper"
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+// InitGenesis import module genesis and installs the vesting system contract
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	data types.GenesisState,
+) {
+	k.SetParams(ctx, data.Params)
+
+	for _, pending := range data.PendingClawbacks {
+		k.SetPendingClawback(ctx, pending)
+	}
+
+	for _, approval := range data.FunderApprovals {
+		k.SetFunderApproval(ctx, approval)
+	}
+
+	if err := k.InstallSystemContract(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		PendingClawbacks: k.GetAllPendingClawbacks(ctx),
+		Params:           k.GetParams(ctx),
+		FunderApprovals:  k.GetAllFunderApprovals(ctx),
+	}
+}
@@ -23,6 +23,12 @@ func NewHandler(server types.MsgServer) sdk.Handler {
 		case *types.MsgUpdateVestingFunder:
 			res, err := server.UpdateVestingFunder(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelClawback:
+			res, err := server.CancelClawback(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgApproveFunder:
+			res, err := server.ApproveFunder(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+// EndBlocker executes the pending clawbacks whose effective time has been
+// reached. A failed clawback is removed without affecting the other ones.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	logger := k.Logger(ctx)
+
+	for _, pending := range k.GetDuePendingClawbacks(ctx, ctx.BlockTime()) {
+		k.DeletePendingClawback(ctx, pending)
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.executePendingClawback(cacheCtx, pending); err != nil {
+			logger.Error(
+				"failed to execute pending clawback",
+				"account", pending.AccountAddress,
+				"funder", pending.FunderAddress,
+				"error", err.Error(),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypePendingClawbackFailed,
+					sdk.NewAttribute(types.AttributeKeyFunder, pending.FunderAddress),
+					sdk.NewAttribute(types.AttributeKeyAccount, pending.AccountAddress),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		writeCache()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClawback,
+				sdk.NewAttribute(types.AttributeKeyFunder, pending.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, pending.AccountAddress),
+				sdk.NewAttribute(types.AttributeKeyDestination, pending.DestAddress),
+			),
+		)
+	}
+}
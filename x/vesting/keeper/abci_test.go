@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+func (suite *KeeperTestSuite) TestEndBlockerPendingClawbacks() {
+	testCases := []struct {
+		name          string
+		malleate      func()
+		elapsed       time.Duration
+		expExecuted   bool
+		expClawedBack int64
+	}{
+		{
+			"before the effective time",
+			func() {},
+			4099 * time.Second,
+			false,
+			0,
+		},
+		{
+			"at the effective time",
+			func() {},
+			4100 * time.Second,
+			true,
+			500,
+		},
+		{
+			"after the effective time",
+			func() {},
+			6100 * time.Second,
+			true,
+			250,
+		},
+		{
+			"funder updated before the effective time",
+			func() {
+				msg := types.NewMsgUpdateVestingFunder(addr, addr4, addr2)
+				_, err := suite.app.VestingKeeper.UpdateVestingFunder(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			4100 * time.Second,
+			true,
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, balances)
+
+			// Create Clawback Vesting Account
+			createMsg := types.NewMsgCreateClawbackVestingAccount(addr, addr2, suite.ctx.BlockTime(), lockupPeriods, vestingPeriods, false)
+			_, err := suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), createMsg)
+			suite.Require().NoError(err)
+
+			// Schedule a clawback of the last two periods
+			msg := types.NewMsgClawback(addr, addr2, addr3)
+			msg.CutoffPeriod = 2
+			effectiveTime := suite.ctx.BlockTime().Add(4100 * time.Second)
+			msg.EffectiveTime = &effectiveTime
+			_, err = suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(tc.elapsed))
+			suite.app.VestingKeeper.EndBlocker(suite.ctx)
+
+			_, found := suite.app.VestingKeeper.GetPendingClawback(suite.ctx, effectiveTime, addr2, addr)
+			suite.Require().Equal(!tc.expExecuted, found)
+
+			balanceDest := suite.app.BankKeeper.GetBalance(suite.ctx, addr3, "test")
+			suite.Require().Equal(sdk.NewInt64Coin("test", tc.expClawedBack), balanceDest)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetAllPendingClawbacks() {
+	suite.SetupTest() // reset
+
+	blockTime := suite.ctx.BlockTime()
+	later := types.PendingClawback{
+		FunderAddress:  addr.String(),
+		AccountAddress: addr2.String(),
+		DestAddress:    addr.String(),
+		EffectiveTime:  time.Unix(blockTime.Unix()+2000, 0).UTC(),
+	}
+	earlier := types.PendingClawback{
+		FunderAddress:  addr.String(),
+		AccountAddress: addr3.String(),
+		DestAddress:    addr.String(),
+		EffectiveTime:  time.Unix(blockTime.Unix()+1000, 0).UTC(),
+	}
+
+	suite.Require().Empty(suite.app.VestingKeeper.GetAllPendingClawbacks(suite.ctx))
+
+	suite.app.VestingKeeper.SetPendingClawback(suite.ctx, later)
+	suite.app.VestingKeeper.SetPendingClawback(suite.ctx, earlier)
+
+	// pending clawbacks are ordered by effective time
+	pendings := suite.app.VestingKeeper.GetAllPendingClawbacks(suite.ctx)
+	suite.Require().Equal([]types.PendingClawback{earlier, later}, pendings)
+}
@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	evmostypes "github.com/evmos/evmos/v10/types"
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for vesting keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
+// the module keeper
+func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return h.k.PostTxProcessing(ctx, msg, receipt)
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. The EVM hook allows
+// EOAs and contracts to create, claw back and update the funder of clawback
+// vesting accounts, to cancel pending clawbacks and to approve new funders by
+// calling the vesting system contract. Each call to the system contract emits
+// a log with the caller and the calldata, which is executed as the
+// corresponding vesting msg with the caller as signer. Returning an error
+// reverts the EVM transaction.
+func (k Keeper) PostTxProcessing(
+	ctx sdk.Context,
+	_ core.Message,
+	receipt *ethtypes.Receipt,
+) error {
+	for _, log := range receipt.Logs {
+		if log.Address != types.SystemContractAddress ||
+			len(log.Topics) != 2 ||
+			log.Topics[0] != evmostypes.SystemCallEventID {
+			continue
+		}
+
+		caller := common.BytesToAddress(log.Topics[1].Bytes())
+		if err := k.executeSystemCall(ctx, caller, log.Data); err != nil {
+			return errorsmod.Wrap(err, "failed to execute vesting system contract call")
+		}
+	}
+
+	return nil
+}
+
+// executeSystemCall decodes the calldata of a vesting system contract call and
+// executes the corresponding msg with the caller as signer, i.e. the funder or,
+// for approveFunder, the clawback vesting account
+func (k Keeper) executeSystemCall(ctx sdk.Context, caller common.Address, calldata []byte) error {
+	if len(calldata) < 4 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "calldata too short")
+	}
+
+	method, err := types.SystemContractABI.MethodById(calldata[:4])
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	values, err := method.Inputs.Unpack(calldata[4:])
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	signer := sdk.AccAddress(caller.Bytes()).String()
+
+	var msg sdk.Msg
+	switch method.Name {
+	case types.SystemContractMethodCreateClawbackVestingAccount:
+		var args types.CreateClawbackVestingAccountArgs
+		if err := method.Inputs.Copy(&args, values); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+
+		startTime, err := toTime(args.StartTime)
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid start time")
+		}
+
+		lockupPeriods, err := toPeriods(args.LockupPeriods)
+		if err != nil {
+			return err
+		}
+
+		vestingPeriods, err := toPeriods(args.VestingPeriods)
+		if err != nil {
+			return err
+		}
+
+		msg = types.NewMsgCreateClawbackVestingAccount(
+			sdk.AccAddress(caller.Bytes()),
+			sdk.AccAddress(args.VestingAddress.Bytes()),
+			startTime,
+			lockupPeriods,
+			vestingPeriods,
+			args.Merge,
+		)
+	case types.SystemContractMethodClawback:
+		var args types.ClawbackArgs
+		if err := method.Inputs.Copy(&args, values); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+
+		// the zero address defaults the destination to the funder
+		var dest sdk.AccAddress
+		if args.DestAddress != (common.Address{}) {
+			dest = args.DestAddress.Bytes()
+		}
+
+		amount, err := toCoins(args.Amount)
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid clawback amount")
+		}
+
+		clawbackMsg := types.NewMsgClawback(
+			sdk.AccAddress(caller.Bytes()),
+			sdk.AccAddress(args.AccountAddress.Bytes()),
+			dest,
+		)
+		clawbackMsg.Amount = amount
+		clawbackMsg.CutoffPeriod = args.CutoffPeriod
+
+		// a zero effective time executes the clawback immediately
+		if args.EffectiveTime != 0 {
+			effectiveTime, err := toTime(args.EffectiveTime)
+			if err != nil {
+				return errorsmod.Wrap(err, "invalid effective time")
+			}
+			clawbackMsg.EffectiveTime = &effectiveTime
+		}
+
+		msg = clawbackMsg
+	case types.SystemContractMethodUpdateVestingFunder:
+		var args types.UpdateVestingFunderArgs
+		if err := method.Inputs.Copy(&args, values); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+
+		msg = types.NewMsgUpdateVestingFunder(
+			sdk.AccAddress(caller.Bytes()),
+			sdk.AccAddress(args.NewFunderAddress.Bytes()),
+			sdk.AccAddress(args.VestingAddress.Bytes()),
+		)
+	case types.SystemContractMethodCancelClawback:
+		var args types.CancelClawbackArgs
+		if err := method.Inputs.Copy(&args, values); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+
+		effectiveTime, err := toTime(args.EffectiveTime)
+		if err != nil {
+			return errorsmod.Wrap(err, "invalid effective time")
+		}
+
+		msg = types.NewMsgCancelClawback(
+			sdk.AccAddress(caller.Bytes()),
+			sdk.AccAddress(args.AccountAddress.Bytes()),
+			effectiveTime,
+		)
+	case types.SystemContractMethodApproveFunder:
+		var args types.ApproveFunderArgs
+		if err := method.Inputs.Copy(&args, values); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+		}
+
+		msg = types.NewMsgApproveFunder(
+			sdk.AccAddress(caller.Bytes()),
+			sdk.AccAddress(args.FunderAddress.Bytes()),
+			args.Revoke,
+		)
+	default:
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "unknown method %s", method.Name)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Debug(
+		"executing vesting system contract call",
+		"method", method.Name,
+		"signer", signer,
+	)
+
+	goCtx := sdk.WrapSDKContext(ctx)
+	switch msg := msg.(type) {
+	case *types.MsgCreateClawbackVestingAccount:
+		_, err = k.CreateClawbackVestingAccount(goCtx, msg)
+	case *types.MsgClawback:
+		_, err = k.Clawback(goCtx, msg)
+	case *types.MsgUpdateVestingFunder:
+		_, err = k.UpdateVestingFunder(goCtx, msg)
+	case *types.MsgCancelClawback:
+		_, err = k.CancelClawback(goCtx, msg)
+	case *types.MsgApproveFunder:
+		_, err = k.ApproveFunder(goCtx, msg)
+	}
+
+	return err
+}
+
+// toPeriods converts the periods of the system contract ABI into vesting
+// periods
+func toPeriods(periods []types.SystemContractPeriod) (sdkvesting.Periods, error) {
+	vestingPeriods := make(sdkvesting.Periods, len(periods))
+	for i, period := range periods {
+		coins, err := toCoins(period.Amount)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid amount in period %d", i)
+		}
+
+		vestingPeriods[i] = sdkvesting.Period{Length: period.Length, Amount: coins}
+	}
+
+	return vestingPeriods, nil
+}
+
+// toCoins converts the coins of the system contract ABI into sorted and valid
+// coins
+func toCoins(systemCoins []types.SystemContractCoin) (sdk.Coins, error) {
+	coins := make(sdk.Coins, len(systemCoins))
+	for i, coin := range systemCoins {
+		if coin.Amount == nil || coin.Amount.BitLen() > 256 {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount for denom %s", coin.Denom)
+		}
+		coins[i] = sdk.Coin{Denom: coin.Denom, Amount: sdk.NewIntFromBigInt(coin.Amount)}
+	}
+
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidCoins, err.Error())
+	}
+
+	return coins, nil
+}
+
+// maxTimestamp is the unix timestamp of 9999-12-31T23:59:59Z, the latest time
+// that can be encoded as a protobuf Timestamp
+const maxTimestamp = 253402300799
+
+// toTime converts a unix timestamp in seconds of the system contract ABI into
+// a time. Timestamps after maxTimestamp are rejected, as they can't be stored
+// and larger values would wrap around to negative times in the conversion.
+func toTime(timestamp uint64) (time.Time, error) {
+	if timestamp > maxTimestamp {
+		return time.Time{}, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "timestamp %d is after %d", timestamp, uint64(maxTimestamp))
+	}
+
+	return time.Unix(int64(timestamp), 0).UTC(), nil
+}
+
+// InstallSystemContract sets the runtime bytecode of the vesting system
+// contract at its address
+func (k Keeper) InstallSystemContract(ctx sdk.Context) error {
+	return evmostypes.InstallSystemContract(ctx, k.evmKeeper, types.SystemContractAddress)
+}
@@ -0,0 +1,495 @@
+package keeper_test
+
+import (
+	"math"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evm "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+// CallSystemContract sends an EVM transaction with the given calldata and
+// value to the vesting system contract.
+func (suite *KeeperTestSuite) CallSystemContract(data []byte, value *big.Int) *evm.MsgEthereumTxResponse {
+	return suite.CallContract(types.SystemContractAddress, data, value)
+}
+
+// CallContract sends an EVM transaction with the given calldata and value to
+// a contract.
+func (suite *KeeperTestSuite) CallContract(contract common.Address, data []byte, value *big.Int) *evm.MsgEthereumTxResponse {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+
+	// fund the fee collector to refund the leftover gas
+	err := testutil.FundModuleAccount(
+		suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(evm.DefaultEVMDenom, sdk.NewInt(1e18))),
+	)
+	suite.Require().NoError(err)
+
+	tx := evm.NewTx(
+		chainID,
+		nonce,
+		&contract,
+		value,
+		1_000_000,
+		nil,
+		suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx),
+		big.NewInt(1),
+		data,
+		&ethtypes.AccessList{},
+	)
+	tx.From = suite.address.Hex()
+	err = tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer)
+	suite.Require().NoError(err)
+
+	rsp, err := suite.app.EvmKeeper.EthereumTx(ctx, tx)
+	suite.Require().NoError(err)
+	return rsp
+}
+
+// forwarderCode is the runtime bytecode of a Safe-style wallet contract that
+// forwards its calldata to the vesting system contract and reverts if the
+// call fails
+var forwarderCode = []byte{
+	byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.CALLDATACOPY),
+	byte(vm.PUSH1), 0x00, byte(vm.PUSH1), 0x00, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0x00,
+	byte(vm.PUSH1), 0x00, byte(vm.PUSH2), 0x08, 0x03, byte(vm.GAS), byte(vm.CALL),
+	byte(vm.PUSH1), 0x1b, byte(vm.JUMPI),
+	byte(vm.PUSH1), 0x00, byte(vm.DUP1), byte(vm.REVERT),
+	byte(vm.JUMPDEST), byte(vm.STOP),
+}
+
+// deployForwarder sets the forwarder bytecode at a new contract address
+func (suite *KeeperTestSuite) deployForwarder() common.Address {
+	address := tests.GenerateAddress()
+	codeHash := crypto.Keccak256Hash(forwarderCode)
+	suite.app.EvmKeeper.SetCode(suite.ctx, codeHash.Bytes(), forwarderCode)
+
+	account := statedb.NewEmptyAccount()
+	account.CodeHash = codeHash.Bytes()
+	err := suite.app.EvmKeeper.SetAccount(suite.ctx, address, *account)
+	suite.Require().NoError(err)
+	return address
+}
+
+func toSystemContractPeriods(periods sdkvesting.Periods) []types.SystemContractPeriod {
+	res := make([]types.SystemContractPeriod, len(periods))
+	for i, period := range periods {
+		res[i].Length = period.Length
+		for _, coin := range period.Amount {
+			res[i].Amount = append(res[i].Amount, types.SystemContractCoin{Denom: coin.Denom, Amount: coin.Amount.BigInt()})
+		}
+	}
+	return res
+}
+
+func (suite *KeeperTestSuite) TestSystemContractCreateClawbackVestingAccount() {
+	var vestingAddr common.Address
+	startTime := uint64(time.Now().Unix())
+
+	testCases := []struct {
+		name       string
+		malleate   func() ([]byte, *big.Int)
+		expectPass bool
+	}{
+		{
+			"ok - new account",
+			func() ([]byte, *big.Int) {
+				data, err := types.SystemContractABI.Pack(
+					types.SystemContractMethodCreateClawbackVestingAccount,
+					vestingAddr, startTime,
+					toSystemContractPeriods(lockupPeriods), toSystemContractPeriods(vestingPeriods), false,
+				)
+				suite.Require().NoError(err)
+				return data, nil
+			},
+			true,
+		},
+		{
+			"fail - call with value",
+			func() ([]byte, *big.Int) {
+				data, err := types.SystemContractABI.Pack(
+					types.SystemContractMethodCreateClawbackVestingAccount,
+					vestingAddr, startTime,
+					toSystemContractPeriods(lockupPeriods), toSystemContractPeriods(vestingPeriods), false,
+				)
+				suite.Require().NoError(err)
+				return data, big.NewInt(1)
+			},
+			false,
+		},
+		{
+			"fail - start time overflows int64",
+			func() ([]byte, *big.Int) {
+				data, err := types.SystemContractABI.Pack(
+					types.SystemContractMethodCreateClawbackVestingAccount,
+					vestingAddr, uint64(math.MaxUint64),
+					toSystemContractPeriods(lockupPeriods), toSystemContractPeriods(vestingPeriods), false,
+				)
+				suite.Require().NoError(err)
+				return data, nil
+			},
+			false,
+		},
+		{
+			"fail - start time after year 9999",
+			func() ([]byte, *big.Int) {
+				data, err := types.SystemContractABI.Pack(
+					types.SystemContractMethodCreateClawbackVestingAccount,
+					vestingAddr, uint64(253402300800),
+					toSystemContractPeriods(lockupPeriods), toSystemContractPeriods(vestingPeriods), false,
+				)
+				suite.Require().NoError(err)
+				return data, nil
+			},
+			false,
+		},
+		{
+			"fail - unknown method",
+			func() ([]byte, *big.Int) {
+				return []byte{0x01, 0x02, 0x03, 0x04}, nil
+			},
+			false,
+		},
+		{
+			"fail - lockup and vesting totals mismatch",
+			func() ([]byte, *big.Int) {
+				data, err := types.SystemContractABI.Pack(
+					types.SystemContractMethodCreateClawbackVestingAccount,
+					vestingAddr, startTime,
+					toSystemContractPeriods(lockupPeriods), toSystemContractPeriods(vestingPeriods[:1]), false,
+				)
+				suite.Require().NoError(err)
+				return data, nil
+			},
+			false,
+		},
+		{
+			"fail - insufficient funder balance",
+			func() ([]byte, *big.Int) {
+				periods := sdkvesting.Periods{{Length: 5000, Amount: balances.Add(balances...)}}
+				data, err := types.SystemContractABI.Pack(
+					types.SystemContractMethodCreateClawbackVestingAccount,
+					vestingAddr, startTime,
+					toSystemContractPeriods(periods), toSystemContractPeriods(periods), false,
+				)
+				suite.Require().NoError(err)
+				return data, nil
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			vestingAddr = tests.GenerateAddress()
+			funder := sdk.AccAddress(suite.address.Bytes())
+			testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, balances)
+
+			data, value := tc.malleate()
+			rsp := suite.CallSystemContract(data, value)
+
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, vestingAddr.Bytes())
+			if tc.expectPass {
+				suite.Require().Empty(rsp.VmError)
+
+				vestingAcc, ok := acc.(*types.ClawbackVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(funder.String(), vestingAcc.FunderAddress)
+				suite.Require().Equal(int64(startTime), vestingAcc.StartTime.Unix())
+				suite.Require().Equal(balances, vestingAcc.OriginalVesting)
+
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, funder, "test")
+				suite.Require().True(balance.IsZero())
+			} else {
+				suite.Require().NotEmpty(rsp.VmError)
+				suite.Require().Nil(acc)
+
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, funder, "test")
+				suite.Require().Equal(balances.AmountOf("test"), balance.Amount)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSystemContractClawbackAndUpdateVestingFunder() {
+	var vestingAddr common.Address
+	dest := tests.GenerateAddress()
+	newFunder := tests.GenerateAddress()
+	noCoins := []types.SystemContractCoin{}
+
+	testCases := []struct {
+		name       string
+		malleate   func() []byte
+		expectPass bool
+		postCheck  func()
+	}{
+		{
+			"ok - clawback to destination",
+			func() []byte {
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodClawback, vestingAddr, dest, noCoins, uint64(0), uint64(0))
+				suite.Require().NoError(err)
+				return data
+			},
+			true,
+			func() {
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, dest.Bytes(), "test")
+				suite.Require().Equal(balances.AmountOf("test"), balance.Amount)
+			},
+		},
+		{
+			"ok - clawback to funder with the zero address",
+			func() []byte {
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodClawback, vestingAddr, common.Address{}, noCoins, uint64(0), uint64(0))
+				suite.Require().NoError(err)
+				return data
+			},
+			true,
+			func() {
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.address.Bytes(), "test")
+				suite.Require().Equal(balances.AmountOf("test"), balance.Amount)
+			},
+		},
+		{
+			"ok - clawback amount",
+			func() []byte {
+				amount := []types.SystemContractCoin{{Denom: "test", Amount: big.NewInt(250)}}
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodClawback, vestingAddr, dest, amount, uint64(0), uint64(0))
+				suite.Require().NoError(err)
+				return data
+			},
+			true,
+			func() {
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, dest.Bytes(), "test")
+				suite.Require().Equal(quarter.AmountOf("test"), balance.Amount)
+			},
+		},
+		{
+			"ok - clawback from cutoff period",
+			func() []byte {
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodClawback, vestingAddr, dest, noCoins, uint64(3), uint64(0))
+				suite.Require().NoError(err)
+				return data
+			},
+			true,
+			func() {
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, dest.Bytes(), "test")
+				suite.Require().Equal(quarter.AmountOf("test"), balance.Amount)
+			},
+		},
+		{
+			"ok - pending clawback with effective time",
+			func() []byte {
+				effectiveTime := uint64(suite.ctx.BlockTime().Add(time.Hour).Unix())
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodClawback, vestingAddr, dest, noCoins, uint64(0), effectiveTime)
+				suite.Require().NoError(err)
+				return data
+			},
+			true,
+			func() {
+				effectiveTime := time.Unix(suite.ctx.BlockTime().Add(time.Hour).Unix(), 0).UTC()
+				_, found := suite.app.VestingKeeper.GetPendingClawback(suite.ctx, effectiveTime, vestingAddr.Bytes(), suite.address.Bytes())
+				suite.Require().True(found)
+
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, dest.Bytes(), "test")
+				suite.Require().True(balance.IsZero())
+			},
+		},
+		{
+			"ok - cancel pending clawback",
+			func() []byte {
+				effectiveTime := time.Unix(suite.ctx.BlockTime().Add(time.Hour).Unix(), 0).UTC()
+				msg := types.NewMsgClawback(suite.address.Bytes(), vestingAddr.Bytes(), dest.Bytes())
+				msg.EffectiveTime = &effectiveTime
+				_, err := suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodCancelClawback, vestingAddr, uint64(effectiveTime.Unix()))
+				suite.Require().NoError(err)
+				return data
+			},
+			true,
+			func() {
+				suite.Require().Empty(suite.app.VestingKeeper.GetAllPendingClawbacks(suite.ctx))
+			},
+		},
+		{
+			"ok - update vesting funder",
+			func() []byte {
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodUpdateVestingFunder, newFunder, vestingAddr)
+				suite.Require().NoError(err)
+				return data
+			},
+			true,
+			func() {
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, vestingAddr.Bytes())
+				vestingAcc, ok := acc.(*types.ClawbackVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(sdk.AccAddress(newFunder.Bytes()).String(), vestingAcc.FunderAddress)
+			},
+		},
+		{
+			"fail - clawback from non-vesting account",
+			func() []byte {
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodClawback, dest, common.Address{}, noCoins, uint64(0), uint64(0))
+				suite.Require().NoError(err)
+				return data
+			},
+			false,
+			func() {},
+		},
+		{
+			"fail - effective time overflows int64",
+			func() []byte {
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodClawback, vestingAddr, dest, noCoins, uint64(0), uint64(math.MaxUint64))
+				suite.Require().NoError(err)
+				return data
+			},
+			false,
+			func() {
+				suite.Require().Empty(suite.app.VestingKeeper.GetAllPendingClawbacks(suite.ctx))
+			},
+		},
+		{
+			"fail - cancel clawback that is not pending",
+			func() []byte {
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodCancelClawback, vestingAddr, uint64(suite.ctx.BlockTime().Unix()))
+				suite.Require().NoError(err)
+				return data
+			},
+			false,
+			func() {},
+		},
+		{
+			"fail - update vesting funder to the same funder",
+			func() []byte {
+				data, err := types.SystemContractABI.Pack(types.SystemContractMethodUpdateVestingFunder, suite.address, vestingAddr)
+				suite.Require().NoError(err)
+				return data
+			},
+			false,
+			func() {},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			vestingAddr = tests.GenerateAddress()
+			funder := sdk.AccAddress(suite.address.Bytes())
+			testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, balances)
+
+			msg := types.NewMsgCreateClawbackVestingAccount(
+				funder, vestingAddr.Bytes(), suite.ctx.BlockTime(), lockupPeriods, vestingPeriods, false,
+			)
+			_, err := suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+
+			rsp := suite.CallSystemContract(tc.malleate(), nil)
+			if tc.expectPass {
+				suite.Require().Empty(rsp.VmError)
+			} else {
+				suite.Require().NotEmpty(rsp.VmError)
+			}
+			tc.postCheck()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSystemContractCallFromContract() {
+	suite.SetupTest()
+
+	safe := suite.deployForwarder()
+	vestingAddr := tests.GenerateAddress()
+	startTime := uint64(time.Now().Unix())
+
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, safe.Bytes(), balances)
+	suite.Require().NoError(err)
+
+	data, err := types.SystemContractABI.Pack(
+		types.SystemContractMethodCreateClawbackVestingAccount,
+		vestingAddr, startTime,
+		toSystemContractPeriods(lockupPeriods), toSystemContractPeriods(vestingPeriods), false,
+	)
+	suite.Require().NoError(err)
+
+	rsp := suite.CallContract(safe, data, nil)
+	suite.Require().Empty(rsp.VmError)
+
+	// the contract that called the system contract is the funder, not the
+	// sender of the transaction
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, vestingAddr.Bytes())
+	vestingAcc, ok := acc.(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.AccAddress(safe.Bytes()).String(), vestingAcc.FunderAddress)
+	suite.Require().Equal(balances, vestingAcc.OriginalVesting)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, safe.Bytes(), "test").IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSystemContractApproveFunder() {
+	newFunder := tests.GenerateAddress()
+
+	testCases := []struct {
+		name        string
+		revoke      bool
+		expApproved bool
+	}{
+		{"ok - approve funder", false, true},
+		{"ok - revoke approval", true, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// the sender of the EVM transaction is a clawback vesting account
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.address.Bytes())
+			ethAcc, ok := acc.(*ethermint.EthAccount)
+			suite.Require().True(ok)
+			vestingAcc := types.NewClawbackVestingAccount(
+				ethAcc.BaseAccount, addr, balances, suite.ctx.BlockTime(), lockupPeriods, vestingPeriods,
+			)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, vestingAcc)
+
+			// approve the funder before revoking the approval
+			if tc.revoke {
+				_, err := suite.app.VestingKeeper.ApproveFunder(
+					sdk.WrapSDKContext(suite.ctx), types.NewMsgApproveFunder(suite.address.Bytes(), newFunder.Bytes(), false),
+				)
+				suite.Require().NoError(err)
+			}
+
+			data, err := types.SystemContractABI.Pack(types.SystemContractMethodApproveFunder, newFunder, tc.revoke)
+			suite.Require().NoError(err)
+
+			rsp := suite.CallSystemContract(data, nil)
+			suite.Require().Empty(rsp.VmError)
+
+			approved := suite.app.VestingKeeper.HasFunderApproval(suite.ctx, suite.address.Bytes(), newFunder.Bytes())
+			suite.Require().Equal(tc.expApproved, approved)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSystemContractApproveFunderNonVestingAccount() {
+	suite.SetupTest()
+
+	data, err := types.SystemContractABI.Pack(types.SystemContractMethodApproveFunder, tests.GenerateAddress(), false)
+	suite.Require().NoError(err)
+
+	rsp := suite.CallSystemContract(data, nil)
+	suite.Require().NotEmpty(rsp.VmError)
+}
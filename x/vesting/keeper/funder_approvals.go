@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+// HasFunderApproval returns true if the ClawbackVestingAccount of the given
+// address approved the funder to add a grant to it
+func (k Keeper) HasFunderApproval(ctx sdk.Context, vestingAddr, funder sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.FunderApprovalKey(vestingAddr, funder))
+}
+
+// SetFunderApproval stores the approval of a ClawbackVestingAccount for a new
+// funder
+func (k Keeper) SetFunderApproval(ctx sdk.Context, approval types.FunderApproval) {
+	store := ctx.KVStore(k.storeKey)
+	vestingAddr := sdk.MustAccAddressFromBech32(approval.VestingAddress)
+	funder := sdk.MustAccAddressFromBech32(approval.FunderAddress)
+	bz := k.cdc.MustMarshal(&approval)
+	store.Set(types.FunderApprovalKey(vestingAddr, funder), bz)
+}
+
+// DeleteFunderApproval removes the approval of a ClawbackVestingAccount for a
+// new funder
+func (k Keeper) DeleteFunderApproval(ctx sdk.Context, vestingAddr, funder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FunderApprovalKey(vestingAddr, funder))
+}
+
+// GetAllFunderApprovals returns all the approvals of ClawbackVestingAccounts
+// for new funders
+func (k Keeper) GetAllFunderApprovals(ctx sdk.Context) []types.FunderApproval {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixFunderApproval)
+	defer iterator.Close()
+
+	approvals := []types.FunderApproval{}
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.FunderApproval
+		k.cdc.MustUnmarshal(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+
+	return approvals
+}
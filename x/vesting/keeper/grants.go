@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	ethermint "github.com/evmos/ethermint/types"
+
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+// FundClawbackVestingAccount transfers a vesting grant from the funder to the
+// account of the given address. Base accounts and Ethereum EOAs are converted
+// into empty ClawbackVestingAccounts of the funder, so that the grant can be
+// merged in the same way as the grants of existing ClawbackVestingAccounts. The
+// grant doesn't require the approval of the account, as the caller transfers
+// it on behalf of the account owner. No state is changed if the grant cannot
+// be added.
+func (k Keeper) FundClawbackVestingAccount(
+	ctx sdk.Context,
+	funder, addr sdk.AccAddress,
+	startTime time.Time,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	if err := k.convertToClawbackVestingAccount(cacheCtx, funder, addr, startTime); err != nil {
+		return err
+	}
+
+	msg := types.NewMsgCreateClawbackVestingAccount(funder, addr, startTime, lockupPeriods, vestingPeriods, true)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := k.createClawbackVestingAccount(cacheCtx, msg, false); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
+
+// convertToClawbackVestingAccount replaces the base account or Ethereum EOA of
+// an address with a ClawbackVestingAccount of the funder without vesting
+// coins. It is a no-op if the account doesn't exist or if it already is a
+// ClawbackVestingAccount.
+func (k Keeper) convertToClawbackVestingAccount(
+	ctx sdk.Context,
+	funder, addr sdk.AccAddress,
+	startTime time.Time,
+) error {
+	var baseAcc *authtypes.BaseAccount
+
+	switch acc := k.accountKeeper.GetAccount(ctx, addr).(type) {
+	case nil, *types.ClawbackVestingAccount:
+		return nil
+	case *authtypes.BaseAccount:
+		baseAcc = acc
+	case *ethermint.EthAccount:
+		if acc.Type() != ethermint.AccountTypeEOA {
+			return errorsmod.Wrapf(errortypes.ErrNotSupported, "account %s is a contract", addr)
+		}
+		baseAcc = acc.GetBaseAccount()
+	default:
+		return errorsmod.Wrapf(errortypes.ErrNotSupported, "account %s cannot be converted to a clawback vesting account: %T", addr, acc)
+	}
+
+	vestingAcc := types.NewClawbackVestingAccount(baseAcc, funder, sdk.NewCoins(), startTime, nil, nil)
+	k.accountKeeper.SetAccount(ctx, vestingAcc)
+	return nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+func (suite *KeeperTestSuite) TestFundClawbackVestingAccount() {
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	dest := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"ok - new account",
+			func() {},
+			true,
+		},
+		{
+			"ok - base account",
+			func() {
+				suite.app.AccountKeeper.SetAccount(suite.ctx, authtypes.NewBaseAccountWithAddress(dest))
+			},
+			true,
+		},
+		{
+			"ok - Ethereum EOA",
+			func() {
+				acc := &ethermint.EthAccount{
+					BaseAccount: authtypes.NewBaseAccountWithAddress(dest),
+					CodeHash:    common.BytesToHash(crypto.Keccak256(nil)).Hex(),
+				}
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			true,
+		},
+		{
+			"fail - contract account",
+			func() {
+				acc := &ethermint.EthAccount{
+					BaseAccount: authtypes.NewBaseAccountWithAddress(dest),
+					CodeHash:    common.BytesToHash([]byte{1}).Hex(),
+				}
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			false,
+		},
+		{
+			"ok - clawback vesting account of another funder",
+			func() {
+				acc := types.NewClawbackVestingAccount(
+					authtypes.NewBaseAccountWithAddress(dest), addr4, sdk.NewCoins(), suite.ctx.BlockTime(), nil, nil,
+				)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, balances)
+			suite.Require().NoError(err)
+			accBefore := suite.app.AccountKeeper.GetAccount(suite.ctx, dest)
+
+			err = suite.app.VestingKeeper.FundClawbackVestingAccount(
+				suite.ctx, funder, dest, suite.ctx.BlockTime(), lockupPeriods, vestingPeriods,
+			)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Equal(accBefore, suite.app.AccountKeeper.GetAccount(suite.ctx, dest))
+				suite.Require().Equal(balances, suite.app.BankKeeper.GetAllBalances(suite.ctx, funder))
+				return
+			}
+			suite.Require().NoError(err)
+
+			va, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, dest).(*types.ClawbackVestingAccount)
+			suite.Require().True(ok)
+			suite.Require().True(va.HasFunder(funder.String()))
+			if len(va.Grants) == 0 {
+				suite.Require().Equal(funder.String(), va.FunderAddress)
+			}
+			suite.Require().Equal(balances, va.GetOriginalVesting())
+			suite.Require().Equal(balances, suite.app.BankKeeper.GetAllBalances(suite.ctx, dest))
+			if accBefore != nil {
+				suite.Require().Equal(accBefore.GetAccountNumber(), va.GetAccountNumber())
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -57,3 +59,79 @@ func (k Keeper) Balances(
 		Vested:   vested,
 	}, nil
 }
+
+// Schedule returns the lockup and vesting schedules of a clawback vesting
+// account with absolute times, the status of each period and the next vesting
+// and unlock events
+func (k Keeper) Schedule(
+	goCtx context.Context,
+	req *types.QueryScheduleRequest,
+) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get vesting account
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"account for address '%s'", req.Address,
+		)
+	}
+
+	// Check if clawback vesting account
+	clawbackAccount, isClawback := acc.(*types.ClawbackVestingAccount)
+	if !isClawback {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"account at address '%s' is not a vesting account ", req.Address,
+		)
+	}
+
+	// copy the schedules to avoid mutating the account when aligning them
+	lockupPeriods := make(sdkvesting.Periods, len(clawbackAccount.LockupPeriods))
+	copy(lockupPeriods, clawbackAccount.LockupPeriods)
+	vestingPeriods := make(sdkvesting.Periods, len(clawbackAccount.VestingPeriods))
+	copy(vestingPeriods, clawbackAccount.VestingPeriods)
+
+	startTime, endTime := types.AlignSchedules(
+		clawbackAccount.GetStartTime(),
+		clawbackAccount.GetStartTime(),
+		lockupPeriods,
+		vestingPeriods,
+	)
+
+	blockTime := ctx.BlockTime().Unix()
+	lockup := types.ReadSchedulePeriods(startTime, lockupPeriods, blockTime)
+	vesting := types.ReadSchedulePeriods(startTime, vestingPeriods, blockTime)
+
+	return &types.QueryScheduleResponse{
+		StartTime:      time.Unix(startTime, 0).UTC(),
+		EndTime:        time.Unix(endTime, 0).UTC(),
+		LockupPeriods:  lockup,
+		VestingPeriods: vesting,
+		NextVesting:    types.NextSchedulePeriod(vesting),
+		NextUnlock:     types.NextSchedulePeriod(lockup),
+	}, nil
+}
+
+// Params returns the module parameters
+func (k Keeper) Params(
+	goCtx context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
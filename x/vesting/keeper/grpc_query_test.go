@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -110,3 +111,154 @@ func (suite *KeeperTestSuite) TestBalances() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSchedule() {
+	var req *types.QueryScheduleRequest
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	createAccount := func(vestingStart time.Time) {
+		funder := sdk.AccAddress(types.ModuleName)
+		err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, balances)
+		suite.Require().NoError(err)
+
+		msg := types.NewMsgCreateClawbackVestingAccount(
+			funder,
+			addr,
+			vestingStart,
+			lockupPeriods,
+			vestingPeriods,
+			false,
+		)
+		ctx := sdk.WrapSDKContext(suite.ctx)
+		_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expPass    bool
+		expStart   time.Duration
+		expPassed  int
+		expUnlocks bool
+	}{
+		{
+			"empty req",
+			func() {
+				req = &types.QueryScheduleRequest{}
+			},
+			false,
+			0,
+			0,
+			false,
+		},
+		{
+			"invalid account - not found",
+			func() {
+				req = &types.QueryScheduleRequest{
+					Address: addr.String(),
+				}
+			},
+			false,
+			0,
+			0,
+			false,
+		},
+		{
+			"invalid account - not clawback vesting account",
+			func() {
+				baseAccount := authtypes.NewBaseAccountWithAddress(addr)
+				acc := suite.app.AccountKeeper.NewAccount(suite.ctx, baseAccount)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				req = &types.QueryScheduleRequest{
+					Address: addr.String(),
+				}
+			},
+			false,
+			0,
+			0,
+			false,
+		},
+		{
+			"valid - one vesting period passed and locked",
+			func() {
+				createAccount(suite.ctx.BlockTime().Add(-2500 * time.Second))
+				req = &types.QueryScheduleRequest{
+					Address: addr.String(),
+				}
+			},
+			true,
+			-2500 * time.Second,
+			1,
+			true,
+		},
+		{
+			"valid - all periods passed",
+			func() {
+				createAccount(suite.ctx.BlockTime().Add(-10000 * time.Second))
+				req = &types.QueryScheduleRequest{
+					Address: addr.String(),
+				}
+			},
+			true,
+			-10000 * time.Second,
+			4,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.Schedule(ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			start := suite.ctx.BlockTime().Add(tc.expStart).Unix()
+			suite.Require().Equal(start, res.StartTime.Unix())
+			suite.Require().Equal(start+8000, res.EndTime.Unix())
+			suite.Require().Len(res.LockupPeriods, len(lockupPeriods))
+			suite.Require().Len(res.VestingPeriods, len(vestingPeriods))
+
+			for i, period := range res.VestingPeriods {
+				suite.Require().Equal(start+int64(i+1)*2000, period.Time.Unix())
+				suite.Require().Equal(quarter, period.Amount)
+
+				expStatus := types.PeriodStatusPending
+				if i < tc.expPassed {
+					expStatus = types.PeriodStatusPassed
+				}
+				suite.Require().Equal(expStatus, period.Status)
+			}
+
+			if tc.expPassed < len(vestingPeriods) {
+				suite.Require().Equal(res.VestingPeriods[tc.expPassed], *res.NextVesting)
+			} else {
+				suite.Require().Nil(res.NextVesting)
+			}
+
+			if tc.expUnlocks {
+				suite.Require().Equal(start+5000, res.NextUnlock.Time.Unix())
+				suite.Require().Equal(balances, res.NextUnlock.Total)
+			} else {
+				suite.Require().Nil(res.NextUnlock)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	expParams := types.DefaultParams()
+
+	res, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expParams, res.Params)
+}
@@ -116,7 +116,15 @@ var _ = Describe("Clawback Vesting Accounts", Ordered, func() {
 		})
 
 		It("cannot perform Ethereum tx", func() {
-			err := performEthTx(clawbackAccount)
+			err := performEthTx(clawbackAccount, nil)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("cannot perform zero value Ethereum tx with unlocked coins for the fees", func() {
+			err := fundEthTxFees(clawbackAccount)
+			s.Require().NoError(err)
+
+			err = performEthTx(clawbackAccount, nil)
 			Expect(err).ToNot(BeNil())
 		})
 	})
@@ -155,7 +163,32 @@ var _ = Describe("Clawback Vesting Accounts", Ordered, func() {
 		})
 
 		It("cannot perform Ethereum tx", func() {
-			err := performEthTx(clawbackAccount)
+			err := performEthTx(clawbackAccount, nil)
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("can perform zero value Ethereum tx with unlocked coins for the fees", func() {
+			err := fundEthTxFees(clawbackAccount)
+			s.Require().NoError(err)
+
+			err = performEthTx(clawbackAccount, nil)
+			Expect(err).To(BeNil())
+		})
+
+		It("cannot perform Ethereum tx with value", func() {
+			err := fundEthTxFees(clawbackAccount)
+			s.Require().NoError(err)
+
+			err = performEthTx(clawbackAccount, big.NewInt(1))
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("cannot perform zero value Ethereum tx if disabled in the params", func() {
+			err := fundEthTxFees(clawbackAccount)
+			s.Require().NoError(err)
+			s.app.VestingKeeper.SetParams(s.ctx, types.NewParams(false))
+
+			err = performEthTx(clawbackAccount, nil)
 			Expect(err).ToNot(BeNil())
 		})
 	})
@@ -205,7 +238,7 @@ var _ = Describe("Clawback Vesting Accounts", Ordered, func() {
 		})
 
 		It("can perform ethereum tx", func() {
-			err := performEthTx(clawbackAccount)
+			err := performEthTx(clawbackAccount, nil)
 			Expect(err).To(BeNil())
 		})
 	})
@@ -535,14 +568,22 @@ func delegate(clawbackAccount *types.ClawbackVestingAccount, amount int64) error
 	return err
 }
 
-func performEthTx(clawbackAccount *types.ClawbackVestingAccount) error {
+// fundEthTxFees sends unlocked coins of the EVM denom to the clawback vesting
+// account to pay the fees of Ethereum txs
+func fundEthTxFees(clawbackAccount *types.ClawbackVestingAccount) error {
+	denom := s.app.EvmKeeper.GetParams(s.ctx).EvmDenom
+	fees := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1e18)))
+	return testutil.FundAccount(s.ctx, s.app.BankKeeper, clawbackAccount.GetAddress(), fees)
+}
+
+func performEthTx(clawbackAccount *types.ClawbackVestingAccount, value *big.Int) error {
 	addr, err := sdk.AccAddressFromBech32(clawbackAccount.Address)
 	s.Require().NoError(err)
 	chainID := s.app.EvmKeeper.ChainID()
 	from := common.BytesToAddress(addr.Bytes())
 	nonce := s.app.EvmKeeper.GetNonce(s.ctx, from)
 
-	msgEthereumTx := evmtypes.NewTx(chainID, nonce, &from, nil, 100000, nil, s.app.FeeMarketKeeper.GetBaseFee(s.ctx), big.NewInt(1), nil, &ethtypes.AccessList{})
+	msgEthereumTx := evmtypes.NewTx(chainID, nonce, &from, value, 100000, nil, s.app.FeeMarketKeeper.GetBaseFee(s.ctx), big.NewInt(1), nil, &ethtypes.AccessList{})
 	msgEthereumTx.From = from.String()
 
 	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
@@ -551,7 +592,7 @@ func performEthTx(clawbackAccount *types.ClawbackVestingAccount) error {
 	tx := txBuilder.GetTx()
 
 	// Call Ante decorator
-	dec := ante.NewEthVestingTransactionDecorator(s.app.AccountKeeper)
+	dec := ante.NewEthVestingTransactionDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.EvmKeeper, s.app.VestingKeeper)
 	_, err = dec.AnteHandle(s.ctx, tx, false, nextFn)
 	return err
 }
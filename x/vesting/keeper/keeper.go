@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/evmos/evmos/v10/x/vesting/types"
@@ -13,28 +14,39 @@ import (
 
 // Keeper of this module maintains collections of vesting.
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	cdc        codec.BinaryCodec
+	paramstore paramtypes.Subspace
 
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	evmKeeper     types.EVMKeeper
 }
 
 // NewKeeper creates new instances of the vesting Keeper
 func NewKeeper(
 	storeKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
+	ps paramtypes.Subspace,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	sk types.StakingKeeper,
+	ek types.EVMKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:      storeKey,
 		cdc:           cdc,
+		paramstore:    ps,
 		accountKeeper: ak,
 		bankKeeper:    bk,
 		stakingKeeper: sk,
+		evmKeeper:     ek,
 	}
 }
 
@@ -20,12 +20,29 @@ import (
 var _ types.MsgServer = &Keeper{}
 
 // CreateClawbackVestingAccount creates a new ClawbackVestingAccount, or merges
-// a grant into an existing one.
+// a grant into an existing one. A new funder of an existing account must be
+// approved by the account.
 func (k Keeper) CreateClawbackVestingAccount(
 	goCtx context.Context,
 	msg *types.MsgCreateClawbackVestingAccount,
 ) (*types.MsgCreateClawbackVestingAccountResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.createClawbackVestingAccount(ctx, msg, true); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// createClawbackVestingAccount creates a new ClawbackVestingAccount, or merges
+// a grant into an existing one. If requireApproval is set, a funder that
+// didn't fund a grant of an existing account yet must have the approval of the
+// account, which is consumed by the new grant.
+func (k Keeper) createClawbackVestingAccount(
+	ctx sdk.Context,
+	msg *types.MsgCreateClawbackVestingAccount,
+	requireApproval bool,
+) error {
 	ak := k.accountKeeper
 	bk := k.bankKeeper
 
@@ -34,7 +51,7 @@ func (k Keeper) CreateClawbackVestingAccount(
 	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
 
 	if bk.BlockedAddr(to) {
-		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized,
 			"%s is not allowed to receive funds", msg.ToAddress,
 		)
 	}
@@ -61,13 +78,14 @@ func (k Keeper) CreateClawbackVestingAccount(
 	// The vesting and lockup schedules must describe the same total amount.
 	// IsEqual can panic, so use (a == b) <=> (a <= b && b <= a).
 	if !(vestingCoins.IsAllLTE(lockupCoins) && lockupCoins.IsAllLTE(vestingCoins)) {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest,
 			"lockup and vesting amounts must be equal",
 		)
 	}
 
-	// Add Grant if vesting account exists, "merge" is true and funder is correct.
-	// Otherwise create a new Clawback Vesting Account
+	// Add Grant if vesting account exists and "merge" is true. Grants of other
+	// funders are tracked separately. Otherwise create a new Clawback Vesting
+	// Account
 	madeNewAcc := false
 	acc := ak.GetAccount(ctx, to)
 	var vestingAcc *types.ClawbackVestingAccount
@@ -78,18 +96,27 @@ func (k Keeper) CreateClawbackVestingAccount(
 
 		switch {
 		case !msg.Merge && isClawback:
-			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s already exists; consider using --merge", msg.ToAddress)
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s already exists; consider using --merge", msg.ToAddress)
 		case !msg.Merge && !isClawback:
-			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
 		case msg.Merge && !isClawback:
-			return nil, errorsmod.Wrapf(errortypes.ErrNotSupported, "account %s must be a clawback vesting account", msg.ToAddress)
-		case msg.FromAddress != vestingAcc.FunderAddress:
-			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s can only accept grants from account %s", msg.ToAddress, vestingAcc.FunderAddress)
+			return errorsmod.Wrapf(errortypes.ErrNotSupported, "account %s must be a clawback vesting account", msg.ToAddress)
+		case from.Equals(to):
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s cannot fund its own grant", msg.ToAddress)
 		}
 
-		err := k.addGrant(ctx, vestingAcc, msg.GetStartTime().Unix(), msg.GetLockupPeriods(), msg.GetVestingPeriods(), vestingCoins)
+		if requireApproval && !vestingAcc.HasFunder(msg.FromAddress) {
+			if !k.HasFunderApproval(ctx, to, from) {
+				return errorsmod.Wrapf(types.ErrFunderNotApproved,
+					"account %s must approve %s as funder", msg.ToAddress, msg.FromAddress,
+				)
+			}
+			k.DeleteFunderApproval(ctx, to, from)
+		}
+
+		err := k.addGrant(ctx, vestingAcc, msg.FromAddress, msg.GetStartTime().Unix(), msg.GetLockupPeriods(), msg.GetVestingPeriods(), vestingCoins)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		ak.SetAccount(ctx, vestingAcc)
 	} else {
@@ -125,7 +152,7 @@ func (k Keeper) CreateClawbackVestingAccount(
 
 	// Send coins from the funder to vesting account
 	if err := bk.SendCoins(ctx, from, to, vestingCoins); err != nil {
-		return nil, err
+		return err
 	}
 
 	ctx.EventManager().EmitEvents(
@@ -141,7 +168,7 @@ func (k Keeper) CreateClawbackVestingAccount(
 		},
 	)
 
-	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+	return nil
 }
 
 // Clawback removes the unvested amount from a ClawbackVestingAccount.
@@ -151,8 +178,6 @@ func (k Keeper) Clawback(
 	msg *types.MsgClawback,
 ) (*types.MsgClawbackResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	ak := k.accountKeeper
-	bk := k.bankKeeper
 
 	// NOTE: ignore error in case dest address is not defined
 	dest, _ := sdk.AccAddressFromBech32(msg.DestAddress)
@@ -165,36 +190,61 @@ func (k Keeper) Clawback(
 		dest, _ = sdk.AccAddressFromBech32(msg.FunderAddress)
 	}
 
-	if bk.BlockedAddr(dest) {
-		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
-			"%s is not allowed to receive funds", msg.DestAddress,
-		)
+	va, err := k.getClawbackAccount(ctx, msg.FunderAddress, addr, dest)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check if account exists
-	acc := ak.GetAccount(ctx, addr)
-	if acc == nil {
-		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "account %s does not exist", msg.AccountAddress)
+	// Clawbacks without an effective time are executed immediately
+	effectiveTime := ctx.BlockTime()
+	if msg.EffectiveTime != nil {
+		effectiveTime = *msg.EffectiveTime
 	}
 
-	// Check if account has a clawback account
-	va, ok := acc.(*types.ClawbackVestingAccount)
-	if !ok {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account not subject to clawback: %s", msg.AccountAddress)
+	// Return error if clawback is attempted before the start time of the
+	// funder's grant
+	grant, _ := va.GetGrant(msg.FunderAddress)
+	if effectiveTime.Before(grant.StartTime) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "clawback can only be executed after vesting begins: %s", msg.FunderAddress)
 	}
 
-	// Check if account funder is same as in msg
-	if va.FunderAddress != msg.FunderAddress {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "clawback can only be requested by original funder %s", va.FunderAddress)
-	}
+	// Schedule the clawback if it takes effect in the future
+	if effectiveTime.After(ctx.BlockTime()) {
+		pending := types.PendingClawback{
+			FunderAddress:  msg.FunderAddress,
+			AccountAddress: msg.AccountAddress,
+			DestAddress:    dest.String(),
+			Amount:         msg.Amount,
+			CutoffPeriod:   msg.CutoffPeriod,
+			EffectiveTime:  effectiveTime,
+		}
+
+		funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+		if _, found := k.GetPendingClawback(ctx, effectiveTime, addr, funder); found {
+			return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest,
+				"clawback from %s is already scheduled at %s", msg.AccountAddress, effectiveTime,
+			)
+		}
+
+		k.SetPendingClawback(ctx, pending)
+
+		ctx.EventManager().EmitEvents(
+			sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeScheduleClawback,
+					sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+					sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
+					sdk.NewAttribute(types.AttributeKeyDestination, msg.DestAddress),
+					sdk.NewAttribute(types.AttributeKeyEffectiveTime, effectiveTime.String()),
+				),
+			},
+		)
 
-	// Return error if clawback is attempted before start time
-	if ctx.BlockTime().Before(va.StartTime) {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "clawback can only be executed after vesting begins: %s", va.FunderAddress)
+		return &types.MsgClawbackResponse{}, nil
 	}
 
 	// Perform clawback transfer
-	if err := k.transferClawback(ctx, *va, dest); err != nil {
+	if err := k.transferClawback(ctx, *va, msg.FunderAddress, dest, msg.Amount, msg.CutoffPeriod); err != nil {
 		return nil, err
 	}
 
@@ -212,6 +262,88 @@ func (k Keeper) Clawback(
 	return &types.MsgClawbackResponse{}, nil
 }
 
+// ApproveFunder approves a new funder to add a grant to a
+// ClawbackVestingAccount, or revokes the approval.
+func (k Keeper) ApproveFunder(
+	goCtx context.Context,
+	msg *types.MsgApproveFunder,
+) (*types.MsgApproveFunderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: errors checked during msg validation
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+
+	acc := k.accountKeeper.GetAccount(ctx, vesting)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "account %s does not exist", msg.VestingAddress)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account not subject to vesting: %s", msg.VestingAddress)
+	}
+
+	switch {
+	case msg.Revoke:
+		k.DeleteFunderApproval(ctx, vesting, funder)
+	case va.HasFunder(msg.FunderAddress):
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s already funded a grant of the account", msg.FunderAddress)
+	default:
+		k.SetFunderApproval(ctx, types.FunderApproval{
+			VestingAddress: msg.VestingAddress,
+			FunderAddress:  msg.FunderAddress,
+		})
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeApproveFunder,
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.VestingAddress),
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyRevoke, strconv.FormatBool(msg.Revoke)),
+			),
+		},
+	)
+
+	return &types.MsgApproveFunderResponse{}, nil
+}
+
+// CancelClawback removes a pending clawback scheduled by the funder.
+func (k Keeper) CancelClawback(
+	goCtx context.Context,
+	msg *types.MsgCancelClawback,
+) (*types.MsgCancelClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// NOTE: errors checked during msg validation
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	addr := sdk.MustAccAddressFromBech32(msg.AccountAddress)
+
+	pending, found := k.GetPendingClawback(ctx, msg.EffectiveTime, addr, funder)
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound,
+			"no clawback from %s scheduled by %s at %s", msg.AccountAddress, msg.FunderAddress, msg.EffectiveTime,
+		)
+	}
+
+	k.DeletePendingClawback(ctx, pending)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCancelClawback,
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress),
+				sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress),
+				sdk.NewAttribute(types.AttributeKeyEffectiveTime, pending.EffectiveTime.String()),
+			),
+		},
+	)
+
+	return &types.MsgCancelClawbackResponse{}, nil
+}
+
 // UpdateVestingFunder updates the funder account of a ClawbackVestingAccount.
 func (k Keeper) UpdateVestingFunder(
 	goCtx context.Context,
@@ -246,12 +378,25 @@ func (k Keeper) UpdateVestingFunder(
 	}
 
 	// Check if account current funder is same as in msg
-	if va.FunderAddress != msg.FunderAddress {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "clawback can only be requested by original funder %s", va.FunderAddress)
+	if !va.HasFunder(msg.FunderAddress) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "funder can only be updated by a funder of the account: %s", msg.FunderAddress)
+	}
+
+	// Grants are tracked per funder, so the new funder can't have a grant
+	if va.HasFunder(msg.NewFunderAddress) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "%s is already a funder of the account", msg.NewFunderAddress)
 	}
 
 	// Perform clawback account update
-	va.FunderAddress = msg.NewFunderAddress
+	if va.FunderAddress == msg.FunderAddress {
+		va.FunderAddress = msg.NewFunderAddress
+	}
+	for i := range va.Grants {
+		if va.Grants[i].FunderAddress == msg.FunderAddress {
+			va.Grants[i].FunderAddress = msg.NewFunderAddress
+		}
+	}
+
 	// set the account with the updated funder
 	ak.SetAccount(ctx, va)
 
@@ -270,10 +415,12 @@ func (k Keeper) UpdateVestingFunder(
 }
 
 // addGrant merges a new clawback vesting grant into an existing
-// ClawbackVestingAccount.
+// ClawbackVestingAccount. The grant is merged with the grant of the same
+// funder, if any, and the account schedules combine the grants of all funders.
 func (k Keeper) addGrant(
 	ctx sdk.Context,
 	va *types.ClawbackVestingAccount,
+	funder string,
 	grantStartTime int64,
 	grantLockupPeriods, grantVestingPeriods sdkvesting.Periods,
 	grantCoins sdk.Coins,
@@ -284,24 +431,42 @@ func (k Keeper) addGrant(
 	delegatedAmt := bondedAmt.Add(unbondingAmt)
 	delegated := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), delegatedAmt))
 
-	// modify schedules for the new grant
-	newLockupStart, newLockupEnd, newLockupPeriods := types.DisjunctPeriods(va.GetStartTime(), grantStartTime, va.LockupPeriods, grantLockupPeriods)
-	newVestingStart, newVestingEnd, newVestingPeriods := types.DisjunctPeriods(va.GetStartTime(), grantStartTime,
-		va.GetVestingPeriods(), grantVestingPeriods)
+	// modify the schedules of the funder's grant, or add a grant for a new
+	// funder
+	grants := va.GetGrants()
+	found := false
+	for i, grant := range grants {
+		if grant.FunderAddress != funder {
+			continue
+		}
 
-	if newLockupStart != newVestingStart {
-		return errorsmod.Wrapf(
-			types.ErrVestingLockup,
-			"vesting start time calculation should match lockup start (%d ≠ %d)",
-			newVestingStart, newLockupStart,
-		)
+		newGrant, err := grant.Merge(grantStartTime, grantLockupPeriods, grantVestingPeriods, grantCoins)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrVestingLockup, err.Error())
+		}
+		grants[i] = newGrant
+		found = true
+		break
+	}
+
+	if !found {
+		if len(grants) >= types.MaxGrants {
+			return errorsmod.Wrapf(types.ErrTooManyGrants, "account %s can't have more than %d grants", va.Address, types.MaxGrants)
+		}
+
+		grants = append(grants, types.NewGrant(
+			funder,
+			time.Unix(grantStartTime, 0),
+			grantLockupPeriods,
+			grantVestingPeriods,
+			grantCoins,
+		))
 	}
 
-	va.StartTime = time.Unix(newLockupStart, 0)
-	va.EndTime = types.Max64(newLockupEnd, newVestingEnd)
-	va.LockupPeriods = newLockupPeriods
-	va.VestingPeriods = newVestingPeriods
-	va.OriginalVesting = va.OriginalVesting.Add(grantCoins...)
+	// combine the schedules of all grants
+	if err := va.SetGrants(grants); err != nil {
+		return errorsmod.Wrap(types.ErrVestingLockup, err.Error())
+	}
 
 	// cap DV at the current unvested amount, DF rounds out to current delegated
 	unvested := va.GetVestingCoins(ctx.BlockTime())
@@ -310,15 +475,60 @@ func (k Keeper) addGrant(
 	return nil
 }
 
-// transferClawback transfers unvested tokens in a ClawbackVestingAccount to
-// dest address, updates the lockup schedule and removes future vesting events.
+// getClawbackAccount returns the ClawbackVestingAccount of the given address
+// after checking that the funder is allowed to claw back from it and that the
+// destination is allowed to receive funds.
+func (k Keeper) getClawbackAccount(
+	ctx sdk.Context,
+	funder string,
+	addr, dest sdk.AccAddress,
+) (*types.ClawbackVestingAccount, error) {
+	if k.bankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized,
+			"%s is not allowed to receive funds", dest,
+		)
+	}
+
+	// Check if account exists
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "account %s does not exist", addr)
+	}
+
+	// Check if account has a clawback account
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account not subject to clawback: %s", addr)
+	}
+
+	// Check if the funder in msg funded a grant of the account
+	if !va.HasFunder(funder) {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "clawback can only be requested by a funder of the account: %s", funder)
+	}
+
+	return va, nil
+}
+
+// transferClawback transfers the unvested tokens of the funder's grant in a
+// ClawbackVestingAccount to dest address and updates the vesting and lockup
+// schedules. A non-empty amount or a non-zero cutoff period limit the clawback
+// to the given unvested coins or to the unvested coins of the periods starting
+// with the cutoff period. Otherwise, all unvested coins of the grant are
+// clawed back.
 func (k Keeper) transferClawback(
 	ctx sdk.Context,
 	va types.ClawbackVestingAccount,
+	funder string,
 	dest sdk.AccAddress,
+	amount sdk.Coins,
+	cutoffPeriod uint64,
 ) error {
 	// Compute clawback amount, unlock unvested tokens and remove future vesting events
-	updatedAcc, toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	updatedAcc, toClawBack, err := va.ComputeFunderClawback(funder, ctx.BlockTime().Unix(), amount, cutoffPeriod)
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
 	if toClawBack.IsZero() {
 		// no-op, nothing to transfer
 		return nil
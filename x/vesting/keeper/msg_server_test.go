@@ -138,7 +138,7 @@ func (suite *KeeperTestSuite) TestMsgCreateClawbackVestingAccount() {
 			false,
 		},
 		{
-			"fail - account exists - wrong funder",
+			"fail - account exists - funded by itself",
 			func() {
 				// Existing clawback account
 				vestingStart := s.ctx.BlockTime()
@@ -157,6 +157,49 @@ func (suite *KeeperTestSuite) TestMsgCreateClawbackVestingAccount() {
 			0,
 			false,
 		},
+		{
+			"fail - account exists - addGrant from another funder without approval",
+			func() {
+				// Existing clawback account
+				vestingStart := s.ctx.BlockTime()
+				baseAccount := authtypes.NewBaseAccountWithAddress(addr2)
+				funder := sdk.AccAddress(types.ModuleName)
+				clawbackAccount := types.NewClawbackVestingAccount(baseAccount, funder, balances, vestingStart, lockupPeriods, vestingPeriods)
+				testutil.FundAccount(s.ctx, s.app.BankKeeper, addr2, balances)
+				s.app.AccountKeeper.SetAccount(s.ctx, clawbackAccount)
+			},
+			addr,
+			addr2,
+			time.Now(),
+			lockupPeriods,
+			vestingPeriods,
+			true,
+			1000,
+			false,
+		},
+		{
+			"ok - account exists - addGrant from another funder",
+			func() {
+				// Existing clawback account
+				vestingStart := s.ctx.BlockTime()
+				baseAccount := authtypes.NewBaseAccountWithAddress(addr2)
+				funder := sdk.AccAddress(types.ModuleName)
+				clawbackAccount := types.NewClawbackVestingAccount(baseAccount, funder, balances, vestingStart, lockupPeriods, vestingPeriods)
+				testutil.FundAccount(s.ctx, s.app.BankKeeper, addr2, balances)
+				s.app.AccountKeeper.SetAccount(s.ctx, clawbackAccount)
+
+				_, err := s.app.VestingKeeper.ApproveFunder(sdk.WrapSDKContext(s.ctx), types.NewMsgApproveFunder(addr2, addr, false))
+				s.Require().NoError(err)
+			},
+			addr,
+			addr2,
+			time.Now(),
+			lockupPeriods,
+			vestingPeriods,
+			true,
+			1000,
+			true,
+		},
 		{
 			"ok - account exists - addGrant",
 			func() {
@@ -472,3 +515,424 @@ func (suite *KeeperTestSuite) TestClawbackVestingAccountMarshal() {
 	_, err = suite.app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
 	suite.Require().Error(err)
 }
+
+func (suite *KeeperTestSuite) TestMsgClawbackPartial() {
+	testCases := []struct {
+		name              string
+		elapsed           time.Duration
+		amount            sdk.Coins
+		cutoffPeriod      uint64
+		expectedPass      bool
+		expClawedBack     int64
+		expVestingPeriods int
+	}{
+		{
+			"pass - amount removed from the last period",
+			0,
+			sdk.NewCoins(sdk.NewInt64Coin("test", 250)),
+			0,
+			true,
+			250,
+			3,
+		},
+		{
+			"pass - amount across periods",
+			0,
+			sdk.NewCoins(sdk.NewInt64Coin("test", 400)),
+			0,
+			true,
+			400,
+			3,
+		},
+		{
+			"pass - cutoff period",
+			4100 * time.Second,
+			nil,
+			3,
+			true,
+			250,
+			3,
+		},
+		{
+			"pass - cutoff period before the passed periods",
+			4100 * time.Second,
+			nil,
+			1,
+			true,
+			500,
+			2,
+		},
+		{
+			"pass - cutoff period after the last period",
+			0,
+			nil,
+			4,
+			true,
+			0,
+			4,
+		},
+		{
+			"fail - amount exceeds the unvested coins",
+			4100 * time.Second,
+			sdk.NewCoins(sdk.NewInt64Coin("test", 501)),
+			0,
+			false,
+			0,
+			4,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, balances)
+
+			// Create Clawback Vesting Account
+			createMsg := types.NewMsgCreateClawbackVestingAccount(addr, addr2, suite.ctx.BlockTime(), lockupPeriods, vestingPeriods, false)
+			_, err := suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), createMsg)
+			suite.Require().NoError(err)
+
+			suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(tc.elapsed))
+
+			// Perform partial clawback
+			msg := types.NewMsgClawback(addr, addr2, addr3)
+			msg.Amount = tc.amount
+			msg.CutoffPeriod = tc.cutoffPeriod
+			_, err = suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(suite.ctx), msg)
+
+			balanceAcc := suite.app.BankKeeper.GetBalance(suite.ctx, addr2, "test")
+			balanceDest := suite.app.BankKeeper.GetBalance(suite.ctx, addr3, "test")
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr2)
+			va, ok := acc.(*types.ClawbackVestingAccount)
+			suite.Require().True(ok)
+
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+				suite.Require().NoError(va.Validate())
+			} else {
+				suite.Require().Error(err)
+			}
+
+			suite.Require().Equal(sdk.NewInt64Coin("test", tc.expClawedBack), balanceDest)
+			suite.Require().Equal(sdk.NewInt64Coin("test", 1000-tc.expClawedBack), balanceAcc)
+			suite.Require().Equal(sdk.NewInt64Coin("test", 1000-tc.expClawedBack).Amount, va.OriginalVesting.AmountOf("test"))
+			suite.Require().Len(va.VestingPeriods, tc.expVestingPeriods)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgClawbackScheduled() {
+	testCases := []struct {
+		name          string
+		malleate      func()
+		effectiveTime time.Duration
+		expectedPass  bool
+	}{
+		{
+			"pass - scheduled clawback",
+			func() {},
+			time.Hour,
+			true,
+		},
+		{
+			"fail - effective time before start time",
+			func() {},
+			-time.Hour,
+			false,
+		},
+		{
+			"fail - already scheduled",
+			func() {
+				msg := types.NewMsgClawback(addr, addr2, nil)
+				effectiveTime := suite.ctx.BlockTime().Add(time.Hour)
+				msg.EffectiveTime = &effectiveTime
+				_, err := suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			time.Hour,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, balances)
+
+			// Create Clawback Vesting Account
+			createMsg := types.NewMsgCreateClawbackVestingAccount(addr, addr2, suite.ctx.BlockTime(), lockupPeriods, vestingPeriods, false)
+			_, err := suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), createMsg)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			// Schedule clawback
+			msg := types.NewMsgClawback(addr, addr2, nil)
+			effectiveTime := suite.ctx.BlockTime().Add(tc.effectiveTime)
+			msg.EffectiveTime = &effectiveTime
+			_, err = suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(suite.ctx), msg)
+
+			// the clawback is not executed before the effective time
+			balanceAcc := suite.app.BankKeeper.GetBalance(suite.ctx, addr2, "test")
+			suite.Require().Equal(balances[0], balanceAcc)
+
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+
+				pending, found := suite.app.VestingKeeper.GetPendingClawback(suite.ctx, effectiveTime, addr2, addr)
+				suite.Require().True(found)
+				suite.Require().Equal(addr.String(), pending.FunderAddress)
+				suite.Require().Equal(addr.String(), pending.DestAddress)
+				suite.Require().Equal(effectiveTime.Unix(), pending.EffectiveTime.Unix())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMultipleFunders() {
+	funder1 := addr
+	funder2 := addr3
+	vestingAddr := addr2
+	half := sdk.NewCoins(sdk.NewInt64Coin("test", 500))
+
+	setup := func() {
+		testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder1, balances)
+		testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder2, half)
+
+		ctx := sdk.WrapSDKContext(suite.ctx)
+		msg := types.NewMsgCreateClawbackVestingAccount(funder1, vestingAddr, suite.ctx.BlockTime(), lockupPeriods, vestingPeriods, false)
+		_, err := suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+		suite.Require().NoError(err)
+
+		_, err = suite.app.VestingKeeper.ApproveFunder(ctx, types.NewMsgApproveFunder(vestingAddr, funder2, false))
+		suite.Require().NoError(err)
+
+		// the second funder's grant vests in a single period after the first
+		// funder's second period
+		msg = types.NewMsgCreateClawbackVestingAccount(
+			funder2, vestingAddr, suite.ctx.BlockTime(),
+			sdkvesting.Periods{{Length: 5000, Amount: half}},
+			sdkvesting.Periods{{Length: 5000, Amount: half}},
+			true,
+		)
+		_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+		suite.Require().NoError(err)
+	}
+
+	getAccount := func() *types.ClawbackVestingAccount {
+		va, ok := suite.app.AccountKeeper.GetAccount(suite.ctx, vestingAddr).(*types.ClawbackVestingAccount)
+		suite.Require().True(ok)
+		return va
+	}
+
+	suite.Run("grants are tracked per funder", func() {
+		suite.SetupTest() // reset
+		setup()
+
+		va := getAccount()
+		suite.Require().NoError(va.Validate())
+		suite.Require().Equal(funder1.String(), va.FunderAddress)
+		suite.Require().Len(va.Grants, 2)
+		suite.Require().Equal(funder1.String(), va.Grants[0].FunderAddress)
+		suite.Require().Equal(balances, va.Grants[0].OriginalVesting)
+		suite.Require().Equal(funder2.String(), va.Grants[1].FunderAddress)
+		suite.Require().Equal(half, va.Grants[1].OriginalVesting)
+
+		// the account schedules combine both grants
+		suite.Require().Equal(balances.Add(half...), va.OriginalVesting)
+		suite.Require().Len(va.VestingPeriods, 5)
+		suite.Require().Equal(balances.Add(half...), va.GetLockedOnly(suite.ctx.BlockTime()))
+		suite.Require().Equal(
+			sdk.NewCoins(sdk.NewInt64Coin("test", 1250)),
+			va.GetVestedOnly(suite.ctx.BlockTime().Add(6000*time.Second)),
+		)
+	})
+
+	suite.Run("each funder claws back their own unvested coins", func() {
+		suite.SetupTest() // reset
+		setup()
+
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(4100 * time.Second))
+		ctx := sdk.WrapSDKContext(suite.ctx)
+
+		msg := types.NewMsgClawback(funder2, vestingAddr, nil)
+		_, err := suite.app.VestingKeeper.Clawback(ctx, msg)
+		suite.Require().NoError(err)
+
+		// the first funder's grant is unchanged
+		va := getAccount()
+		suite.Require().NoError(va.Validate())
+		suite.Require().Equal(half, suite.app.BankKeeper.GetAllBalances(suite.ctx, funder2))
+		suite.Require().Equal(balances, va.OriginalVesting)
+		suite.Require().Equal(vestingPeriods, va.Grants[0].VestingPeriods)
+		suite.Require().True(va.Grants[1].OriginalVesting.IsZero())
+
+		msg = types.NewMsgClawback(funder1, vestingAddr, nil)
+		_, err = suite.app.VestingKeeper.Clawback(ctx, msg)
+		suite.Require().NoError(err)
+
+		va = getAccount()
+		suite.Require().NoError(va.Validate())
+		suite.Require().Equal(
+			sdk.NewCoins(sdk.NewInt64Coin("test", 500)),
+			suite.app.BankKeeper.GetAllBalances(suite.ctx, funder1),
+		)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 500)), va.OriginalVesting)
+	})
+
+	suite.Run("each funder schedules and cancels their own clawback", func() {
+		suite.SetupTest() // reset
+		setup()
+		ctx := sdk.WrapSDKContext(suite.ctx)
+		effectiveTime := suite.ctx.BlockTime().Add(time.Hour)
+
+		for _, funder := range []sdk.AccAddress{funder1, funder2} {
+			msg := types.NewMsgClawback(funder, vestingAddr, nil)
+			msg.EffectiveTime = &effectiveTime
+			_, err := suite.app.VestingKeeper.Clawback(ctx, msg)
+			suite.Require().NoError(err)
+		}
+		suite.Require().Len(suite.app.VestingKeeper.GetAllPendingClawbacks(suite.ctx), 2)
+
+		// a funder can't cancel the clawback of another funder
+		_, err := suite.app.VestingKeeper.CancelClawback(ctx, types.NewMsgCancelClawback(addr4, vestingAddr, effectiveTime))
+		suite.Require().Error(err)
+
+		_, err = suite.app.VestingKeeper.CancelClawback(ctx, types.NewMsgCancelClawback(funder2, vestingAddr, effectiveTime))
+		suite.Require().NoError(err)
+
+		_, found := suite.app.VestingKeeper.GetPendingClawback(suite.ctx, effectiveTime, vestingAddr, funder2)
+		suite.Require().False(found)
+		_, found = suite.app.VestingKeeper.GetPendingClawback(suite.ctx, effectiveTime, vestingAddr, funder1)
+		suite.Require().True(found)
+
+		// the cancelled clawback is not executed
+		suite.ctx = suite.ctx.WithBlockTime(effectiveTime)
+		suite.app.VestingKeeper.EndBlocker(suite.ctx)
+		suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, funder2).IsZero())
+		suite.Require().False(suite.app.BankKeeper.GetAllBalances(suite.ctx, funder1).IsZero())
+	})
+
+	suite.Run("the effective time can't be before the funder's grant start", func() {
+		suite.SetupTest() // reset
+		setup()
+
+		grantStart := suite.ctx.BlockTime().Add(time.Hour)
+		testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr4, half)
+		_, err := suite.app.VestingKeeper.ApproveFunder(sdk.WrapSDKContext(suite.ctx), types.NewMsgApproveFunder(vestingAddr, addr4, false))
+		suite.Require().NoError(err)
+
+		createMsg := types.NewMsgCreateClawbackVestingAccount(
+			addr4, vestingAddr, grantStart,
+			sdkvesting.Periods{{Length: 5000, Amount: half}},
+			sdkvesting.Periods{{Length: 5000, Amount: half}},
+			true,
+		)
+		_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(sdk.WrapSDKContext(suite.ctx), createMsg)
+		suite.Require().NoError(err)
+
+		msg := types.NewMsgClawback(addr4, vestingAddr, nil)
+		effectiveTime := grantStart.Add(-time.Minute)
+		msg.EffectiveTime = &effectiveTime
+		_, err = suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(suite.ctx), msg)
+		suite.Require().Error(err)
+
+		effectiveTime = grantStart
+		_, err = suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(suite.ctx), msg)
+		suite.Require().NoError(err)
+	})
+
+	suite.Run("a new funder needs the approval of the account", func() {
+		suite.SetupTest() // reset
+		setup()
+		ctx := sdk.WrapSDKContext(suite.ctx)
+
+		testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr4, balances)
+		msg := types.NewMsgCreateClawbackVestingAccount(addr4, vestingAddr, suite.ctx.BlockTime(), lockupPeriods, vestingPeriods, true)
+		_, err := suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+		suite.Require().ErrorIs(err, types.ErrFunderNotApproved)
+
+		// a revoked approval can't be used
+		_, err = suite.app.VestingKeeper.ApproveFunder(ctx, types.NewMsgApproveFunder(vestingAddr, addr4, false))
+		suite.Require().NoError(err)
+		_, err = suite.app.VestingKeeper.ApproveFunder(ctx, types.NewMsgApproveFunder(vestingAddr, addr4, true))
+		suite.Require().NoError(err)
+		_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+		suite.Require().ErrorIs(err, types.ErrFunderNotApproved)
+
+		// the approval is consumed by the first grant of the funder
+		_, err = suite.app.VestingKeeper.ApproveFunder(ctx, types.NewMsgApproveFunder(vestingAddr, addr4, false))
+		suite.Require().NoError(err)
+		_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().False(suite.app.VestingKeeper.HasFunderApproval(suite.ctx, vestingAddr, addr4))
+		suite.Require().Len(getAccount().Grants, 3)
+
+		// existing funders don't need an approval
+		_, err = suite.app.VestingKeeper.ApproveFunder(ctx, types.NewMsgApproveFunder(vestingAddr, addr4, false))
+		suite.Require().Error(err)
+		testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr4, balances)
+		_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+		suite.Require().NoError(err)
+	})
+
+	suite.Run("the number of grants is capped", func() {
+		suite.SetupTest() // reset
+		setup()
+		ctx := sdk.WrapSDKContext(suite.ctx)
+
+		for i := len(getAccount().Grants); i <= types.MaxGrants; i++ {
+			funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, half)
+			_, err := suite.app.VestingKeeper.ApproveFunder(ctx, types.NewMsgApproveFunder(vestingAddr, funder, false))
+			suite.Require().NoError(err)
+
+			msg := types.NewMsgCreateClawbackVestingAccount(
+				funder, vestingAddr, suite.ctx.BlockTime(),
+				sdkvesting.Periods{{Length: 5000, Amount: half}},
+				sdkvesting.Periods{{Length: 5000, Amount: half}},
+				true,
+			)
+			_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, msg)
+			if i < types.MaxGrants {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrTooManyGrants)
+			}
+		}
+
+		suite.Require().Len(getAccount().Grants, types.MaxGrants)
+	})
+
+	suite.Run("a funder can't claw back another funder's grant", func() {
+		suite.SetupTest() // reset
+		setup()
+
+		msg := types.NewMsgClawback(addr4, vestingAddr, nil)
+		_, err := suite.app.VestingKeeper.Clawback(sdk.WrapSDKContext(suite.ctx), msg)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("update the funder of a grant", func() {
+		suite.SetupTest() // reset
+		setup()
+		ctx := sdk.WrapSDKContext(suite.ctx)
+
+		// the new funder can't be a funder of the account
+		msg := types.NewMsgUpdateVestingFunder(funder2, funder1, vestingAddr)
+		_, err := suite.app.VestingKeeper.UpdateVestingFunder(ctx, msg)
+		suite.Require().Error(err)
+
+		msg = types.NewMsgUpdateVestingFunder(funder2, addr4, vestingAddr)
+		_, err = suite.app.VestingKeeper.UpdateVestingFunder(ctx, msg)
+		suite.Require().NoError(err)
+
+		va := getAccount()
+		suite.Require().NoError(va.Validate())
+		suite.Require().Equal(funder1.String(), va.FunderAddress)
+		suite.Require().Equal(addr4.String(), va.Grants[1].FunderAddress)
+		suite.Require().False(va.HasFunder(funder2.String()))
+	})
+}
@@ -0,0 +1,9 @@
+package keeper_test
+
+func (suite *KeeperTestSuite) TestParams() {
+	params := suite.app.VestingKeeper.GetParams(suite.ctx)
+	params.EnableZeroValueEthTxs = false
+	suite.app.VestingKeeper.SetParams(suite.ctx, params)
+	newParams := suite.app.VestingKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(newParams, params)
+}
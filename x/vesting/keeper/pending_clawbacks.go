@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+// GetPendingClawback returns the pending clawback of a funder from the given
+// account with the given effective time
+func (k Keeper) GetPendingClawback(
+	ctx sdk.Context,
+	effectiveTime time.Time,
+	addr, funder sdk.AccAddress,
+) (types.PendingClawback, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingClawbackKey(effectiveTime, addr, funder))
+	if bz == nil {
+		return types.PendingClawback{}, false
+	}
+
+	var pending types.PendingClawback
+	k.cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+// SetPendingClawback stores a pending clawback
+func (k Keeper) SetPendingClawback(ctx sdk.Context, pending types.PendingClawback) {
+	store := ctx.KVStore(k.storeKey)
+	addr := sdk.MustAccAddressFromBech32(pending.AccountAddress)
+	funder := sdk.MustAccAddressFromBech32(pending.FunderAddress)
+	bz := k.cdc.MustMarshal(&pending)
+	store.Set(types.PendingClawbackKey(pending.EffectiveTime, addr, funder), bz)
+}
+
+// DeletePendingClawback removes a pending clawback
+func (k Keeper) DeletePendingClawback(ctx sdk.Context, pending types.PendingClawback) {
+	store := ctx.KVStore(k.storeKey)
+	addr := sdk.MustAccAddressFromBech32(pending.AccountAddress)
+	funder := sdk.MustAccAddressFromBech32(pending.FunderAddress)
+	store.Delete(types.PendingClawbackKey(pending.EffectiveTime, addr, funder))
+}
+
+// GetDuePendingClawbacks returns the pending clawbacks with an effective time
+// before or equal to the given time
+func (k Keeper) GetDuePendingClawbacks(ctx sdk.Context, blockTime time.Time) []types.PendingClawback {
+	store := ctx.KVStore(k.storeKey)
+	end := types.PendingClawbackTimeKey(blockTime.Add(time.Second))
+	iterator := store.Iterator(types.KeyPrefixPendingClawback, end)
+	defer iterator.Close()
+
+	pendings := []types.PendingClawback{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingClawback
+		k.cdc.MustUnmarshal(iterator.Value(), &pending)
+		pendings = append(pendings, pending)
+	}
+
+	return pendings
+}
+
+// GetAllPendingClawbacks returns all pending clawbacks ordered by effective
+// time
+func (k Keeper) GetAllPendingClawbacks(ctx sdk.Context) []types.PendingClawback {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPendingClawback)
+	defer iterator.Close()
+
+	pendings := []types.PendingClawback{}
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingClawback
+		k.cdc.MustUnmarshal(iterator.Value(), &pending)
+		pendings = append(pendings, pending)
+	}
+
+	return pendings
+}
+
+// executePendingClawback performs the checks of the clawback msg again and
+// transfers the clawback of a pending clawback
+func (k Keeper) executePendingClawback(ctx sdk.Context, pending types.PendingClawback) error {
+	addr := sdk.MustAccAddressFromBech32(pending.AccountAddress)
+	dest := sdk.MustAccAddressFromBech32(pending.DestAddress)
+
+	va, err := k.getClawbackAccount(ctx, pending.FunderAddress, addr, dest)
+	if err != nil {
+		return err
+	}
+
+	return k.transferClawback(ctx, *va, pending.FunderAddress, dest, pending.Amount, pending.CutoffPeriod)
+}
@@ -0,0 +1,20 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+// UpdateParams sets the module parameter EnableZeroValueEthTxs to its default
+// value of true.
+func UpdateParams(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyEnableZeroValueEthTxs, types.DefaultParams().EnableZeroValueEthTxs)
+	return nil
+}
@@ -0,0 +1,50 @@
+package v2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+
+	"github.com/evmos/evmos/v10/app"
+	v2 "github.com/evmos/evmos/v10/x/vesting/migrations/v2"
+	vestingtypes "github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	vestingKey := sdk.NewKVStoreKey(vestingtypes.StoreKey)
+	tVestingKey := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", vestingtypes.StoreKey))
+	ctx := testutil.DefaultContext(vestingKey, tVestingKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, vestingKey, tVestingKey, "vesting",
+	)
+	paramstore = paramstore.WithKeyTable(vestingtypes.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, vestingtypes.ParamStoreKeyEnableZeroValueEthTxs))
+
+	// Run migrations
+	err := v2.UpdateParams(ctx, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params are set
+	require.True(t, paramstore.Has(ctx, vestingtypes.ParamStoreKeyEnableZeroValueEthTxs))
+
+	var enableZeroValueEthTxs bool
+
+	// Make sure the new params are set
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, vestingtypes.ParamStoreKeyEnableZeroValueEthTxs, &enableZeroValueEthTxs)
+	})
+
+	// check the params are updated
+	require.True(t, enableZeroValueEthTxs)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -129,9 +130,44 @@ func (va ClawbackVestingAccount) Validate() error {
 		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
 	}
 
+	if len(va.Grants) > 0 {
+		if err := va.validateGrants(); err != nil {
+			return err
+		}
+	}
+
 	return va.BaseVestingAccount.Validate()
 }
 
+// validateGrants checks that the grants have distinct funders, starting with
+// the account funder, and that they describe the original vesting coins
+func (va ClawbackVestingAccount) validateGrants() error {
+	if va.Grants[0].FunderAddress != va.FunderAddress {
+		return errors.New("funder address must be the funder of the first grant")
+	}
+
+	funders := make(map[string]bool, len(va.Grants))
+	grantCoins := sdk.NewCoins()
+
+	for _, grant := range va.Grants {
+		if funders[grant.FunderAddress] {
+			return fmt.Errorf("duplicate grant from %s", grant.FunderAddress)
+		}
+		funders[grant.FunderAddress] = true
+
+		if err := grant.Validate(); err != nil {
+			return err
+		}
+		grantCoins = grantCoins.Add(grant.OriginalVesting...)
+	}
+
+	if !coinEq(grantCoins, va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in grants")
+	}
+
+	return nil
+}
+
 // GetUnlockedOnly returns the unlocking schedule at blockTIme.
 func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
 	return ReadSchedule(va.GetStartTime(), va.EndTime, va.LockupPeriods, va.OriginalVesting, blockTime.Unix())
@@ -204,6 +240,83 @@ func (va ClawbackVestingAccount) ComputeClawback(
 	return va, totalUnvested
 }
 
+// ComputePartialClawback returns an account with the given amount removed from
+// the unvested vesting periods and from the lockup periods, in both cases
+// starting with the last period. Periods that become empty are merged into the
+// following period. It returns an error if the amount exceeds the unvested
+// coins at the clawback time.
+func (va ClawbackVestingAccount) ComputePartialClawback(
+	clawbackTime int64,
+	amount sdk.Coins,
+) (ClawbackVestingAccount, error) {
+	unvested := va.GetUnvestedOnly(time.Unix(clawbackTime, 0))
+	if !amount.IsAllLTE(unvested) {
+		return va, fmt.Errorf("clawback amount %s exceeds unvested coins %s", amount, unvested)
+	}
+
+	// Remove the amount from the unvested periods only, so that the vested
+	// coins are preserved
+	passedPeriodID := va.GetPassedPeriodCount(time.Unix(clawbackTime, 0))
+	newVestingPeriods := make(sdkvesting.Periods, passedPeriodID)
+	copy(newVestingPeriods, va.VestingPeriods[:passedPeriodID])
+	newVestingPeriods = append(newVestingPeriods, removeFromLastPeriods(va.VestingPeriods[passedPeriodID:], amount)...)
+
+	newLockupPeriods := removeFromLastPeriods(va.LockupPeriods, amount)
+
+	// Now construct the new account state
+	va.OriginalVesting = va.OriginalVesting.Sub(amount...)
+	va.EndTime = Max64(
+		va.GetStartTime()+newVestingPeriods.TotalLength(),
+		va.GetStartTime()+newLockupPeriods.TotalLength(),
+	)
+	va.LockupPeriods = newLockupPeriods
+	va.VestingPeriods = newVestingPeriods
+
+	return va, nil
+}
+
+// GetUnvestedFromPeriod returns the unvested coins of the vesting periods
+// starting with the given cutoff period at clawbackTime.
+func (va ClawbackVestingAccount) GetUnvestedFromPeriod(clawbackTime int64, cutoffPeriod uint64) sdk.Coins {
+	firstPeriodID := uint64(va.GetPassedPeriodCount(time.Unix(clawbackTime, 0)))
+	if cutoffPeriod > firstPeriodID {
+		firstPeriodID = cutoffPeriod
+	}
+
+	coins := sdk.Coins{}
+	for i := firstPeriodID; i < uint64(len(va.VestingPeriods)); i++ {
+		coins = coins.Add(va.VestingPeriods[i].Amount...)
+	}
+	return coins
+}
+
+// removeFromLastPeriods removes the amount from the periods, starting with the
+// last one. Empty periods are merged into the following period and removed if
+// there is none.
+func removeFromLastPeriods(periods sdkvesting.Periods, amount sdk.Coins) sdkvesting.Periods {
+	updated := make(sdkvesting.Periods, len(periods))
+	copy(updated, periods)
+
+	remaining := amount
+	for i := len(updated) - 1; i >= 0 && !remaining.IsZero(); i-- {
+		removed := updated[i].Amount.Min(remaining)
+		updated[i].Amount = updated[i].Amount.Sub(removed...)
+		remaining = remaining.Sub(removed...)
+	}
+
+	newPeriods := sdkvesting.Periods{}
+	length := int64(0)
+	for _, period := range updated {
+		length += period.Length
+		if period.Amount.IsZero() {
+			continue
+		}
+		newPeriods = append(newPeriods, sdkvesting.Period{Length: length, Amount: period.Amount})
+		length = 0
+	}
+	return newPeriods
+}
+
 // HasLockedCoins returns true if the blocktime has not passed all clawback
 // account's lockup periods
 func (va ClawbackVestingAccount) HasLockedCoins(blockTime time.Time) bool {
@@ -538,3 +538,238 @@ func (suite *VestingAccountTestSuite) TestComputeClawback() {
 		})
 	}
 }
+
+func (suite *VestingAccountTestSuite) TestComputePartialClawback() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+	now := tmtime.Now()
+	lockupPeriods := sdkvesting.Periods{
+		{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))}, // noon
+	}
+	vestingPeriods := sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},            // 8am
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 9am
+		{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 3pm
+		{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(200))},            // 5pm
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200))},            // 6pm
+	}
+
+	testCases := []struct {
+		name               string
+		time               int64
+		amount             sdk.Coins
+		expPass            bool
+		expOriginalVesting sdk.Coins
+		expEndTime         int64
+		expLockupPeriods   sdkvesting.Periods
+		expVestingPeriods  sdkvesting.Periods
+	}{
+		{
+			"should remove the last vesting period",
+			now.Add(10 * time.Hour).Unix(),
+			sdk.NewCoins(fee(200)),
+			true,
+			sdk.NewCoins(fee(800), stake(100)),
+			now.Add(17 * time.Hour).Unix(),
+			sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(800), stake(100))}},
+			vestingPeriods[:4],
+		},
+		{
+			"should remove the amount from the last unvested periods first",
+			now.Add(10 * time.Hour).Unix(),
+			sdk.NewCoins(fee(300), stake(50)),
+			true,
+			sdk.NewCoins(fee(700), stake(50)),
+			now.Add(17 * time.Hour).Unix(),
+			sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(700), stake(50))}},
+			sdkvesting.Periods{
+				vestingPeriods[0],
+				vestingPeriods[1],
+				{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200))},
+				{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(100))},
+			},
+		},
+		{
+			"should drop the emptied last periods",
+			now.Unix(),
+			sdk.NewCoins(fee(400), stake(50)),
+			true,
+			sdk.NewCoins(fee(600), stake(50)),
+			now.Add(15 * time.Hour).Unix(),
+			sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(600), stake(50))}},
+			sdkvesting.Periods{
+				vestingPeriods[0],
+				vestingPeriods[1],
+				{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200))},
+			},
+		},
+		{
+			"should fail if the amount exceeds the unvested coins",
+			now.Add(10 * time.Hour).Unix(),
+			sdk.NewCoins(fee(700)),
+			false,
+			nil,
+			0,
+			nil,
+			nil,
+		},
+		{
+			"should fail if the amount has a denom that is not vesting",
+			now.Add(10 * time.Hour).Unix(),
+			sdk.NewCoins(sdk.NewInt64Coin("other", 1)),
+			false,
+			nil,
+			0,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+
+			va2, err := va.ComputePartialClawback(tc.time, tc.amount)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expOriginalVesting, va2.OriginalVesting)
+			suite.Require().Equal(tc.expEndTime, va2.EndTime)
+			suite.Require().Equal(tc.expLockupPeriods, va2.LockupPeriods)
+			suite.Require().Equal(tc.expVestingPeriods, va2.VestingPeriods)
+			suite.Require().NoError(va2.Validate())
+
+			// the input account is not mutated
+			suite.Require().Equal(vestingPeriods, va.VestingPeriods)
+		})
+	}
+}
+
+func (suite *VestingAccountTestSuite) TestComputePartialClawbackMergesEmptyPeriods() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+	now := tmtime.Now()
+	lockupPeriods := sdkvesting.Periods{
+		{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(1000), stake(100))},
+	}
+	vestingPeriods := sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(500))},
+		{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(500))},
+		{Length: int64(2 * 3600), Amount: sdk.NewCoins(stake(100))},
+	}
+
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+
+	// the second period is emptied and merged into the last one, which keeps
+	// its vesting time
+	va2, err := va.ComputePartialClawback(now.Unix(), sdk.NewCoins(fee(500)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(500))},
+		{Length: int64(4 * 3600), Amount: sdk.NewCoins(stake(100))},
+	}, va2.VestingPeriods)
+	suite.Require().Equal(now.Add(12*time.Hour).Unix(), va2.EndTime)
+	suite.Require().NoError(va2.Validate())
+}
+
+func (suite *VestingAccountTestSuite) TestGetUnvestedFromPeriod() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	stake := func(x int64) sdk.Coin { return sdk.NewInt64Coin(stakeDenom, x) }
+	now := tmtime.Now()
+	vestingPeriods := sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},            // 8am
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 9am
+		{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(200), stake(50))}, // 3pm
+		{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(200))},            // 5pm
+		{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(200))},            // 6pm
+	}
+
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, nil, vestingPeriods)
+
+	clawbackTime := now.Add(10 * time.Hour).Unix()
+	suite.Require().Equal(sdk.NewCoins(fee(600), stake(50)), va.GetUnvestedFromPeriod(clawbackTime, 0))
+	suite.Require().Equal(sdk.NewCoins(fee(600), stake(50)), va.GetUnvestedFromPeriod(clawbackTime, 1))
+	suite.Require().Equal(sdk.NewCoins(fee(400)), va.GetUnvestedFromPeriod(clawbackTime, 3))
+	suite.Require().True(va.GetUnvestedFromPeriod(clawbackTime, 5).IsZero())
+}
+
+func (suite *VestingAccountTestSuite) TestGrants() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	now := time.Unix(tmtime.Now().Unix(), 0)
+	funder1 := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	funder2 := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+
+	grant1 := types.NewGrant(
+		funder1, now,
+		sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(1000))}},
+		sdkvesting.Periods{
+			{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(500))},
+			{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(500))},
+		},
+		sdk.NewCoins(fee(1000)),
+	)
+	grant2 := types.NewGrant(
+		funder2, now.Add(time.Hour),
+		sdkvesting.Periods{{Length: int64(1 * 3600), Amount: sdk.NewCoins(fee(300))}},
+		sdkvesting.Periods{{Length: int64(11 * 3600), Amount: sdk.NewCoins(fee(300))}},
+		sdk.NewCoins(fee(300)),
+	)
+
+	addr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, sdk.AccAddress([]byte("funder")), origCoins, now, lockupPeriods, vestingPeriods)
+
+	// a single grant isn't stored separately
+	suite.Require().NoError(va.SetGrants([]types.Grant{grant1}))
+	suite.Require().Empty(va.Grants)
+	suite.Require().Equal(funder1, va.FunderAddress)
+	suite.Require().Equal([]types.Grant{grant1}, va.GetGrants())
+	suite.Require().NoError(va.Validate())
+
+	// the account schedules combine the grants
+	suite.Require().NoError(va.SetGrants([]types.Grant{grant1, grant2}))
+	suite.Require().Len(va.Grants, 2)
+	suite.Require().Equal(funder1, va.FunderAddress)
+	suite.Require().True(va.HasFunder(funder2))
+	suite.Require().Equal(sdk.NewCoins(fee(1300)), va.OriginalVesting)
+	suite.Require().Equal(now.Add(16*time.Hour).Unix(), va.EndTime)
+	suite.Require().Equal(sdkvesting.Periods{
+		{Length: int64(2 * 3600), Amount: sdk.NewCoins(fee(300))},
+		{Length: int64(10 * 3600), Amount: sdk.NewCoins(fee(1000))},
+	}, va.LockupPeriods)
+	suite.Require().Equal(sdkvesting.Periods{
+		{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(500))},
+		{Length: int64(4 * 3600), Amount: sdk.NewCoins(fee(300))},
+		{Length: int64(4 * 3600), Amount: sdk.NewCoins(fee(500))},
+	}, va.VestingPeriods)
+	suite.Require().NoError(va.Validate())
+
+	// the clawback of a funder only applies to its grant
+	va2, clawedBack, err := va.ComputeFunderClawback(funder2, now.Unix(), nil, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(fee(300)), clawedBack)
+	suite.Require().Equal(sdk.NewCoins(fee(1000)), va2.OriginalVesting)
+	suite.Require().Equal(grant1, va2.Grants[0])
+	suite.Require().True(va2.Grants[1].OriginalVesting.IsZero())
+	suite.Require().Equal(grant1.VestingPeriods, va2.VestingPeriods)
+	suite.Require().NoError(va2.Validate())
+
+	// the input account is not mutated
+	suite.Require().Equal(sdk.NewCoins(fee(1300)), va.OriginalVesting)
+
+	_, _, err = va.ComputeFunderClawback(addr.String(), now.Unix(), nil, 0)
+	suite.Require().Error(err)
+
+	// the grants must describe the original vesting coins
+	va.OriginalVesting = sdk.NewCoins(fee(1000))
+	suite.Require().Error(va.Validate())
+}
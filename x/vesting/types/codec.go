@@ -24,6 +24,8 @@ const (
 	clawback                     = "evmos/MsgClawback"
 	createClawbackVestingAccount = "evmos/MsgCreateClawbackVestingAccount"
 	updateVestingFunder          = "evmos/MsgUpdateVestingFunder"
+	cancelClawback               = "evmos/MsgCancelClawback"
+	approveFunder                = "evmos/MsgApproveFunder"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -60,6 +62,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgClawback{},
 		&MsgCreateClawbackVestingAccount{},
 		&MsgUpdateVestingFunder{},
+		&MsgCancelClawback{},
+		&MsgApproveFunder{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -72,4 +76,6 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgClawback{}, clawback, nil)
 	cdc.RegisterConcrete(&MsgCreateClawbackVestingAccount{}, createClawbackVestingAccount, nil)
 	cdc.RegisterConcrete(&MsgUpdateVestingFunder{}, updateVestingFunder, nil)
+	cdc.RegisterConcrete(&MsgCancelClawback{}, cancelClawback, nil)
+	cdc.RegisterConcrete(&MsgApproveFunder{}, approveFunder, nil)
 }
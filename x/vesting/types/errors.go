@@ -8,4 +8,6 @@ import (
 var (
 	ErrInsufficientVestedCoins = errorsmod.Register(ModuleName, 2, "insufficient vested coins error")
 	ErrVestingLockup           = errorsmod.Register(ModuleName, 3, "vesting lockup error")
+	ErrFunderNotApproved       = errorsmod.Register(ModuleName, 4, "funder not approved by the vesting account")
+	ErrTooManyGrants           = errorsmod.Register(ModuleName, 5, "too many grants")
 )
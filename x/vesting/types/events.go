@@ -5,12 +5,19 @@ const (
 	EventTypeCreateClawbackVestingAccount = "create_clawback_vesting_account"
 	EventTypeClawback                     = "clawback"
 	EventTypeUpdateVestingFunder          = "update_vesting_funder"
+	EventTypeScheduleClawback             = "schedule_clawback"
+	EventTypePendingClawbackFailed        = "pending_clawback_failed"
+	EventTypeCancelClawback               = "cancel_clawback"
+	EventTypeApproveFunder                = "approve_funder"
 
-	AttributeKeyCoins       = "coins"
-	AttributeKeyStartTime   = "start_time"
-	AttributeKeyMerge       = "merge"
-	AttributeKeyAccount     = "account"
-	AttributeKeyFunder      = "funder"
-	AttributeKeyNewFunder   = "new_funder"
-	AttributeKeyDestination = "destination"
+	AttributeKeyCoins         = "coins"
+	AttributeKeyStartTime     = "start_time"
+	AttributeKeyMerge         = "merge"
+	AttributeKeyAccount       = "account"
+	AttributeKeyFunder        = "funder"
+	AttributeKeyNewFunder     = "new_funder"
+	AttributeKeyDestination   = "destination"
+	AttributeKeyEffectiveTime = "effective_time"
+	AttributeKeyError         = "error"
+	AttributeKeyRevoke        = "revoke"
 )
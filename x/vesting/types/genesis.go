@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pendingClawbacks []PendingClawback) GenesisState {
+	return GenesisState{
+		PendingClawbacks: pendingClawbacks,
+		Params:           params,
+	}
+}
+
+// DefaultGenesisState sets default vesting genesis state with default params
+// and no pending clawbacks
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, pending := range gs.PendingClawbacks {
+		funder, err := sdk.AccAddressFromBech32(pending.FunderAddress)
+		if err != nil {
+			return fmt.Errorf("invalid funder address of pending clawback: %w", err)
+		}
+		if _, err := sdk.AccAddressFromBech32(pending.DestAddress); err != nil {
+			return fmt.Errorf("invalid destination address of pending clawback: %w", err)
+		}
+		addr, err := sdk.AccAddressFromBech32(pending.AccountAddress)
+		if err != nil {
+			return fmt.Errorf("invalid account address of pending clawback: %w", err)
+		}
+		if err := pending.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid amount of pending clawback: %w", err)
+		}
+
+		// pending clawbacks are stored by effective time, account and funder
+		// address
+		key := string(PendingClawbackKey(pending.EffectiveTime, addr, funder))
+		if seen[key] {
+			return fmt.Errorf(
+				"duplicate pending clawback of %s for account %s at %s",
+				pending.FunderAddress, pending.AccountAddress, pending.EffectiveTime,
+			)
+		}
+		seen[key] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, approval := range gs.FunderApprovals {
+		vesting, err := sdk.AccAddressFromBech32(approval.VestingAddress)
+		if err != nil {
+			return fmt.Errorf("invalid vesting address of funder approval: %w", err)
+		}
+		funder, err := sdk.AccAddressFromBech32(approval.FunderAddress)
+		if err != nil {
+			return fmt.Errorf("invalid funder address of funder approval: %w", err)
+		}
+
+		key := string(FunderApprovalKey(vesting, funder))
+		if seen[key] {
+			return fmt.Errorf("duplicate approval of funder %s for account %s", approval.FunderAddress, approval.VestingAddress)
+		}
+		seen[key] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,140 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/evmos/v10/x/vesting/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	account := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	effectiveTime := time.Unix(1_700_000_000, 0).UTC()
+
+	pending := types.PendingClawback{
+		FunderAddress:  funder,
+		AccountAddress: account,
+		DestAddress:    funder,
+		EffectiveTime:  effectiveTime,
+	}
+
+	testCases := []struct {
+		name     string
+		genesis  types.GenesisState
+		expError bool
+	}{
+		{
+			"empty genesis",
+			types.GenesisState{},
+			false,
+		},
+		{
+			"default genesis",
+			*types.DefaultGenesisState(),
+			false,
+		},
+		{
+			"custom genesis",
+			types.NewGenesisState(types.NewParams(false), []types.PendingClawback{pending}),
+			false,
+		},
+		{
+			"pending clawbacks of an account at different times",
+			types.NewGenesisState(types.DefaultParams(), []types.PendingClawback{
+				pending,
+				{
+					FunderAddress:  funder,
+					AccountAddress: account,
+					DestAddress:    funder,
+					EffectiveTime:  effectiveTime.Add(time.Hour),
+				},
+			}),
+			false,
+		},
+		{
+			"pending clawbacks of an account by different funders at the same time",
+			types.NewGenesisState(types.DefaultParams(), []types.PendingClawback{
+				pending,
+				{
+					FunderAddress:  account,
+					AccountAddress: account,
+					DestAddress:    account,
+					EffectiveTime:  effectiveTime,
+				},
+			}),
+			false,
+		},
+		{
+			"duplicate pending clawback",
+			types.NewGenesisState(types.DefaultParams(), []types.PendingClawback{pending, pending}),
+			true,
+		},
+		{
+			"invalid account address of pending clawback",
+			types.NewGenesisState(types.DefaultParams(), []types.PendingClawback{
+				{
+					FunderAddress:  funder,
+					AccountAddress: "invalid",
+					DestAddress:    funder,
+					EffectiveTime:  effectiveTime,
+				},
+			}),
+			true,
+		},
+		{
+			"invalid amount of pending clawback",
+			types.NewGenesisState(types.DefaultParams(), []types.PendingClawback{
+				{
+					FunderAddress:  funder,
+					AccountAddress: account,
+					DestAddress:    funder,
+					Amount:         sdk.Coins{{Denom: "test", Amount: sdk.NewInt(-1)}},
+					EffectiveTime:  effectiveTime,
+				},
+			}),
+			true,
+		},
+		{
+			"funder approvals",
+			types.GenesisState{
+				Params:          types.DefaultParams(),
+				FunderApprovals: []types.FunderApproval{{VestingAddress: account, FunderAddress: funder}},
+			},
+			false,
+		},
+		{
+			"duplicate funder approval",
+			types.GenesisState{
+				Params: types.DefaultParams(),
+				FunderApprovals: []types.FunderApproval{
+					{VestingAddress: account, FunderAddress: funder},
+					{VestingAddress: account, FunderAddress: funder},
+				},
+			},
+			true,
+		},
+		{
+			"invalid funder address of funder approval",
+			types.GenesisState{
+				Params:          types.DefaultParams(),
+				FunderApprovals: []types.FunderApproval{{VestingAddress: account, FunderAddress: "invalid"}},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesis.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// NewGrant returns a new vesting grant of a funder
+func NewGrant(
+	funder string,
+	startTime time.Time,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+	originalVesting sdk.Coins,
+) Grant {
+	return Grant{
+		FunderAddress:   funder,
+		StartTime:       startTime,
+		LockupPeriods:   lockupPeriods,
+		VestingPeriods:  vestingPeriods,
+		OriginalVesting: originalVesting,
+	}
+}
+
+// GetEndTime returns the time of the last lockup or vesting event of the grant
+func (g Grant) GetEndTime() int64 {
+	return g.StartTime.Unix() + Max64(g.LockupPeriods.TotalLength(), g.VestingPeriods.TotalLength())
+}
+
+// Merge returns the grant with the schedules of a new grant of the same funder
+// merged into its schedules
+func (g Grant) Merge(
+	startTime int64,
+	lockupPeriods, vestingPeriods sdkvesting.Periods,
+	coins sdk.Coins,
+) (Grant, error) {
+	newLockupStart, _, newLockupPeriods := DisjunctPeriods(g.StartTime.Unix(), startTime, g.LockupPeriods, lockupPeriods)
+	newVestingStart, _, newVestingPeriods := DisjunctPeriods(g.StartTime.Unix(), startTime, g.VestingPeriods, vestingPeriods)
+
+	if newLockupStart != newVestingStart {
+		return g, fmt.Errorf(
+			"vesting start time calculation should match lockup start (%d ≠ %d)",
+			newVestingStart, newLockupStart,
+		)
+	}
+
+	g.StartTime = time.Unix(newLockupStart, 0)
+	g.LockupPeriods = newLockupPeriods
+	g.VestingPeriods = newVestingPeriods
+	g.OriginalVesting = g.OriginalVesting.Add(coins...)
+	return g, nil
+}
+
+// Validate checks that the lockup and vesting schedules of the grant describe
+// its original vesting coins
+func (g Grant) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+
+	// use coinEq to prevent panic
+	if !coinEq(g.LockupPeriods.TotalAmount(), g.OriginalVesting) {
+		return fmt.Errorf("original vesting coins of the grant of %s do not match the sum of all coins in lockup periods", g.FunderAddress)
+	}
+
+	if !coinEq(g.VestingPeriods.TotalAmount(), g.OriginalVesting) {
+		return fmt.Errorf("original vesting coins of the grant of %s do not match the sum of all coins in vesting periods", g.FunderAddress)
+	}
+
+	return nil
+}
+
+// GetGrants returns the vesting grants of the account. The grant of an account
+// with a single funder is given by the account schedules.
+func (va ClawbackVestingAccount) GetGrants() []Grant {
+	if len(va.Grants) > 0 {
+		grants := make([]Grant, len(va.Grants))
+		copy(grants, va.Grants)
+		return grants
+	}
+
+	return []Grant{
+		NewGrant(va.FunderAddress, va.StartTime, va.LockupPeriods, va.VestingPeriods, va.OriginalVesting),
+	}
+}
+
+// GetGrant returns the vesting grant of the given funder
+func (va ClawbackVestingAccount) GetGrant(funder string) (Grant, bool) {
+	for _, grant := range va.GetGrants() {
+		if grant.FunderAddress == funder {
+			return grant, true
+		}
+	}
+	return Grant{}, false
+}
+
+// HasFunder returns true if the given address funded a grant of the account
+func (va ClawbackVestingAccount) HasFunder(funder string) bool {
+	_, found := va.GetGrant(funder)
+	return found
+}
+
+// SetGrants sets the grants of the account and combines their schedules into
+// the account schedules. The funder of the first grant is the account funder.
+// The grants aren't stored separately if there is a single one.
+func (va *ClawbackVestingAccount) SetGrants(grants []Grant) error {
+	if len(grants) == 0 {
+		return fmt.Errorf("account %s must have at least one grant", va.Address)
+	}
+
+	startTime := grants[0].StartTime.Unix()
+	endTime := grants[0].GetEndTime()
+	lockupPeriods := grants[0].LockupPeriods
+	vestingPeriods := grants[0].VestingPeriods
+	originalVesting := grants[0].OriginalVesting
+
+	for _, grant := range grants[1:] {
+		newLockupStart, newLockupEnd, newLockupPeriods := DisjunctPeriods(startTime, grant.StartTime.Unix(), lockupPeriods, grant.LockupPeriods)
+		newVestingStart, newVestingEnd, newVestingPeriods := DisjunctPeriods(startTime, grant.StartTime.Unix(), vestingPeriods, grant.VestingPeriods)
+
+		if newLockupStart != newVestingStart {
+			return fmt.Errorf(
+				"vesting start time calculation should match lockup start (%d ≠ %d)",
+				newVestingStart, newLockupStart,
+			)
+		}
+
+		startTime = newLockupStart
+		endTime = Max64(newLockupEnd, newVestingEnd)
+		lockupPeriods = newLockupPeriods
+		vestingPeriods = newVestingPeriods
+		originalVesting = originalVesting.Add(grant.OriginalVesting...)
+	}
+
+	va.FunderAddress = grants[0].FunderAddress
+	va.StartTime = time.Unix(startTime, 0)
+	va.EndTime = endTime
+	va.LockupPeriods = lockupPeriods
+	va.VestingPeriods = vestingPeriods
+	va.OriginalVesting = originalVesting
+
+	va.Grants = nil
+	if len(grants) > 1 {
+		va.Grants = grants
+	}
+
+	return nil
+}
+
+// ComputeFunderClawback returns an account with the clawback of the funder
+// applied to the funder's grant only, and the clawed back coins. A non-empty
+// amount or a non-zero cutoff period limit the clawback to the given unvested
+// coins or to the unvested coins of the grant's vesting periods starting with
+// the cutoff period. Otherwise, all unvested coins of the grant are clawed
+// back.
+func (va ClawbackVestingAccount) ComputeFunderClawback(
+	funder string,
+	clawbackTime int64,
+	amount sdk.Coins,
+	cutoffPeriod uint64,
+) (ClawbackVestingAccount, sdk.Coins, error) {
+	// the schedules of an account with a single funder are the ones of its
+	// grant
+	if len(va.Grants) == 0 {
+		if va.FunderAddress != funder {
+			return va, nil, fmt.Errorf("account %s has no grant from %s", va.Address, funder)
+		}
+		return va.computeClawback(clawbackTime, amount, cutoffPeriod)
+	}
+
+	grants := va.GetGrants()
+	for i, grant := range grants {
+		if grant.FunderAddress != funder {
+			continue
+		}
+
+		grantAcc := ClawbackVestingAccount{
+			BaseVestingAccount: &sdkvesting.BaseVestingAccount{
+				BaseAccount:     va.BaseAccount,
+				OriginalVesting: grant.OriginalVesting,
+				EndTime:         grant.GetEndTime(),
+			},
+			FunderAddress:  grant.FunderAddress,
+			StartTime:      grant.StartTime,
+			LockupPeriods:  grant.LockupPeriods,
+			VestingPeriods: grant.VestingPeriods,
+		}
+
+		// the coins of a grant that hasn't started yet are all unvested
+		grantClawbackTime := Max64(clawbackTime, grant.StartTime.Unix())
+
+		updatedGrantAcc, toClawBack, err := grantAcc.computeClawback(grantClawbackTime, amount, cutoffPeriod)
+		if err != nil || toClawBack.IsZero() {
+			return va, toClawBack, err
+		}
+
+		grants[i] = NewGrant(
+			funder,
+			updatedGrantAcc.StartTime,
+			updatedGrantAcc.LockupPeriods,
+			updatedGrantAcc.VestingPeriods,
+			updatedGrantAcc.OriginalVesting,
+		)
+
+		// copy the base vesting account to avoid mutating the input account
+		baseVestingAcc := *va.BaseVestingAccount
+		va.BaseVestingAccount = &baseVestingAcc
+		if err := va.SetGrants(grants); err != nil {
+			return va, nil, err
+		}
+		return va, toClawBack, nil
+	}
+
+	return va, nil, fmt.Errorf("account %s has no grant from %s", va.Address, funder)
+}
+
+// computeClawback applies a partial clawback if an amount or a cutoff period is
+// given and a full clawback otherwise
+func (va ClawbackVestingAccount) computeClawback(
+	clawbackTime int64,
+	amount sdk.Coins,
+	cutoffPeriod uint64,
+) (ClawbackVestingAccount, sdk.Coins, error) {
+	if amount.Empty() && cutoffPeriod == 0 {
+		updatedAcc, toClawBack := va.ComputeClawback(clawbackTime)
+		return updatedAcc, toClawBack, nil
+	}
+
+	toClawBack := amount
+	if cutoffPeriod > 0 {
+		toClawBack = va.GetUnvestedFromPeriod(clawbackTime, cutoffPeriod)
+	}
+	if toClawBack.IsZero() {
+		return va, toClawBack, nil
+	}
+
+	updatedAcc, err := va.ComputePartialClawback(clawbackTime, toClawBack)
+	if err != nil {
+		return va, nil, err
+	}
+	return updatedAcc, toClawBack, nil
+}
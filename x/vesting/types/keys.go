@@ -1,5 +1,12 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module's name.
 	ModuleName = "vesting"
@@ -10,3 +17,38 @@ const (
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
+
+// prefix bytes for the vesting persistent store
+const (
+	prefixPendingClawback = iota + 1
+	prefixFunderApproval
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixPendingClawback = []byte{prefixPendingClawback}
+	KeyPrefixFunderApproval  = []byte{prefixFunderApproval}
+)
+
+// MaxGrants is the maximum number of grants of a ClawbackVestingAccount
+const MaxGrants = 10
+
+// PendingClawbackKey returns the key of a pending clawback of a funder, which
+// orders the pending clawbacks by effective time
+func PendingClawbackKey(effectiveTime time.Time, addr, funder sdk.AccAddress) []byte {
+	key := append(PendingClawbackTimeKey(effectiveTime), address.MustLengthPrefix(addr.Bytes())...)
+	return append(key, funder.Bytes()...)
+}
+
+// PendingClawbackTimeKey returns the key prefix of the pending clawbacks with
+// the given effective time
+func PendingClawbackTimeKey(effectiveTime time.Time) []byte {
+	return append(KeyPrefixPendingClawback, sdk.Uint64ToBigEndian(uint64(effectiveTime.Unix()))...)
+}
+
+// FunderApprovalKey returns the key of the approval of a ClawbackVestingAccount
+// for a new funder
+func FunderApprovalKey(vestingAddr, funder sdk.AccAddress) []byte {
+	key := append(KeyPrefixFunderApproval, address.MustLengthPrefix(vestingAddr.Bytes())...)
+	return append(key, funder.Bytes()...)
+}
@@ -12,12 +12,17 @@ import (
 var (
 	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
 	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgUpdateVestingFunder{}
+	_ sdk.Msg = &MsgCancelClawback{}
+	_ sdk.Msg = &MsgApproveFunder{}
 )
 
 const (
 	TypeMsgCreateClawbackVestingAccount = "create_clawback_vesting_account"
 	TypeMsgClawback                     = "clawback"
 	TypeMsgUpdateVestingFunder          = "update_vesting_funder"
+	TypeMsgCancelClawback               = "cancel_clawback"
+	TypeMsgApproveFunder                = "approve_funder"
 )
 
 // NewMsgCreateClawbackVestingAccount creates new instance of MsgCreateClawbackVestingAccount
@@ -127,6 +132,14 @@ func (msg MsgClawback) ValidateBasic() error {
 		}
 	}
 
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid amount: %s", err)
+	}
+
+	if !msg.Amount.Empty() && msg.CutoffPeriod > 0 {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "amount and cutoff period can't be set together")
+	}
+
 	return nil
 }
 
@@ -188,3 +201,85 @@ func (msg MsgUpdateVestingFunder) GetSigners() []sdk.AccAddress {
 	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
 	return []sdk.AccAddress{funder}
 }
+
+// NewMsgCancelClawback creates new instance of MsgCancelClawback
+func NewMsgCancelClawback(funder, addr sdk.AccAddress, effectiveTime time.Time) *MsgCancelClawback {
+	return &MsgCancelClawback{
+		FunderAddress:  funder.String(),
+		AccountAddress: addr.String(),
+		EffectiveTime:  effectiveTime,
+	}
+}
+
+// Route returns the message route for a MsgCancelClawback.
+func (msg MsgCancelClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCancelClawback.
+func (msg MsgCancelClawback) Type() string { return TypeMsgCancelClawback }
+
+// ValidateBasic runs stateless checks on the MsgCancelClawback message
+func (msg MsgCancelClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GetFunderAddress()); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.GetAccountAddress()); err != nil {
+		return errorsmod.Wrapf(err, "invalid account address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgCancelClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelClawback) GetSigners() []sdk.AccAddress {
+	funder := sdk.MustAccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// NewMsgApproveFunder creates new instance of MsgApproveFunder
+func NewMsgApproveFunder(vesting, funder sdk.AccAddress, revoke bool) *MsgApproveFunder {
+	return &MsgApproveFunder{
+		VestingAddress: vesting.String(),
+		FunderAddress:  funder.String(),
+		Revoke:         revoke,
+	}
+}
+
+// Route returns the message route for a MsgApproveFunder.
+func (msg MsgApproveFunder) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgApproveFunder.
+func (msg MsgApproveFunder) Type() string { return TypeMsgApproveFunder }
+
+// ValidateBasic runs stateless checks on the MsgApproveFunder message
+func (msg MsgApproveFunder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GetVestingAddress()); err != nil {
+		return errorsmod.Wrapf(err, "invalid vesting address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.GetFunderAddress()); err != nil {
+		return errorsmod.Wrapf(err, "invalid funder address")
+	}
+
+	if msg.VestingAddress == msg.FunderAddress {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "vesting account cannot approve itself as funder")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgApproveFunder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgApproveFunder) GetSigners() []sdk.AccAddress {
+	vesting := sdk.MustAccAddressFromBech32(msg.VestingAddress)
+	return []sdk.AccAddress{vesting}
+}
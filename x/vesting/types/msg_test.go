@@ -227,17 +227,21 @@ func (suite *MsgsTestSuite) TestMsgClawbackNew() {
 
 func (suite *MsgsTestSuite) TestMsgClawback() {
 	testCases := []struct {
-		msg        string
-		funder     string
-		addr       string
-		dest       string
-		expectPass bool
+		msg          string
+		funder       string
+		addr         string
+		dest         string
+		amount       sdk.Coins
+		cutoffPeriod uint64
+		expectPass   bool
 	}{
 		{
 			"msg create clawback vesting account - invalid fund address",
 			"foo",
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			nil,
+			0,
 			false,
 		},
 		{
@@ -245,6 +249,8 @@ func (suite *MsgsTestSuite) TestMsgClawback() {
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
 			"foo",
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			nil,
+			0,
 			false,
 		},
 		{
@@ -252,6 +258,8 @@ func (suite *MsgsTestSuite) TestMsgClawback() {
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
 			"foo",
+			nil,
+			0,
 			false,
 		},
 		{
@@ -259,6 +267,8 @@ func (suite *MsgsTestSuite) TestMsgClawback() {
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
 			"",
+			nil,
+			0,
 			true,
 		},
 		{
@@ -266,15 +276,55 @@ func (suite *MsgsTestSuite) TestMsgClawback() {
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
 			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			nil,
+			0,
 			true,
 		},
+		{
+			"msg clawback - pass partial amount",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			"",
+			sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+			0,
+			true,
+		},
+		{
+			"msg clawback - pass cutoff period",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			"",
+			nil,
+			2,
+			true,
+		},
+		{
+			"msg clawback - invalid amount",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			"",
+			sdk.Coins{{Denom: "test", Amount: sdk.NewInt(-1)}},
+			0,
+			false,
+		},
+		{
+			"msg clawback - amount and cutoff period",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			"",
+			sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+			2,
+			false,
+		},
 	}
 
 	for i, tc := range testCases {
 		tx := MsgClawback{
-			tc.funder,
-			tc.addr,
-			tc.dest,
+			FunderAddress:  tc.funder,
+			AccountAddress: tc.addr,
+			DestAddress:    tc.dest,
+			Amount:         tc.amount,
+			CutoffPeriod:   tc.cutoffPeriod,
 		}
 		err := tx.ValidateBasic()
 
@@ -376,3 +426,120 @@ func (suite *MsgsTestSuite) TestMsgUpdateVestingFunder() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgCancelClawbackGetters() {
+	msgInvalid := MsgCancelClawback{}
+	msg := NewMsgCancelClawback(
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		time.Now(),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgCancelClawback, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgCancelClawback() {
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	vestingAcc := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name       string
+		msg        *MsgCancelClawback
+		expectPass bool
+	}{
+		{
+			name:       "msg cancel clawback - valid addresses",
+			msg:        NewMsgCancelClawback(funder, vestingAcc, time.Now()),
+			expectPass: true,
+		},
+		{
+			name: "msg cancel clawback - invalid funder address",
+			msg: &MsgCancelClawback{
+				FunderAddress:  "invalid_address",
+				AccountAddress: vestingAcc.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "msg cancel clawback - invalid account address",
+			msg: &MsgCancelClawback{
+				FunderAddress:  funder.String(),
+				AccountAddress: "invalid_address",
+			},
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.name)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgApproveFunderGetters() {
+	msgInvalid := MsgApproveFunder{}
+	vesting := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	msg := NewMsgApproveFunder(vesting, sdk.AccAddress(tests.GenerateAddress().Bytes()), false)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgApproveFunder, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{vesting}, msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgApproveFunder() {
+	vestingAcc := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name       string
+		msg        *MsgApproveFunder
+		expectPass bool
+	}{
+		{
+			name:       "msg approve funder - valid addresses",
+			msg:        NewMsgApproveFunder(vestingAcc, funder, false),
+			expectPass: true,
+		},
+		{
+			name:       "msg approve funder - revoke",
+			msg:        NewMsgApproveFunder(vestingAcc, funder, true),
+			expectPass: true,
+		},
+		{
+			name: "msg approve funder - invalid vesting address",
+			msg: &MsgApproveFunder{
+				VestingAddress: "invalid_address",
+				FunderAddress:  funder.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "msg approve funder - invalid funder address",
+			msg: &MsgApproveFunder{
+				VestingAddress: vestingAcc.String(),
+				FunderAddress:  "invalid_address",
+			},
+			expectPass: false,
+		},
+		{
+			name:       "msg approve funder - vesting account approves itself",
+			msg:        NewMsgApproveFunder(vestingAcc, vestingAcc, false),
+			expectPass: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.name)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.name)
+		}
+	}
+}
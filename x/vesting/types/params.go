@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store key
+var (
+	ParamStoreKeyEnableZeroValueEthTxs = []byte("EnableZeroValueEthTxs")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(enableZeroValueEthTxs bool) Params {
+	return Params{
+		EnableZeroValueEthTxs: enableZeroValueEthTxs,
+	}
+}
+
+// DefaultParams defines the default params for the vesting module
+func DefaultParams() Params {
+	return Params{
+		EnableZeroValueEthTxs: true,
+	}
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableZeroValueEthTxs, &p.EnableZeroValueEthTxs, validateBool),
+	}
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// Validate checks that the fields have valid values
+func (p Params) Validate() error {
+	return validateBool(p.EnableZeroValueEthTxs)
+}
@@ -11,19 +11,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +35,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PeriodStatus defines if the event of a schedule period has passed
+type PeriodStatus int32
+
+const (
+	// PERIOD_STATUS_PENDING defines a period whose event hasn't happened yet
+	PeriodStatusPending PeriodStatus = 0
+	// PERIOD_STATUS_PASSED defines a period whose amount has vested or unlocked
+	PeriodStatusPassed PeriodStatus = 1
+)
+
+var PeriodStatus_name = map[int32]string{
+	0: "PERIOD_STATUS_PENDING",
+	1: "PERIOD_STATUS_PASSED",
+}
+
+var PeriodStatus_value = map[string]int32{
+	"PERIOD_STATUS_PENDING": 0,
+	"PERIOD_STATUS_PASSED":  1,
+}
+
+func (x PeriodStatus) String() string {
+	return proto.EnumName(PeriodStatus_name, int32(x))
+}
+
+func (PeriodStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{0}
+}
+
 // QueryBalancesRequest is the request type for the Query/Balances RPC method.
 type QueryBalancesRequest struct {
 	// address of the clawback vesting account
@@ -142,39 +174,376 @@ func (m *QueryBalancesResponse) GetVested() github_com_cosmos_cosmos_sdk_types.C
 	return nil
 }
 
+// QueryScheduleRequest is the request type for the Query/Schedule RPC method.
+type QueryScheduleRequest struct {
+	// address of the clawback vesting account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryScheduleRequest) Reset()         { *m = QueryScheduleRequest{} }
+func (m *QueryScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleRequest) ProtoMessage()    {}
+func (*QueryScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{2}
+}
+func (m *QueryScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleRequest.Merge(m, src)
+}
+func (m *QueryScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryScheduleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryScheduleResponse is the response type for the Query/Schedule RPC
+// method.
+type QueryScheduleResponse struct {
+	// start_time defines the time at which the vesting and lockup schedules begin
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time defines the time of the last vesting or unlock event
+	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// lockup_periods defines the unlocking schedule
+	LockupPeriods []SchedulePeriod `protobuf:"bytes,3,rep,name=lockup_periods,json=lockupPeriods,proto3" json:"lockup_periods"`
+	// vesting_periods defines the vesting schedule
+	VestingPeriods []SchedulePeriod `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+	// next_vesting is the next pending vesting period, if any
+	NextVesting *SchedulePeriod `protobuf:"bytes,5,opt,name=next_vesting,json=nextVesting,proto3" json:"next_vesting,omitempty"`
+	// next_unlock is the next pending lockup period, if any
+	NextUnlock *SchedulePeriod `protobuf:"bytes,6,opt,name=next_unlock,json=nextUnlock,proto3" json:"next_unlock,omitempty"`
+}
+
+func (m *QueryScheduleResponse) Reset()         { *m = QueryScheduleResponse{} }
+func (m *QueryScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleResponse) ProtoMessage()    {}
+func (*QueryScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{3}
+}
+func (m *QueryScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleResponse.Merge(m, src)
+}
+func (m *QueryScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryScheduleResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryScheduleResponse) GetLockupPeriods() []SchedulePeriod {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+func (m *QueryScheduleResponse) GetVestingPeriods() []SchedulePeriod {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+func (m *QueryScheduleResponse) GetNextVesting() *SchedulePeriod {
+	if m != nil {
+		return m.NextVesting
+	}
+	return nil
+}
+
+func (m *QueryScheduleResponse) GetNextUnlock() *SchedulePeriod {
+	if m != nil {
+		return m.NextUnlock
+	}
+	return nil
+}
+
+// SchedulePeriod defines a period of a vesting or lockup schedule with the
+// absolute time of its event
+type SchedulePeriod struct {
+	// time at which the amount of the period vests or unlocks
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// length of the period in seconds
+	Length int64 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	// amount that vests or unlocks at the end of the period
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// total amount vested or unlocked at the end of the period
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// status of the period at the block time
+	Status PeriodStatus `protobuf:"varint,5,opt,name=status,proto3,enum=evmos.vesting.v1.PeriodStatus" json:"status,omitempty"`
+}
+
+func (m *SchedulePeriod) Reset()         { *m = SchedulePeriod{} }
+func (m *SchedulePeriod) String() string { return proto.CompactTextString(m) }
+func (*SchedulePeriod) ProtoMessage()    {}
+func (*SchedulePeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{4}
+}
+func (m *SchedulePeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePeriod.Merge(m, src)
+}
+func (m *SchedulePeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePeriod proto.InternalMessageInfo
+
+func (m *SchedulePeriod) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SchedulePeriod) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *SchedulePeriod) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *SchedulePeriod) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *SchedulePeriod) GetStatus() PeriodStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PeriodStatusPending
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{5}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params defines the parameters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff0457b141ab5d28, []int{6}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
+	proto.RegisterEnum("evmos.vesting.v1.PeriodStatus", PeriodStatus_name, PeriodStatus_value)
 	proto.RegisterType((*QueryBalancesRequest)(nil), "evmos.vesting.v1.QueryBalancesRequest")
 	proto.RegisterType((*QueryBalancesResponse)(nil), "evmos.vesting.v1.QueryBalancesResponse")
+	proto.RegisterType((*QueryScheduleRequest)(nil), "evmos.vesting.v1.QueryScheduleRequest")
+	proto.RegisterType((*QueryScheduleResponse)(nil), "evmos.vesting.v1.QueryScheduleResponse")
+	proto.RegisterType((*SchedulePeriod)(nil), "evmos.vesting.v1.SchedulePeriod")
+	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.vesting.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.vesting.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v1/query.proto", fileDescriptor_ff0457b141ab5d28) }
 
 var fileDescriptor_ff0457b141ab5d28 = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0xed, 0x40, 0x1e, 0x8f, 0x37, 0x6f, 0xf3, 0xd2, 0xf0, 0x92, 0x4a, 0x48, 0x21, 0xc4, 0x20,
-	0x31, 0x3a, 0x43, 0xf1, 0x0f, 0xd0, 0x1f, 0x90, 0xa5, 0xbb, 0x69, 0x3b, 0xa9, 0x0d, 0x30, 0xb7,
-	0x30, 0xd3, 0x46, 0x62, 0xdc, 0xb8, 0x73, 0x67, 0xc2, 0x5f, 0xf8, 0x25, 0xc4, 0x15, 0x89, 0x1b,
-	0x57, 0x6a, 0xc0, 0x0f, 0x31, 0x6d, 0x07, 0x62, 0xd0, 0xc4, 0x8d, 0xae, 0x7a, 0x3b, 0xf7, 0x9e,
-	0x73, 0xef, 0xb9, 0xe7, 0xe2, 0x1a, 0x4f, 0x46, 0x20, 0x69, 0xc2, 0xa5, 0x0a, 0x45, 0x40, 0x13,
-	0x87, 0x8e, 0x63, 0x3e, 0x99, 0x92, 0x68, 0x02, 0x0a, 0xcc, 0x7f, 0x59, 0x96, 0xe8, 0x2c, 0x49,
-	0x9c, 0xaa, 0xed, 0x81, 0x4c, 0x01, 0x2e, 0x93, 0x9c, 0x26, 0x8e, 0xcb, 0x15, 0x73, 0xa8, 0x07,
-	0xa1, 0xc8, 0x11, 0xd5, 0x4a, 0x00, 0x01, 0x64, 0x21, 0x4d, 0x23, 0xfd, 0x5a, 0x0b, 0x00, 0x82,
-	0x21, 0xa7, 0x2c, 0x0a, 0x29, 0x13, 0x02, 0x14, 0x53, 0x21, 0x08, 0x99, 0x67, 0x9b, 0x1d, 0x5c,
-	0x39, 0x4d, 0x9b, 0xf6, 0xd8, 0x90, 0x09, 0x8f, 0xcb, 0x3e, 0x1f, 0xc7, 0x5c, 0x2a, 0xd3, 0xc2,
-	0xbf, 0x99, 0xef, 0x4f, 0xb8, 0x94, 0x16, 0x6a, 0xa0, 0xf6, 0x9f, 0xfe, 0xfa, 0xb7, 0x79, 0x5f,
-	0xc0, 0xff, 0xb7, 0x20, 0x32, 0x02, 0x21, 0xb9, 0xe9, 0xe1, 0xd2, 0x10, 0xbc, 0x01, 0xf7, 0x2d,
-	0xd4, 0x28, 0xb6, 0xff, 0x76, 0x77, 0x48, 0x3e, 0x30, 0x49, 0x07, 0x26, 0x7a, 0x60, 0x72, 0x0c,
-	0xa1, 0xe8, 0x75, 0xe6, 0x4f, 0x75, 0xe3, 0xee, 0xb9, 0xde, 0x0e, 0x42, 0x75, 0x1e, 0xbb, 0xc4,
-	0x83, 0x11, 0xd5, 0xea, 0xf2, 0xcf, 0xa1, 0xf4, 0x07, 0x54, 0x4d, 0x23, 0x2e, 0x33, 0x80, 0xec,
-	0x6b, 0x6a, 0x33, 0xc0, 0xe5, 0x58, 0xa4, 0x4b, 0xe1, 0xbe, 0x55, 0xf8, 0xfe, 0x36, 0x1b, 0xf2,
-	0x54, 0x8d, 0x6e, 0x53, 0xfc, 0x01, 0x35, 0x39, 0x75, 0x77, 0x86, 0xf0, 0xaf, 0x6c, 0x99, 0xe6,
-	0x0d, 0xc2, 0xe5, 0xf5, 0x46, 0xcd, 0x16, 0xd9, 0x36, 0x9f, 0x7c, 0xe6, 0x52, 0x75, 0xef, 0xcb,
-	0xba, 0xdc, 0x9a, 0xe6, 0xc1, 0xf5, 0xc3, 0xeb, 0xac, 0xd0, 0x32, 0x77, 0xe9, 0x87, 0x9b, 0x73,
-	0x75, 0x2d, 0xbd, 0xd4, 0x0e, 0x5f, 0xf5, 0x4e, 0xe6, 0x4b, 0x1b, 0x2d, 0x96, 0x36, 0x7a, 0x59,
-	0xda, 0xe8, 0x76, 0x65, 0x1b, 0x8b, 0x95, 0x6d, 0x3c, 0xae, 0x6c, 0xe3, 0x6c, 0xff, 0x9d, 0xc2,
-	0x9c, 0x49, 0xf3, 0x39, 0x1d, 0x7a, 0xb1, 0x61, 0xcd, 0x94, 0xba, 0xa5, 0xec, 0xc2, 0x8e, 0xde,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x65, 0x95, 0x07, 0xe7, 0x02, 0x00, 0x00,
+	// 808 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xbd, 0x6f, 0x23, 0x45,
+	0x1c, 0xf5, 0xda, 0x8e, 0xcf, 0x37, 0x39, 0x4c, 0x34, 0xe7, 0x1c, 0xcb, 0xea, 0xb4, 0xb6, 0xac,
+	0xe3, 0x2e, 0x3a, 0xc1, 0xae, 0x6d, 0xa4, 0x13, 0x1d, 0x8a, 0xe3, 0x08, 0x5d, 0x71, 0x77, 0x66,
+	0x9d, 0x50, 0xd0, 0x58, 0x63, 0xef, 0xb0, 0x59, 0xc5, 0x9e, 0xd9, 0x78, 0x66, 0x4d, 0x02, 0xa2,
+	0xa1, 0x82, 0x54, 0x91, 0x68, 0x68, 0x52, 0xd1, 0xf1, 0x7f, 0x20, 0x45, 0x54, 0x91, 0x68, 0xa8,
+	0x08, 0x72, 0xf8, 0x43, 0xd0, 0x7c, 0xac, 0xe5, 0x8f, 0x44, 0x4e, 0xa4, 0xa4, 0xf2, 0xce, 0xfc,
+	0xde, 0x7b, 0xf3, 0xfb, 0x78, 0x33, 0x06, 0x4f, 0xf1, 0x68, 0x40, 0x99, 0x3b, 0xc2, 0x8c, 0x87,
+	0x24, 0x70, 0x47, 0x35, 0xf7, 0x20, 0xc6, 0xc3, 0x23, 0x27, 0x1a, 0x52, 0x4e, 0xe1, 0x9a, 0x8c,
+	0x3a, 0x3a, 0xea, 0x8c, 0x6a, 0x96, 0xdd, 0xa3, 0x4c, 0x10, 0xba, 0x88, 0x61, 0x77, 0x54, 0xeb,
+	0x62, 0x8e, 0x6a, 0x6e, 0x8f, 0x86, 0x44, 0x31, 0x2c, 0x7b, 0x41, 0x2f, 0xc0, 0x04, 0xb3, 0x90,
+	0xe9, 0x78, 0x31, 0xa0, 0x01, 0x95, 0x9f, 0xae, 0xf8, 0xd2, 0xbb, 0x4f, 0x03, 0x4a, 0x83, 0x3e,
+	0x76, 0x51, 0x14, 0xba, 0x88, 0x10, 0xca, 0x11, 0x0f, 0x29, 0x49, 0x38, 0x25, 0x1d, 0x95, 0xab,
+	0x6e, 0xfc, 0x8d, 0xcb, 0xc3, 0x01, 0x66, 0x1c, 0x0d, 0x22, 0x05, 0xa8, 0x54, 0x41, 0xf1, 0x4b,
+	0x91, 0x75, 0x03, 0xf5, 0x11, 0xe9, 0x61, 0xe6, 0xe1, 0x83, 0x18, 0x33, 0x0e, 0x4d, 0xf0, 0x00,
+	0xf9, 0xfe, 0x10, 0x33, 0x66, 0x1a, 0x65, 0x63, 0xe3, 0xa1, 0x97, 0x2c, 0x2b, 0x7f, 0xa6, 0xc1,
+	0xfa, 0x1c, 0x85, 0x45, 0x94, 0x30, 0x0c, 0x7b, 0x20, 0xd7, 0xa7, 0xbd, 0x7d, 0xec, 0x9b, 0x46,
+	0x39, 0xb3, 0xb1, 0x5a, 0xff, 0xd0, 0x51, 0x15, 0x3b, 0xa2, 0x62, 0x47, 0x57, 0xec, 0x6c, 0xd1,
+	0x90, 0x34, 0xaa, 0x67, 0xff, 0x94, 0x52, 0xbf, 0x5f, 0x94, 0x36, 0x82, 0x90, 0xef, 0xc5, 0x5d,
+	0xa7, 0x47, 0x07, 0xae, 0x6e, 0x8f, 0xfa, 0xf9, 0x84, 0xf9, 0xfb, 0x2e, 0x3f, 0x8a, 0x30, 0x93,
+	0x04, 0xe6, 0x69, 0x69, 0x18, 0x80, 0x7c, 0x4c, 0x44, 0x8f, 0xb0, 0x6f, 0xa6, 0xef, 0xfe, 0x98,
+	0x89, 0xb8, 0xa8, 0x46, 0x1f, 0x93, 0xb9, 0x87, 0x6a, 0x94, 0xf4, 0xa4, 0xfd, 0xed, 0xde, 0x1e,
+	0xf6, 0xe3, 0x3e, 0x5e, 0xde, 0xfe, 0x3f, 0x32, 0x60, 0x7d, 0x8e, 0xa2, 0xdb, 0xbf, 0x05, 0x00,
+	0xe3, 0x68, 0xc8, 0x3b, 0x62, 0xc6, 0x92, 0xb6, 0x5a, 0xb7, 0x1c, 0x65, 0x00, 0x27, 0x31, 0x80,
+	0xb3, 0x93, 0x18, 0xa0, 0x91, 0x17, 0x59, 0x9f, 0x5c, 0x94, 0x0c, 0xef, 0xa1, 0xe4, 0x89, 0x08,
+	0xfc, 0x1c, 0xe4, 0x31, 0xf1, 0x95, 0x44, 0xfa, 0x16, 0x12, 0x0f, 0x30, 0xf1, 0xa5, 0xc0, 0x1b,
+	0x50, 0x10, 0x93, 0x8a, 0xa3, 0x4e, 0x84, 0x87, 0x21, 0xf5, 0x99, 0x6e, 0x5f, 0xd9, 0x99, 0xbf,
+	0x10, 0x4e, 0x52, 0x41, 0x4b, 0x02, 0x1b, 0x59, 0x21, 0xe6, 0xbd, 0xa7, 0xd8, 0x6a, 0x8f, 0xc1,
+	0x77, 0xe0, 0x7d, 0xcd, 0x98, 0xe8, 0x65, 0x6f, 0xa5, 0x57, 0xd0, 0x80, 0x44, 0x70, 0x0b, 0x3c,
+	0x22, 0xf8, 0x90, 0x77, 0xf4, 0xb6, 0xb9, 0x52, 0x36, 0x6e, 0xa2, 0xe6, 0xad, 0x0a, 0xd6, 0x57,
+	0x2a, 0x0c, 0x37, 0x81, 0x5c, 0x76, 0x62, 0x22, 0xb2, 0x35, 0x73, 0x37, 0xd4, 0x00, 0x82, 0xb4,
+	0x2b, 0x39, 0x95, 0x71, 0x1a, 0x14, 0x66, 0xc3, 0xf0, 0x33, 0x90, 0xbd, 0xf5, 0xe8, 0x24, 0x03,
+	0x3e, 0x01, 0xb9, 0x3e, 0x26, 0x01, 0xdf, 0x93, 0x33, 0xcb, 0x78, 0x7a, 0x25, 0x3c, 0x8c, 0x06,
+	0x34, 0x26, 0xfc, 0x5e, 0x3c, 0xac, 0xa4, 0x21, 0x02, 0x2b, 0x9c, 0x72, 0xd4, 0x37, 0xb3, 0x77,
+	0x7f, 0x86, 0x52, 0x86, 0xaf, 0x40, 0x8e, 0x71, 0xc4, 0x63, 0x26, 0xc7, 0x55, 0xa8, 0xdb, 0x8b,
+	0xad, 0x56, 0x3d, 0x6c, 0x4b, 0x94, 0xa7, 0xd1, 0x95, 0x22, 0x80, 0xf2, 0xae, 0xb4, 0xd0, 0x10,
+	0x0d, 0x92, 0xb7, 0xad, 0xf2, 0x06, 0x3c, 0x9e, 0xd9, 0xd5, 0xf7, 0xe7, 0x15, 0xc8, 0x45, 0x72,
+	0x47, 0x0f, 0xc0, 0xbc, 0xe2, 0x10, 0x19, 0xd7, 0xce, 0xd2, 0xe8, 0x97, 0xdf, 0x81, 0x47, 0xd3,
+	0x87, 0xc3, 0x3a, 0x58, 0x6f, 0x6d, 0x7b, 0xaf, 0xdf, 0x35, 0x3b, 0xed, 0x9d, 0xcd, 0x9d, 0xdd,
+	0x76, 0xa7, 0xb5, 0xfd, 0xb6, 0xf9, 0xfa, 0xed, 0x17, 0x6b, 0x29, 0xeb, 0x83, 0xe3, 0xd3, 0xf2,
+	0xe3, 0x69, 0x70, 0x0b, 0x13, 0x5f, 0x18, 0xaa, 0x0a, 0x8a, 0x73, 0x9c, 0xcd, 0x76, 0x7b, 0xbb,
+	0xb9, 0x66, 0x58, 0x4f, 0x8e, 0x4f, 0xcb, 0x70, 0x86, 0x82, 0x18, 0xc3, 0xbe, 0x95, 0xfd, 0xe9,
+	0x37, 0x3b, 0x55, 0xff, 0x35, 0x03, 0x56, 0x64, 0x2d, 0xf0, 0x67, 0x03, 0xe4, 0x93, 0x17, 0x19,
+	0x3e, 0x5f, 0x4c, 0xfd, 0xaa, 0x57, 0xde, 0x7a, 0xb1, 0x14, 0xa7, 0x7a, 0x53, 0xf9, 0xf8, 0xc7,
+	0xbf, 0xfe, 0xfb, 0x25, 0xfd, 0x1c, 0x3e, 0x73, 0x17, 0xfe, 0xa4, 0xba, 0x1a, 0xeb, 0x7e, 0xaf,
+	0x9f, 0xa8, 0x1f, 0x64, 0x2e, 0x89, 0xb7, 0xaf, 0xcd, 0x65, 0xee, 0xc9, 0xb3, 0x5e, 0x2c, 0xc5,
+	0x2d, 0xcf, 0x85, 0x69, 0xec, 0x54, 0x2e, 0xdf, 0x82, 0x9c, 0x9a, 0x1a, 0x7c, 0x76, 0xcd, 0x01,
+	0x33, 0xe6, 0xb0, 0x3e, 0x5a, 0x82, 0xd2, 0x49, 0x94, 0x65, 0x12, 0x16, 0x34, 0x17, 0x93, 0x50,
+	0xb6, 0x68, 0x34, 0xcf, 0xc6, 0xb6, 0x71, 0x3e, 0xb6, 0x8d, 0x7f, 0xc7, 0xb6, 0x71, 0x72, 0x69,
+	0xa7, 0xce, 0x2f, 0xed, 0xd4, 0xdf, 0x97, 0x76, 0xea, 0xeb, 0x97, 0x53, 0xf6, 0x57, 0x6c, 0xad,
+	0x51, 0xab, 0xba, 0x87, 0x13, 0x25, 0x79, 0x0d, 0xba, 0x39, 0x79, 0xfb, 0x3f, 0xfd, 0x7f, 0x00,
+	0x55, 0xaa, 0x14, 0x92, 0x6d, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -191,6 +560,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(ctx context.Context, in *QueryBalancesRequest, opts ...grpc.CallOption) (*QueryBalancesResponse, error)
+	// Schedule retrieves the lockup and vesting schedules of a vesting account
+	// with absolute times, the status of each period and the next vesting and
+	// unlock events
+	Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error)
+	// Params retrieves the total set of vesting parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -210,10 +585,34 @@ func (c *queryClient) Balances(ctx context.Context, in *QueryBalancesRequest, op
 	return out, nil
 }
 
+func (c *queryClient) Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error) {
+	out := new(QueryScheduleResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Query/Schedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balances retrieves the unvested, vested and locked tokens for a vesting account
 	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// Schedule retrieves the lockup and vesting schedules of a vesting account
+	// with absolute times, the status of each period and the next vesting and
+	// unlock events
+	Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error)
+	// Params retrieves the total set of vesting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -223,6 +622,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Balances(ctx context.Context, req *QueryBalancesRequest) (*QueryBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
 }
+func (*UnimplementedQueryServer) Schedule(ctx context.Context, req *QueryScheduleRequest) (*QueryScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedule not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -246,6 +651,42 @@ func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Schedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Query/Schedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedule(ctx, req.(*QueryScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -254,6 +695,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Balances",
 			Handler:    _Query_Balances_Handler,
 		},
+		{
+			MethodName: "Schedule",
+			Handler:    _Query_Schedule_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v1/query.proto",
@@ -354,33 +803,279 @@ func (m *QueryBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextUnlock != nil {
+		{
+			size, err := m.NextUnlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NextVesting != nil {
+		{
+			size, err := m.NextVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Length != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x10
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -405,6 +1100,101 @@ func (m *QueryBalancesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextVesting != nil {
+		l = m.NextVesting.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextUnlock != nil {
+		l = m.NextUnlock.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulePeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Length != 0 {
+		n += 1 + sovQuery(uint64(m.Length))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -645,6 +1435,666 @@ func (m *QueryBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, SchedulePeriod{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, SchedulePeriod{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextVesting == nil {
+				m.NextVesting = &SchedulePeriod{}
+			}
+			if err := m.NextVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextUnlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextUnlock == nil {
+				m.NextUnlock = &SchedulePeriod{}
+			}
+			if err := m.NextUnlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PeriodStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
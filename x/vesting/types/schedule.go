@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
@@ -43,6 +45,51 @@ func ReadSchedule(
 	return coins
 }
 
+// ReadSchedulePeriods returns the periods of a schedule with the absolute time
+// of their events, the value of the schedule after each event and the status
+// of each period at readTime. The value after an event is the sum of the
+// amounts of the periods up to it, which includes zero-length periods.
+func ReadSchedulePeriods(
+	startTime int64,
+	periods sdkvesting.Periods,
+	readTime int64,
+) []SchedulePeriod {
+	schedulePeriods := make([]SchedulePeriod, len(periods))
+	eventTime := startTime
+	total := sdk.Coins{}
+
+	for i, period := range periods {
+		eventTime += period.Length
+		total = total.Add(period.Amount...)
+
+		status := PeriodStatusPending
+		if readTime > startTime && readTime >= eventTime {
+			status = PeriodStatusPassed
+		}
+
+		schedulePeriods[i] = SchedulePeriod{
+			Time:   time.Unix(eventTime, 0).UTC(),
+			Length: period.Length,
+			Amount: period.Amount,
+			Total:  total,
+			Status: status,
+		}
+	}
+
+	return schedulePeriods
+}
+
+// NextSchedulePeriod returns the first pending period of a schedule or nil if
+// all periods have passed.
+func NextSchedulePeriod(periods []SchedulePeriod) *SchedulePeriod {
+	for _, period := range periods {
+		if period.Status == PeriodStatusPending {
+			return &period
+		}
+	}
+	return nil
+}
+
 // ReadPastPeriodCount returns the amount of passed periods before read time
 func ReadPastPeriodCount(
 	startTime, endTime int64,
@@ -521,3 +521,79 @@ func (suite *ScheduleTestSuite) TestAlignSchedules() {
 		})
 	}
 }
+
+func (suite *ScheduleTestSuite) TestReadSchedulePeriods() {
+	periods := sdkvesting.Periods{period(10, 100), period(20, 200), period(30, 300)}
+
+	testCases := []struct {
+		name        string
+		readTime    int64
+		expStatuses []PeriodStatus
+		expNext     int
+	}{
+		{
+			name:        "before start time",
+			readTime:    0,
+			expStatuses: []PeriodStatus{PeriodStatusPending, PeriodStatusPending, PeriodStatusPending},
+			expNext:     0,
+		},
+		{
+			name:        "at the first event",
+			readTime:    110,
+			expStatuses: []PeriodStatus{PeriodStatusPassed, PeriodStatusPending, PeriodStatusPending},
+			expNext:     1,
+		},
+		{
+			name:        "between events",
+			readTime:    135,
+			expStatuses: []PeriodStatus{PeriodStatusPassed, PeriodStatusPassed, PeriodStatusPending},
+			expNext:     2,
+		},
+		{
+			name:        "after end time",
+			readTime:    200,
+			expStatuses: []PeriodStatus{PeriodStatusPassed, PeriodStatusPassed, PeriodStatusPassed},
+			expNext:     -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			schedulePeriods := ReadSchedulePeriods(100, periods, tc.readTime)
+			suite.Require().Len(schedulePeriods, len(periods))
+
+			expTimes := []int64{110, 130, 160}
+			expTotals := []int64{100, 300, 600}
+			for i, schedulePeriod := range schedulePeriods {
+				suite.Require().Equal(expTimes[i], schedulePeriod.Time.Unix())
+				suite.Require().Equal(periods[i].Length, schedulePeriod.Length)
+				suite.Require().Equal(periods[i].Amount, schedulePeriod.Amount)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, expTotals[i])), schedulePeriod.Total)
+				suite.Require().Equal(tc.expStatuses[i], schedulePeriod.Status)
+			}
+
+			next := NextSchedulePeriod(schedulePeriods)
+			if tc.expNext < 0 {
+				suite.Require().Nil(next)
+			} else {
+				suite.Require().Equal(schedulePeriods[tc.expNext], *next)
+			}
+		})
+	}
+}
+
+func (suite *ScheduleTestSuite) TestReadSchedulePeriodsZeroLength() {
+	// an immediate unlock is a zero-length period at the start time
+	periods := sdkvesting.Periods{period(0, 100), period(20, 200)}
+
+	schedulePeriods := ReadSchedulePeriods(100, periods, 110)
+	suite.Require().Len(schedulePeriods, len(periods))
+
+	suite.Require().Equal(int64(100), schedulePeriods[0].Time.Unix())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), schedulePeriods[0].Total)
+	suite.Require().Equal(PeriodStatusPassed, schedulePeriods[0].Status)
+
+	suite.Require().Equal(int64(120), schedulePeriods[1].Time.Unix())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)), schedulePeriods[1].Total)
+	suite.Require().Equal(PeriodStatusPending, schedulePeriods[1].Status)
+}
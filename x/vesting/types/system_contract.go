@@ -0,0 +1,186 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// System contract methods
+const (
+	SystemContractMethodCreateClawbackVestingAccount = "createClawbackVestingAccount"
+	SystemContractMethodClawback                     = "clawback"
+	SystemContractMethodUpdateVestingFunder          = "updateVestingFunder"
+	SystemContractMethodCancelClawback               = "cancelClawback"
+	SystemContractMethodApproveFunder                = "approveFunder"
+)
+
+// systemContractABIJSON defines the ABI of the vesting system contract. The
+// periods of a vesting grant are given as (length, amount) tuples, with the
+// length in seconds and the amount as a list of (denom, amount) coins. Times
+// are given as unix timestamps in seconds. The optional arguments of the
+// vesting msgs are left unset with their zero values.
+const systemContractABIJSON = `[
+  {
+    "type": "function",
+    "name": "createClawbackVestingAccount",
+    "stateMutability": "nonpayable",
+    "inputs": [
+      {"name": "vestingAddress", "type": "address"},
+      {"name": "startTime", "type": "uint64"},
+      {
+        "name": "lockupPeriods",
+        "type": "tuple[]",
+        "components": [
+          {"name": "length", "type": "int64"},
+          {
+            "name": "amount",
+            "type": "tuple[]",
+            "components": [
+              {"name": "denom", "type": "string"},
+              {"name": "amount", "type": "uint256"}
+            ]
+          }
+        ]
+      },
+      {
+        "name": "vestingPeriods",
+        "type": "tuple[]",
+        "components": [
+          {"name": "length", "type": "int64"},
+          {
+            "name": "amount",
+            "type": "tuple[]",
+            "components": [
+              {"name": "denom", "type": "string"},
+              {"name": "amount", "type": "uint256"}
+            ]
+          }
+        ]
+      },
+      {"name": "merge", "type": "bool"}
+    ],
+    "outputs": []
+  },
+  {
+    "type": "function",
+    "name": "clawback",
+    "stateMutability": "nonpayable",
+    "inputs": [
+      {"name": "accountAddress", "type": "address"},
+      {"name": "destAddress", "type": "address"},
+      {
+        "name": "amount",
+        "type": "tuple[]",
+        "components": [
+          {"name": "denom", "type": "string"},
+          {"name": "amount", "type": "uint256"}
+        ]
+      },
+      {"name": "cutoffPeriod", "type": "uint64"},
+      {"name": "effectiveTime", "type": "uint64"}
+    ],
+    "outputs": []
+  },
+  {
+    "type": "function",
+    "name": "cancelClawback",
+    "stateMutability": "nonpayable",
+    "inputs": [
+      {"name": "accountAddress", "type": "address"},
+      {"name": "effectiveTime", "type": "uint64"}
+    ],
+    "outputs": []
+  },
+  {
+    "type": "function",
+    "name": "approveFunder",
+    "stateMutability": "nonpayable",
+    "inputs": [
+      {"name": "funderAddress", "type": "address"},
+      {"name": "revoke", "type": "bool"}
+    ],
+    "outputs": []
+  },
+  {
+    "type": "function",
+    "name": "updateVestingFunder",
+    "stateMutability": "nonpayable",
+    "inputs": [
+      {"name": "newFunderAddress", "type": "address"},
+      {"name": "vestingAddress", "type": "address"}
+    ],
+    "outputs": []
+  }
+]`
+
+var (
+	// SystemContractAddress is the address of the vesting system contract
+	SystemContractAddress = common.HexToAddress("0x0000000000000000000000000000000000000803")
+
+	// SystemContractABI is the ABI of the vesting system contract
+	SystemContractABI abi.ABI
+)
+
+func init() {
+	var err error
+	SystemContractABI, err = abi.JSON(strings.NewReader(systemContractABIJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// SystemContractCoin defines a coin of a period in the system contract ABI
+type SystemContractCoin struct {
+	Denom  string
+	Amount *big.Int
+}
+
+// SystemContractPeriod defines a period of a vesting grant in the system
+// contract ABI
+type SystemContractPeriod struct {
+	Length int64
+	Amount []SystemContractCoin
+}
+
+// CreateClawbackVestingAccountArgs defines the arguments of the
+// createClawbackVestingAccount system contract method
+type CreateClawbackVestingAccountArgs struct {
+	VestingAddress common.Address
+	StartTime      uint64
+	LockupPeriods  []SystemContractPeriod
+	VestingPeriods []SystemContractPeriod
+	Merge          bool
+}
+
+// ClawbackArgs defines the arguments of the clawback system contract method
+type ClawbackArgs struct {
+	AccountAddress common.Address
+	DestAddress    common.Address
+	Amount         []SystemContractCoin
+	CutoffPeriod   uint64
+	EffectiveTime  uint64
+}
+
+// CancelClawbackArgs defines the arguments of the cancelClawback system
+// contract method
+type CancelClawbackArgs struct {
+	AccountAddress common.Address
+	EffectiveTime  uint64
+}
+
+// ApproveFunderArgs defines the arguments of the approveFunder system contract
+// method, which is called by the clawback vesting account
+type ApproveFunderArgs struct {
+	FunderAddress common.Address
+	Revoke        bool
+}
+
+// UpdateVestingFunderArgs defines the arguments of the updateVestingFunder
+// system contract method
+type UpdateVestingFunderArgs struct {
+	NewFunderAddress common.Address
+	VestingAddress   common.Address
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -52,8 +54,8 @@ type MsgCreateClawbackVestingAccount struct {
 	// merge specifies a the creation mechanism for existing
 	// ClawbackVestingAccounts. If true, merge this new grant into an existing
 	// ClawbackVestingAccount, or create it if it does not exist. If false,
-	// creates a new account. New grants to an existing account must be from the
-	// same from_address.
+	// creates a new account. New grants from another from_address are tracked as
+	// separate grants of the account.
 	Merge bool `protobuf:"varint,6,opt,name=merge,proto3" json:"merge,omitempty"`
 }
 
@@ -184,6 +186,19 @@ type MsgClawback struct {
 	// to. If empty, the tokens will be transferred back to the original funder of
 	// the account.
 	DestAddress string `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	// amount is the optional amount of unvested coins to claw back. The coins
+	// are removed from the last vesting periods first. If empty, all unvested
+	// coins are clawed back.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// cutoff_period is the optional index of the first vesting period to claw
+	// back. The unvested coins of this period and the following ones are
+	// clawed back, while earlier periods keep vesting. It can't be set together
+	// with amount.
+	CutoffPeriod uint64 `protobuf:"varint,5,opt,name=cutoff_period,json=cutoffPeriod,proto3" json:"cutoff_period,omitempty"`
+	// effective_time is the optional time at which the clawback is executed. If
+	// it is after the block time, the clawback is stored as pending and executed
+	// in the EndBlocker of the first block at or after the effective time.
+	EffectiveTime *time.Time `protobuf:"bytes,6,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time,omitempty"`
 }
 
 func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
@@ -240,6 +255,27 @@ func (m *MsgClawback) GetDestAddress() string {
 	return ""
 }
 
+func (m *MsgClawback) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgClawback) GetCutoffPeriod() uint64 {
+	if m != nil {
+		return m.CutoffPeriod
+	}
+	return 0
+}
+
+func (m *MsgClawback) GetEffectiveTime() *time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return nil
+}
+
 // MsgClawbackResponse defines the MsgClawback response type.
 type MsgClawbackResponse struct {
 }
@@ -380,6 +416,212 @@ func (m *MsgUpdateVestingFunderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateVestingFunderResponse proto.InternalMessageInfo
 
+// MsgCancelClawback defines a message that removes a pending clawback from a
+// ClawbackVestingAccount.
+type MsgCancelClawback struct {
+	// funder_address is the address of the funder that scheduled the clawback
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// account_address is the address of the ClawbackVestingAccount of the
+	// pending clawback
+	AccountAddress string `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	// effective_time is the time at which the pending clawback is scheduled
+	EffectiveTime time.Time `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *MsgCancelClawback) Reset()         { *m = MsgCancelClawback{} }
+func (m *MsgCancelClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelClawback) ProtoMessage()    {}
+func (*MsgCancelClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{6}
+}
+func (m *MsgCancelClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelClawback.Merge(m, src)
+}
+func (m *MsgCancelClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelClawback proto.InternalMessageInfo
+
+func (m *MsgCancelClawback) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelClawback) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelClawback) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// MsgCancelClawbackResponse defines the MsgCancelClawback response type.
+type MsgCancelClawbackResponse struct {
+}
+
+func (m *MsgCancelClawbackResponse) Reset()         { *m = MsgCancelClawbackResponse{} }
+func (m *MsgCancelClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelClawbackResponse) ProtoMessage()    {}
+func (*MsgCancelClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{7}
+}
+func (m *MsgCancelClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelClawbackResponse.Merge(m, src)
+}
+func (m *MsgCancelClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelClawbackResponse proto.InternalMessageInfo
+
+// MsgApproveFunder defines a message that approves a new funder to add a grant
+// to a ClawbackVestingAccount, or revokes the approval.
+type MsgApproveFunder struct {
+	// vesting_address is the address of the ClawbackVestingAccount that signs
+	// the approval
+	VestingAddress string `protobuf:"bytes,1,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// funder_address is the address of the funder to approve
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// revoke removes the approval of the funder instead
+	Revoke bool `protobuf:"varint,3,opt,name=revoke,proto3" json:"revoke,omitempty"`
+}
+
+func (m *MsgApproveFunder) Reset()         { *m = MsgApproveFunder{} }
+func (m *MsgApproveFunder) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveFunder) ProtoMessage()    {}
+func (*MsgApproveFunder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{8}
+}
+func (m *MsgApproveFunder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveFunder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveFunder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveFunder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveFunder.Merge(m, src)
+}
+func (m *MsgApproveFunder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveFunder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveFunder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveFunder proto.InternalMessageInfo
+
+func (m *MsgApproveFunder) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *MsgApproveFunder) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgApproveFunder) GetRevoke() bool {
+	if m != nil {
+		return m.Revoke
+	}
+	return false
+}
+
+// MsgApproveFunderResponse defines the MsgApproveFunder response type.
+type MsgApproveFunderResponse struct {
+}
+
+func (m *MsgApproveFunderResponse) Reset()         { *m = MsgApproveFunderResponse{} }
+func (m *MsgApproveFunderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveFunderResponse) ProtoMessage()    {}
+func (*MsgApproveFunderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5db113bc0c7240c, []int{9}
+}
+func (m *MsgApproveFunderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveFunderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveFunderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveFunderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveFunderResponse.Merge(m, src)
+}
+func (m *MsgApproveFunderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveFunderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveFunderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveFunderResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "evmos.vesting.v1.MsgCreateClawbackVestingAccount")
 	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "evmos.vesting.v1.MsgCreateClawbackVestingAccountResponse")
@@ -387,54 +629,73 @@ func init() {
 	proto.RegisterType((*MsgClawbackResponse)(nil), "evmos.vesting.v1.MsgClawbackResponse")
 	proto.RegisterType((*MsgUpdateVestingFunder)(nil), "evmos.vesting.v1.MsgUpdateVestingFunder")
 	proto.RegisterType((*MsgUpdateVestingFunderResponse)(nil), "evmos.vesting.v1.MsgUpdateVestingFunderResponse")
+	proto.RegisterType((*MsgCancelClawback)(nil), "evmos.vesting.v1.MsgCancelClawback")
+	proto.RegisterType((*MsgCancelClawbackResponse)(nil), "evmos.vesting.v1.MsgCancelClawbackResponse")
+	proto.RegisterType((*MsgApproveFunder)(nil), "evmos.vesting.v1.MsgApproveFunder")
+	proto.RegisterType((*MsgApproveFunderResponse)(nil), "evmos.vesting.v1.MsgApproveFunderResponse")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v1/tx.proto", fileDescriptor_d5db113bc0c7240c) }
 
 var fileDescriptor_d5db113bc0c7240c = []byte{
-	// 664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0x3b, 0x14, 0x08, 0x4c, 0xa1, 0x90, 0x05, 0x4d, 0x6d, 0x60, 0xb7, 0x34, 0x12, 0x2a,
-	0xc1, 0x1d, 0x5a, 0x8d, 0x09, 0xc6, 0x0b, 0x60, 0xb8, 0x91, 0x98, 0x46, 0x3d, 0x78, 0x69, 0xa6,
-	0xbb, 0xc3, 0xb2, 0x81, 0xdd, 0xd9, 0xec, 0xcc, 0xb6, 0x78, 0x33, 0x9e, 0x8c, 0x27, 0x12, 0x13,
-	0xcf, 0x5e, 0xbc, 0xf8, 0x97, 0x10, 0x4f, 0x24, 0x5e, 0x3c, 0x18, 0x31, 0xe0, 0xc1, 0xbb, 0xff,
-	0x80, 0xd9, 0xf9, 0xb1, 0x42, 0xb3, 0x01, 0xbc, 0x78, 0xea, 0xce, 0x7b, 0xdf, 0x79, 0xef, 0xd3,
-	0xef, 0x9b, 0x19, 0x78, 0x8b, 0xf4, 0x02, 0xca, 0x50, 0x8f, 0x30, 0xee, 0x87, 0x1e, 0xea, 0x35,
-	0x11, 0x3f, 0xb0, 0xa3, 0x98, 0x72, 0x6a, 0x4c, 0x8b, 0x94, 0xad, 0x52, 0x76, 0xaf, 0x59, 0xbd,
-	0xed, 0x50, 0x76, 0x51, 0xdd, 0x25, 0x1c, 0x37, 0xf5, 0x5a, 0xee, 0xab, 0xce, 0x7a, 0xd4, 0xa3,
-	0xe2, 0x13, 0xa5, 0x5f, 0x2a, 0x3a, 0xe7, 0x51, 0xea, 0xed, 0x13, 0x84, 0x23, 0x1f, 0xe1, 0x30,
-	0xa4, 0x1c, 0x73, 0x9f, 0x86, 0x4c, 0x65, 0x2d, 0x95, 0x15, 0xab, 0x6e, 0xb2, 0x83, 0xb8, 0x1f,
-	0x10, 0xc6, 0x71, 0x10, 0x49, 0x41, 0xfd, 0x5b, 0x11, 0x5a, 0xdb, 0xcc, 0xdb, 0x8c, 0x09, 0xe6,
-	0x64, 0x73, 0x1f, 0xf7, 0xbb, 0xd8, 0xd9, 0x7b, 0x2e, 0xfb, 0xae, 0x3b, 0x0e, 0x4d, 0x42, 0x6e,
-	0x2c, 0xc0, 0x89, 0x9d, 0x98, 0x06, 0x1d, 0xec, 0xba, 0x31, 0x61, 0xac, 0x02, 0x6a, 0xa0, 0x31,
-	0xde, 0x2e, 0xa5, 0xb1, 0x75, 0x19, 0x32, 0xe6, 0x21, 0xe4, 0x34, 0x13, 0x0c, 0x09, 0xc1, 0x38,
-	0xa7, 0x3a, 0xbd, 0x09, 0x21, 0xe3, 0x38, 0xe6, 0x9d, 0xb4, 0x7d, 0xa5, 0x58, 0x03, 0x8d, 0x52,
-	0xab, 0x6a, 0x4b, 0x36, 0x5b, 0xb3, 0xd9, 0x4f, 0x35, 0xdb, 0xc6, 0xd8, 0xd1, 0x77, 0xab, 0x70,
-	0x78, 0x62, 0x81, 0xf6, 0xb8, 0xd8, 0x97, 0x66, 0x8c, 0x37, 0x00, 0x96, 0xf7, 0xa9, 0xb3, 0x97,
-	0x44, 0x9d, 0x88, 0xc4, 0x3e, 0x75, 0x59, 0x65, 0xb8, 0x56, 0x6c, 0x94, 0x5a, 0xa6, 0x2d, 0xfd,
-	0x3b, 0x67, 0xa9, 0xf0, 0xcf, 0x7e, 0x22, 0x64, 0x1b, 0xeb, 0x69, 0xb5, 0x4f, 0x27, 0xd6, 0x9a,
-	0xe7, 0xf3, 0xdd, 0xa4, 0x6b, 0x3b, 0x34, 0x40, 0xca, 0x71, 0xf9, 0x73, 0x97, 0xb9, 0x7b, 0xe8,
-	0x00, 0xe1, 0x84, 0xef, 0x66, 0x33, 0xe0, 0x2f, 0x23, 0xc2, 0x54, 0x05, 0xd6, 0x9e, 0x94, 0x8d,
-	0xd5, 0xd2, 0x78, 0x0b, 0xe0, 0x94, 0x12, 0x66, 0x2c, 0x23, 0xff, 0x8b, 0xa5, 0xac, 0xc2, 0x1a,
-	0x66, 0x16, 0x8e, 0x04, 0x24, 0xf6, 0x48, 0x65, 0xb4, 0x06, 0x1a, 0x63, 0x6d, 0xb9, 0x78, 0x38,
-	0xfc, 0xeb, 0x83, 0x55, 0xa8, 0xdf, 0x81, 0x4b, 0x57, 0x4c, 0xb7, 0x4d, 0x58, 0x44, 0x43, 0x46,
-	0xea, 0xaf, 0x00, 0x2c, 0xa5, 0x5a, 0xa5, 0x32, 0x16, 0x61, 0x79, 0x27, 0x09, 0x5d, 0x12, 0x0f,
-	0xcc, 0x7d, 0x52, 0x46, 0xf5, 0x68, 0x97, 0xe0, 0x14, 0x96, 0x95, 0x06, 0xc6, 0x5f, 0x56, 0x61,
-	0x2d, 0x5c, 0x80, 0x13, 0x2e, 0x61, 0x7f, 0x55, 0x45, 0x79, 0x8a, 0xd2, 0x98, 0x92, 0xd4, 0x6f,
-	0xc0, 0x99, 0x73, 0x04, 0x19, 0xd9, 0x7b, 0x00, 0x6f, 0x6e, 0x33, 0xef, 0x59, 0xe4, 0x62, 0x4e,
-	0x14, 0xfd, 0x96, 0x80, 0xb8, 0x2e, 0xe4, 0x0a, 0x34, 0x42, 0xd2, 0xef, 0x0c, 0x48, 0x25, 0xe7,
-	0x74, 0x48, 0xfa, 0x5b, 0x83, 0x7f, 0x49, 0x0f, 0xf7, 0x22, 0xac, 0x76, 0x5e, 0xf3, 0xd6, 0xa0,
-	0x99, 0xcf, 0xa5, 0xd1, 0x5b, 0xbf, 0x8b, 0xb0, 0xb8, 0xcd, 0x3c, 0xe3, 0x33, 0x80, 0x73, 0x97,
-	0xde, 0xb1, 0xa6, 0x3d, 0xf8, 0x2a, 0xd8, 0x57, 0x0c, 0xae, 0xba, 0xf6, 0xcf, 0x5b, 0x32, 0x47,
-	0x1f, 0xbd, 0xfe, 0xf2, 0xf3, 0xdd, 0xd0, 0x03, 0xe3, 0x3e, 0xca, 0x79, 0xa6, 0x90, 0x23, 0x4a,
-	0x74, 0x1c, 0x55, 0xa3, 0x93, 0x99, 0xa1, 0x58, 0xfb, 0x70, 0x2c, 0x3b, 0x25, 0xf3, 0xf9, 0x10,
-	0x2a, 0x5d, 0x5d, 0xbc, 0x34, 0x9d, 0xf1, 0x2c, 0x0a, 0x1e, 0xcb, 0x98, 0xcf, 0xe7, 0xd1, 0xcd,
-	0x3e, 0x02, 0x38, 0x93, 0x77, 0x0a, 0x1a, 0xb9, 0x5d, 0x72, 0x94, 0xd5, 0xd5, 0xeb, 0x2a, 0x33,
-	0xb4, 0x96, 0x40, 0x5b, 0x31, 0x96, 0x73, 0xd1, 0x12, 0xb1, 0x33, 0x73, 0x48, 0x1e, 0xb0, 0x8d,
-	0xc7, 0x47, 0xa7, 0x26, 0x38, 0x3e, 0x35, 0xc1, 0x8f, 0x53, 0x13, 0x1c, 0x9e, 0x99, 0x85, 0xe3,
-	0x33, 0xb3, 0xf0, 0xf5, 0xcc, 0x2c, 0xbc, 0x58, 0x3e, 0x77, 0xed, 0x65, 0x3d, 0x55, 0xb5, 0xb9,
-	0x8a, 0x0e, 0x2e, 0xde, 0xf7, 0xee, 0xa8, 0x78, 0x18, 0xef, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff,
-	0xe8, 0x4a, 0xe2, 0x61, 0x4b, 0x06, 0x00, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc4, 0x89, 0x95, 0xbc, 0xc4, 0x6e, 0x98, 0x96, 0xca, 0x59, 0x9a, 0xb5, 0xeb, 0x34,
+	0xd4, 0xa4, 0x61, 0x37, 0x0e, 0x08, 0xa9, 0x88, 0x4b, 0x12, 0x54, 0x0e, 0x28, 0x12, 0xb2, 0x80,
+	0x03, 0x17, 0x6b, 0xbc, 0x1e, 0x6f, 0x57, 0x89, 0x77, 0x56, 0x3b, 0x63, 0x27, 0x70, 0xe4, 0x84,
+	0x10, 0x87, 0x48, 0x08, 0xce, 0x5c, 0xb8, 0x70, 0xe2, 0x67, 0x54, 0x9c, 0x2a, 0xf5, 0xc2, 0x01,
+	0x51, 0x94, 0x70, 0xe0, 0x67, 0xa0, 0x9d, 0x99, 0x9d, 0xc6, 0xdb, 0x51, 0x9c, 0x1e, 0xe0, 0x64,
+	0xcf, 0x7b, 0xdf, 0x7b, 0xf3, 0xcd, 0xf7, 0xbd, 0xd9, 0x81, 0x35, 0x3a, 0x19, 0x31, 0xee, 0x4f,
+	0x28, 0x17, 0x51, 0x1c, 0xfa, 0x93, 0x8e, 0x2f, 0x4e, 0xbd, 0x24, 0x65, 0x82, 0xe1, 0x55, 0x99,
+	0xf2, 0x74, 0xca, 0x9b, 0x74, 0x1c, 0x37, 0x60, 0x3c, 0x43, 0xf7, 0x09, 0xa7, 0xfe, 0xa4, 0xd3,
+	0xa7, 0x82, 0x74, 0xfc, 0x80, 0x45, 0xb1, 0xaa, 0x70, 0xee, 0xe9, 0xfc, 0x8b, 0x6e, 0x0a, 0x92,
+	0xb7, 0x50, 0xa8, 0x5b, 0x21, 0x0b, 0x99, 0xfc, 0xeb, 0x67, 0xff, 0x74, 0xf4, 0x4e, 0xc8, 0x58,
+	0x78, 0x4c, 0x7d, 0x92, 0x44, 0x3e, 0x89, 0x63, 0x26, 0x88, 0x88, 0x58, 0xcc, 0x75, 0xb6, 0xa1,
+	0xb3, 0x72, 0xd5, 0x1f, 0x0f, 0x7d, 0x11, 0x8d, 0x28, 0x17, 0x64, 0x94, 0x28, 0x40, 0xeb, 0x8f,
+	0x32, 0x34, 0x0e, 0x79, 0x78, 0x90, 0x52, 0x22, 0xe8, 0xc1, 0x31, 0x39, 0xe9, 0x93, 0xe0, 0xe8,
+	0x73, 0xb5, 0xef, 0x5e, 0x10, 0xb0, 0x71, 0x2c, 0xf0, 0x5d, 0x58, 0x19, 0xa6, 0x6c, 0xd4, 0x23,
+	0x83, 0x41, 0x4a, 0x39, 0xaf, 0xa3, 0x26, 0x6a, 0x2f, 0x75, 0x97, 0xb3, 0xd8, 0x9e, 0x0a, 0xe1,
+	0x75, 0x00, 0xc1, 0x0c, 0x60, 0x4e, 0x02, 0x96, 0x04, 0xcb, 0xd3, 0x07, 0x00, 0x5c, 0x90, 0x54,
+	0xf4, 0xb2, 0xed, 0xeb, 0xe5, 0x26, 0x6a, 0x2f, 0xef, 0x3a, 0x9e, 0xe2, 0xe6, 0xe5, 0xdc, 0xbc,
+	0x4f, 0x73, 0x6e, 0xfb, 0x8b, 0x4f, 0xfe, 0x6c, 0x94, 0xce, 0x9e, 0x37, 0x50, 0x77, 0x49, 0xd6,
+	0x65, 0x19, 0xfc, 0x0d, 0x82, 0xda, 0x31, 0x0b, 0x8e, 0xc6, 0x49, 0x2f, 0xa1, 0x69, 0xc4, 0x06,
+	0xbc, 0x3e, 0xdf, 0x2c, 0xb7, 0x97, 0x77, 0x5d, 0x4f, 0xe9, 0x77, 0x49, 0x72, 0xa9, 0x9f, 0xf7,
+	0x89, 0x84, 0xed, 0xef, 0x65, 0xdd, 0x7e, 0x79, 0xde, 0x78, 0x18, 0x46, 0xe2, 0xf1, 0xb8, 0xef,
+	0x05, 0x6c, 0xe4, 0x6b, 0xc5, 0xd5, 0xcf, 0xdb, 0x7c, 0x70, 0xe4, 0x9f, 0xfa, 0x64, 0x2c, 0x1e,
+	0x1b, 0x0f, 0xc4, 0x97, 0x09, 0xe5, 0xba, 0x03, 0xef, 0x56, 0xd5, 0xc6, 0x7a, 0x89, 0xbf, 0x45,
+	0x70, 0x43, 0x03, 0x0d, 0x97, 0x85, 0xff, 0x8b, 0x4b, 0x4d, 0x87, 0x73, 0x32, 0xb7, 0x60, 0x61,
+	0x44, 0xd3, 0x90, 0xd6, 0x2b, 0x4d, 0xd4, 0x5e, 0xec, 0xaa, 0xc5, 0xfb, 0xf3, 0xff, 0xfc, 0xd4,
+	0x28, 0xb5, 0xde, 0x82, 0xfb, 0x33, 0xdc, 0xed, 0x52, 0x9e, 0xb0, 0x98, 0xd3, 0xd6, 0xb3, 0x39,
+	0x58, 0xce, 0xb0, 0x1a, 0x85, 0x37, 0xa1, 0x36, 0x1c, 0xc7, 0x03, 0x9a, 0x16, 0x7c, 0xaf, 0xaa,
+	0x68, 0x6e, 0xed, 0x7d, 0xb8, 0x41, 0x54, 0xa7, 0x82, 0xfd, 0x35, 0x1d, 0xce, 0x81, 0x77, 0x61,
+	0x65, 0x40, 0xf9, 0x0b, 0x54, 0x59, 0x4d, 0x51, 0x16, 0xcb, 0x21, 0x01, 0x54, 0xc8, 0x28, 0xab,
+	0xd1, 0xc6, 0xae, 0xe5, 0x62, 0x66, 0x17, 0xc7, 0x28, 0x79, 0xc0, 0xa2, 0x78, 0x7f, 0x47, 0xeb,
+	0xd8, 0xbe, 0x52, 0x47, 0x25, 0x5c, 0x56, 0xc0, 0xbb, 0xba, 0x35, 0xde, 0x80, 0x6a, 0x30, 0x16,
+	0x6c, 0x38, 0xd4, 0xce, 0xd5, 0x17, 0x9a, 0xa8, 0x3d, 0xdf, 0x5d, 0x51, 0x41, 0x25, 0x2a, 0xfe,
+	0x08, 0x6a, 0x74, 0x38, 0xa4, 0x81, 0x88, 0x26, 0x54, 0x0d, 0x6d, 0x65, 0xe6, 0xd0, 0xce, 0xcb,
+	0x81, 0xad, 0x9a, 0xba, 0x2c, 0xd3, 0x7a, 0x1d, 0x6e, 0x5e, 0x12, 0xd5, 0x88, 0xfd, 0x23, 0x82,
+	0xdb, 0x87, 0x3c, 0xfc, 0x2c, 0x19, 0x10, 0x41, 0xb5, 0x21, 0x8f, 0xa4, 0xae, 0xd7, 0xd5, 0x7d,
+	0x1b, 0x70, 0x4c, 0x4f, 0x7a, 0x05, 0xa8, 0x92, 0x7e, 0x35, 0xa6, 0x27, 0x8f, 0x8a, 0x2e, 0xe5,
+	0xf3, 0x3a, 0xad, 0x7f, 0x3e, 0x4c, 0x1a, 0xd8, 0x6a, 0x82, 0x6b, 0xe7, 0x65, 0xa8, 0xff, 0x8a,
+	0xe0, 0xb5, 0xec, 0x48, 0x24, 0x0e, 0xe8, 0xf1, 0x7f, 0x36, 0x2d, 0x1f, 0xbf, 0x64, 0xc0, 0xab,
+	0x7c, 0x35, 0x0a, 0x26, 0xbc, 0x01, 0x6b, 0x2f, 0x31, 0x36, 0xe7, 0xf9, 0x0a, 0x56, 0x0f, 0x79,
+	0xb8, 0x97, 0x24, 0x29, 0x9b, 0x50, 0xed, 0x81, 0x45, 0x2e, 0x64, 0x93, 0xcb, 0x72, 0xec, 0x39,
+	0xdb, 0xb1, 0x6f, 0x43, 0x25, 0xa5, 0x13, 0x76, 0xa4, 0x4e, 0xb1, 0xd8, 0xd5, 0xab, 0x96, 0x03,
+	0xf5, 0xe2, 0xde, 0x39, 0xaf, 0xdd, 0x1f, 0x2a, 0x50, 0x3e, 0xe4, 0x21, 0xfe, 0x0d, 0xc1, 0x9d,
+	0x2b, 0x3f, 0xcf, 0x1d, 0xaf, 0xf8, 0xe0, 0x78, 0x33, 0xee, 0xbc, 0xf3, 0xf0, 0x95, 0x4b, 0x8c,
+	0x5c, 0x1f, 0x7c, 0xfd, 0xec, 0xef, 0xef, 0xe7, 0xde, 0xc3, 0xef, 0xfa, 0x96, 0x17, 0xd0, 0x0f,
+	0x64, 0x8b, 0x5e, 0xa0, 0x7b, 0xf4, 0x8c, 0x8a, 0x9a, 0xeb, 0x09, 0x2c, 0x9a, 0x91, 0x59, 0xb7,
+	0x93, 0xd0, 0x69, 0x67, 0xf3, 0xca, 0xb4, 0xe1, 0xb3, 0x29, 0xf9, 0x34, 0xf0, 0xba, 0x9d, 0x4f,
+	0xbe, 0xd9, 0xcf, 0x08, 0x6e, 0xda, 0x6e, 0x5b, 0xdb, 0xba, 0x8b, 0x05, 0xe9, 0xec, 0x5c, 0x17,
+	0x69, 0xa8, 0xed, 0x4a, 0x6a, 0xdb, 0x78, 0xcb, 0x4a, 0x6d, 0x2c, 0x2b, 0x8d, 0x42, 0x6a, 0x60,
+	0xf0, 0x19, 0x82, 0x5a, 0xe1, 0x6a, 0x6d, 0xd8, 0x85, 0x98, 0x02, 0x39, 0x0f, 0xae, 0x01, 0x32,
+	0xc4, 0xb6, 0x25, 0xb1, 0x37, 0xf1, 0x3d, 0xbb, 0x66, 0xb2, 0xc8, 0x78, 0x88, 0xbf, 0x43, 0x50,
+	0x9d, 0xbe, 0x1e, 0x2d, 0xeb, 0x66, 0x53, 0x18, 0x67, 0x6b, 0x36, 0xc6, 0xf0, 0x79, 0x20, 0xf9,
+	0x6c, 0xe2, 0x0d, 0x2b, 0x1f, 0xa2, 0x6a, 0xb4, 0x42, 0xfb, 0x1f, 0x3e, 0x39, 0x77, 0xd1, 0xd3,
+	0x73, 0x17, 0xfd, 0x75, 0xee, 0xa2, 0xb3, 0x0b, 0xb7, 0xf4, 0xf4, 0xc2, 0x2d, 0xfd, 0x7e, 0xe1,
+	0x96, 0xbe, 0xd8, 0xba, 0xf4, 0x16, 0xa8, 0x46, 0xba, 0x5d, 0x67, 0xc7, 0x3f, 0x9d, 0x7e, 0x4c,
+	0xfb, 0x15, 0xf9, 0xfd, 0x78, 0xe7, 0xdf, 0x01, 0x00, 0x8f, 0xdc, 0x88, 0x5c, 0xc8, 0x09, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -457,6 +718,11 @@ type MsgClient interface {
 	// UpdateVestingFunder updates the funder address of an existing
 	// ClawbackVestingAccount.
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
+	// CancelClawback removes a pending clawback scheduled by the funder.
+	CancelClawback(ctx context.Context, in *MsgCancelClawback, opts ...grpc.CallOption) (*MsgCancelClawbackResponse, error)
+	// ApproveFunder approves or revokes the approval of a new funder to add a
+	// grant to a ClawbackVestingAccount.
+	ApproveFunder(ctx context.Context, in *MsgApproveFunder, opts ...grpc.CallOption) (*MsgApproveFunderResponse, error)
 }
 
 type msgClient struct {
@@ -494,6 +760,24 @@ func (c *msgClient) UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestin
 	return out, nil
 }
 
+func (c *msgClient) CancelClawback(ctx context.Context, in *MsgCancelClawback, opts ...grpc.CallOption) (*MsgCancelClawbackResponse, error) {
+	out := new(MsgCancelClawbackResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Msg/CancelClawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveFunder(ctx context.Context, in *MsgApproveFunder, opts ...grpc.CallOption) (*MsgApproveFunderResponse, error) {
+	out := new(MsgApproveFunderResponse)
+	err := c.cc.Invoke(ctx, "/evmos.vesting.v1.Msg/ApproveFunder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateClawbackVestingAccount creats a vesting account that is subject to
@@ -504,6 +788,11 @@ type MsgServer interface {
 	// UpdateVestingFunder updates the funder address of an existing
 	// ClawbackVestingAccount.
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
+	// CancelClawback removes a pending clawback scheduled by the funder.
+	CancelClawback(context.Context, *MsgCancelClawback) (*MsgCancelClawbackResponse, error)
+	// ApproveFunder approves or revokes the approval of a new funder to add a
+	// grant to a ClawbackVestingAccount.
+	ApproveFunder(context.Context, *MsgApproveFunder) (*MsgApproveFunderResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -519,6 +808,12 @@ func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (
 func (*UnimplementedMsgServer) UpdateVestingFunder(ctx context.Context, req *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateVestingFunder not implemented")
 }
+func (*UnimplementedMsgServer) CancelClawback(ctx context.Context, req *MsgCancelClawback) (*MsgCancelClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelClawback not implemented")
+}
+func (*UnimplementedMsgServer) ApproveFunder(ctx context.Context, req *MsgApproveFunder) (*MsgApproveFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFunder not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -578,6 +873,42 @@ func _Msg_UpdateVestingFunder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelClawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelClawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Msg/CancelClawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelClawback(ctx, req.(*MsgCancelClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveFunder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.vesting.v1.Msg/ApproveFunder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveFunder(ctx, req.(*MsgApproveFunder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.vesting.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -594,6 +925,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateVestingFunder",
 			Handler:    _Msg_UpdateVestingFunder_Handler,
 		},
+		{
+			MethodName: "CancelClawback",
+			Handler:    _Msg_CancelClawback_Handler,
+		},
+		{
+			MethodName: "ApproveFunder",
+			Handler:    _Msg_ApproveFunder_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/vesting/v1/tx.proto",
@@ -725,6 +1064,35 @@ func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EffectiveTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EffectiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EffectiveTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CutoffPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CutoffPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.DestAddress) > 0 {
 		i -= len(m.DestAddress)
 		copy(dAtA[i:], m.DestAddress)
@@ -839,47 +1207,185 @@ func (m *MsgUpdateVestingFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCancelClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCancelClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.LockupPeriods) > 0 {
-		for _, e := range m.LockupPeriods {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.VestingPeriods) > 0 {
-		for _, e := range m.VestingPeriods {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Merge {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveFunder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveFunder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveFunder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoke {
+		i--
+		if m.Revoke {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Merge {
+		n += 2
 	}
 	return n
 }
@@ -911,6 +1417,19 @@ func (m *MsgClawback) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CutoffPeriod != 0 {
+		n += 1 + sovTx(uint64(m.CutoffPeriod))
+	}
+	if m.EffectiveTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EffectiveTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -953,6 +1472,63 @@ func (m *MsgUpdateVestingFunderResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveFunder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Revoke {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgApproveFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -992,7 +1568,388 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Merge = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1002,29 +1959,31 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CutoffPeriod", wireType)
 			}
-			var stringLen uint64
+			m.CutoffPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1034,27 +1993,14 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CutoffPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1081,15 +2027,118 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if m.EffectiveTime == nil {
+				m.EffectiveTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1099,31 +2148,29 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
-			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1133,31 +2180,29 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Merge", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1167,12 +2212,24 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Merge = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1194,7 +2251,7 @@ func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1217,10 +2274,10 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1244,7 +2301,7 @@ func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1267,10 +2324,10 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1339,9 +2396,9 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1351,23 +2408,24 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1390,7 +2448,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1413,10 +2471,10 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1440,7 +2498,7 @@ func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveFunder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1463,15 +2521,15 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveFunder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveFunder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1499,11 +2557,11 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1531,13 +2589,13 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFunderAddress = string(dAtA[iNdEx:postIndex])
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoke", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1547,24 +2605,12 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VestingAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Revoke = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1586,7 +2632,7 @@ func (m *MsgUpdateVestingFunder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveFunderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1609,10 +2655,10 @@ func (m *MsgUpdateVestingFunderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveFunderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateVestingFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_x_auth_vesting_types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -45,6 +47,11 @@ type ClawbackVestingAccount struct {
 	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
 	// vesting_periods defines the vesting schedule relative to the start_time
 	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// grants defines the vesting grants of each funder of an account funded by
+	// multiple funders. The lockup and vesting periods of the account combine
+	// the periods of all grants. It is empty if the account has a single funder,
+	// whose grant is given by the account schedules.
+	Grants []Grant `protobuf:"bytes,6,rep,name=grants,proto3" json:"grants"`
 }
 
 func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
@@ -79,40 +86,295 @@ func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
 
+// Grant defines the vesting grant of a funder of a ClawbackVestingAccount
+type Grant struct {
+	// funder_address specifies the account which funded the grant and can claw
+	// back its unvested coins
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// start_time defines the time at which the vesting period of the grant
+	// begins
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// lockup_periods defines the unlocking schedule of the grant relative to the
+	// start_time
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,3,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the vesting schedule of the grant relative to the
+	// start_time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// original_vesting defines the total amount of the grant
+	OriginalVesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=original_vesting,json=originalVesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"original_vesting"`
+}
+
+func (m *Grant) Reset()         { *m = Grant{} }
+func (m *Grant) String() string { return proto.CompactTextString(m) }
+func (*Grant) ProtoMessage()    {}
+func (*Grant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f1a3c86c0cebe5f, []int{1}
+}
+func (m *Grant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Grant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Grant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Grant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Grant.Merge(m, src)
+}
+func (m *Grant) XXX_Size() int {
+	return m.Size()
+}
+func (m *Grant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Grant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Grant proto.InternalMessageInfo
+
+func (m *Grant) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *Grant) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Grant) GetLockupPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+func (m *Grant) GetVestingPeriods() github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+func (m *Grant) GetOriginalVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OriginalVesting
+	}
+	return nil
+}
+
+// PendingClawback defines a clawback from a ClawbackVestingAccount that is
+// scheduled for execution at its effective time.
+type PendingClawback struct {
+	// funder_address is the address of the funder that requested the clawback
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// account_address is the address of the ClawbackVestingAccount to claw back
+	// from
+	AccountAddress string `protobuf:"bytes,2,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty"`
+	// dest_address specifies where the clawed-back tokens are transferred to
+	DestAddress string `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	// amount is the amount of unvested coins to claw back. If empty, the
+	// cutoff_period applies.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// cutoff_period is the index of the first vesting period to claw back
+	CutoffPeriod uint64 `protobuf:"varint,5,opt,name=cutoff_period,json=cutoffPeriod,proto3" json:"cutoff_period,omitempty"`
+	// effective_time is the time at which the clawback is executed
+	EffectiveTime time.Time `protobuf:"bytes,6,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingClawback) Reset()         { *m = PendingClawback{} }
+func (m *PendingClawback) String() string { return proto.CompactTextString(m) }
+func (*PendingClawback) ProtoMessage()    {}
+func (*PendingClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f1a3c86c0cebe5f, []int{2}
+}
+func (m *PendingClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingClawback.Merge(m, src)
+}
+func (m *PendingClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingClawback proto.InternalMessageInfo
+
+func (m *PendingClawback) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *PendingClawback) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+func (m *PendingClawback) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+func (m *PendingClawback) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PendingClawback) GetCutoffPeriod() uint64 {
+	if m != nil {
+		return m.CutoffPeriod
+	}
+	return 0
+}
+
+func (m *PendingClawback) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// FunderApproval defines the approval of a ClawbackVestingAccount for a new
+// funder to add a grant to it. The approval is consumed by the first grant of
+// the funder.
+type FunderApproval struct {
+	// vesting_address is the address of the ClawbackVestingAccount
+	VestingAddress string `protobuf:"bytes,1,opt,name=vesting_address,json=vestingAddress,proto3" json:"vesting_address,omitempty"`
+	// funder_address is the address of the approved funder
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+}
+
+func (m *FunderApproval) Reset()         { *m = FunderApproval{} }
+func (m *FunderApproval) String() string { return proto.CompactTextString(m) }
+func (*FunderApproval) ProtoMessage()    {}
+func (*FunderApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f1a3c86c0cebe5f, []int{3}
+}
+func (m *FunderApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunderApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunderApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FunderApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunderApproval.Merge(m, src)
+}
+func (m *FunderApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunderApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunderApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunderApproval proto.InternalMessageInfo
+
+func (m *FunderApproval) GetVestingAddress() string {
+	if m != nil {
+		return m.VestingAddress
+	}
+	return ""
+}
+
+func (m *FunderApproval) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ClawbackVestingAccount)(nil), "evmos.vesting.v1.ClawbackVestingAccount")
+	proto.RegisterType((*Grant)(nil), "evmos.vesting.v1.Grant")
+	proto.RegisterType((*PendingClawback)(nil), "evmos.vesting.v1.PendingClawback")
+	proto.RegisterType((*FunderApproval)(nil), "evmos.vesting.v1.FunderApproval")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v1/vesting.proto", fileDescriptor_5f1a3c86c0cebe5f) }
 
 var fileDescriptor_5f1a3c86c0cebe5f = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
-	0x10, 0x8d, 0xd9, 0x82, 0x76, 0xbd, 0xda, 0x82, 0xa2, 0x15, 0x8a, 0x7a, 0x70, 0x2a, 0x04, 0x52,
-	0xb5, 0x12, 0x36, 0x5d, 0x4e, 0x70, 0x6b, 0x96, 0x0f, 0x40, 0x11, 0xe2, 0xc0, 0x25, 0xb2, 0x13,
-	0x6f, 0x36, 0x6a, 0x53, 0x47, 0xb1, 0x13, 0x96, 0x3f, 0xa8, 0x7a, 0xea, 0x91, 0x63, 0xcf, 0x7c,
-	0x49, 0x8f, 0x3d, 0x72, 0x6a, 0x51, 0xfb, 0x23, 0x28, 0xb6, 0x83, 0x5a, 0x10, 0xd7, 0x3d, 0x65,
-	0xe6, 0x8d, 0x67, 0xde, 0x7b, 0x99, 0x81, 0x88, 0xd7, 0xb9, 0x90, 0xa4, 0xe6, 0x52, 0x65, 0xd3,
-	0x94, 0xd4, 0xc3, 0x36, 0xc4, 0x45, 0x29, 0x94, 0x70, 0x9f, 0xe9, 0x3a, 0x6e, 0xc1, 0x7a, 0xd8,
-	0x7b, 0x19, 0x0b, 0x79, 0xdc, 0xc2, 0xb8, 0xa2, 0x7f, 0xf5, 0xf5, 0x2e, 0x53, 0x91, 0x0a, 0x1d,
-	0x92, 0x26, 0xb2, 0xa8, 0x9f, 0x0a, 0x91, 0x4e, 0x38, 0xd1, 0x19, 0xab, 0x6e, 0x89, 0xca, 0x72,
-	0x2e, 0x15, 0xcd, 0x0b, 0xf3, 0xe0, 0xc5, 0xbc, 0x03, 0x9f, 0xdf, 0x4c, 0xe8, 0x57, 0x46, 0xe3,
-	0xf1, 0x67, 0x33, 0x70, 0x14, 0xc7, 0xa2, 0x9a, 0x2a, 0x97, 0xc1, 0x4b, 0x46, 0x25, 0x8f, 0x2c,
-	0x4f, 0x44, 0x0d, 0xee, 0x81, 0x3e, 0x18, 0x9c, 0x5f, 0x5f, 0x61, 0x23, 0xeb, 0x40, 0xa9, 0x96,
-	0x85, 0x03, 0x2a, 0xf9, 0xf1, 0xa4, 0xa0, 0xb3, 0xde, 0xf8, 0x20, 0x74, 0xd9, 0x3f, 0x15, 0xf7,
-	0x15, 0xec, 0xde, 0x56, 0xd3, 0x84, 0x97, 0x11, 0x4d, 0x92, 0x92, 0x4b, 0xe9, 0x3d, 0xea, 0x83,
-	0xc1, 0x59, 0x78, 0x61, 0xd0, 0x91, 0x01, 0xdd, 0x1b, 0x08, 0xa5, 0xa2, 0xa5, 0x8a, 0x1a, 0xf9,
-	0xde, 0x89, 0x16, 0xd0, 0xc3, 0xc6, 0x1b, 0x6e, 0xbd, 0xe1, 0x4f, 0xad, 0xb7, 0xe0, 0x74, 0xb5,
-	0xf1, 0x9d, 0xc5, 0xd6, 0x07, 0xe1, 0x99, 0xee, 0x6b, 0x2a, 0xee, 0x0c, 0xc0, 0xee, 0x44, 0xc4,
-	0xe3, 0xaa, 0x88, 0x0a, 0x5e, 0x66, 0x22, 0x91, 0x5e, 0xa7, 0x7f, 0x32, 0x38, 0xbf, 0x46, 0xff,
-	0xb3, 0xf2, 0x51, 0x3f, 0x0b, 0x46, 0xcd, 0xb4, 0x1f, 0x5b, 0xff, 0x5d, 0x9a, 0xa9, 0xbb, 0x8a,
-	0xe1, 0x58, 0xe4, 0xc4, 0xee, 0xc4, 0x7c, 0x5e, 0xcb, 0x64, 0x4c, 0xee, 0x09, 0xad, 0xd4, 0xdd,
-	0x9f, 0x2d, 0xa9, 0x6f, 0x05, 0x97, 0x76, 0x82, 0x0c, 0x2f, 0x0c, 0xb1, 0x4d, 0xdd, 0x39, 0x80,
-	0x4f, 0xdb, 0xdf, 0xda, 0x6a, 0x79, 0xfc, 0x50, 0x5a, 0xba, 0x16, 0xb6, 0xf9, 0xfb, 0xd3, 0xd9,
-	0xd2, 0x77, 0xbe, 0x2f, 0x7d, 0x27, 0xf8, 0xb0, 0xda, 0x21, 0xb0, 0xde, 0x21, 0xf0, 0x6b, 0x87,
-	0xc0, 0x62, 0x8f, 0x9c, 0xf5, 0x1e, 0x39, 0x3f, 0xf7, 0xc8, 0xf9, 0x72, 0x75, 0x40, 0x67, 0x0e,
-	0xd8, 0x9e, 0xf1, 0xf0, 0x0d, 0xb9, 0x3f, 0xe6, 0x61, 0x4f, 0xf4, 0x42, 0xde, 0xfe, 0x0e, 0x00,
-	0x00, 0xff, 0xff, 0xeb, 0x55, 0x12, 0x0d, 0xea, 0x02, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x14, 0xcf, 0x35, 0x6e, 0xd4, 0x5e, 0x9b, 0xa4, 0xb2, 0xaa, 0xef, 0x37, 0x64, 0xb0, 0x43, 0x00,
+	0x11, 0x55, 0xc2, 0x6e, 0x8a, 0x18, 0x60, 0xab, 0x8b, 0x60, 0x60, 0xa9, 0x2c, 0xc4, 0xc0, 0x12,
+	0xce, 0xf6, 0xc5, 0xb5, 0x9a, 0xf8, 0x2c, 0xdf, 0xd9, 0x94, 0x99, 0xa5, 0x62, 0xea, 0xc8, 0xd8,
+	0x99, 0xff, 0x82, 0xad, 0x63, 0x47, 0x24, 0xa4, 0x16, 0x35, 0xff, 0x08, 0xba, 0x5f, 0x69, 0x53,
+	0x7e, 0x15, 0xa9, 0x02, 0x96, 0xe4, 0xee, 0xfd, 0xfc, 0xbc, 0xf7, 0x79, 0xf7, 0x0c, 0x2d, 0x5c,
+	0x8e, 0x09, 0x75, 0x4b, 0x4c, 0x59, 0x92, 0xc6, 0x6e, 0xd9, 0xd7, 0x47, 0x27, 0xcb, 0x09, 0x23,
+	0xe6, 0x8a, 0xd0, 0x3b, 0x5a, 0x58, 0xf6, 0xdb, 0x56, 0x48, 0x28, 0x77, 0x09, 0x10, 0xc5, 0x6e,
+	0xd9, 0x0f, 0x30, 0x43, 0x7d, 0x37, 0x24, 0x49, 0x2a, 0x3d, 0xda, 0xb7, 0x95, 0xfe, 0x3c, 0xa4,
+	0x34, 0x99, 0x89, 0xdb, 0x5e, 0x8d, 0x49, 0x4c, 0xc4, 0xd1, 0xe5, 0x27, 0x25, 0xb5, 0x63, 0x42,
+	0xe2, 0x11, 0x76, 0xc5, 0x2d, 0x28, 0x86, 0x2e, 0x4b, 0xc6, 0x98, 0x32, 0x34, 0xce, 0xa4, 0x41,
+	0xf7, 0xa3, 0x01, 0xff, 0xdb, 0x1a, 0xa1, 0xd7, 0x01, 0x0a, 0x77, 0x5f, 0xc8, 0x80, 0x9b, 0x61,
+	0x48, 0x8a, 0x94, 0x99, 0x01, 0x5c, 0xe5, 0x90, 0x06, 0x2a, 0xcf, 0x00, 0x49, 0x79, 0x0b, 0x74,
+	0x40, 0x6f, 0x69, 0x63, 0xcd, 0x91, 0xb0, 0x2e, 0x54, 0x22, 0x60, 0x39, 0x1e, 0xa2, 0x78, 0x36,
+	0x92, 0x67, 0x1c, 0x9f, 0xd8, 0xc0, 0x37, 0x83, 0x6f, 0x34, 0xe6, 0x1d, 0xd8, 0x18, 0x16, 0x69,
+	0x84, 0xf3, 0x01, 0x8a, 0xa2, 0x1c, 0x53, 0xda, 0x9a, 0xeb, 0x80, 0xde, 0xa2, 0x5f, 0x97, 0xd2,
+	0x4d, 0x29, 0x34, 0xb7, 0x20, 0xa4, 0x0c, 0xe5, 0x6c, 0xc0, 0xe1, 0xb7, 0xaa, 0x02, 0x40, 0xdb,
+	0x91, 0xb5, 0x39, 0xba, 0x36, 0xe7, 0xb9, 0xae, 0xcd, 0x5b, 0x38, 0x3a, 0xb1, 0x2b, 0x07, 0xa7,
+	0x36, 0xf0, 0x17, 0x85, 0x1f, 0xd7, 0x98, 0xfb, 0x00, 0x36, 0x46, 0x24, 0xdc, 0x2d, 0xb2, 0x41,
+	0x86, 0xf3, 0x84, 0x44, 0xb4, 0x65, 0x74, 0xaa, 0xbd, 0xa5, 0x0d, 0xeb, 0x47, 0xa5, 0x6c, 0x0b,
+	0x33, 0x6f, 0x93, 0x47, 0xfb, 0x70, 0x6a, 0x3f, 0x8c, 0x13, 0xb6, 0x53, 0x04, 0x4e, 0x48, 0xc6,
+	0xae, 0xe2, 0x44, 0xfe, 0xdd, 0xa3, 0xd1, 0xae, 0xbb, 0xe7, 0xa2, 0x82, 0xed, 0x4c, 0x59, 0x62,
+	0x6f, 0x32, 0x4c, 0x55, 0x04, 0xea, 0xd7, 0x65, 0x62, 0x75, 0x35, 0xdf, 0x01, 0xd8, 0xd4, 0x6d,
+	0xd5, 0x58, 0xe6, 0xff, 0x14, 0x96, 0x86, 0x12, 0x6b, 0x30, 0x0f, 0x60, 0x2d, 0xce, 0x51, 0xca,
+	0x68, 0xab, 0x26, 0x20, 0xfc, 0xef, 0x5c, 0x1e, 0x51, 0xe7, 0x29, 0xd7, 0x7b, 0x06, 0xcf, 0xed,
+	0x2b, 0xe3, 0x47, 0x0b, 0xfb, 0x87, 0x76, 0xe5, 0xfd, 0xa1, 0x5d, 0xe9, 0xbe, 0x35, 0xe0, 0xbc,
+	0xb0, 0xf8, 0x0e, 0x9d, 0xe0, 0xd7, 0x74, 0xce, 0x5d, 0x1b, 0x9d, 0xd5, 0x7f, 0x88, 0x4e, 0xe3,
+	0x6f, 0xd1, 0x59, 0xc2, 0x15, 0x92, 0x27, 0x71, 0x92, 0xa2, 0x91, 0x7e, 0xba, 0x6a, 0xb6, 0x6e,
+	0x68, 0x30, 0xfc, 0x21, 0x4e, 0x91, 0x6c, 0x91, 0x24, 0xf5, 0xd6, 0x15, 0x8e, 0xde, 0x4f, 0x71,
+	0xc8, 0xc4, 0xdc, 0x81, 0xfa, 0x4d, 0x9d, 0x44, 0xbd, 0xe8, 0xee, 0xe7, 0x39, 0xd8, 0xdc, 0xc6,
+	0x69, 0x94, 0xa4, 0xb1, 0x5e, 0x28, 0x57, 0x9d, 0x87, 0xbb, 0xb0, 0xa9, 0x96, 0xcb, 0xa5, 0x35,
+	0xd0, 0x50, 0x62, 0x6d, 0x78, 0x13, 0x2e, 0x47, 0x98, 0x9e, 0x5b, 0x55, 0x85, 0xd5, 0x12, 0x97,
+	0x69, 0x93, 0x10, 0xd6, 0xd0, 0x58, 0xec, 0x29, 0xe3, 0xfa, 0x8b, 0x56, 0xa1, 0xcd, 0x5b, 0xb0,
+	0x1e, 0x16, 0x8c, 0x0c, 0x87, 0x8a, 0xee, 0xd6, 0x7c, 0x07, 0xf4, 0x0c, 0x7f, 0x59, 0x0a, 0x25,
+	0x13, 0xe6, 0x33, 0xd8, 0xc0, 0xc3, 0x21, 0x0e, 0x59, 0x52, 0x62, 0x39, 0xe9, 0xb5, 0xdf, 0x98,
+	0xf4, 0xfa, 0xd4, 0x97, 0x6b, 0xbb, 0xaf, 0x60, 0xe3, 0x89, 0xec, 0x59, 0x96, 0xe5, 0xa4, 0x44,
+	0x23, 0xde, 0xb4, 0xe9, 0x66, 0x9e, 0x69, 0xae, 0x1e, 0x08, 0xdd, 0x91, 0xab, 0xed, 0x58, 0xef,
+	0xf1, 0xd1, 0x99, 0x05, 0x8e, 0xcf, 0x2c, 0xf0, 0xe5, 0xcc, 0x02, 0x07, 0x13, 0xab, 0x72, 0x3c,
+	0xb1, 0x2a, 0x9f, 0x26, 0x56, 0xe5, 0xe5, 0xda, 0x85, 0xfe, 0xc8, 0xaf, 0x9b, 0xfc, 0x2d, 0xfb,
+	0xeb, 0xee, 0xde, 0xec, 0x54, 0x06, 0x35, 0x51, 0xd4, 0xfd, 0xaf, 0x03, 0x00, 0x45, 0xb8, 0x2a,
+	0xa9, 0x07, 0x07, 0x00, 0x00,
 }
 
 func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -135,6 +397,20 @@ func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.VestingPeriods) > 0 {
 		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -159,89 +435,835 @@ func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 				i -= size
 				i = encodeVarintVesting(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintVesting(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.FunderAddress) > 0 {
-		i -= len(m.FunderAddress)
-		copy(dAtA[i:], m.FunderAddress)
-		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BaseVestingAccount != nil {
-		{
-			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintVesting(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OriginalVesting) > 0 {
+		for iNdEx := len(m.OriginalVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintVesting(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintVesting(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	if m.CutoffPeriod != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.CutoffPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunderApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunderApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunderApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VestingAddress) > 0 {
+		i -= len(m.VestingAddress)
+		copy(dAtA[i:], m.VestingAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.VestingAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovVesting(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovVesting(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.OriginalVesting) > 0 {
+		for _, e := range m.OriginalVesting {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if m.CutoffPeriod != 0 {
+		n += 1 + sovVesting(uint64(m.CutoffPeriod))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovVesting(uint64(l))
+	return n
+}
+
+func (m *FunderApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VestingAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	return n
+}
+
+func sovVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVesting(x uint64) (n int) {
+	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &types.BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalVesting = append(m.OriginalVesting, types1.Coin{})
+			if err := m.OriginalVesting[len(m.OriginalVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintVesting(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
-	offset -= sovVesting(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ClawbackVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseVestingAccount != nil {
-		l = m.BaseVestingAccount.Size()
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	l = len(m.FunderAddress)
-	if l > 0 {
-		n += 1 + l + sovVesting(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovVesting(uint64(l))
-	if len(m.LockupPeriods) > 0 {
-		for _, e := range m.LockupPeriods {
-			l = e.Size()
-			n += 1 + l + sovVesting(uint64(l))
-		}
-	}
-	if len(m.VestingPeriods) > 0 {
-		for _, e := range m.VestingPeriods {
-			l = e.Size()
-			n += 1 + l + sovVesting(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovVesting(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVesting(x uint64) (n int) {
-	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *PendingClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -264,17 +1286,17 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -284,31 +1306,27 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseVestingAccount == nil {
-				m.BaseVestingAccount = &types.BaseVestingAccount{}
-			}
-			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -336,13 +1354,13 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -352,28 +1370,27 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthVesting
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthVesting
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -400,14 +1417,33 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
-			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CutoffPeriod", wireType)
+			}
+			m.CutoffPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CutoffPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -434,10 +1470,123 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FunderApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FunderApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FunderApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex